@@ -149,6 +149,7 @@ var (
 		inheritancetypes.ModuleName:                  nil, // Inheritance module doesn't need minting/burning
 		validatortypes.ModuleName:                    {authtypes.Burner}, // Burns slashed tokens
 		bridgetypes.ModuleName:                       {authtypes.Minter, authtypes.Burner}, // Bridge needs mint/burn for wrapped assets
+		equitytypes.ModuleName:                       {authtypes.Minter, authtypes.Burner}, // Equity mints/burns share denoms on stock splits
 	}
 )
 
@@ -349,11 +350,15 @@ func NewShareHODLApp(
 		interfaceRegistry: interfaceRegistry,
 	}
 
+	// Every governance-gated keeper shares the authority the governance module signs
+	// executed proposal messages with
+	authority := authtypes.NewModuleAddress("gov").String()
+
 	// set the BaseApp's parameter store
 	app.ConsensusParamsKeeper = consensusparamkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[consensusparamtypes.StoreKey]),
-		authtypes.NewModuleAddress("gov").String(),
+		authority,
 		runtime.EventService{},
 	)
 	bApp.SetParamStore(app.ConsensusParamsKeeper.ParamsStore)
@@ -369,7 +374,7 @@ func NewShareHODLApp(
 		maccPerms,
 		addressCodec,
 		Bech32PrefixAccAddr,
-		authtypes.NewModuleAddress("gov").String(),
+		authority,
 	)
 
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
@@ -377,7 +382,7 @@ func NewShareHODLApp(
 		runtime.NewKVStoreService(keys[banktypes.StoreKey]),
 		app.AccountKeeper,
 		map[string]bool{},
-		authtypes.NewModuleAddress("gov").String(),
+		authority,
 		logger,
 	)
 
@@ -388,7 +393,7 @@ func NewShareHODLApp(
 		runtime.NewKVStoreService(keys[stakingtypes.StoreKey]),
 		app.AccountKeeper,
 		app.BankKeeper,
-		authtypes.NewModuleAddress("gov").String(),
+		authority,
 		validatorAddressCodec,
 		address.NewBech32Codec(Bech32PrefixConsAddr),
 	)
@@ -399,7 +404,7 @@ func NewShareHODLApp(
 		appCodec,
 		DefaultNodeHome,
 		app.BaseApp,
-		authtypes.NewModuleAddress("gov").String(),
+		authority,
 	)
 
 	// Initialize HODL keeper
@@ -409,7 +414,7 @@ func NewShareHODLApp(
 		memKeys[hodltypes.MemStoreKey],
 		app.BankKeeper,
 		app.AccountKeeper,
-		authtypes.NewModuleAddress(hodltypes.ModuleName).String(),
+		authority,
 	)
 
 	// Initialize Equity keeper (UniversalStakingKeeper wired later via SetStakingKeeper)
@@ -419,7 +424,7 @@ func NewShareHODLApp(
 		memKeys[equitytypes.MemStoreKey],
 		app.BankKeeper,
 		app.AccountKeeper,
-		authtypes.NewModuleAddress(governancetypes.ModuleName).String(),
+		authority,
 	)
 
 	// Initialize DEX keeper
@@ -431,6 +436,7 @@ func NewShareHODLApp(
 		app.AccountKeeper,
 		app.EquityKeeper,
 		app.HODLKeeper,
+		authority,
 	)
 
 	// Initialize Escrow keeper (staking keeper set later via SetStakingKeeper)
@@ -462,7 +468,7 @@ func NewShareHODLApp(
 		app.HODLKeeper,
 		nil, // UniversalStakingKeeper - set later after staking keeper is initialized
 		app.BankKeeper,
-		authtypes.NewModuleAddress("gov").String(),
+		authority,
 	)
 
 	// Initialize Agent keeper
@@ -470,7 +476,7 @@ func NewShareHODLApp(
 		appCodec,
 		runtime.NewKVStoreService(keys[agenttypes.StoreKey]),
 		logger,
-		authtypes.NewModuleAddress("gov").String(),
+		authority,
 		app.BankKeeper,
 	)
 
@@ -480,7 +486,7 @@ func NewShareHODLApp(
 	app.FeeAbstractionKeeper = feeabstractionkeeper.NewKeeper(
 		appCodec,
 		keys[feeabstractiontypes.StoreKey],
-		authtypes.NewModuleAddress("gov").String(),
+		authority,
 		app.AccountKeeper,
 		app.BankKeeper,
 		dexAdapter,
@@ -492,7 +498,7 @@ func NewShareHODLApp(
 		appCodec,
 		keys[universalstakingtypes.StoreKey],
 		memKeys[universalstakingtypes.MemStoreKey],
-		authtypes.NewModuleAddress("gov").String(), // Governance authority for param updates
+		authority, // Governance authority for param updates
 		app.BankKeeper,
 		app.AccountKeeper,
 		app.GovernanceKeeper,
@@ -517,18 +523,33 @@ func NewShareHODLApp(
 	// Prevents treasury/escrow/DEX module accounts from voting
 	app.GovernanceKeeper.SetAccountKeeper(app.AccountKeeper)
 
+	// Wire the message router into governance so passed proposals execute their messages
+	app.GovernanceKeeper.SetRouter(app.MsgServiceRouter())
+
 	// Wire universal staking into lending module
 	app.LendingKeeper.SetStakingKeeper(app.UniversalStakingKeeper)
 
 	// Wire universal staking into escrow module (for moderator tier checks)
 	app.EscrowKeeper.SetStakingKeeper(app.UniversalStakingKeeper)
 
+	// Wire DEX into escrow module (OTC block trades print to DEX trade history)
+	app.EscrowKeeper.SetDexKeeper(app.DexKeeper)
+
 	// Wire universal staking into equity module (for listing tier checks and stake locks)
 	app.EquityKeeper.SetStakingKeeper(app.UniversalStakingKeeper)
 
 	// Wire validator keeper into equity module (for audit verification)
 	app.EquityKeeper.SetValidatorKeeper(NewValidatorKeeperAdapter(app.ValidatorKeeper))
 
+	// Wire custody modules into equity stock splits (rescale orders, escrows and collateral)
+	app.EquityKeeper.SetShareSplitHooks(equitytypes.NewMultiShareSplitHooks(app.DexKeeper, app.EscrowKeeper, app.LendingKeeper))
+
+	// Wire custody modules into equity mergers (move orders, escrows and collateral to the acquirer)
+	app.EquityKeeper.SetShareExchangeHooks(equitytypes.NewMultiShareExchangeHooks(app.DexKeeper, app.EscrowKeeper, app.LendingKeeper))
+
+	// Wire DEX into equity module (cashless option and warrant exercise)
+	app.EquityKeeper.SetDexKeeper(app.DexKeeper)
+
 	// TODO: Wire DEX and HODL keepers into agent module (requires adapters for interface compatibility)
 	// app.AgentKeeper.SetDEXKeeper(&app.DexKeeper)
 	// app.AgentKeeper.SetHODLKeeper(&app.HODLKeeper)
@@ -542,7 +563,7 @@ func NewShareHODLApp(
 		app.AccountKeeper,
 		app.UniversalStakingKeeper,
 		app.EscrowKeeper, // For ban checking
-		authtypes.NewModuleAddress("gov").String(),
+		authority,
 	)
 
 	// Initialize Inheritance keeper (Dead Man Switch / Next of Kin)
@@ -558,7 +579,7 @@ func NewShareHODLApp(
 		equityKeeperAdapter, // Adapts equity keeper for inheritance interface
 		banKeeperAdapter,    // Adapts escrow ban checks to inheritance interface
 		app.HODLKeeper,      // For HODL token operations
-		authtypes.NewModuleAddress("gov").String(),
+		authority,
 	)
 
 	// Wire staking, lending, and escrow keepers into inheritance (for position transfers)
@@ -587,7 +608,7 @@ func NewShareHODLApp(
 		memKeys[bridgetypes.MemStoreKey],
 		app.BankKeeper,
 		app.ValidatorKeeper,
-		authtypes.NewModuleAddress("gov").String(),
+		authority,
 	)
 
 	// Initialize Explorer keeper (requires other keepers for indexing)
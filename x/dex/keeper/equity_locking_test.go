@@ -173,6 +173,10 @@ func (m *mockEquityKeeper) IsBlacklisted(ctx sdk.Context, companyID uint64, addr
 	return false
 }
 
+func (m *mockEquityKeeper) CheckTransferCompliance(ctx sdk.Context, companyID uint64, classID string, from, to string, shares math.Int) error {
+	return nil
+}
+
 // documentEquityLockingFlow documents how the equity locking flow should work
 // This is not a test, just documentation in code form
 func documentEquityLockingFlow() {
@@ -23,6 +23,9 @@ type Keeper struct {
 	accountKeeper types.AccountKeeper
 	equityKeeper  types.EquityKeeper
 	hodlKeeper    types.HODLKeeper
+
+	// authority is the address allowed to change parameters (governance)
+	authority string
 }
 
 // NewKeeper creates a new dex Keeper instance
@@ -34,6 +37,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	equityKeeper types.EquityKeeper,
 	hodlKeeper types.HODLKeeper,
+	authority string,
 ) *Keeper {
 	return &Keeper{
 		cdc:           cdc,
@@ -43,9 +47,15 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		equityKeeper:  equityKeeper,
 		hodlKeeper:    hodlKeeper,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the module's governance authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -616,6 +626,85 @@ func (k Keeper) PlaceOrder(
 	return updatedOrder, nil
 }
 
+// PlaceMarketSellOrder places an immediate-or-cancel market sell order on behalf of
+// another module (e.g. a cashless option exercise) and returns the quantity filled and
+// the net quote proceeds credited to the seller after fees
+func (k Keeper) PlaceMarketSellOrder(
+	ctx sdk.Context,
+	seller string,
+	marketSymbol string,
+	quantity math.Int,
+) (math.Int, math.Int, error) {
+	sellerAddr, err := sdk.AccAddressFromBech32(seller)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrUnauthorized
+	}
+	_, quoteSymbol := k.parseMarketSymbol(marketSymbol)
+	before := k.bankKeeper.GetBalance(ctx, sellerAddr, quoteSymbol).Amount
+
+	order, err := k.PlaceOrder(
+		ctx,
+		seller,
+		marketSymbol,
+		types.OrderSideSell,
+		types.OrderTypeMarket,
+		types.TimeInForceIOC,
+		quantity,
+		math.LegacyZeroDec(),
+		math.LegacyZeroDec(),
+		"",
+	)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), err
+	}
+
+	proceeds := k.bankKeeper.GetBalance(ctx, sellerAddr, quoteSymbol).Amount.Sub(before)
+	if proceeds.IsNegative() {
+		proceeds = math.ZeroInt()
+	}
+	return order.FilledQuantity, proceeds, nil
+}
+
+// PlaceLimitBuyOrder places an immediate-or-cancel limit buy order on behalf of
+// another module (e.g. an issuer buyback) and returns the quantity filled and the
+// quote amount that left the buyer's account
+func (k Keeper) PlaceLimitBuyOrder(
+	ctx sdk.Context,
+	buyer string,
+	marketSymbol string,
+	quantity math.Int,
+	price math.LegacyDec,
+) (math.Int, math.Int, error) {
+	buyerAddr, err := sdk.AccAddressFromBech32(buyer)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrUnauthorized
+	}
+	_, quoteSymbol := k.parseMarketSymbol(marketSymbol)
+	before := k.bankKeeper.GetBalance(ctx, buyerAddr, quoteSymbol).Amount
+
+	order, err := k.PlaceOrder(
+		ctx,
+		buyer,
+		marketSymbol,
+		types.OrderSideBuy,
+		types.OrderTypeLimit,
+		types.TimeInForceIOC,
+		quantity,
+		price,
+		math.LegacyZeroDec(),
+		"",
+	)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), err
+	}
+
+	spent := before.Sub(k.bankKeeper.GetBalance(ctx, buyerAddr, quoteSymbol).Amount)
+	if spent.IsNegative() {
+		spent = math.ZeroInt()
+	}
+	return order.FilledQuantity, spent, nil
+}
+
 // CancelOrder cancels an existing order
 func (k Keeper) CancelOrder(ctx sdk.Context, userAddr sdk.AccAddress, orderID uint64) error {
 	// Get order
@@ -689,7 +778,7 @@ func (k Keeper) GetLiquidityPool(ctx sdk.Context, baseSymbol, quoteSymbol string
 // SetLiquidityPool stores a liquidity pool
 func (k Keeper) SetLiquidityPool(ctx sdk.Context, pool types.LiquidityPool) error {
 	store := ctx.KVStore(k.storeKey)
-	key := types.GetLiquidityPoolKey(pool.MarketSymbol, "")
+	key := types.GetLiquidityPoolKey(k.parseMarketSymbol(pool.MarketSymbol))
 	bz, err := json.Marshal(pool)
 	if err != nil {
 		return fmt.Errorf("failed to marshal liquidity pool: %w", err)
@@ -701,7 +790,7 @@ func (k Keeper) SetLiquidityPool(ctx sdk.Context, pool types.LiquidityPool) erro
 // GetAllLiquidityPools returns all liquidity pools
 func (k Keeper) GetAllLiquidityPools(ctx sdk.Context) []types.LiquidityPool {
 	store := ctx.KVStore(k.storeKey)
-	iterator := store.Iterator(types.LiquidityPoolPrefix, nil)
+	iterator := storetypes.KVStorePrefixIterator(store, types.LiquidityPoolPrefix)
 	defer iterator.Close()
 
 	var pools []types.LiquidityPool
@@ -715,6 +804,12 @@ func (k Keeper) GetAllLiquidityPools(ctx sdk.Context) []types.LiquidityPool {
 	return pools
 }
 
+// DeleteLiquidityPool removes a liquidity pool
+func (k Keeper) DeleteLiquidityPool(ctx sdk.Context, baseSymbol, quoteSymbol string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLiquidityPoolKey(baseSymbol, quoteSymbol))
+}
+
 // LP Position Management for Beneficial Ownership Tracking
 
 // getNextLPPositionID returns the next LP position ID and increments the counter
@@ -429,6 +429,13 @@ func (k Keeper) executeTrade(
 		return types.Trade{}, err
 	}
 
+	// Equity settlement must satisfy the share class compliance rules
+	if companyID, found := k.GetCompanyIDBySymbol(ctx, takerOrder.BaseSymbol); found {
+		if err := k.equityKeeper.CheckTransferCompliance(ctx, companyID, "COMMON", sellerAddr, buyerAddr, quantity); err != nil {
+			return types.Trade{}, err
+		}
+	}
+
 	// SECURITY FIX: Use cache context for atomic operations
 	// If any transfer fails, the entire operation is rolled back
 	cacheCtx, writeCache := ctx.CacheContext()
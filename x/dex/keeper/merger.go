@@ -0,0 +1,288 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/dex/types"
+)
+
+// AfterShareExchange moves trading in a target symbol over to the acquirer symbol
+// after a merger in the equity module. By the time this runs the equity module has
+// already exchanged the module account's target balance for the acquirer symbol and
+// moved the beneficial owner records to the acquirer class, so every target amount
+// the DEX holds is restated in acquirer shares, rounded down like the registry.
+//
+// Markets with the target on either side are re-keyed to the acquirer symbol with
+// their prices restated at the exchange ratio, and their pools and LP positions
+// follow them. Pool LP tokens keep their denom, so whoever holds them can still
+// redeem them. A market whose acquirer counterpart already exists, that would
+// pair the acquirer with itself, or whose target was exchanged for cash only is
+// retired instead: it stops trading and its pool stays open for redemption,
+// paying the exchanged side in the acquirer symbol.
+// Open orders on the affected markets are cancelled and refunded, since their
+// prices were set against the target symbol.
+func (k Keeper) AfterShareExchange(
+	ctx sdk.Context,
+	targetID uint64, targetClassID, targetSymbol string,
+	acquirerID uint64, acquirerClassID, acquirerSymbol string,
+	numerator, denominator uint64,
+) error {
+	if denominator == 0 {
+		return types.ErrInvalidParameter.Wrap("exchange ratio denominator must be positive")
+	}
+	num := math.NewIntFromUint64(numerator)
+	den := math.NewIntFromUint64(denominator)
+	exchange := func(amount math.Int) math.Int {
+		if amount.IsNil() || !amount.IsPositive() {
+			return math.ZeroInt()
+		}
+		return amount.Mul(num).Quo(den)
+	}
+	// A target share is worth num/den acquirer shares, so prices in the target
+	// scale up by den/num when it is the base and down when it is the quote
+	basePrice := func(price math.LegacyDec) math.LegacyDec {
+		if price.IsNil() {
+			return price
+		}
+		return price.MulInt(den).QuoInt(num)
+	}
+	quotePrice := func(price math.LegacyDec) math.LegacyDec {
+		if price.IsNil() {
+			return price
+		}
+		return price.MulInt(num).QuoInt(den)
+	}
+
+	// Markets with the target symbol on either side
+	var markets []types.Market
+	marketIter := prefix.NewStore(ctx.KVStore(k.storeKey), types.MarketPrefix).Iterator(nil, nil)
+	for ; marketIter.Valid(); marketIter.Next() {
+		var market types.Market
+		if err := json.Unmarshal(marketIter.Value(), &market); err != nil {
+			continue
+		}
+		if market.BaseSymbol == targetSymbol || market.QuoteSymbol == targetSymbol {
+			markets = append(markets, market)
+		}
+	}
+	marketIter.Close()
+
+	cancelled, err := k.cancelMergerOrders(ctx, targetSymbol, acquirerID, acquirerClassID, acquirerSymbol, exchange)
+	if err != nil {
+		return err
+	}
+
+	// LP positions in the affected markets
+	affected := make(map[string]bool, len(markets))
+	for _, market := range markets {
+		affected[market.BaseSymbol+"/"+market.QuoteSymbol] = true
+	}
+	positions := make(map[string][]types.LPPosition)
+	positionIter := prefix.NewStore(ctx.KVStore(k.storeKey), types.LPPositionPrefix).Iterator(nil, nil)
+	for ; positionIter.Valid(); positionIter.Next() {
+		var position types.LPPosition
+		if err := json.Unmarshal(positionIter.Value(), &position); err != nil {
+			continue
+		}
+		if affected[position.MarketSymbol] {
+			positions[position.MarketSymbol] = append(positions[position.MarketSymbol], position)
+		}
+	}
+	positionIter.Close()
+
+	migrated, retired := 0, 0
+	for _, market := range markets {
+		oldSymbol := market.BaseSymbol + "/" + market.QuoteSymbol
+		baseSide := market.BaseSymbol == targetSymbol
+
+		newBase, newQuote := market.BaseSymbol, market.QuoteSymbol
+		if baseSide {
+			newBase = acquirerSymbol
+		} else {
+			newQuote = acquirerSymbol
+		}
+		newSymbol := newBase + "/" + newQuote
+
+		_, exists := k.GetMarket(ctx, newBase, newQuote)
+		// A cash-only merger leaves no acquirer price to restate the market at
+		rekey := numerator > 0 && !exists && newBase != newQuote
+
+		pool, hasPool := k.GetLiquidityPool(ctx, market.BaseSymbol, market.QuoteSymbol)
+		if hasPool {
+			pool.LPTokenDenom = pool.LPDenom()
+			if baseSide {
+				pool.BaseReserve = exchange(pool.BaseReserve)
+			} else {
+				pool.QuoteReserve = exchange(pool.QuoteReserve)
+			}
+			pool.UpdatedAt = ctx.BlockTime()
+		}
+
+		if rekey {
+			if baseSide {
+				market.BaseAssetID = acquirerID
+				market.LastPrice = basePrice(market.LastPrice)
+				market.High24h = basePrice(market.High24h)
+				market.Low24h = basePrice(market.Low24h)
+			} else {
+				if market.QuoteAssetID == targetID {
+					market.QuoteAssetID = acquirerID
+				}
+				market.LastPrice = quotePrice(market.LastPrice)
+				market.High24h = quotePrice(market.High24h)
+				market.Low24h = quotePrice(market.Low24h)
+			}
+			k.DeleteMarket(ctx, market.BaseSymbol, market.QuoteSymbol)
+			if hasPool {
+				k.DeleteLiquidityPool(ctx, market.BaseSymbol, market.QuoteSymbol)
+				pool.MarketSymbol = newSymbol
+			}
+			market.BaseSymbol, market.QuoteSymbol = newBase, newQuote
+			migrated++
+		} else {
+			market.Active = false
+			market.TradingHalted = true
+			market.ExchangedSymbol = targetSymbol
+			market.ExchangedFor = acquirerSymbol
+			retired++
+		}
+		market.UpdatedAt = ctx.BlockTime()
+		if err := k.SetMarket(ctx, market); err != nil {
+			return err
+		}
+		if hasPool {
+			if err := k.SetLiquidityPool(ctx, pool); err != nil {
+				return err
+			}
+		}
+
+		for _, position := range positions[oldSymbol] {
+			if rekey {
+				position.MarketSymbol = newSymbol
+			}
+			if baseSide {
+				position.BaseAmount = exchange(position.BaseAmount)
+				if position.CompanyID == targetID {
+					position.CompanyID = acquirerID
+				}
+			} else {
+				position.QuoteAmount = exchange(position.QuoteAmount)
+			}
+			if err := k.SetLPPosition(ctx, position); err != nil {
+				return err
+			}
+		}
+
+		eventType, symbol := types.EventTypeMarketMigrated, newSymbol
+		if !rekey {
+			eventType, symbol = types.EventTypeMarketRetired, oldSymbol
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute("market_symbol", symbol),
+				sdk.NewAttribute("previous_symbol", oldSymbol),
+				sdk.NewAttribute("exchanged_symbol", targetSymbol),
+				sdk.NewAttribute("exchanged_for", acquirerSymbol),
+				sdk.NewAttribute("exchange_ratio", fmt.Sprintf("%d:%d", numerator, denominator)),
+				sdk.NewAttribute("lp_positions", fmt.Sprintf("%d", len(positions[oldSymbol]))),
+			),
+		)
+	}
+
+	k.Logger(ctx).Info("moved DEX records to acquirer for merger",
+		"target_id", targetID,
+		"target_class_id", targetClassID,
+		"target_symbol", targetSymbol,
+		"acquirer_symbol", acquirerSymbol,
+		"orders_cancelled", cancelled,
+		"markets_migrated", migrated,
+		"markets_retired", retired,
+	)
+
+	return nil
+}
+
+// cancelMergerOrders cancels open orders with the target symbol on either side.
+// Funds locked in the target symbol are refunded in acquirer shares, anything
+// else is returned as it was locked.
+func (k Keeper) cancelMergerOrders(
+	ctx sdk.Context,
+	targetSymbol string,
+	acquirerID uint64, acquirerClassID, acquirerSymbol string,
+	exchange func(math.Int) math.Int,
+) (int, error) {
+	var orders []types.Order
+	orderIter := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderPrefix).Iterator(nil, nil)
+	for ; orderIter.Valid(); orderIter.Next() {
+		var order types.Order
+		if err := json.Unmarshal(orderIter.Value(), &order); err != nil {
+			continue
+		}
+		if (order.BaseSymbol == targetSymbol || order.QuoteSymbol == targetSymbol) && order.IsFillable() {
+			orders = append(orders, order)
+		}
+	}
+	orderIter.Close()
+
+	for _, order := range orders {
+		userAddr, err := sdk.AccAddressFromBech32(order.User)
+		if err != nil {
+			return 0, err
+		}
+		remaining := order.RemainingQuantity
+		if remaining.IsNil() {
+			remaining = order.Quantity.Sub(order.FilledQuantity)
+		}
+
+		var refund sdk.Coins
+		switch {
+		case order.Side == types.OrderSideSell && order.BaseSymbol == targetSymbol:
+			refund = sdk.NewCoins(sdk.NewCoin(acquirerSymbol, exchange(remaining)))
+			// The equity module moved the seller's record to the acquirer class
+			if k.equityKeeper != nil {
+				if err := k.equityKeeper.UnregisterBeneficialOwner(ctx, types.ModuleName, acquirerID, acquirerClassID, order.User, order.ID); err != nil {
+					k.Logger(ctx).Error("failed to unregister beneficial owner",
+						"order_id", order.ID,
+						"user", order.User,
+						"error", err,
+					)
+				}
+			}
+		case order.Side == types.OrderSideBuy && order.QuoteSymbol == targetSymbol:
+			refund = sdk.NewCoins(sdk.NewCoin(acquirerSymbol, exchange(order.Price.MulInt(remaining).TruncateInt())))
+		default:
+			if err := k.unlockOrderFunds(ctx, order); err != nil {
+				return 0, fmt.Errorf("failed to refund order %d: %w", order.ID, err)
+			}
+		}
+		if refund.IsAllPositive() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, userAddr, refund); err != nil {
+				return 0, fmt.Errorf("failed to refund order %d: %w", order.ID, err)
+			}
+		}
+
+		k.DeleteOrder(ctx, order.ID)
+		order.Status = types.OrderStatusCancelled
+		order.UpdatedAt = ctx.BlockTime()
+		if err := k.SetOrder(ctx, order); err != nil {
+			return 0, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOrderCancelled,
+				sdk.NewAttribute("order_id", fmt.Sprintf("%d", order.ID)),
+				sdk.NewAttribute("user", order.User),
+				sdk.NewAttribute("reason", "merger"),
+			),
+		)
+	}
+
+	return len(orders), nil
+}
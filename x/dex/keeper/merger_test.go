@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cometbfttypes "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/dex/keeper"
+	"github.com/sharehodl/sharehodl-blockchain/x/dex/types"
+)
+
+// mockBankKeeper keeps balances in memory; module accounts are addressed by
+// authtypes.NewModuleAddress like the real bank keeper
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (m *mockBankKeeper) move(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance := m.balances[from.String()]
+	if !balance.IsAllGTE(amt) {
+		return fmt.Errorf("insufficient funds: %s < %s", balance, amt)
+	}
+	m.balances[from.String()] = balance.Sub(amt...)
+	m.balances[to.String()] = m.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, m.balances[addr.String()].AmountOf(denom))
+}
+
+func (m *mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.move(fromAddr, toAddr, amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.move(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.move(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (m *mockBankKeeper) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	m.balances[addr] = m.balances[addr].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	return m.move(authtypes.NewModuleAddress(moduleName), authtypes.NewModuleAddress("burned"), amt)
+}
+
+// setupKeeper returns a dex keeper on an in-memory store
+func setupKeeper(t *testing.T) (*keeper.Keeper, sdk.Context, *mockBankKeeper) {
+	t.Helper()
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	memKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(memKey, storetypes.StoreTypeMemory, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	bank := &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+	k := keeper.NewKeeper(cdc, storeKey, memKey, bank, nil, nil, nil, authtypes.NewModuleAddress("gov").String())
+
+	header := cometbfttypes.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	ctx := sdk.NewContext(stateStore, header, false, log.NewNopLogger())
+
+	return k, ctx, bank
+}
+
+// TestAfterShareExchangeMigratesMarkets tests that a merger re-keys markets with
+// the target on either side to the acquirer symbol, retires markets it cannot
+// re-key, and leaves LP tokens redeemable by whoever holds them
+func TestAfterShareExchangeMigratesMarkets(t *testing.T) {
+	k, ctx, bank := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	module := authtypes.NewModuleAddress(types.ModuleName)
+
+	provider := sdk.AccAddress([]byte("lp_provider_________"))
+	holder := sdk.AccAddress([]byte("lp_token_holder_____"))
+	seller := sdk.AccAddress([]byte("order_seller________"))
+
+	setMarket := func(base, quote string, lastPrice int64) {
+		require.NoError(t, k.SetMarket(ctx, types.Market{
+			BaseSymbol:  base,
+			QuoteSymbol: quote,
+			Active:      true,
+			LastPrice:   math.LegacyNewDec(lastPrice),
+			High24h:     math.LegacyNewDec(lastPrice),
+			Low24h:      math.LegacyNewDec(lastPrice),
+		}))
+	}
+	setPool := func(base, quote string, baseReserve, quoteReserve, supply int64) {
+		require.NoError(t, k.SetLiquidityPool(ctx, types.LiquidityPool{
+			MarketSymbol:  base + "/" + quote,
+			BaseReserve:   math.NewInt(baseReserve),
+			QuoteReserve:  math.NewInt(quoteReserve),
+			LPTokenSupply: math.NewInt(supply),
+		}))
+	}
+
+	// TGT is exchanged 1:2 for ACQ; ACQ/XYZ already trades
+	setMarket("TGT", "HODL", 10)
+	setMarket("XYZ", "TGT", 4)
+	setMarket("TGT", "XYZ", 3)
+	setMarket("ACQ", "XYZ", 6)
+	setPool("TGT", "HODL", 1000, 10000, 2000)
+	setPool("XYZ", "TGT", 100, 400, 200)
+	setPool("TGT", "XYZ", 300, 900, 600)
+
+	_, found := k.GetLiquidityPool(ctx, "TGT", "HODL")
+	require.True(t, found, "pools are stored under the key they are read from")
+
+	require.NoError(t, k.SetLPPosition(ctx, types.LPPosition{
+		ID:            1,
+		Provider:      provider.String(),
+		MarketSymbol:  "TGT/HODL",
+		BaseAmount:    math.NewInt(1000),
+		QuoteAmount:   math.NewInt(10000),
+		LPTokenAmount: math.NewInt(2000),
+		CompanyID:     7,
+	}))
+	require.NoError(t, k.SetOrder(ctx, types.Order{
+		ID:                1,
+		MarketSymbol:      "TGT/HODL",
+		BaseSymbol:        "TGT",
+		QuoteSymbol:       "HODL",
+		User:              seller.String(),
+		Side:              types.OrderSideSell,
+		Status:            types.OrderStatusOpen,
+		Quantity:          math.NewInt(10),
+		FilledQuantity:    math.ZeroInt(),
+		RemainingQuantity: math.NewInt(10),
+		Price:             math.LegacyNewDec(10),
+	}))
+
+	// The provider handed some of their LP tokens to someone else
+	lpDenom := types.GetLPTokenDenom("TGT", "HODL")
+	bank.balances[provider.String()] = sdk.NewCoins(sdk.NewCoin(lpDenom, math.NewInt(1500)))
+	bank.balances[holder.String()] = sdk.NewCoins(sdk.NewCoin(lpDenom, math.NewInt(500)))
+
+	// The equity module has already exchanged the module's 1710 TGT for 855 ACQ
+	bank.balances[module.String()] = sdk.NewCoins(
+		sdk.NewCoin("ACQ", math.NewInt(855)),
+		sdk.NewCoin("HODL", math.NewInt(10000)),
+		sdk.NewCoin("XYZ", math.NewInt(1000)),
+	)
+
+	require.NoError(t, k.AfterShareExchange(ctx, 7, "COMMON", "TGT", 9, "COMMON", "ACQ", 1, 2))
+
+	// Base-side market moves to ACQ/HODL with its price doubled
+	_, found = k.GetMarket(ctx, "TGT", "HODL")
+	require.False(t, found)
+	market, found := k.GetMarket(ctx, "ACQ", "HODL")
+	require.True(t, found)
+	require.True(t, market.Active)
+	require.Equal(t, uint64(9), market.BaseAssetID)
+	require.Equal(t, math.LegacyNewDec(20), market.LastPrice)
+
+	pool, found := k.GetLiquidityPool(ctx, "ACQ", "HODL")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(500), pool.BaseReserve)
+	require.Equal(t, math.NewInt(10000), pool.QuoteReserve)
+	require.Equal(t, lpDenom, pool.LPDenom())
+
+	position, found := k.GetLPPosition(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, "ACQ/HODL", position.MarketSymbol)
+	require.Equal(t, math.NewInt(500), position.BaseAmount)
+	require.Equal(t, uint64(9), position.CompanyID)
+
+	// Quote-side market moves to XYZ/ACQ with its price halved
+	_, found = k.GetMarket(ctx, "XYZ", "TGT")
+	require.False(t, found)
+	market, found = k.GetMarket(ctx, "XYZ", "ACQ")
+	require.True(t, found)
+	require.Equal(t, math.LegacyNewDec(2), market.LastPrice)
+	pool, found = k.GetLiquidityPool(ctx, "XYZ", "ACQ")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(200), pool.QuoteReserve)
+
+	// TGT/XYZ cannot move onto the existing ACQ/XYZ market and is retired
+	market, found = k.GetMarket(ctx, "TGT", "XYZ")
+	require.True(t, found)
+	require.False(t, market.Active)
+	base, quote := market.SettlementSymbols()
+	require.Equal(t, "ACQ", base)
+	require.Equal(t, "XYZ", quote)
+	pool, found = k.GetLiquidityPool(ctx, "TGT", "XYZ")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(150), pool.BaseReserve)
+
+	// The open sell order is refunded in acquirer shares
+	order, found := k.GetOrder(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, types.OrderStatusCancelled, order.Status)
+	require.Equal(t, math.NewInt(5), bank.balances[seller.String()].AmountOf("ACQ"))
+
+	// The LP token holder redeems from the migrated pool, not the original provider
+	_, err := msgServer.RemoveLiquidity(ctx, &types.SimpleMsgRemoveLiquidity{
+		Creator:        holder.String(),
+		MarketSymbol:   "ACQ/HODL",
+		LPTokenAmount:  math.NewInt(500),
+		MinBaseAmount:  math.ZeroInt(),
+		MinQuoteAmount: math.ZeroInt(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(125), bank.balances[holder.String()].AmountOf("ACQ"))
+	require.Equal(t, math.NewInt(2500), bank.balances[holder.String()].AmountOf("HODL"))
+	require.True(t, bank.balances[holder.String()].AmountOf(lpDenom).IsZero())
+
+	// The retired market's pool pays the exchanged side in acquirer shares
+	bank.balances[holder.String()] = bank.balances[holder.String()].Add(
+		sdk.NewCoin(types.GetLPTokenDenom("TGT", "XYZ"), math.NewInt(600)))
+	_, err = msgServer.RemoveLiquidity(ctx, &types.SimpleMsgRemoveLiquidity{
+		Creator:        holder.String(),
+		MarketSymbol:   "TGT/XYZ",
+		LPTokenAmount:  math.NewInt(600),
+		MinBaseAmount:  math.ZeroInt(),
+		MinQuoteAmount: math.ZeroInt(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(275), bank.balances[holder.String()].AmountOf("ACQ"))
+	require.Equal(t, math.NewInt(900), bank.balances[holder.String()].AmountOf("XYZ"))
+	require.True(t, bank.balances[holder.String()].AmountOf("TGT").IsZero())
+}
@@ -362,6 +362,9 @@ func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.SimpleMsgAddLi
 	if !found {
 		return nil, errors.Wrapf(types.ErrInvalidMarket, "market %s not found", msg.MarketSymbol)
 	}
+	if !market.Active {
+		return nil, errors.Wrapf(types.ErrMarketInactive, "market %s is not active", msg.MarketSymbol)
+	}
 
 	// Get the liquidity pool
 	pool, found := k.GetLiquidityPool(ctx, market.BaseSymbol, market.QuoteSymbol)
@@ -414,7 +417,7 @@ func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.SimpleMsgAddLi
 
 	// MINT LP TOKENS TO USER'S WALLET
 	// LP token denom format: lp/{base}-{quote} (e.g., "lp/APPLE-HODL")
-	lpTokenDenom := types.GetLPTokenDenom(market.BaseSymbol, market.QuoteSymbol)
+	lpTokenDenom := pool.LPDenom()
 	lpCoins := sdk.NewCoins(sdk.NewCoin(lpTokenDenom, lpTokensToMint))
 
 	// Mint LP tokens to module first
@@ -540,7 +543,8 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.SimpleMsgRe
 	}
 
 	// VERIFY USER OWNS LP TOKENS
-	lpTokenDenom := types.GetLPTokenDenom(market.BaseSymbol, market.QuoteSymbol)
+	// Whoever holds the LP tokens redeems them, whether or not they provided the liquidity
+	lpTokenDenom := pool.LPDenom()
 	userLPBalance := k.bankKeeper.GetBalance(ctx, creatorAddr, lpTokenDenom)
 	if userLPBalance.Amount.LT(msg.LPTokenAmount) {
 		return nil, errors.Wrapf(types.ErrInsufficientFunds,
@@ -573,10 +577,9 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.SimpleMsgRe
 	}
 
 	// Transfer assets back to provider
-	coins := sdk.NewCoins(
-		sdk.NewCoin(market.BaseSymbol, baseReceived),
-		sdk.NewCoin(market.QuoteSymbol, quoteReceived),
-	)
+	// A market retired by a merger pays the exchanged side in the acquirer symbol
+	baseDenom, quoteDenom := market.SettlementSymbols()
+	coins := sdk.NewCoins(sdk.NewCoin(baseDenom, baseReceived)).Add(sdk.NewCoin(quoteDenom, quoteReceived))
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, coins); err != nil {
 		return nil, errors.Wrap(err, "failed to transfer funds to provider")
 	}
@@ -661,6 +664,36 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.SimpleMsgRe
 	}, nil
 }
 
+// SetFeeTier handles maker and taker fee updates from governance
+func (k msgServer) SetFeeTier(goCtx context.Context, msg *types.MsgSetFeeTier) (*types.MsgSetFeeTierResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Verify authority
+	if msg.Authority != k.GetAuthority() {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	params.MakerFee = msg.MakerFee
+	params.TakerFee = msg.TakerFee
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidParameter, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"fee_tier_updated",
+			sdk.NewAttribute("maker_fee", msg.MakerFee.String()),
+			sdk.NewAttribute("taker_fee", msg.TakerFee.String()),
+		),
+	)
+
+	return &types.MsgSetFeeTierResponse{Success: true}, nil
+}
+
 // Swap handles AMM swaps using constant product formula (x * y = k)
 func (k msgServer) Swap(goCtx context.Context, msg *types.SimpleMsgSwap) (*types.MsgSwapResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -676,6 +709,9 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.SimpleMsgSwap) (*types
 	if !found {
 		return nil, errors.Wrapf(types.ErrInvalidMarket, "market %s not found", msg.MarketSymbol)
 	}
+	if !market.Active {
+		return nil, errors.Wrapf(types.ErrMarketInactive, "market %s is not active", msg.MarketSymbol)
+	}
 
 	// Get the liquidity pool
 	pool, found := k.GetLiquidityPool(ctx, market.BaseSymbol, market.QuoteSymbol)
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/dex/keeper"
+	"github.com/sharehodl/sharehodl-blockchain/x/dex/types"
+)
+
+// TestSetFeeTier tests that governance sets the maker and taker fees without
+// touching any other parameter
+func TestSetFeeTier(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	before := k.GetParams(ctx)
+
+	_, err := msgServer.SetFeeTier(ctx, &types.MsgSetFeeTier{
+		Authority: authtypes.NewModuleAddress("trader").String(),
+		MakerFee:  math.LegacyNewDecWithPrec(5, 4),
+		TakerFee:  math.LegacyNewDecWithPrec(15, 4),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.SetFeeTier(ctx, &types.MsgSetFeeTier{
+		Authority: k.GetAuthority(),
+		MakerFee:  math.LegacyNewDecWithPrec(5, 4),
+		TakerFee:  math.LegacyNewDec(2),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidParameter)
+
+	_, err = msgServer.SetFeeTier(ctx, &types.MsgSetFeeTier{
+		Authority: k.GetAuthority(),
+		MakerFee:  math.LegacyNewDecWithPrec(5, 4),
+		TakerFee:  math.LegacyNewDecWithPrec(15, 4),
+	})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 4), k.GetMakerFee(ctx))
+	require.Equal(t, math.LegacyNewDecWithPrec(15, 4), k.GetTakerFee(ctx))
+
+	after := k.GetParams(ctx)
+	after.MakerFee, after.TakerFee = before.MakerFee, before.TakerFee
+	require.Equal(t, before, after)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/dex/types"
+)
+
+// RecordOffBookTrade prints a trade that was negotiated and settled outside the order book
+// (e.g. an escrow-backed OTC block trade) to trade history and trading statistics.
+// No assets move here; settlement is the caller's responsibility. The lit market's last
+// price and 24h volume are left untouched so a block print cannot move the market or
+// count towards the volume circuit breaker.
+func (k Keeper) RecordOffBookTrade(
+	ctx sdk.Context,
+	marketSymbol string,
+	buyer, seller string,
+	quantity math.Int,
+	price math.LegacyDec,
+	buyerFee, sellerFee math.LegacyDec,
+	referenceID uint64,
+) (uint64, error) {
+	if marketSymbol == "" {
+		return 0, types.ErrInvalidMarket
+	}
+	if _, err := sdk.AccAddressFromBech32(buyer); err != nil {
+		return 0, err
+	}
+	if _, err := sdk.AccAddressFromBech32(seller); err != nil {
+		return 0, err
+	}
+	if quantity.IsNil() || !quantity.IsPositive() {
+		return 0, types.ErrInvalidOrderSize
+	}
+	if price.IsNil() || !price.IsPositive() {
+		return 0, types.ErrInvalidPrice
+	}
+
+	trade := types.NewTrade(
+		k.GetNextTradeID(ctx),
+		marketSymbol,
+		0, 0, // No resting orders back an off-book trade
+		buyer,
+		seller,
+		quantity,
+		price,
+		buyerFee,
+		sellerFee,
+		false,
+	)
+	trade.OffBook = true
+	trade.ReferenceID = referenceID
+	trade.ExecutedAt = ctx.BlockTime()
+
+	if err := k.SetTrade(ctx, trade); err != nil {
+		return 0, fmt.Errorf("failed to store trade: %w", err)
+	}
+
+	k.UpdateMarketStatsOnTrade(ctx, trade)
+	k.UpdateTraderStatsOnTrade(ctx, trade)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOffBookTrade,
+			sdk.NewAttribute("trade_id", fmt.Sprintf("%d", trade.ID)),
+			sdk.NewAttribute("market", marketSymbol),
+			sdk.NewAttribute("buyer", buyer),
+			sdk.NewAttribute("seller", seller),
+			sdk.NewAttribute("quantity", quantity.String()),
+			sdk.NewAttribute("price", price.String()),
+			sdk.NewAttribute("value", trade.Value.String()),
+			sdk.NewAttribute("reference_id", fmt.Sprintf("%d", referenceID)),
+		),
+	)
+
+	return trade.ID, nil
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/dex/types"
+)
+
+// AfterShareSplit rescales open orders, markets, pools and LP positions for an
+// equity symbol after a stock split in the equity module. Quantities round down
+// (matching the beneficial owner registry) and prices scale by the inverse ratio.
+// The symbol balances held by the module account are rescaled by the equity module.
+func (k Keeper) AfterShareSplit(ctx sdk.Context, companyID uint64, classID, symbol string, numerator, denominator uint64) error {
+	if numerator == 0 || denominator == 0 {
+		return types.ErrInvalidParameter.Wrap("split ratio terms must be positive")
+	}
+	num := math.NewIntFromUint64(numerator)
+	den := math.NewIntFromUint64(denominator)
+	scaleQty := func(amount math.Int) math.Int {
+		if amount.IsNil() {
+			return amount
+		}
+		return amount.Mul(num).Quo(den)
+	}
+	scalePrice := func(price math.LegacyDec) math.LegacyDec {
+		if price.IsNil() {
+			return price
+		}
+		return price.MulInt(den).QuoInt(num)
+	}
+
+	// Open orders on the base side of the symbol
+	var orders []types.Order
+	orderIter := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderPrefix).Iterator(nil, nil)
+	for ; orderIter.Valid(); orderIter.Next() {
+		var order types.Order
+		if err := json.Unmarshal(orderIter.Value(), &order); err != nil {
+			continue
+		}
+		if order.BaseSymbol == symbol && order.IsFillable() {
+			orders = append(orders, order)
+		}
+	}
+	orderIter.Close()
+
+	for _, order := range orders {
+		// Remove the price-level index entry before the price changes
+		k.DeleteOrder(ctx, order.ID)
+
+		order.FilledQuantity = scaleQty(order.FilledQuantity)
+		order.RemainingQuantity = scaleQty(order.RemainingQuantity)
+		order.Quantity = order.FilledQuantity.Add(order.RemainingQuantity)
+		order.Price = scalePrice(order.Price)
+		order.StopPrice = scalePrice(order.StopPrice)
+		order.AveragePrice = scalePrice(order.AveragePrice)
+		order.UpdatedAt = ctx.BlockTime()
+		if order.RemainingQuantity.IsZero() {
+			order.Status = types.OrderStatusCancelled
+		}
+		if err := k.SetOrder(ctx, order); err != nil {
+			return err
+		}
+	}
+
+	// Markets quoted against the symbol
+	var markets []types.Market
+	marketIter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetMarketKey(symbol, "")).Iterator(nil, nil)
+	for ; marketIter.Valid(); marketIter.Next() {
+		var market types.Market
+		if err := json.Unmarshal(marketIter.Value(), &market); err != nil {
+			continue
+		}
+		markets = append(markets, market)
+	}
+	marketIter.Close()
+
+	for _, market := range markets {
+		market.LastPrice = scalePrice(market.LastPrice)
+		market.High24h = scalePrice(market.High24h)
+		market.Low24h = scalePrice(market.Low24h)
+		market.UpdatedAt = ctx.BlockTime()
+		if err := k.SetMarket(ctx, market); err != nil {
+			return err
+		}
+	}
+
+	// Pool reserves and the LP positions drawing on them
+	for _, pool := range k.GetAllLiquidityPools(ctx) {
+		if base, _ := k.parseMarketSymbol(pool.MarketSymbol); base != symbol {
+			continue
+		}
+		pool.BaseReserve = scaleQty(pool.BaseReserve)
+		pool.UpdatedAt = ctx.BlockTime()
+		if err := k.SetLiquidityPool(ctx, pool); err != nil {
+			return err
+		}
+	}
+
+	var positions []types.LPPosition
+	positionIter := prefix.NewStore(ctx.KVStore(k.storeKey), types.LPPositionPrefix).Iterator(nil, nil)
+	for ; positionIter.Valid(); positionIter.Next() {
+		var position types.LPPosition
+		if err := json.Unmarshal(positionIter.Value(), &position); err != nil {
+			continue
+		}
+		if base, _ := k.parseMarketSymbol(position.MarketSymbol); base == symbol {
+			positions = append(positions, position)
+		}
+	}
+	positionIter.Close()
+
+	for _, position := range positions {
+		position.BaseAmount = scaleQty(position.BaseAmount)
+		position.UpdatedAt = ctx.BlockTime()
+		if err := k.SetLPPosition(ctx, position); err != nil {
+			return err
+		}
+	}
+
+	k.Logger(ctx).Info("rescaled DEX records for stock split",
+		"company_id", companyID,
+		"class_id", classID,
+		"symbol", symbol,
+		"orders", len(orders),
+		"markets", len(markets),
+		"lp_positions", len(positions),
+	)
+
+	return nil
+}
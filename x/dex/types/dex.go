@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 	
 	"cosmossdk.io/math"
@@ -148,10 +149,30 @@ type Market struct {
 	MakerFee        math.LegacyDec `json:"maker_fee"`         // Fee for market makers (negative = rebate)
 	TakerFee        math.LegacyDec `json:"taker_fee"`         // Fee for market takers
 	
+	// Set on a market retired by a merger: the exchanged symbol's side of its pool
+	// is paid out in the acquirer symbol
+	ExchangedSymbol string         `json:"exchanged_symbol,omitempty"` // Target symbol of the merger
+	ExchangedFor    string         `json:"exchanged_for,omitempty"`    // Acquirer symbol it was exchanged for
+	
 	CreatedAt       time.Time      `json:"created_at"`
 	UpdatedAt       time.Time      `json:"updated_at"`
 }
 
+// SettlementSymbols returns the symbols the market's pool pays out, with a
+// symbol exchanged in a merger replaced by the acquirer symbol
+func (m Market) SettlementSymbols() (string, string) {
+	base, quote := m.BaseSymbol, m.QuoteSymbol
+	if m.ExchangedSymbol != "" {
+		if base == m.ExchangedSymbol {
+			base = m.ExchangedFor
+		}
+		if quote == m.ExchangedSymbol {
+			quote = m.ExchangedFor
+		}
+	}
+	return base, quote
+}
+
 // Order represents a trading order
 type Order struct {
 	ID              uint64         `json:"id"`                // Unique order ID
@@ -209,6 +230,10 @@ type Trade struct {
 	// Market making
 	BuyerIsMaker    bool           `json:"buyer_is_maker"`    // Whether buyer was market maker
 	
+	// Off-book settlement (negotiated block trades settled outside the order book)
+	OffBook         bool           `json:"off_book,omitempty"`     // Printed to history but never matched
+	ReferenceID     uint64         `json:"reference_id,omitempty"` // Settling block trade ID for off-book prints
+	
 	ExecutedAt      time.Time      `json:"executed_at"`       // Trade execution time
 }
 
@@ -242,6 +267,7 @@ type LiquidityPool struct {
 	
 	// Pool tokens
 	LPTokenSupply   math.Int       `json:"lp_token_supply"`   // Total LP token supply
+	LPTokenDenom    string         `json:"lp_token_denom,omitempty"` // Set when a merger re-keys the pool
 	
 	// Pool configuration
 	Fee             math.LegacyDec `json:"fee"`               // Pool trading fee
@@ -254,6 +280,16 @@ type LiquidityPool struct {
 	UpdatedAt       time.Time      `json:"updated_at"`
 }
 
+// LPDenom returns the denom of the pool's LP tokens. A pool re-keyed by a merger
+// keeps the denom its LP tokens were minted under.
+func (p LiquidityPool) LPDenom() string {
+	if p.LPTokenDenom != "" {
+		return p.LPTokenDenom
+	}
+	base, quote, _ := strings.Cut(p.MarketSymbol, "/")
+	return GetLPTokenDenom(base, quote)
+}
+
 // LPPosition represents a user's liquidity provision position
 // This tracks beneficial ownership of equity in liquidity pools
 type LPPosition struct {
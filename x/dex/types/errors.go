@@ -93,4 +93,7 @@ var (
 	EventTypeOrderPartiallyFilled   = "order_partially_filled"
 	EventTypeOrderExpired          = "order_expired"
 	EventTypeOrderCancelled        = "order_cancelled"
+	EventTypeOffBookTrade          = "off_book_trade_executed"
+	EventTypeMarketMigrated        = "market_migrated"
+	EventTypeMarketRetired         = "market_retired"
 )
\ No newline at end of file
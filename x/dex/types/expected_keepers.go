@@ -46,6 +46,9 @@ type EquityKeeper interface {
 
 	// Blacklist integration - check if an address is blacklisted from trading a company's shares
 	IsBlacklisted(ctx sdk.Context, companyID uint64, address string) bool
+
+	// Compliance integration - evaluate share class transfer rules before settlement
+	CheckTransferCompliance(ctx sdk.Context, companyID uint64, classID string, from, to string, shares math.Int) error
 }
 
 // HODLKeeper defines the expected interface for interacting with HODL stablecoin
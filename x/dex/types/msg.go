@@ -79,6 +79,14 @@ type SimpleMsgRemoveLiquidity struct {
 	MinQuoteAmount  math.Int        `json:"min_quote_amount"`  // Minimum quote to receive
 }
 
+// MsgSetFeeTier sets the maker and taker fees (governance only). It touches no
+// other parameter, so it can be allowlisted for optimistic governance.
+type MsgSetFeeTier struct {
+	Authority       string          `json:"authority"`
+	MakerFee        math.LegacyDec  `json:"maker_fee"`         // Fee for makers
+	TakerFee        math.LegacyDec  `json:"taker_fee"`         // Fee for takers
+}
+
 // SimpleMsgSwap performs an AMM swap
 type SimpleMsgSwap struct {
 	Creator         string          `json:"creator"`
@@ -142,6 +150,10 @@ type MsgRemoveLiquidityResponse struct {
 }
 
 // MsgSwapResponse returns swap result
+type MsgSetFeeTierResponse struct {
+	Success         bool            `json:"success"`
+}
+
 type MsgSwapResponse struct {
 	OutputAmount    math.Int        `json:"output_amount"`
 	Fee             math.LegacyDec  `json:"fee"`
@@ -319,6 +331,26 @@ func (msg SimpleMsgRemoveLiquidity) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic validates MsgSetFeeTier
+func (msg MsgSetFeeTier) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.MakerFee.IsNil() || msg.MakerFee.IsNegative() || msg.MakerFee.GT(math.LegacyOneDec()) {
+		return ErrInvalidParameter.Wrap("maker fee must be between 0 and 100%")
+	}
+	if msg.TakerFee.IsNil() || msg.TakerFee.IsNegative() || msg.TakerFee.GT(math.LegacyOneDec()) {
+		return ErrInvalidParameter.Wrap("taker fee must be between 0 and 100%")
+	}
+	return nil
+}
+
+// GetSigners returns the signers of MsgSetFeeTier
+func (msg MsgSetFeeTier) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
 // ValidateBasic validates SimpleMsgSwap
 func (msg SimpleMsgSwap) ValidateBasic() error {
 	if msg.Creator == "" {
@@ -14,6 +14,7 @@ type MsgServer interface {
 	AddLiquidity(context.Context, *SimpleMsgAddLiquidity) (*MsgAddLiquidityResponse, error)
 	RemoveLiquidity(context.Context, *SimpleMsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
 	Swap(context.Context, *SimpleMsgSwap) (*MsgSwapResponse, error)
+	SetFeeTier(context.Context, *MsgSetFeeTier) (*MsgSetFeeTierResponse, error)
 	
 	// Blockchain-native trading features
 	PlaceAtomicSwapOrder(context.Context, *MsgPlaceAtomicSwapOrder) (*MsgPlaceAtomicSwapOrderResponse, error)
@@ -327,6 +327,12 @@ func (k Keeper) ProcessAntiDilutionAdjustments(
 		return nil, types.ErrShareClassNotFound
 	}
 
+	// Broad-based weighted average counts options and warrants outstanding (fully diluted)
+	sharesBefore := shareClass.OutstandingShares
+	if provision.ProvisionType == types.AntiDilutionBroadBasedWeightedAverage {
+		sharesBefore = sharesBefore.Add(k.GetOutstandingGrantShares(ctx, issuanceRecord.CompanyID, issuanceRecord.ClassID))
+	}
+
 	// Process each shareholder
 	for _, holding := range shareholders {
 		// Skip the recipient of the new issuance (they don't get protection on their own purchase)
@@ -350,7 +356,7 @@ func (k Keeper) ProcessAntiDilutionAdjustments(
 				holding.Shares,
 				issuanceRecord.PreviousPrice,
 				issuanceRecord.IssuePrice,
-				shareClass.OutstandingShares,
+				sharesBefore,
 				issuanceRecord.SharesIssued,
 			)
 
@@ -485,6 +491,11 @@ func (k Keeper) IssueSharesWithAntiDilution(
 		return nil, err
 	}
 
+	// Lower conversion prices of classes that convert into the issued class
+	if err := k.AdjustConversionPrices(ctx, record); err != nil {
+		return nil, err
+	}
+
 	return adjustments, nil
 }
 
@@ -76,6 +76,7 @@ func (k Keeper) RegisterBeneficialOwner(
 	if err != nil {
 		return types.ErrInternalError.Wrap(err.Error())
 	}
+	k.journalBeneficialOwner(ctx, moduleAccount, companyID, classID, beneficialOwner, refID, shares)
 	store.Set(key, bz)
 
 	// Create index for fast lookup by module
@@ -126,6 +127,7 @@ func (k Keeper) UnregisterBeneficialOwner(
 	}
 
 	// Delete the record
+	k.journalBeneficialOwner(ctx, moduleAccount, companyID, classID, beneficialOwner, refID, math.ZeroInt())
 	store.Delete(key)
 
 	k.Logger(ctx).Info("unregistered beneficial owner",
@@ -168,6 +170,32 @@ func (k Keeper) GetBeneficialOwnershipsByOwner(
 	return ownerships, nil
 }
 
+// GetCompanyBeneficialOwnerships returns every beneficial ownership record for a share class,
+// whichever module holds the shares. Governance uses it to snapshot holders whose shares
+// sit in LP pools, escrow, etc. alongside direct holders.
+func (k Keeper) GetCompanyBeneficialOwnerships(
+	ctx sdk.Context,
+	companyID uint64,
+	classID string,
+) []BeneficialOwnership {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BeneficialOwnerPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ownerships := []BeneficialOwnership{}
+	for ; iterator.Valid(); iterator.Next() {
+		var ownership BeneficialOwnership
+		if err := json.Unmarshal(iterator.Value(), &ownership); err != nil {
+			continue
+		}
+		if ownership.CompanyID == companyID && ownership.ClassID == classID {
+			ownerships = append(ownerships, ownership)
+		}
+	}
+
+	return ownerships
+}
+
 // GetBeneficialOwnersForModule returns all beneficial ownership records for a specific module and share class
 func (k Keeper) GetBeneficialOwnersForModule(
 	ctx sdk.Context,
@@ -518,6 +546,7 @@ func (k Keeper) UpdateBeneficialOwnerShares(
 	if err != nil {
 		return types.ErrInternalError.Wrap(err.Error())
 	}
+	k.journalBeneficialOwner(ctx, moduleAccount, companyID, classID, beneficialOwner, refID, newShares)
 	store.Set(key, bz)
 
 	k.Logger(ctx).Info("updated beneficial owner shares",
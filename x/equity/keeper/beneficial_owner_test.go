@@ -272,3 +272,24 @@ func TestBeneficialOwnerAggregation(t *testing.T) {
 	// Dividend should be calculated for all 2000 shares
 	t.Skip("Requires keeper setup")
 }
+
+// TestGetCompanyBeneficialOwnerships tests listing a share class's beneficial
+// owners across every module that holds its shares
+func TestGetCompanyBeneficialOwnerships(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+
+	require.NoError(t, k.RegisterBeneficialOwner(ctx, "escrow", 1, "COMMON", testAddr1, math.NewInt(500), 1, "escrow"))
+	require.NoError(t, k.RegisterBeneficialOwner(ctx, "dex", 1, "COMMON", testAddr2, math.NewInt(200), 7, "order"))
+	require.NoError(t, k.RegisterBeneficialOwner(ctx, "dex", 1, "PREFERRED", testAddr3, math.NewInt(100), 8, "order"))
+	require.NoError(t, k.RegisterBeneficialOwner(ctx, "escrow", 2, "COMMON", testAddr3, math.NewInt(300), 2, "escrow"))
+
+	ownerships := k.GetCompanyBeneficialOwnerships(ctx, 1, "COMMON")
+	require.Len(t, ownerships, 2)
+
+	owners := map[string]math.Int{}
+	for _, ownership := range ownerships {
+		owners[ownership.BeneficialOwner] = ownership.Shares
+	}
+	require.Equal(t, math.NewInt(500), owners[testAddr1])
+	require.Equal(t, math.NewInt(200), owners[testAddr2])
+}
@@ -0,0 +1,372 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// =============================================================================
+// BOARD OF DIRECTORS
+// Per-company boards with fixed-term seats, independence flags and committees.
+// The company owner appoints the initial directors; once shareholders elect the
+// board through a company proposal, seats change only through further elections.
+// =============================================================================
+
+// SetBoard stores a company's board configuration
+func (k Keeper) SetBoard(ctx sdk.Context, board types.Board) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(board)
+	if err != nil {
+		return fmt.Errorf("failed to marshal board: %w", err)
+	}
+	store.Set(types.GetBoardKey(board.CompanyID), bz)
+	return nil
+}
+
+// GetBoard returns a company's board configuration
+func (k Keeper) GetBoard(ctx sdk.Context, companyID uint64) (types.Board, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBoardKey(companyID))
+	if bz == nil {
+		return types.Board{}, false
+	}
+
+	var board types.Board
+	if err := json.Unmarshal(bz, &board); err != nil {
+		return types.Board{}, false
+	}
+	return board, true
+}
+
+// SetBoardSeat stores a director's seat
+func (k Keeper) SetBoardSeat(ctx sdk.Context, seat types.BoardSeat) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(seat)
+	if err != nil {
+		return fmt.Errorf("failed to marshal board seat: %w", err)
+	}
+	store.Set(types.GetBoardSeatKey(seat.CompanyID, seat.Director), bz)
+	return nil
+}
+
+// GetBoardSeat returns a director's seat on a company board
+func (k Keeper) GetBoardSeat(ctx sdk.Context, companyID uint64, director string) (types.BoardSeat, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBoardSeatKey(companyID, director))
+	if bz == nil {
+		return types.BoardSeat{}, false
+	}
+
+	var seat types.BoardSeat
+	if err := json.Unmarshal(bz, &seat); err != nil {
+		return types.BoardSeat{}, false
+	}
+	return seat, true
+}
+
+// GetBoardSeats returns every seat of a company's board, including expired terms not yet vacated
+func (k Keeper) GetBoardSeats(ctx sdk.Context, companyID uint64) []types.BoardSeat {
+	return k.getBoardSeats(ctx, types.GetBoardSeatsPrefix(companyID))
+}
+
+// GetActiveBoardSeats returns the seats of a company's board whose term covers the block time
+func (k Keeper) GetActiveBoardSeats(ctx sdk.Context, companyID uint64) []types.BoardSeat {
+	var active []types.BoardSeat
+	for _, seat := range k.GetBoardSeats(ctx, companyID) {
+		if seat.IsActive(ctx.BlockTime()) {
+			active = append(active, seat)
+		}
+	}
+	return active
+}
+
+func (k Keeper) getBoardSeats(ctx sdk.Context, keyPrefix []byte) []types.BoardSeat {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var seats []types.BoardSeat
+	for ; iterator.Valid(); iterator.Next() {
+		var seat types.BoardSeat
+		if err := json.Unmarshal(iterator.Value(), &seat); err != nil {
+			continue
+		}
+		seats = append(seats, seat)
+	}
+	return seats
+}
+
+// IsBoardMember reports whether an address holds an active seat on a company's board
+func (k Keeper) IsBoardMember(ctx sdk.Context, companyID uint64, address string) bool {
+	seat, found := k.GetBoardSeat(ctx, companyID, address)
+	return found && seat.IsActive(ctx.BlockTime())
+}
+
+// IsIndependentBoardMember reports whether an address holds an active independent seat
+func (k Keeper) IsIndependentBoardMember(ctx sdk.Context, companyID uint64, address string) bool {
+	seat, found := k.GetBoardSeat(ctx, companyID, address)
+	return found && seat.Independent && seat.IsActive(ctx.BlockTime())
+}
+
+// ConfigureBoard creates or updates a company's board. Only the company owner may
+// configure the board, and not below the number of directors currently seated.
+func (k Keeper) ConfigureBoard(ctx sdk.Context, board types.Board, configuredBy string) error {
+	if _, found := k.getCompany(ctx, board.CompanyID); !found {
+		return types.ErrCompanyNotFound
+	}
+	if !k.IsCompanyOwner(ctx, board.CompanyID, configuredBy) {
+		return types.ErrNotCompanyOwner
+	}
+
+	if existing, found := k.GetBoard(ctx, board.CompanyID); found {
+		board.LastElectionID = existing.LastElectionID
+	}
+	board.UpdatedBy = configuredBy
+	board.UpdatedAt = ctx.BlockTime()
+	if err := board.Validate(); err != nil {
+		return types.ErrInvalidBoard.Wrap(err.Error())
+	}
+	if seated := len(k.GetActiveBoardSeats(ctx, board.CompanyID)); seated > int(board.Seats) {
+		return types.ErrInvalidBoard.Wrapf("%d directors are seated, more than %d seats", seated, board.Seats)
+	}
+
+	if err := k.SetBoard(ctx, board); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBoardConfigured,
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", board.CompanyID)),
+			sdk.NewAttribute("seats", fmt.Sprintf("%d", board.Seats)),
+			sdk.NewAttribute("resolution_threshold", fmt.Sprintf("%d", board.ResolutionThreshold)),
+			sdk.NewAttribute("cumulative_voting", fmt.Sprintf("%t", board.CumulativeVoting)),
+			sdk.NewAttribute("configured_by", configuredBy),
+		),
+	)
+
+	return nil
+}
+
+// AppointDirector seats an initial director chosen by the company owner. Appointments
+// close once shareholders have elected the board.
+func (k Keeper) AppointDirector(ctx sdk.Context, companyID uint64, nominee types.BoardNominee, appointedBy string) error {
+	board, found := k.GetBoard(ctx, companyID)
+	if !found {
+		return types.ErrBoardNotFound
+	}
+	if !k.IsCompanyOwner(ctx, companyID, appointedBy) {
+		return types.ErrNotCompanyOwner
+	}
+	if board.IsElected() {
+		return types.ErrBoardElected
+	}
+	if k.IsBoardMember(ctx, companyID, nominee.Candidate) {
+		return types.ErrDirectorSeated
+	}
+	if len(k.GetActiveBoardSeats(ctx, companyID)) >= int(board.Seats) {
+		return types.ErrBoardFull
+	}
+
+	seat := types.BoardSeat{
+		CompanyID:   companyID,
+		Director:    nominee.Candidate,
+		Independent: nominee.Independent,
+		Committees:  nominee.Committees,
+		TermStart:   ctx.BlockTime(),
+		TermEnd:     ctx.BlockTime().Add(board.TermLength),
+	}
+	if err := seat.Validate(); err != nil {
+		return types.ErrInvalidBoard.Wrap(err.Error())
+	}
+	if err := k.SetBoardSeat(ctx, seat); err != nil {
+		return err
+	}
+
+	k.emitDirectorSeated(ctx, seat)
+	return nil
+}
+
+// UpdateDirectorCommittees replaces the committees a director sits on. Only the company
+// owner may assign committees.
+func (k Keeper) UpdateDirectorCommittees(ctx sdk.Context, companyID uint64, director string, committees []string, updatedBy string) error {
+	if !k.IsCompanyOwner(ctx, companyID, updatedBy) {
+		return types.ErrNotCompanyOwner
+	}
+	seat, found := k.GetBoardSeat(ctx, companyID, director)
+	if !found || !seat.IsActive(ctx.BlockTime()) {
+		return types.ErrNotDirector
+	}
+	if err := types.ValidateBoardCommittees(committees, seat.Independent); err != nil {
+		return types.ErrInvalidBoard.Wrap(err.Error())
+	}
+
+	seat.Committees = committees
+	if err := k.SetBoardSeat(ctx, seat); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBoardCommittees,
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", companyID)),
+			sdk.NewAttribute(types.AttributeKeyDirector, director),
+			sdk.NewAttribute("committees", fmt.Sprintf("%v", committees)),
+		),
+	)
+
+	return nil
+}
+
+// ResignBoardSeat vacates a director's seat at the director's request
+func (k Keeper) ResignBoardSeat(ctx sdk.Context, companyID uint64, director string) error {
+	seat, found := k.GetBoardSeat(ctx, companyID, director)
+	if !found || !seat.IsActive(ctx.BlockTime()) {
+		return types.ErrNotDirector
+	}
+	k.vacateBoardSeat(ctx, seat, "resigned")
+	return nil
+}
+
+// SeatBoardElection seats the winners of a passed board election (called by governance).
+// Incumbents standing for re-election give up their seat either way; when the
+// remaining directors and the elected seats would exceed the board, the directors
+// with the earliest-ending terms retire first. Winners must include enough
+// independent directors to keep the board at its independent minimum.
+func (k Keeper) SeatBoardElection(ctx sdk.Context, companyID uint64, proposalID uint64, seats uint32, candidates []types.BoardCandidate) error {
+	board, found := k.GetBoard(ctx, companyID)
+	if !found {
+		return types.ErrBoardNotFound
+	}
+	if seats == 0 || seats > board.Seats {
+		return types.ErrInvalidBoardElection.Wrapf("election for %d seats on a board of %d", seats, board.Seats)
+	}
+
+	standing := make(map[string]bool, len(candidates))
+	for _, c := range candidates {
+		standing[c.Candidate] = true
+	}
+
+	var retained, vacated []types.BoardSeat
+	for _, seat := range k.GetBoardSeats(ctx, companyID) {
+		if standing[seat.Director] || !seat.IsActive(ctx.BlockTime()) {
+			vacated = append(vacated, seat)
+			continue
+		}
+		retained = append(retained, seat)
+	}
+	sort.SliceStable(retained, func(i, j int) bool {
+		if retained[i].TermEnd.Equal(retained[j].TermEnd) {
+			return retained[i].Director < retained[j].Director
+		}
+		return retained[i].TermEnd.Before(retained[j].TermEnd)
+	})
+	for len(retained) > 0 && len(retained)+int(seats) > int(board.Seats) {
+		vacated = append(vacated, retained[0])
+		retained = retained[1:]
+	}
+
+	independent := uint32(0)
+	for _, seat := range retained {
+		if seat.Independent {
+			independent++
+		}
+	}
+	requiredIndependent := uint32(0)
+	if board.MinIndependent > independent {
+		requiredIndependent = board.MinIndependent - independent
+	}
+
+	winners := types.ElectBoardCandidates(candidates, seats, requiredIndependent)
+
+	for _, seat := range vacated {
+		k.vacateBoardSeat(ctx, seat, "election")
+	}
+	for _, winner := range winners {
+		seat := types.BoardSeat{
+			CompanyID:   companyID,
+			Director:    winner.Candidate,
+			Independent: winner.Independent,
+			Committees:  winner.Committees,
+			ProposalID:  proposalID,
+			TermStart:   ctx.BlockTime(),
+			TermEnd:     ctx.BlockTime().Add(board.TermLength),
+		}
+		if err := seat.Validate(); err != nil {
+			return types.ErrInvalidBoardElection.Wrapf("candidate %s: %v", winner.Candidate, err)
+		}
+		if err := k.SetBoardSeat(ctx, seat); err != nil {
+			return err
+		}
+		k.emitDirectorSeated(ctx, seat)
+	}
+
+	board.LastElectionID = proposalID
+	if err := k.SetBoard(ctx, board); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBoardElected,
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", companyID)),
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute("seats", fmt.Sprintf("%d", seats)),
+			sdk.NewAttribute("elected", fmt.Sprintf("%d", len(winners))),
+			sdk.NewAttribute("vacated", fmt.Sprintf("%d", len(vacated))),
+		),
+	)
+
+	k.Logger(ctx).Info("board election seated",
+		"company_id", companyID,
+		"proposal_id", proposalID,
+		"elected", len(winners),
+		"vacated", len(vacated),
+	)
+
+	return nil
+}
+
+// ProcessBoardTerms vacates the seats of directors whose term has ended
+func (k Keeper) ProcessBoardTerms(ctx sdk.Context) {
+	for _, seat := range k.getBoardSeats(ctx, types.BoardSeatPrefix) {
+		if !ctx.BlockTime().Before(seat.TermEnd) {
+			k.vacateBoardSeat(ctx, seat, "term_ended")
+		}
+	}
+}
+
+// vacateBoardSeat removes a director's seat
+func (k Keeper) vacateBoardSeat(ctx sdk.Context, seat types.BoardSeat, reason string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetBoardSeatKey(seat.CompanyID, seat.Director))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDirectorVacated,
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", seat.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyDirector, seat.Director),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+}
+
+// emitDirectorSeated emits the event for a newly seated director
+func (k Keeper) emitDirectorSeated(ctx sdk.Context, seat types.BoardSeat) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDirectorSeated,
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", seat.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyDirector, seat.Director),
+			sdk.NewAttribute(types.AttributeKeyIndependent, fmt.Sprintf("%t", seat.Independent)),
+			sdk.NewAttribute(types.AttributeKeyTermEnd, seat.TermEnd.Format(time.RFC3339)),
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", seat.ProposalID)),
+		),
+	)
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// TestBoardOfDirectors tests board configuration, committee rules, straight and
+// cumulative election ballots, and electing winners with an independence minimum
+func TestBoardOfDirectors(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	alice := sdk.AccAddress([]byte("board_alice_________")).String()
+	bob := sdk.AccAddress([]byte("board_bob___________")).String()
+	carol := sdk.AccAddress([]byte("board_carol_________")).String()
+	dave := sdk.AccAddress([]byte("board_dave__________")).String()
+
+	board := types.Board{
+		CompanyID:           1,
+		Seats:               3,
+		ResolutionThreshold: 2,
+		MinIndependent:      1,
+		TermLength:          365 * 24 * time.Hour,
+	}
+	require.NoError(t, board.Validate())
+	require.False(t, board.IsElected())
+
+	invalid := board
+	invalid.ResolutionThreshold = 4
+	require.Error(t, invalid.Validate(), "threshold above seats")
+	invalid = board
+	invalid.MinIndependent = 4
+	require.Error(t, invalid.Validate(), "independent seats above seats")
+	invalid = board
+	invalid.TermLength = time.Hour
+	require.Error(t, invalid.Validate(), "term too short")
+
+	// Seats are active for their term
+	seat := types.BoardSeat{
+		CompanyID:   1,
+		Director:    alice,
+		Independent: true,
+		Committees:  []string{types.BoardCommitteeAudit},
+		TermStart:   now,
+		TermEnd:     now.Add(board.TermLength),
+	}
+	require.NoError(t, seat.Validate())
+	require.True(t, seat.IsActive(now))
+	require.False(t, seat.IsActive(seat.TermEnd))
+	require.True(t, seat.OnCommittee(types.BoardCommitteeAudit))
+	require.False(t, seat.OnCommittee(types.BoardCommitteeRisk))
+
+	// Audit and compensation committees are independent-only
+	require.Error(t, types.ValidateBoardCommittees([]string{types.BoardCommitteeAudit}, false))
+	require.NoError(t, types.ValidateBoardCommittees([]string{types.BoardCommitteeNominating}, false))
+	require.Error(t, types.ValidateBoardCommittees([]string{"finance"}, true))
+	require.Error(t, types.ValidateBoardCommittees([]string{types.BoardCommitteeRisk, types.BoardCommitteeRisk}, true))
+
+	nominees := []types.BoardNominee{
+		{Candidate: alice, Independent: true},
+		{Candidate: bob},
+		{Candidate: carol},
+		{Candidate: dave, Independent: true},
+	}
+	require.NoError(t, types.ValidateBoardNominees(nominees))
+	require.Error(t, types.ValidateBoardNominees(append(nominees, types.BoardNominee{Candidate: bob})), "duplicate nominee")
+	candidates := types.NewBoardCandidates(nominees)
+
+	ballot := func(votes ...int64) []types.BoardBallotEntry {
+		var entries []types.BoardBallotEntry
+		for i, v := range votes {
+			if v > 0 {
+				entries = append(entries, types.BoardBallotEntry{Candidate: nominees[i].Candidate, Votes: math.NewInt(v)})
+			}
+		}
+		return entries
+	}
+
+	// Straight voting: up to 100 votes on each of up to 2 candidates
+	power := math.NewInt(100)
+	require.Equal(t, math.NewInt(200), types.BoardBallotAllowance(power, 2))
+	require.NoError(t, types.ValidateBoardBallot(candidates, 2, false, power, ballot(100, 100)))
+	require.Error(t, types.ValidateBoardBallot(candidates, 2, false, power, ballot(200)), "concentrated straight vote")
+	require.Error(t, types.ValidateBoardBallot(candidates, 2, false, power, ballot(10, 10, 10)), "too many candidates")
+
+	// Cumulative voting: all 200 votes on one candidate
+	require.NoError(t, types.ValidateBoardBallot(candidates, 2, true, power, ballot(200)))
+	require.NoError(t, types.ValidateBoardBallot(candidates, 2, true, power, ballot(50, 50, 50, 50)))
+	require.Error(t, types.ValidateBoardBallot(candidates, 2, true, power, ballot(201)), "over allowance")
+	require.Error(t, types.ValidateBoardBallot(candidates, 2, true, power, []types.BoardBallotEntry{
+		{Candidate: sdk.AccAddress([]byte("board_stranger______")).String(), Votes: math.NewInt(1)},
+	}), "not a candidate")
+
+	// Election: bob 300, carol 300, alice 200, dave 0
+	tallied := types.NewBoardCandidates(nominees)
+	tallied[0].Votes = math.NewInt(200)
+	tallied[1].Votes = math.NewInt(300)
+	tallied[2].Votes = math.NewInt(300)
+
+	winners := types.ElectBoardCandidates(tallied, 2, 0)
+	require.Len(t, winners, 2)
+	require.Equal(t, bob, winners[0].Candidate) // Tie goes to the earlier nomination
+	require.Equal(t, carol, winners[1].Candidate)
+
+	// One independent seat required: carol gives way to alice
+	winners = types.ElectBoardCandidates(tallied, 2, 1)
+	require.Len(t, winners, 2)
+	require.Equal(t, bob, winners[0].Candidate)
+	require.Equal(t, alice, winners[1].Candidate)
+
+	// Candidates without votes are never elected, even to meet the minimum
+	winners = types.ElectBoardCandidates(tallied, 3, 2)
+	require.Len(t, winners, 3)
+	for _, w := range winners {
+		require.NotEqual(t, dave, w.Candidate)
+	}
+
+	// More seats than candidates with votes leaves seats vacant
+	winners = types.ElectBoardCandidates(tallied, 4, 0)
+	require.Len(t, winners, 3)
+}
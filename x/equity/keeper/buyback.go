@@ -0,0 +1,864 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// =============================================================================
+// ISSUER SHARE BUYBACKS
+// Tender offers settled against locked treasury funds, and open-market buyback
+// programs executed as treasury-owned DEX buy orders
+// =============================================================================
+
+// GetNextTenderOfferID returns the next tender offer ID and increments the counter
+func (k Keeper) GetNextTenderOfferID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TenderOfferCounterKey)
+
+	var counter uint64 = 1
+	if bz != nil {
+		counter = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.TenderOfferCounterKey, sdk.Uint64ToBigEndian(counter+1))
+	return counter
+}
+
+// SetTenderOffer stores a tender offer and indexes it by company
+func (k Keeper) SetTenderOffer(ctx sdk.Context, offer types.TenderOffer) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(offer)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tender offer: %w", err)
+	}
+	store.Set(types.GetTenderOfferKey(offer.ID), bz)
+	store.Set(types.GetTenderOfferByCompanyKey(offer.CompanyID, offer.ID), sdk.Uint64ToBigEndian(offer.ID))
+	return nil
+}
+
+// GetTenderOffer returns a tender offer by ID
+func (k Keeper) GetTenderOffer(ctx sdk.Context, offerID uint64) (types.TenderOffer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTenderOfferKey(offerID))
+	if bz == nil {
+		return types.TenderOffer{}, false
+	}
+
+	var offer types.TenderOffer
+	if err := json.Unmarshal(bz, &offer); err != nil {
+		return types.TenderOffer{}, false
+	}
+	return offer, true
+}
+
+// GetTenderOffersByCompany returns all tender offers of a company
+func (k Keeper) GetTenderOffersByCompany(ctx sdk.Context, companyID uint64) []types.TenderOffer {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTenderOffersByCompanyPrefix(companyID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var offers []types.TenderOffer
+	for ; iterator.Valid(); iterator.Next() {
+		if offer, found := k.GetTenderOffer(ctx, sdk.BigEndianToUint64(iterator.Value())); found {
+			offers = append(offers, offer)
+		}
+	}
+	return offers
+}
+
+// GetAllTenderOffers returns every tender offer in the store
+func (k Keeper) GetAllTenderOffers(ctx sdk.Context) []types.TenderOffer {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TenderOfferPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var offers []types.TenderOffer
+	for ; iterator.Valid(); iterator.Next() {
+		var offer types.TenderOffer
+		if err := json.Unmarshal(iterator.Value(), &offer); err != nil {
+			continue
+		}
+		offers = append(offers, offer)
+	}
+	return offers
+}
+
+// SetTender stores a holder's tender into an offer
+func (k Keeper) SetTender(ctx sdk.Context, tender types.Tender) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(tender)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tender: %w", err)
+	}
+	store.Set(types.GetTenderKey(tender.OfferID, tender.Holder), bz)
+	return nil
+}
+
+// GetTender returns a holder's tender into an offer
+func (k Keeper) GetTender(ctx sdk.Context, offerID uint64, holder string) (types.Tender, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTenderKey(offerID, holder))
+	if bz == nil {
+		return types.Tender{}, false
+	}
+
+	var tender types.Tender
+	if err := json.Unmarshal(bz, &tender); err != nil {
+		return types.Tender{}, false
+	}
+	return tender, true
+}
+
+// DeleteTender removes a holder's tender
+func (k Keeper) DeleteTender(ctx sdk.Context, offerID uint64, holder string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTenderKey(offerID, holder))
+}
+
+// GetTendersByOffer returns every tender into an offer
+func (k Keeper) GetTendersByOffer(ctx sdk.Context, offerID uint64) []types.Tender {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTendersByOfferPrefix(offerID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var tenders []types.Tender
+	for ; iterator.Valid(); iterator.Next() {
+		var tender types.Tender
+		if err := json.Unmarshal(iterator.Value(), &tender); err != nil {
+			continue
+		}
+		tenders = append(tenders, tender)
+	}
+	return tenders
+}
+
+// CreateTenderOffer opens a tender offer for a share class. The most the offer can
+// cost is locked in the company treasury and counted against the treasury's
+// withdrawal limits up front, so settlement can always be paid.
+func (k Keeper) CreateTenderOffer(ctx sdk.Context, offer types.TenderOffer, creator string) (uint64, error) {
+	if !k.CanProposeForCompany(ctx, offer.CompanyID, creator) {
+		return 0, types.ErrUnauthorized
+	}
+
+	company, found := k.getCompany(ctx, offer.CompanyID)
+	if !found {
+		return 0, types.ErrCompanyNotFound
+	}
+	if company.Status != types.CompanyStatusActive {
+		return 0, types.ErrCompanyNotActive
+	}
+	if _, found := k.getShareClass(ctx, offer.CompanyID, offer.ClassID); !found {
+		return 0, types.ErrShareClassNotFound
+	}
+	if k.IsTreasuryFrozen(ctx, offer.CompanyID) {
+		return 0, types.ErrTreasuryFrozenForInvestigation
+	}
+	for _, existing := range k.GetTenderOffersByCompany(ctx, offer.CompanyID) {
+		if existing.ClassID == offer.ClassID && existing.Status == types.BuybackStatusOpen {
+			return 0, types.ErrTenderOfferOpen.Wrapf("tender offer %d is still open", existing.ID)
+		}
+	}
+
+	if offer.StartTime.IsZero() {
+		offer.StartTime = ctx.BlockTime()
+	}
+	offer.ID = 0
+	offer.Status = types.BuybackStatusOpen
+	offer.ClearingPrice = math.LegacyZeroDec()
+	offer.SharesTendered = math.ZeroInt()
+	offer.SharesAccepted = math.ZeroInt()
+	offer.TotalPaid = math.ZeroInt()
+	offer.CreatedBy = creator
+	offer.CreatedAt = ctx.BlockTime()
+	if offer.Type == types.TenderOfferDutchAuction {
+		offer.Price = math.LegacyZeroDec()
+	} else {
+		offer.MinPrice = math.LegacyZeroDec()
+		offer.MaxPrice = math.LegacyZeroDec()
+	}
+	if err := offer.Validate(); err != nil {
+		return 0, types.ErrInvalidTenderOffer.Wrap(err.Error())
+	}
+	if !offer.EndTime.After(ctx.BlockTime()) {
+		return 0, types.ErrInvalidTenderOffer.Wrap("end time must be in the future")
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	maxCost := offer.MaxCost()
+	if err := k.CheckTreasuryWithdrawalAllowed(cacheCtx, offer.CompanyID, maxCost); err != nil {
+		return 0, err
+	}
+	offer.LockedAmount = sdk.NewCoins(sdk.NewCoin(types.BuybackDenom, maxCost))
+	if err := k.LockTreasuryAmount(cacheCtx, offer.CompanyID, offer.LockedAmount); err != nil {
+		return 0, err
+	}
+
+	offer.ID = k.GetNextTenderOfferID(cacheCtx)
+	if err := k.SetTenderOffer(cacheCtx, offer); err != nil {
+		return 0, err
+	}
+
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTenderOfferCreated,
+			sdk.NewAttribute(types.AttributeKeyTenderOfferID, fmt.Sprintf("%d", offer.ID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", offer.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareClass, offer.ClassID),
+			sdk.NewAttribute("type", offer.Type.String()),
+			sdk.NewAttribute("max_shares", offer.MaxShares.String()),
+			sdk.NewAttribute("highest_price", offer.HighestPrice().String()),
+			sdk.NewAttribute("locked_amount", offer.LockedAmount.String()),
+			sdk.NewAttribute("end_time", offer.EndTime.String()),
+		),
+	)
+
+	return offer.ID, nil
+}
+
+// SubmitTender tenders a holder's shares into an open offer, replacing any earlier
+// tender by the same holder. Tendered shares are locked until settlement or withdrawal.
+func (k Keeper) SubmitTender(ctx sdk.Context, holder string, offerID uint64, shares math.Int, price math.LegacyDec) error {
+	if shares.IsNil() || !shares.IsPositive() {
+		return types.ErrInvalidTender.Wrap("shares must be positive")
+	}
+
+	offer, found := k.GetTenderOffer(ctx, offerID)
+	if !found {
+		return types.ErrTenderOfferNotFound
+	}
+	if !offer.IsAcceptingTenders(ctx.BlockTime()) {
+		return types.ErrTenderOfferClosed
+	}
+	if offer.Type != types.TenderOfferDutchAuction {
+		price = offer.Price
+	}
+	if !offer.ValidTenderPrice(price) {
+		return types.ErrInvalidTender.Wrapf("price must be between %s and %s", offer.MinPrice, offer.MaxPrice)
+	}
+
+	holding, found := k.getShareholding(ctx, offer.CompanyID, offer.ClassID, holder)
+	if !found {
+		return types.ErrShareholdingNotFound
+	}
+	previous, hasPrevious := k.GetTender(ctx, offerID, holder)
+	if hasPrevious {
+		holding.LockedShares = holding.LockedShares.Sub(previous.Shares)
+	}
+	if holding.VestedShares.Sub(holding.LockedShares).LT(shares) {
+		return types.ErrInsufficientShares.Wrapf("%s shares available to tender", holding.VestedShares.Sub(holding.LockedShares))
+	}
+
+	holding.LockedShares = holding.LockedShares.Add(shares)
+	holding.UpdatedAt = ctx.BlockTime()
+	if err := k.SetShareholding(ctx, holding); err != nil {
+		return err
+	}
+	if err := k.SetTender(ctx, types.Tender{
+		OfferID:     offerID,
+		Holder:      holder,
+		Shares:      shares,
+		Price:       price,
+		SubmittedAt: ctx.BlockTime(),
+	}); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTenderSubmitted,
+			sdk.NewAttribute(types.AttributeKeyTenderOfferID, fmt.Sprintf("%d", offerID)),
+			sdk.NewAttribute(types.AttributeKeyShareholder, holder),
+			sdk.NewAttribute(types.AttributeKeySharesTendered, shares.String()),
+			sdk.NewAttribute("price", price.String()),
+		),
+	)
+
+	return nil
+}
+
+// WithdrawTender withdraws a holder's tender while the offer is still open
+func (k Keeper) WithdrawTender(ctx sdk.Context, holder string, offerID uint64) error {
+	offer, found := k.GetTenderOffer(ctx, offerID)
+	if !found {
+		return types.ErrTenderOfferNotFound
+	}
+	if !offer.IsAcceptingTenders(ctx.BlockTime()) {
+		return types.ErrTenderOfferClosed
+	}
+	tender, found := k.GetTender(ctx, offerID, holder)
+	if !found {
+		return types.ErrTenderNotFound
+	}
+
+	if err := k.releaseTender(ctx, offer, tender); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTenderWithdrawn,
+			sdk.NewAttribute(types.AttributeKeyTenderOfferID, fmt.Sprintf("%d", offerID)),
+			sdk.NewAttribute(types.AttributeKeyShareholder, holder),
+			sdk.NewAttribute(types.AttributeKeySharesTendered, tender.Shares.String()),
+		),
+	)
+
+	return nil
+}
+
+// releaseTender unlocks a tender's shares and removes the tender
+func (k Keeper) releaseTender(ctx sdk.Context, offer types.TenderOffer, tender types.Tender) error {
+	if holding, found := k.getShareholding(ctx, offer.CompanyID, offer.ClassID, tender.Holder); found {
+		holding.LockedShares = holding.LockedShares.Sub(tender.Shares)
+		if holding.LockedShares.IsNegative() {
+			holding.LockedShares = math.ZeroInt()
+		}
+		holding.UpdatedAt = ctx.BlockTime()
+		if err := k.SetShareholding(ctx, holding); err != nil {
+			return err
+		}
+	}
+	k.DeleteTender(ctx, offer.ID, tender.Holder)
+	return nil
+}
+
+// CancelTenderOffer withdraws an open tender offer, returning every tender and
+// releasing the locked treasury funds
+func (k Keeper) CancelTenderOffer(ctx sdk.Context, offerID uint64, authority string) error {
+	offer, found := k.GetTenderOffer(ctx, offerID)
+	if !found {
+		return types.ErrTenderOfferNotFound
+	}
+	if !k.CanProposeForCompany(ctx, offer.CompanyID, authority) {
+		return types.ErrUnauthorized
+	}
+	if offer.Status != types.BuybackStatusOpen {
+		return types.ErrTenderOfferClosed
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.cancelTenderOffer(cacheCtx, offer); err != nil {
+		return err
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTenderOfferCancelled,
+			sdk.NewAttribute(types.AttributeKeyTenderOfferID, fmt.Sprintf("%d", offer.ID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", offer.CompanyID)),
+			sdk.NewAttribute("cancelled_by", authority),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) cancelTenderOffer(ctx sdk.Context, offer types.TenderOffer) error {
+	for _, tender := range k.GetTendersByOffer(ctx, offer.ID) {
+		if err := k.releaseTender(ctx, offer, tender); err != nil {
+			return err
+		}
+	}
+	if err := k.UnlockTreasuryAmount(ctx, offer.CompanyID, offer.LockedAmount); err != nil {
+		return err
+	}
+
+	offer.Status = types.BuybackStatusCancelled
+	offer.LockedAmount = sdk.NewCoins()
+	return k.SetTenderOffer(ctx, offer)
+}
+
+// SettleTenderOffer clears a closed tender offer: accepted shares are bought from
+// their holders at the clearing price out of the locked treasury funds, and the
+// rest of each tender and of the locked funds is released
+func (k Keeper) SettleTenderOffer(ctx sdk.Context, offerID uint64) error {
+	offer, found := k.GetTenderOffer(ctx, offerID)
+	if !found {
+		return types.ErrTenderOfferNotFound
+	}
+	if offer.Status != types.BuybackStatusOpen {
+		return types.ErrTenderOfferClosed
+	}
+	if ctx.BlockTime().Before(offer.EndTime) {
+		return types.ErrTenderOfferOpen.Wrap("tender period has not ended")
+	}
+	company, found := k.getCompany(ctx, offer.CompanyID)
+	if !found {
+		return types.ErrCompanyNotFound
+	}
+
+	tenders := k.GetTendersByOffer(ctx, offer.ID)
+	clearing := types.ClearTenderOffer(offer, tenders)
+
+	cacheCtx, write := ctx.CacheContext()
+
+	if err := k.UnlockTreasuryAmount(cacheCtx, offer.CompanyID, offer.LockedAmount); err != nil {
+		return err
+	}
+	for _, tender := range tenders {
+		if err := k.releaseTender(cacheCtx, offer, tender); err != nil {
+			return err
+		}
+	}
+
+	totalPaid := math.ZeroInt()
+	for _, allocation := range clearing.Allocations {
+		if !allocation.Accepted.IsPositive() {
+			continue
+		}
+		payment := clearing.ClearingPrice.MulInt(allocation.Accepted).TruncateInt()
+		if err := k.repurchaseFromHolder(cacheCtx, company, offer.ClassID, allocation.Holder, allocation.Accepted, payment); err != nil {
+			return err
+		}
+		totalPaid = totalPaid.Add(payment)
+	}
+
+	if clearing.SharesAccepted.IsPositive() {
+		if err := k.deductTreasuryPayment(cacheCtx, offer.CompanyID, totalPaid); err != nil {
+			return err
+		}
+		if err := k.retireOrHoldRepurchased(cacheCtx, offer.CompanyID, offer.ClassID, clearing.SharesAccepted, offer.RetireShares); err != nil {
+			return err
+		}
+	}
+
+	offer.Status = types.BuybackStatusCompleted
+	offer.ClearingPrice = clearing.ClearingPrice
+	offer.SharesTendered = clearing.SharesTendered
+	offer.SharesAccepted = clearing.SharesAccepted
+	offer.TotalPaid = totalPaid
+	offer.LockedAmount = sdk.NewCoins()
+	offer.SettledAt = ctx.BlockTime()
+	if err := k.SetTenderOffer(cacheCtx, offer); err != nil {
+		return err
+	}
+
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTenderOfferSettled,
+			sdk.NewAttribute(types.AttributeKeyTenderOfferID, fmt.Sprintf("%d", offer.ID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", offer.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareClass, offer.ClassID),
+			sdk.NewAttribute(types.AttributeKeyClearingPrice, clearing.ClearingPrice.String()),
+			sdk.NewAttribute(types.AttributeKeySharesTendered, clearing.SharesTendered.String()),
+			sdk.NewAttribute(types.AttributeKeySharesAccepted, clearing.SharesAccepted.String()),
+			sdk.NewAttribute("total_paid", totalPaid.String()),
+		),
+	)
+
+	return nil
+}
+
+// repurchaseFromHolder takes accepted shares out of a holder's shareholding, burns
+// the matching symbol coins, and pays the holder from the equity module account
+func (k Keeper) repurchaseFromHolder(ctx sdk.Context, company types.Company, classID, holder string, shares, payment math.Int) error {
+	holderAddr, err := sdk.AccAddressFromBech32(holder)
+	if err != nil {
+		return types.ErrInvalidAddress
+	}
+
+	holding, found := k.getShareholding(ctx, company.ID, classID, holder)
+	if !found || holding.VestedShares.Sub(holding.LockedShares).LT(shares) {
+		return types.ErrInsufficientShares.Wrapf("%s no longer holds the tendered shares", holder)
+	}
+	holding.Shares = holding.Shares.Sub(shares)
+	holding.VestedShares = holding.VestedShares.Sub(shares)
+	holding.TotalCost = holding.TotalCost.Sub(holding.CostBasis.MulInt(shares))
+	holding.UpdatedAt = ctx.BlockTime()
+	if holding.Shares.IsZero() {
+		k.DeleteShareholding(ctx, company.ID, classID, holder)
+	} else if err := k.SetShareholding(ctx, holding); err != nil {
+		return err
+	}
+
+	if err := k.rebalanceConvertedCoins(ctx, holderAddr, company.Symbol, shares, math.ZeroInt()); err != nil {
+		return err
+	}
+
+	if payment.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(types.BuybackDenom, payment))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holderAddr, coins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deductTreasuryPayment records a buyback payment that has left the equity module
+// account against the company treasury
+func (k Keeper) deductTreasuryPayment(ctx sdk.Context, companyID uint64, amount math.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+	treasury, found := k.GetCompanyTreasury(ctx, companyID)
+	if !found {
+		return types.ErrTreasuryNotFound
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(types.BuybackDenom, amount))
+	if !treasury.Balance.IsAllGTE(coins) {
+		return types.ErrInsufficientTreasuryBalance
+	}
+	treasury.Balance = treasury.Balance.Sub(coins...)
+	treasury.TotalWithdrawn = treasury.TotalWithdrawn.Add(coins...)
+	treasury.UpdatedAt = ctx.BlockTime()
+	return k.SetCompanyTreasury(ctx, treasury)
+}
+
+// retireOrHoldRepurchased removes repurchased shares from the class's issued and
+// outstanding counts and, unless they are retired, adds them to treasury shares
+func (k Keeper) retireOrHoldRepurchased(ctx sdk.Context, companyID uint64, classID string, shares math.Int, retire bool) error {
+	shareClass, found := k.getShareClass(ctx, companyID, classID)
+	if !found {
+		return types.ErrShareClassNotFound
+	}
+	shareClass.IssuedShares = shareClass.IssuedShares.Sub(shares)
+	shareClass.OutstandingShares = shareClass.OutstandingShares.Sub(shares)
+	shareClass.UpdatedAt = ctx.BlockTime()
+	if err := k.SetShareClass(ctx, shareClass); err != nil {
+		return err
+	}
+
+	if !retire {
+		if err := k.AddSharesToTreasury(ctx, companyID, classID, shares); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSharesRepurchased,
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", companyID)),
+			sdk.NewAttribute(types.AttributeKeyShareClass, classID),
+			sdk.NewAttribute("shares", shares.String()),
+			sdk.NewAttribute(types.AttributeKeyRetired, fmt.Sprintf("%t", retire)),
+		),
+	)
+	return nil
+}
+
+// =============================================================================
+// Open-Market Buyback Programs
+// =============================================================================
+
+// GetNextBuybackProgramID returns the next buyback program ID and increments the counter
+func (k Keeper) GetNextBuybackProgramID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BuybackProgramCounterKey)
+
+	var counter uint64 = 1
+	if bz != nil {
+		counter = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.BuybackProgramCounterKey, sdk.Uint64ToBigEndian(counter+1))
+	return counter
+}
+
+// SetBuybackProgram stores a buyback program and indexes it by company
+func (k Keeper) SetBuybackProgram(ctx sdk.Context, program types.BuybackProgram) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(program)
+	if err != nil {
+		return fmt.Errorf("failed to marshal buyback program: %w", err)
+	}
+	store.Set(types.GetBuybackProgramKey(program.ID), bz)
+	store.Set(types.GetBuybackProgramByCompanyKey(program.CompanyID, program.ID), sdk.Uint64ToBigEndian(program.ID))
+	return nil
+}
+
+// GetBuybackProgram returns a buyback program by ID
+func (k Keeper) GetBuybackProgram(ctx sdk.Context, programID uint64) (types.BuybackProgram, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBuybackProgramKey(programID))
+	if bz == nil {
+		return types.BuybackProgram{}, false
+	}
+
+	var program types.BuybackProgram
+	if err := json.Unmarshal(bz, &program); err != nil {
+		return types.BuybackProgram{}, false
+	}
+	return program, true
+}
+
+// GetBuybackProgramsByCompany returns all buyback programs of a company
+func (k Keeper) GetBuybackProgramsByCompany(ctx sdk.Context, companyID uint64) []types.BuybackProgram {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBuybackProgramsByCompanyPrefix(companyID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var programs []types.BuybackProgram
+	for ; iterator.Valid(); iterator.Next() {
+		if program, found := k.GetBuybackProgram(ctx, sdk.BigEndianToUint64(iterator.Value())); found {
+			programs = append(programs, program)
+		}
+	}
+	return programs
+}
+
+// GetAllBuybackPrograms returns every buyback program in the store
+func (k Keeper) GetAllBuybackPrograms(ctx sdk.Context) []types.BuybackProgram {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BuybackProgramPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var programs []types.BuybackProgram
+	for ; iterator.Valid(); iterator.Next() {
+		var program types.BuybackProgram
+		if err := json.Unmarshal(iterator.Value(), &program); err != nil {
+			continue
+		}
+		programs = append(programs, program)
+	}
+	return programs
+}
+
+// CreateBuybackProgram authorizes an open-market buyback of a share class. Funds stay
+// in the treasury until each purchase is executed.
+func (k Keeper) CreateBuybackProgram(ctx sdk.Context, program types.BuybackProgram, creator string) (uint64, error) {
+	if !k.CanProposeForCompany(ctx, program.CompanyID, creator) {
+		return 0, types.ErrUnauthorized
+	}
+
+	company, found := k.getCompany(ctx, program.CompanyID)
+	if !found {
+		return 0, types.ErrCompanyNotFound
+	}
+	if company.Status != types.CompanyStatusActive {
+		return 0, types.ErrCompanyNotActive
+	}
+	if _, found := k.getShareClass(ctx, program.CompanyID, program.ClassID); !found {
+		return 0, types.ErrShareClassNotFound
+	}
+	if _, found := k.GetCompanyTreasury(ctx, program.CompanyID); !found {
+		return 0, types.ErrTreasuryNotFound
+	}
+
+	if program.StartTime.IsZero() {
+		program.StartTime = ctx.BlockTime()
+	}
+	program.ID = 0
+	program.Status = types.BuybackStatusOpen
+	program.Spent = math.ZeroInt()
+	program.SharesRepurchased = math.ZeroInt()
+	program.BoughtToday = math.ZeroInt()
+	program.CurrentDay = ctx.BlockTime().Truncate(24 * time.Hour)
+	program.CreatedBy = creator
+	program.CreatedAt = ctx.BlockTime()
+	if err := program.Validate(); err != nil {
+		return 0, types.ErrInvalidBuyback.Wrap(err.Error())
+	}
+	if !program.EndTime.After(ctx.BlockTime()) {
+		return 0, types.ErrInvalidBuyback.Wrap("end time must be in the future")
+	}
+
+	program.ID = k.GetNextBuybackProgramID(ctx)
+	if err := k.SetBuybackProgram(ctx, program); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBuybackCreated,
+			sdk.NewAttribute(types.AttributeKeyBuybackID, fmt.Sprintf("%d", program.ID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", program.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareClass, program.ClassID),
+			sdk.NewAttribute("budget", program.Budget.String()),
+			sdk.NewAttribute("max_price", program.MaxPrice.String()),
+			sdk.NewAttribute("daily_cap", program.DailyCap.String()),
+			sdk.NewAttribute("end_time", program.EndTime.String()),
+		),
+	)
+
+	return program.ID, nil
+}
+
+// ExecuteBuyback buys up to shares of the program's class on the DEX with an
+// immediate-or-cancel limit order owned by the equity module and paid from the
+// treasury. The order is sized within the daily cap and remaining budget at the
+// limit price, and the treasury's withdrawal limits apply to the most it can cost.
+// Returns the shares bought and the amount spent.
+func (k Keeper) ExecuteBuyback(ctx sdk.Context, programID uint64, shares math.Int, limitPrice math.LegacyDec, executor string) (math.Int, math.Int, error) {
+	if k.dexKeeper == nil {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrDexUnavailable
+	}
+	if shares.IsNil() || !shares.IsPositive() {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrInsufficientShares
+	}
+
+	program, found := k.GetBuybackProgram(ctx, programID)
+	if !found {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrBuybackNotFound
+	}
+	if !k.CanProposeForCompany(ctx, program.CompanyID, executor) {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrUnauthorized
+	}
+	now := ctx.BlockTime()
+	if !program.IsActive(now) {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrBuybackInactive
+	}
+	if k.IsTreasuryFrozen(ctx, program.CompanyID) {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrTreasuryFrozenForInvestigation
+	}
+	company, found := k.getCompany(ctx, program.CompanyID)
+	if !found {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrCompanyNotFound
+	}
+
+	if limitPrice.IsNil() || limitPrice.IsZero() {
+		limitPrice = program.MaxPrice
+	}
+	if limitPrice.IsNegative() || limitPrice.GT(program.MaxPrice) {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrInvalidBuyback.Wrapf("limit price must not exceed %s", program.MaxPrice)
+	}
+	if remaining := program.RemainingToday(now); shares.GT(remaining) {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrBuybackLimitExceeded.Wrapf("%s shares left under today's cap", remaining)
+	}
+	maxCost := limitPrice.MulInt(shares).Ceil().TruncateInt()
+	if remaining := program.RemainingBudget(); maxCost.GT(remaining) {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrBuybackLimitExceeded.Wrapf("%s budget remaining", remaining)
+	}
+
+	treasury, found := k.GetCompanyTreasury(ctx, program.CompanyID)
+	if !found {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrTreasuryNotFound
+	}
+	if !treasury.CanWithdraw(sdk.NewCoins(sdk.NewCoin(types.BuybackDenom, maxCost))) {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrInsufficientTreasuryBalance
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	if err := k.CheckTreasuryWithdrawalAllowed(cacheCtx, program.CompanyID, maxCost); err != nil {
+		return math.ZeroInt(), math.ZeroInt(), err
+	}
+
+	buyer := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	marketSymbol := company.Symbol + "/" + types.BuybackDenom
+	filled, spent, err := k.dexKeeper.PlaceLimitBuyOrder(cacheCtx, buyer.String(), marketSymbol, shares, limitPrice)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), err
+	}
+	if !filled.IsPositive() {
+		return math.ZeroInt(), math.ZeroInt(), nil
+	}
+
+	// The bought coins are the repurchased shares; burn them from the module account
+	bought := sdk.NewCoins(sdk.NewCoin(company.Symbol, filled))
+	if err := k.bankKeeper.BurnCoins(cacheCtx, types.ModuleName, bought); err != nil {
+		return math.ZeroInt(), math.ZeroInt(), err
+	}
+	if err := k.deductTreasuryPayment(cacheCtx, program.CompanyID, spent); err != nil {
+		return math.ZeroInt(), math.ZeroInt(), err
+	}
+	if err := k.retireOrHoldRepurchased(cacheCtx, program.CompanyID, program.ClassID, filled, program.RetireShares); err != nil {
+		return math.ZeroInt(), math.ZeroInt(), err
+	}
+
+	program.RecordPurchase(now, filled, spent)
+	if !program.RemainingBudget().IsPositive() {
+		program.Status = types.BuybackStatusCompleted
+	}
+	if err := k.SetBuybackProgram(cacheCtx, program); err != nil {
+		return math.ZeroInt(), math.ZeroInt(), err
+	}
+
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBuybackExecuted,
+			sdk.NewAttribute(types.AttributeKeyBuybackID, fmt.Sprintf("%d", program.ID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", program.CompanyID)),
+			sdk.NewAttribute("shares", filled.String()),
+			sdk.NewAttribute("price", limitPrice.String()),
+			sdk.NewAttribute("spent", spent.String()),
+			sdk.NewAttribute("bought_today", program.BoughtToday.String()),
+			sdk.NewAttribute("total_spent", program.Spent.String()),
+		),
+	)
+
+	return filled, spent, nil
+}
+
+// EndBuybackProgram stops a buyback program before its end time
+func (k Keeper) EndBuybackProgram(ctx sdk.Context, programID uint64, authority string) error {
+	program, found := k.GetBuybackProgram(ctx, programID)
+	if !found {
+		return types.ErrBuybackNotFound
+	}
+	if !k.CanProposeForCompany(ctx, program.CompanyID, authority) {
+		return types.ErrUnauthorized
+	}
+	if program.Status != types.BuybackStatusOpen {
+		return types.ErrBuybackInactive
+	}
+
+	program.Status = types.BuybackStatusCancelled
+	if err := k.SetBuybackProgram(ctx, program); err != nil {
+		return err
+	}
+	k.emitBuybackEnded(ctx, program)
+	return nil
+}
+
+func (k Keeper) emitBuybackEnded(ctx sdk.Context, program types.BuybackProgram) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBuybackEnded,
+			sdk.NewAttribute(types.AttributeKeyBuybackID, fmt.Sprintf("%d", program.ID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", program.CompanyID)),
+			sdk.NewAttribute("status", program.Status.String()),
+			sdk.NewAttribute("shares_repurchased", program.SharesRepurchased.String()),
+			sdk.NewAttribute("spent", program.Spent.String()),
+		),
+	)
+}
+
+// ProcessBuybacks settles tender offers whose tender period has ended and completes
+// buyback programs past their end time. An offer that cannot settle is cancelled so
+// tendered shares and locked funds are never stranded.
+func (k Keeper) ProcessBuybacks(ctx sdk.Context) {
+	now := ctx.BlockTime()
+
+	for _, offer := range k.GetAllTenderOffers(ctx) {
+		if offer.Status != types.BuybackStatusOpen || now.Before(offer.EndTime) {
+			continue
+		}
+		if err := k.SettleTenderOffer(ctx, offer.ID); err != nil {
+			k.Logger(ctx).Error("failed to settle tender offer, cancelling", "offer_id", offer.ID, "error", err)
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.cancelTenderOffer(cacheCtx, offer); err != nil {
+				k.Logger(ctx).Error("failed to cancel tender offer", "offer_id", offer.ID, "error", err)
+				continue
+			}
+			write()
+		}
+	}
+
+	for _, program := range k.GetAllBuybackPrograms(ctx) {
+		if program.Status != types.BuybackStatusOpen || now.Before(program.EndTime) {
+			continue
+		}
+		program.Status = types.BuybackStatusCompleted
+		if err := k.SetBuybackProgram(ctx, program); err != nil {
+			k.Logger(ctx).Error("failed to end buyback program", "buyback_id", program.ID, "error", err)
+			continue
+		}
+		k.emitBuybackEnded(ctx, program)
+	}
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// TestTenderOfferClearing tests fixed-price and Dutch-auction clearing, pro-rata
+// acceptance and buyback program limits
+func TestTenderOfferClearing(t *testing.T) {
+	founder := sdk.AccAddress([]byte("buyback_founder_____")).String()
+	alice := sdk.AccAddress([]byte("buyback_alice_______")).String()
+	bob := sdk.AccAddress([]byte("buyback_bob_________")).String()
+	carol := sdk.AccAddress([]byte("buyback_carol_______")).String()
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	fixed := types.TenderOffer{
+		CompanyID: 1,
+		ClassID:   "COMMON",
+		Type:      types.TenderOfferFixedPrice,
+		Price:     math.LegacyNewDec(5),
+		MaxShares: math.NewInt(1000),
+		StartTime: start,
+		EndTime:   start.Add(7 * 24 * time.Hour),
+		CreatedBy: founder,
+	}
+	require.NoError(t, fixed.Validate())
+	require.Equal(t, math.NewInt(5000), fixed.MaxCost())
+	require.True(t, fixed.IsAcceptingTenders(start))
+	require.False(t, fixed.IsAcceptingTenders(fixed.EndTime))
+
+	// Undersubscribed: every tender is accepted in full
+	result := types.ClearTenderOffer(fixed, []types.Tender{
+		{Holder: alice, Shares: math.NewInt(300), Price: fixed.Price},
+		{Holder: bob, Shares: math.NewInt(200), Price: fixed.Price},
+	})
+	require.Equal(t, math.NewInt(500), result.SharesAccepted)
+	require.Equal(t, math.LegacyNewDec(5), result.ClearingPrice)
+
+	// Oversubscribed: pro-rata, with rounding leftovers handed out one share at a time
+	result = types.ClearTenderOffer(fixed, []types.Tender{
+		{Holder: alice, Shares: math.NewInt(1000), Price: fixed.Price},
+		{Holder: bob, Shares: math.NewInt(1000), Price: fixed.Price},
+		{Holder: carol, Shares: math.NewInt(1000), Price: fixed.Price},
+	})
+	require.Equal(t, math.NewInt(3000), result.SharesTendered)
+	require.Equal(t, math.NewInt(1000), result.SharesAccepted)
+	accepted := map[string]math.Int{}
+	for _, allocation := range result.Allocations {
+		accepted[allocation.Holder] = allocation.Accepted
+		require.True(t, allocation.Accepted.GTE(math.NewInt(333)))
+		require.True(t, allocation.Accepted.LTE(math.NewInt(334)))
+	}
+	require.Len(t, accepted, 3)
+
+	// Dutch auction: the lowest price that fills the offer clears it
+	dutch := fixed
+	dutch.Type = types.TenderOfferDutchAuction
+	dutch.Price = math.LegacyZeroDec()
+	dutch.MinPrice = math.LegacyNewDec(4)
+	dutch.MaxPrice = math.LegacyNewDec(6)
+	require.NoError(t, dutch.Validate())
+	require.Equal(t, math.NewInt(6000), dutch.MaxCost())
+	require.False(t, dutch.ValidTenderPrice(math.LegacyNewDec(7)))
+	require.True(t, dutch.ValidTenderPrice(math.LegacyNewDec(4)))
+
+	result = types.ClearTenderOffer(dutch, []types.Tender{
+		{Holder: alice, Shares: math.NewInt(600), Price: math.LegacyNewDec(4)},
+		{Holder: bob, Shares: math.NewInt(800), Price: math.LegacyNewDec(5)},
+		{Holder: carol, Shares: math.NewInt(500), Price: math.LegacyNewDec(6)},
+	})
+	require.Equal(t, math.LegacyNewDec(5), result.ClearingPrice)
+	require.Equal(t, math.NewInt(1000), result.SharesAccepted)
+	for _, allocation := range result.Allocations {
+		switch allocation.Holder {
+		case alice:
+			require.Equal(t, math.NewInt(600), allocation.Accepted, "below clearing price, taken in full")
+		case bob:
+			require.Equal(t, math.NewInt(400), allocation.Accepted, "at the margin, pro-rated")
+		case carol:
+			require.True(t, allocation.Accepted.IsZero(), "above clearing price")
+		}
+	}
+
+	// Undersubscribed Dutch auction clears at the highest tendered price
+	result = types.ClearTenderOffer(dutch, []types.Tender{
+		{Holder: alice, Shares: math.NewInt(100), Price: math.LegacyNewDec(4)},
+		{Holder: bob, Shares: math.NewInt(100), Price: math.LegacyMustNewDecFromStr("5.5")},
+	})
+	require.Equal(t, math.LegacyMustNewDecFromStr("5.5"), result.ClearingPrice)
+	require.Equal(t, math.NewInt(200), result.SharesAccepted)
+
+	// No tenders, nothing accepted
+	result = types.ClearTenderOffer(dutch, nil)
+	require.True(t, result.SharesAccepted.IsZero())
+
+	// Open-market program: daily cap resets each day, budget caps total spend
+	program := types.BuybackProgram{
+		CompanyID:         1,
+		ClassID:           "COMMON",
+		Budget:            math.NewInt(10000),
+		MaxPrice:          math.LegacyNewDec(5),
+		DailyCap:          math.NewInt(500),
+		StartTime:         start,
+		EndTime:           start.Add(30 * 24 * time.Hour),
+		Spent:             math.ZeroInt(),
+		SharesRepurchased: math.ZeroInt(),
+		BoughtToday:       math.ZeroInt(),
+		CreatedBy:         founder,
+	}
+	require.NoError(t, program.Validate())
+	require.True(t, program.IsActive(start))
+	require.False(t, program.IsActive(program.EndTime))
+
+	noon := start.Add(12 * time.Hour)
+	program.RecordPurchase(noon, math.NewInt(400), math.NewInt(1900))
+	require.Equal(t, math.NewInt(100), program.RemainingToday(noon.Add(time.Hour)))
+	require.Equal(t, math.NewInt(500), program.RemainingToday(noon.Add(24*time.Hour)))
+	require.Equal(t, math.NewInt(8100), program.RemainingBudget())
+
+	program.RecordPurchase(noon.Add(24*time.Hour), math.NewInt(200), math.NewInt(1000))
+	require.Equal(t, math.NewInt(200), program.BoughtToday)
+	require.Equal(t, math.NewInt(600), program.SharesRepurchased)
+	require.Equal(t, math.NewInt(2900), program.Spent)
+
+	invalid := program
+	invalid.DailyCap = math.ZeroInt()
+	require.Error(t, invalid.Validate(), "zero daily cap")
+}
@@ -0,0 +1,314 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// =============================================================================
+// CAP TABLE JOURNAL
+// Every change to a company's holdings, class totals, treasury shares, grants and
+// custodied positions is journaled so the cap table can be rebuilt at any height
+// =============================================================================
+
+// GetNextCapTableJournalID returns the next journal entry ID and increments the counter
+func (k Keeper) GetNextCapTableJournalID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CapTableJournalCounterKey)
+
+	var counter uint64 = 1
+	if bz != nil {
+		counter = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.CapTableJournalCounterKey, sdk.Uint64ToBigEndian(counter+1))
+	return counter
+}
+
+// GetCapTableJournal returns a company's journal entries in journal order
+func (k Keeper) GetCapTableJournal(ctx sdk.Context, companyID uint64) []types.CapTableJournalEntry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCapTableJournalByCompanyPrefix(companyID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var entries []types.CapTableJournalEntry
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.CapTableJournalEntry
+		if err := json.Unmarshal(iterator.Value(), &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// GetCapTableJournalStart returns the height a company's journal starts at
+func (k Keeper) GetCapTableJournalStart(ctx sdk.Context, companyID uint64) (int64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCapTableJournalStartKey(companyID))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// GetCapTableJournalActivation returns the height cap table journaling was activated at
+func (k Keeper) GetCapTableJournalActivation(ctx sdk.Context) (int64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CapTableJournalActivationKey)
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// ActivateCapTableJournal records the current height as the height cap table
+// journaling was activated at, unless it is already recorded. No cap table
+// before it can be replayed.
+func (k Keeper) ActivateCapTableJournal(ctx sdk.Context) {
+	if _, active := k.GetCapTableJournalActivation(ctx); active {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.CapTableJournalActivationKey, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+}
+
+// recordCapTableChanges appends entries to a company's journal. The first change to
+// a company seeds its journal with the state before the change; that state held
+// from journal activation on, so the seed is recorded at the activation height and
+// replays from activation onward are complete.
+func (k Keeper) recordCapTableChanges(ctx sdk.Context, companyID uint64, entries ...types.CapTableJournalEntry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	k.ActivateCapTableJournal(ctx)
+	store := ctx.KVStore(k.storeKey)
+	if _, started := k.GetCapTableJournalStart(ctx, companyID); !started {
+		activation, _ := k.GetCapTableJournalActivation(ctx)
+		seed := k.currentCapTableEntries(ctx, companyID)
+		for i := range seed {
+			seed[i].Height = activation
+		}
+		k.writeCapTableEntries(ctx, companyID, seed)
+		store.Set(types.GetCapTableJournalStartKey(companyID), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+	}
+
+	for i := range entries {
+		entries[i].Height = ctx.BlockHeight()
+	}
+	k.writeCapTableEntries(ctx, companyID, entries)
+}
+
+// writeCapTableEntries stores journal entries at the heights they carry
+func (k Keeper) writeCapTableEntries(ctx sdk.Context, companyID uint64, entries []types.CapTableJournalEntry) {
+	store := ctx.KVStore(k.storeKey)
+	for _, entry := range entries {
+		entry.ID = k.GetNextCapTableJournalID(ctx)
+		entry.CompanyID = companyID
+		entry.Time = ctx.BlockTime()
+		bz, err := json.Marshal(entry)
+		if err != nil {
+			k.Logger(ctx).Error("failed to marshal cap table journal entry", "error", err)
+			continue
+		}
+		store.Set(types.GetCapTableJournalKey(companyID, entry.ID), bz)
+	}
+}
+
+// currentCapTableEntries expresses a company's current ownership as journal entries
+func (k Keeper) currentCapTableEntries(ctx sdk.Context, companyID uint64) []types.CapTableJournalEntry {
+	var entries []types.CapTableJournalEntry
+	for _, shareClass := range k.GetCompanyShareClasses(ctx, companyID) {
+		entries = append(entries,
+			capTableEntry(shareClass.ClassID, types.CapTableJournalIssued, shareClass.IssuedShares),
+			capTableEntry(shareClass.ClassID, types.CapTableJournalOutstanding, shareClass.OutstandingShares),
+		)
+		for _, holding := range k.GetCompanyShareholdings(ctx, companyID, shareClass.ClassID) {
+			if holding.ClassID != shareClass.ClassID {
+				continue
+			}
+			entry := capTableEntry(holding.ClassID, types.CapTableJournalHolding, holding.Shares)
+			entry.Holder = holding.Owner
+			entries = append(entries, entry)
+		}
+	}
+
+	if treasury, found := k.GetCompanyTreasury(ctx, companyID); found {
+		for classID, shares := range treasury.TreasuryShares {
+			entries = append(entries, capTableEntry(classID, types.CapTableJournalTreasury, shares))
+		}
+	}
+
+	for _, grant := range k.GetEquityGrantsByCompany(ctx, companyID) {
+		entries = append(entries, grantJournalEntry(grant))
+	}
+
+	for _, ownership := range k.GetAllBeneficialOwnerships(ctx) {
+		if ownership.CompanyID != companyID {
+			continue
+		}
+		entries = append(entries, beneficialJournalEntry(ownership.ModuleAccount, ownership.ClassID,
+			ownership.BeneficialOwner, ownership.ReferenceID, ownership.Shares))
+	}
+
+	for i := range entries {
+		entries[i].CompanyID = companyID
+		entries[i].Height = ctx.BlockHeight()
+	}
+	// Treasury classes come from a map; keep the seed deterministic
+	sortCapTableEntries(entries)
+	return entries
+}
+
+// BuildCapTable returns a company's cap table at a height, or at the current height
+// if height is zero. Past heights are replayed from the journal and must not precede
+// journal activation; a company whose journal has not started has not changed since
+// activation, so its current state is returned.
+func (k Keeper) BuildCapTable(ctx sdk.Context, companyID uint64, height int64) (types.CapTable, error) {
+	if _, found := k.getCompany(ctx, companyID); !found {
+		return types.CapTable{}, types.ErrCompanyNotFound
+	}
+	if height < 0 || height > ctx.BlockHeight() {
+		return types.CapTable{}, types.ErrCapTableUnavailable.Wrapf("height %d is not in [0, %d]", height, ctx.BlockHeight())
+	}
+
+	if height == 0 || height == ctx.BlockHeight() {
+		return types.ReplayCapTable(companyID, ctx.BlockHeight(), k.currentCapTableEntries(ctx, companyID)), nil
+	}
+
+	activation, active := k.GetCapTableJournalActivation(ctx)
+	if !active {
+		return types.CapTable{}, types.ErrCapTableUnavailable.Wrap("cap table journal has not been activated")
+	}
+	if height < activation {
+		return types.CapTable{}, types.ErrCapTableUnavailable.Wrapf("cap table journal starts at height %d", activation)
+	}
+	if _, started := k.GetCapTableJournalStart(ctx, companyID); !started {
+		return types.ReplayCapTable(companyID, height, k.currentCapTableEntries(ctx, companyID)), nil
+	}
+	return types.ReplayCapTable(companyID, height, k.GetCapTableJournal(ctx, companyID)), nil
+}
+
+// ExportCapTable renders a cap table in the requested format
+func ExportCapTable(table types.CapTable, format string) (string, error) {
+	switch format {
+	case types.CapTableFormatCSV:
+		return table.ExportCSV()
+	case types.CapTableFormatJSON:
+		return table.ExportJSON()
+	default:
+		return "", types.ErrInvalidExportFormat.Wrapf("%q, expected %q or %q", format, types.CapTableFormatCSV, types.CapTableFormatJSON)
+	}
+}
+
+// Journal hooks called by the storage setters
+
+func (k Keeper) journalShareholding(ctx sdk.Context, companyID uint64, classID, owner string, shares math.Int) {
+	previous := math.ZeroInt()
+	if holding, found := k.getShareholding(ctx, companyID, classID, owner); found {
+		previous = holding.Shares
+	}
+	if sameBalance(previous, shares) {
+		return
+	}
+	entry := capTableEntry(classID, types.CapTableJournalHolding, shares)
+	entry.Holder = owner
+	k.recordCapTableChanges(ctx, companyID, entry)
+}
+
+func (k Keeper) journalShareClass(ctx sdk.Context, companyID uint64, classID string, issued, outstanding math.Int) {
+	previousIssued, previousOutstanding := math.ZeroInt(), math.ZeroInt()
+	if shareClass, found := k.getShareClass(ctx, companyID, classID); found {
+		previousIssued, previousOutstanding = shareClass.IssuedShares, shareClass.OutstandingShares
+	}
+
+	var entries []types.CapTableJournalEntry
+	if !sameBalance(previousIssued, issued) {
+		entries = append(entries, capTableEntry(classID, types.CapTableJournalIssued, issued))
+	}
+	if !sameBalance(previousOutstanding, outstanding) {
+		entries = append(entries, capTableEntry(classID, types.CapTableJournalOutstanding, outstanding))
+	}
+	k.recordCapTableChanges(ctx, companyID, entries...)
+}
+
+func (k Keeper) journalTreasury(ctx sdk.Context, treasury types.CompanyTreasury) {
+	previous := map[string]math.Int{}
+	if existing, found := k.GetCompanyTreasury(ctx, treasury.CompanyID); found {
+		previous = existing.TreasuryShares
+	}
+
+	var entries []types.CapTableJournalEntry
+	for classID, shares := range treasury.TreasuryShares {
+		if !sameBalance(previous[classID], shares) {
+			entries = append(entries, capTableEntry(classID, types.CapTableJournalTreasury, shares))
+		}
+	}
+	for classID, shares := range previous {
+		if _, kept := treasury.TreasuryShares[classID]; !kept && !sameBalance(shares, math.ZeroInt()) {
+			entries = append(entries, capTableEntry(classID, types.CapTableJournalTreasury, math.ZeroInt()))
+		}
+	}
+	sortCapTableEntries(entries)
+	k.recordCapTableChanges(ctx, treasury.CompanyID, entries...)
+}
+
+func (k Keeper) journalEquityGrant(ctx sdk.Context, grant types.EquityGrant) {
+	entry := grantJournalEntry(grant)
+	if existing, found := k.GetEquityGrant(ctx, grant.ID); found && sameBalance(grantJournalEntry(existing).Balance, entry.Balance) {
+		return
+	}
+	k.recordCapTableChanges(ctx, grant.CompanyID, entry)
+}
+
+func (k Keeper) journalBeneficialOwner(ctx sdk.Context, moduleAccount string, companyID uint64, classID, owner string, refID uint64, shares math.Int) {
+	k.recordCapTableChanges(ctx, companyID, beneficialJournalEntry(moduleAccount, classID, owner, refID, shares))
+}
+
+func capTableEntry(classID string, kind types.CapTableJournalKind, balance math.Int) types.CapTableJournalEntry {
+	if balance.IsNil() {
+		balance = math.ZeroInt()
+	}
+	return types.CapTableJournalEntry{ClassID: classID, Kind: kind, Balance: balance}
+}
+
+func grantJournalEntry(grant types.EquityGrant) types.CapTableJournalEntry {
+	balance := math.ZeroInt()
+	if grant.IsOpen() && !grant.Shares.IsNil() {
+		balance = grant.OutstandingShares()
+	}
+	entry := capTableEntry(grant.ClassID, types.CapTableJournalGrant, balance)
+	entry.Holder = grant.Holder
+	entry.ReferenceID = grant.ID
+	return entry
+}
+
+func beneficialJournalEntry(moduleAccount, classID, owner string, refID uint64, shares math.Int) types.CapTableJournalEntry {
+	entry := capTableEntry(classID, types.CapTableJournalBeneficial, shares)
+	entry.Holder = moduleAccount
+	entry.BeneficialOwner = owner
+	entry.ReferenceID = refID
+	return entry
+}
+
+func sameBalance(a, b math.Int) bool {
+	if a.IsNil() {
+		a = math.ZeroInt()
+	}
+	if b.IsNil() {
+		b = math.ZeroInt()
+	}
+	return a.Equal(b)
+}
+
+func sortCapTableEntries(entries []types.CapTableJournalEntry) {
+	key := func(e types.CapTableJournalEntry) string {
+		return fmt.Sprintf("%s|%d|%s|%s|%d", e.ClassID, e.Kind, e.Holder, e.BeneficialOwner, e.ReferenceID)
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return key(entries[i]) < key(entries[j]) })
+}
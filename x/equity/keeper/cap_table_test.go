@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/keeper"
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// TestCapTableReplay tests point-in-time reconstruction of a cap table from its journal
+// and the auditor export layouts
+func TestCapTableReplay(t *testing.T) {
+	alice := sdk.AccAddress([]byte("captable_alice______")).String()
+	bob := sdk.AccAddress([]byte("captable_bob________")).String()
+
+	entry := func(height int64, classID string, kind types.CapTableJournalKind, holder string, balance int64) types.CapTableJournalEntry {
+		return types.CapTableJournalEntry{CompanyID: 1, ClassID: classID, Kind: kind, Holder: holder, Balance: math.NewInt(balance), Height: height}
+	}
+	journal := []types.CapTableJournalEntry{
+		// Height 10: founding issuance
+		entry(10, "COMMON", types.CapTableJournalIssued, "", 1000),
+		entry(10, "COMMON", types.CapTableJournalOutstanding, "", 1000),
+		entry(10, "COMMON", types.CapTableJournalHolding, alice, 1000),
+		// Height 20: alice sells 400 to bob, the company buys back 100 into treasury
+		entry(20, "COMMON", types.CapTableJournalHolding, alice, 500),
+		entry(20, "COMMON", types.CapTableJournalHolding, bob, 400),
+		entry(20, "COMMON", types.CapTableJournalOutstanding, "", 900),
+		entry(20, "COMMON", types.CapTableJournalTreasury, "", 100),
+		// Height 30: bob receives a 100 share option and escrows 150 shares
+		{CompanyID: 1, ClassID: "COMMON", Kind: types.CapTableJournalGrant, Holder: bob, ReferenceID: 7, Balance: math.NewInt(100), Height: 30},
+		{CompanyID: 1, ClassID: "COMMON", Kind: types.CapTableJournalBeneficial, Holder: "escrow", BeneficialOwner: bob, ReferenceID: 3, Balance: math.NewInt(150), Height: 30},
+		// Another company's entries are ignored
+		{CompanyID: 2, ClassID: "COMMON", Kind: types.CapTableJournalIssued, Balance: math.NewInt(5), Height: 10},
+	}
+
+	table := types.ReplayCapTable(1, 15, journal)
+	require.Len(t, table.Classes, 1)
+	require.Equal(t, math.NewInt(1000), table.OutstandingShares)
+	require.Len(t, table.Classes[0].Holders, 1)
+	require.Equal(t, math.LegacyNewDec(100), table.Classes[0].Holders[0].OutstandingPercent)
+
+	table = types.ReplayCapTable(1, 20, journal)
+	class := table.Classes[0]
+	require.Equal(t, math.NewInt(1000), class.IssuedShares)
+	require.Equal(t, math.NewInt(900), class.OutstandingShares)
+	require.Equal(t, math.NewInt(100), class.TreasuryShares)
+	require.Equal(t, math.NewInt(900), class.FullyDilutedShares)
+	require.Equal(t, alice, class.Holders[0].Address, "largest holder first")
+	require.Equal(t, math.NewInt(500), class.Holders[0].Shares)
+
+	table = types.ReplayCapTable(1, 30, journal)
+	class = table.Classes[0]
+	require.Equal(t, math.NewInt(100), class.GrantShares)
+	require.Equal(t, math.NewInt(1000), class.FullyDilutedShares)
+	require.Equal(t, math.NewInt(1000), table.FullyDilutedShares)
+	require.Equal(t, bob, class.Holders[1].Address)
+	require.Equal(t, math.LegacyNewDec(50), class.Holders[1].FullyDilutedPercent, "(400 shares + 100 options) / 1000")
+	require.Len(t, class.BeneficialOwners, 1)
+	require.Equal(t, "escrow", class.BeneficialOwners[0].Custodian)
+
+	// Nothing before the first entry
+	require.Empty(t, types.ReplayCapTable(1, 5, journal).Classes)
+
+	// Exports
+	csvExport, err := keeper.ExportCapTable(table, types.CapTableFormatCSV)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(csvExport), "\n")
+	require.Len(t, lines, 5, "header, class, two holders, one beneficial owner")
+	require.Equal(t, strings.Join(types.CapTableCSVHeader, ","), lines[0])
+	require.True(t, strings.HasPrefix(lines[1], "1,30,class,COMMON,"))
+
+	jsonExport, err := keeper.ExportCapTable(table, types.CapTableFormatJSON)
+	require.NoError(t, err)
+	require.Contains(t, jsonExport, `"fully_diluted_shares": "1000"`)
+
+	_, err = keeper.ExportCapTable(table, "xlsx")
+	require.ErrorIs(t, err, types.ErrInvalidExportFormat)
+}
+
+// TestBuildCapTableFromActivation tests that past cap tables replay from journal
+// activation on and that earlier heights are refused
+func TestBuildCapTableFromActivation(t *testing.T) {
+	k, ctx, bank := setupKeeper(t)
+	alice := sdk.AccAddress([]byte("captable_alice______")).String()
+	bob := sdk.AccAddress([]byte("captable_bob________")).String()
+
+	k.ActivateCapTableJournal(ctx.WithBlockHeight(10))
+	createTestCompany(t, k, ctx.WithBlockHeight(12), bank, 1, "CAPT", alice, map[string]int64{alice: 1000})
+
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, k.SetShareholding(ctx, types.NewShareholding(1, "COMMON", alice, math.NewInt(600), math.LegacyOneDec())))
+	require.NoError(t, k.SetShareholding(ctx, types.NewShareholding(1, "COMMON", bob, math.NewInt(400), math.LegacyOneDec())))
+	ctx = ctx.WithBlockHeight(25)
+
+	activation, active := k.GetCapTableJournalActivation(ctx)
+	require.True(t, active)
+	require.Equal(t, int64(10), activation)
+
+	_, err := k.BuildCapTable(ctx, 1, 9)
+	require.ErrorIs(t, err, types.ErrCapTableUnavailable)
+
+	table, err := k.BuildCapTable(ctx, 1, 11)
+	require.NoError(t, err)
+	require.Empty(t, table.Classes, "company had no shares before it was created")
+
+	table, err = k.BuildCapTable(ctx, 1, 15)
+	require.NoError(t, err)
+	require.Len(t, table.Classes[0].Holders, 1)
+	require.Equal(t, math.NewInt(1000), table.Classes[0].Holders[0].Shares)
+
+	table, err = k.BuildCapTable(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Len(t, table.Classes[0].Holders, 2)
+	require.Equal(t, math.NewInt(600), table.Classes[0].Holders[0].Shares)
+
+	// A later activation call leaves the recorded height alone
+	k.ActivateCapTableJournal(ctx)
+	activation, _ = k.GetCapTableJournalActivation(ctx)
+	require.Equal(t, int64(10), activation)
+}
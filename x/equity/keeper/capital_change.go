@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// =============================================================================
+// CAPITAL STRUCTURE CHANGES
+// Changes to share class terms proposed by a company and applied once a
+// capital structure proposal passes
+// =============================================================================
+
+// GetNextCapitalChangeID returns the next capital structure change ID and increments the counter
+func (k Keeper) GetNextCapitalChangeID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CapitalChangeCounterKey)
+
+	var counter uint64 = 1
+	if bz != nil {
+		counter = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.CapitalChangeCounterKey, sdk.Uint64ToBigEndian(counter+1))
+	return counter
+}
+
+// SetCapitalStructureChange stores a capital structure change
+func (k Keeper) SetCapitalStructureChange(ctx sdk.Context, change types.CapitalStructureChange) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(change)
+	if err != nil {
+		return fmt.Errorf("failed to marshal capital structure change: %w", err)
+	}
+	store.Set(types.GetCapitalChangeKey(change.ID), bz)
+	return nil
+}
+
+// GetCapitalStructureChange returns a capital structure change by ID
+func (k Keeper) GetCapitalStructureChange(ctx sdk.Context, changeID uint64) (types.CapitalStructureChange, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCapitalChangeKey(changeID))
+	if bz == nil {
+		return types.CapitalStructureChange{}, false
+	}
+
+	var change types.CapitalStructureChange
+	if err := json.Unmarshal(bz, &change); err != nil {
+		return types.CapitalStructureChange{}, false
+	}
+	return change, true
+}
+
+// proposeCapitalChange stores a pending capital structure change awaiting a governance proposal
+func (k Keeper) proposeCapitalChange(ctx sdk.Context, change types.CapitalStructureChange) (uint64, error) {
+	change.ID = k.GetNextCapitalChangeID(ctx)
+	change.Status = types.CapitalChangeStatusPending
+	change.CreatedAt = ctx.BlockTime()
+	if err := change.Validate(); err != nil {
+		return 0, types.ErrInvalidCapitalChange.Wrap(err.Error())
+	}
+
+	if err := k.SetCapitalStructureChange(ctx, change); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCapitalChangeProposed,
+			sdk.NewAttribute(types.AttributeKeyCapitalChangeID, fmt.Sprintf("%d", change.ID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", change.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareClass, change.ClassID),
+			sdk.NewAttribute("proposed_by", change.ProposedBy),
+		),
+	)
+
+	return change.ID, nil
+}
+
+// ApplyCapitalStructureChange puts a pending change into effect (called by governance
+// once a capital structure proposal passes)
+func (k Keeper) ApplyCapitalStructureChange(ctx sdk.Context, changeID uint64, proposalID uint64) error {
+	change, found := k.GetCapitalStructureChange(ctx, changeID)
+	if !found {
+		return types.ErrCapitalChangeNotFound
+	}
+	if change.Status != types.CapitalChangeStatusPending {
+		return types.ErrCapitalChangeNotPending
+	}
+
+	switch {
+	case change.LiquidationPreference != nil:
+		if err := k.applyLiquidationPreference(ctx, *change.LiquidationPreference, proposalID); err != nil {
+			return err
+		}
+	case change.ConversionTerms != nil:
+		terms := *change.ConversionTerms
+		if err := k.validateConversionTerms(ctx, terms); err != nil {
+			return err
+		}
+		terms.ProposalID = proposalID
+		if err := k.applyConversionTerms(ctx, terms); err != nil {
+			return err
+		}
+	}
+
+	change.Status = types.CapitalChangeStatusApplied
+	change.ProposalID = proposalID
+	change.AppliedAt = ctx.BlockTime()
+	if err := k.SetCapitalStructureChange(ctx, change); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCapitalChangeApplied,
+			sdk.NewAttribute(types.AttributeKeyCapitalChangeID, fmt.Sprintf("%d", changeID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", change.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareClass, change.ClassID),
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// =============================================================================
+// TRANSFER COMPLIANCE
+// Per-class rule sets (holder limits, ownership caps, jurisdiction and accreditation
+// attestations, holding periods, whitelists) evaluated on every share transfer
+// =============================================================================
+
+// SetClassComplianceRules stores the compliance rules of a share class
+func (k Keeper) SetClassComplianceRules(ctx sdk.Context, rules types.ClassComplianceRules) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(rules)
+	if err != nil {
+		return fmt.Errorf("failed to marshal compliance rules: %w", err)
+	}
+	store.Set(types.GetClassComplianceRulesKey(rules.CompanyID, rules.ClassID), bz)
+	return nil
+}
+
+// GetClassComplianceRules returns the compliance rules of a share class
+func (k Keeper) GetClassComplianceRules(ctx sdk.Context, companyID uint64, classID string) (types.ClassComplianceRules, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetClassComplianceRulesKey(companyID, classID))
+	if bz == nil {
+		return types.ClassComplianceRules{}, false
+	}
+
+	var rules types.ClassComplianceRules
+	if err := json.Unmarshal(bz, &rules); err != nil {
+		return types.ClassComplianceRules{}, false
+	}
+	return rules, true
+}
+
+// SetInvestorAttestation stores an investor attestation
+func (k Keeper) SetInvestorAttestation(ctx sdk.Context, attestation types.InvestorAttestation) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(attestation)
+	if err != nil {
+		return fmt.Errorf("failed to marshal investor attestation: %w", err)
+	}
+	store.Set(types.GetInvestorAttestationKey(attestation.CompanyID, attestation.Address), bz)
+	return nil
+}
+
+// GetInvestorAttestation returns a company's attestation for an investor
+func (k Keeper) GetInvestorAttestation(ctx sdk.Context, companyID uint64, address string) (types.InvestorAttestation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetInvestorAttestationKey(companyID, address))
+	if bz == nil {
+		return types.InvestorAttestation{}, false
+	}
+
+	var attestation types.InvestorAttestation
+	if err := json.Unmarshal(bz, &attestation); err != nil {
+		return types.InvestorAttestation{}, false
+	}
+	return attestation, true
+}
+
+// UpdateClassComplianceRules replaces the compliance rules of a share class. An empty
+// rule set removes every rule. Only company authorities may change the rules.
+func (k Keeper) UpdateClassComplianceRules(
+	ctx sdk.Context,
+	companyID uint64,
+	classID string,
+	rules []types.ComplianceRule,
+	setBy string,
+) error {
+	if !k.CanProposeForCompany(ctx, companyID, setBy) {
+		return types.ErrUnauthorized
+	}
+	if _, found := k.getShareClass(ctx, companyID, classID); !found {
+		return types.ErrShareClassNotFound
+	}
+
+	classRules := types.ClassComplianceRules{
+		CompanyID: companyID,
+		ClassID:   classID,
+		Rules:     rules,
+		UpdatedBy: setBy,
+		UpdatedAt: ctx.BlockTime(),
+	}
+	if err := classRules.Validate(); err != nil {
+		return types.ErrInvalidComplianceRule.Wrap(err.Error())
+	}
+
+	if len(rules) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.GetClassComplianceRulesKey(companyID, classID))
+	} else if err := k.SetClassComplianceRules(ctx, classRules); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeComplianceRulesSet,
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", companyID)),
+			sdk.NewAttribute(types.AttributeKeyShareClass, classID),
+			sdk.NewAttribute(types.AttributeKeyComplianceRules, fmt.Sprintf("%d", len(rules))),
+			sdk.NewAttribute("set_by", setBy),
+		),
+	)
+
+	return nil
+}
+
+// AttestInvestor records or replaces the company's attestation of an investor's
+// jurisdiction and accreditation. Only company authorities may attest.
+func (k Keeper) AttestInvestor(ctx sdk.Context, attestation types.InvestorAttestation, attestedBy string) error {
+	if !k.CanProposeForCompany(ctx, attestation.CompanyID, attestedBy) {
+		return types.ErrUnauthorized
+	}
+	if _, found := k.getCompany(ctx, attestation.CompanyID); !found {
+		return types.ErrCompanyNotFound
+	}
+
+	attestation.AttestedBy = attestedBy
+	attestation.AttestedAt = ctx.BlockTime()
+	if err := attestation.Validate(); err != nil {
+		return types.ErrInvalidComplianceRule.Wrap(err.Error())
+	}
+
+	if err := k.SetInvestorAttestation(ctx, attestation); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInvestorAttested,
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", attestation.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareholder, attestation.Address),
+			sdk.NewAttribute(types.AttributeKeyJurisdiction, attestation.Jurisdiction),
+			sdk.NewAttribute(types.AttributeKeyAccredited, fmt.Sprintf("%t", attestation.Accredited)),
+			sdk.NewAttribute("attested_by", attestedBy),
+		),
+	)
+
+	return nil
+}
+
+// RevokeInvestorAttestation removes the company's attestation of an investor
+func (k Keeper) RevokeInvestorAttestation(ctx sdk.Context, companyID uint64, address string, revokedBy string) error {
+	if !k.CanProposeForCompany(ctx, companyID, revokedBy) {
+		return types.ErrUnauthorized
+	}
+	if _, found := k.GetInvestorAttestation(ctx, companyID, address); !found {
+		return types.ErrAttestationNotFound
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetInvestorAttestationKey(companyID, address))
+	return nil
+}
+
+// CheckTransferCompliance evaluates a transfer of shares against the compliance rules
+// of the share class and returns ErrComplianceViolation with the rejection reason if
+// any rule fails. Module accounts hold shares as custodians for their beneficial
+// owners: transfers into them are not evaluated, and transfers out of them are
+// evaluated against the recipient only.
+func (k Keeper) CheckTransferCompliance(
+	ctx sdk.Context,
+	companyID uint64,
+	classID string,
+	from, to string,
+	shares math.Int,
+) error {
+	rules, found := k.GetClassComplianceRules(ctx, companyID, classID)
+	if !found || len(rules.Rules) == 0 {
+		return nil
+	}
+	if k.isModuleAccount(to) {
+		return nil
+	}
+
+	input := types.ComplianceInput{
+		From:              from,
+		To:                to,
+		Shares:            shares,
+		Now:               ctx.BlockTime(),
+		FromBalance:       math.ZeroInt(),
+		ToBalance:         math.ZeroInt(),
+		OutstandingShares: math.ZeroInt(),
+	}
+	if shareClass, found := k.getShareClass(ctx, companyID, classID); found {
+		input.OutstandingShares = shareClass.OutstandingShares
+	}
+	if !k.isModuleAccount(from) {
+		if holding, found := k.getShareholding(ctx, companyID, classID, from); found {
+			input.FromBalance = holding.Shares
+			input.FromAcquiredAt = holding.AcquisitionDate
+		}
+	}
+	if holding, found := k.getShareholding(ctx, companyID, classID, to); found {
+		input.ToBalance = holding.Shares
+	}
+	if attestation, found := k.GetInvestorAttestation(ctx, companyID, to); found {
+		input.ToAttestation = &attestation
+	}
+	input.Holders = k.countClassHolders(ctx, companyID, classID)
+
+	rejection := rules.Evaluate(input)
+	if rejection == nil {
+		return nil
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferRejected,
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", companyID)),
+			sdk.NewAttribute(types.AttributeKeyShareClass, classID),
+			sdk.NewAttribute("from", from),
+			sdk.NewAttribute("to", to),
+			sdk.NewAttribute("shares", shares.String()),
+			sdk.NewAttribute(types.AttributeKeyComplianceRule, rejection.Rule.String()),
+			sdk.NewAttribute(types.AttributeKeyRejectedAddress, rejection.Address),
+			sdk.NewAttribute(types.AttributeKeyRejectionReason, rejection.Reason),
+		),
+	)
+
+	return types.ErrComplianceViolation.Wrap(rejection.Error())
+}
+
+// countClassHolders returns the number of non-custodial holders of a share class
+func (k Keeper) countClassHolders(ctx sdk.Context, companyID uint64, classID string) uint64 {
+	var holders uint64
+	for _, holding := range k.GetCompanyShareholdings(ctx, companyID, classID) {
+		if holding.ClassID != classID || !holding.Shares.IsPositive() || k.isModuleAccount(holding.Owner) {
+			continue
+		}
+		holders++
+	}
+	return holders
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// TestTransferCompliance tests rule validation and evaluation of each compliance rule type
+func TestTransferCompliance(t *testing.T) {
+	alice := sdk.AccAddress([]byte("compliance_alice____")).String()
+	bob := sdk.AccAddress([]byte("compliance_bob______")).String()
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	base := types.ComplianceInput{
+		From:              alice,
+		To:                bob,
+		Shares:            math.NewInt(100),
+		Now:               now,
+		FromBalance:       math.NewInt(1000),
+		FromAcquiredAt:    now.Add(-400 * 24 * time.Hour),
+		ToBalance:         math.ZeroInt(),
+		Holders:           10,
+		OutstandingShares: math.NewInt(10000),
+	}
+	evaluate := func(rule types.ComplianceRule, in types.ComplianceInput) *types.ComplianceRejection {
+		rules := types.ClassComplianceRules{CompanyID: 1, ClassID: "COMMON", Rules: []types.ComplianceRule{rule}}
+		require.NoError(t, rules.Validate())
+		return rules.Evaluate(in)
+	}
+
+	// Holder limit: only transfers that add a holder count against it
+	maxHolders := types.ComplianceRule{Type: types.ComplianceRuleMaxHolders, MaxHolders: 10}
+	rejection := evaluate(maxHolders, base)
+	require.NotNil(t, rejection)
+	require.Equal(t, types.ComplianceRuleMaxHolders, rejection.Rule)
+	require.Equal(t, bob, rejection.Address)
+
+	in := base
+	in.ToBalance = math.NewInt(5)
+	require.Nil(t, evaluate(maxHolders, in), "existing holder")
+	in = base
+	in.Shares = in.FromBalance
+	require.Nil(t, evaluate(maxHolders, in), "sender exits, holder count unchanged")
+
+	// Ownership cap on the recipient's post-transfer balance
+	ownershipCap := types.ComplianceRule{Type: types.ComplianceRuleOwnershipCap, MaxOwnership: math.LegacyMustNewDecFromStr("0.05")}
+	in = base
+	in.ToBalance = math.NewInt(400)
+	require.Nil(t, evaluate(ownershipCap, in), "exactly at the cap")
+	in.ToBalance = math.NewInt(401)
+	require.NotNil(t, evaluate(ownershipCap, in))
+
+	// Jurisdiction and accreditation depend on the recipient's attestation
+	jurisdiction := types.ComplianceRule{Type: types.ComplianceRuleJurisdiction, Jurisdictions: []string{"US", "CA"}}
+	require.NotNil(t, evaluate(jurisdiction, base), "no attestation")
+	in = base
+	in.ToAttestation = &types.InvestorAttestation{CompanyID: 1, Address: bob, Jurisdiction: "DE"}
+	require.NotNil(t, evaluate(jurisdiction, in))
+	in.ToAttestation.Jurisdiction = "CA"
+	require.Nil(t, evaluate(jurisdiction, in))
+
+	accreditation := types.ComplianceRule{Type: types.ComplianceRuleAccreditation}
+	require.NotNil(t, evaluate(accreditation, in), "not accredited")
+	in.ToAttestation.Accredited = true
+	in.ToAttestation.AccreditedUntil = now.Add(-time.Hour)
+	require.NotNil(t, evaluate(accreditation, in), "accreditation expired")
+	in.ToAttestation.AccreditedUntil = time.Time{}
+	require.Nil(t, evaluate(accreditation, in))
+
+	// Holding period applies to the sender
+	holding := types.ComplianceRule{Type: types.ComplianceRuleHoldingPeriod, MinHoldingPeriod: 365 * 24 * time.Hour}
+	require.Nil(t, evaluate(holding, base))
+	in = base
+	in.FromAcquiredAt = now.Add(-30 * 24 * time.Hour)
+	rejection = evaluate(holding, in)
+	require.NotNil(t, rejection)
+	require.Equal(t, alice, rejection.Address)
+
+	// Whitelist
+	whitelist := types.ComplianceRule{Type: types.ComplianceRuleWhitelist, Whitelist: []string{alice}}
+	require.NotNil(t, evaluate(whitelist, base))
+	whitelist.Whitelist = append(whitelist.Whitelist, bob)
+	require.Nil(t, evaluate(whitelist, base))
+
+	// The first failing rule is reported
+	rules := types.ClassComplianceRules{CompanyID: 1, ClassID: "COMMON", Rules: []types.ComplianceRule{whitelist, maxHolders, jurisdiction}}
+	rejection = rules.Evaluate(base)
+	require.NotNil(t, rejection)
+	require.Equal(t, types.ComplianceRuleMaxHolders, rejection.Rule)
+	require.Contains(t, rejection.Error(), "max_holders")
+
+	// Invalid rule sets
+	rules.Rules = append(rules.Rules, maxHolders)
+	require.Error(t, rules.Validate(), "duplicate rule type")
+	require.Error(t, types.ComplianceRule{Type: types.ComplianceRuleOwnershipCap, MaxOwnership: math.LegacyNewDec(2)}.Validate())
+	require.Error(t, types.ComplianceRule{Type: types.ComplianceRuleWhitelist, Whitelist: []string{"not-an-address"}}.Validate())
+	require.Error(t, types.InvestorAttestation{CompanyID: 1, Address: bob}.Validate(), "attests nothing")
+}
@@ -0,0 +1,575 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// =============================================================================
+// SHARE CLASS CONVERSION
+// Conversion of shares between classes (e.g. preferred to common) under per-class
+// terms, either at the holder's election or on a mandatory trigger
+// =============================================================================
+
+// SetConversionTerms stores the conversion terms for a source share class
+func (k Keeper) SetConversionTerms(ctx sdk.Context, terms types.ConversionTerms) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(terms)
+	if err != nil {
+		return fmt.Errorf("failed to marshal conversion terms: %w", err)
+	}
+	store.Set(types.GetConversionTermsKey(terms.CompanyID, terms.SourceClassID), bz)
+	return nil
+}
+
+// GetConversionTerms returns the conversion terms for a source share class
+func (k Keeper) GetConversionTerms(ctx sdk.Context, companyID uint64, sourceClassID string) (types.ConversionTerms, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetConversionTermsKey(companyID, sourceClassID))
+	if bz == nil {
+		return types.ConversionTerms{}, false
+	}
+
+	var terms types.ConversionTerms
+	if err := json.Unmarshal(bz, &terms); err != nil {
+		return types.ConversionTerms{}, false
+	}
+	return terms, true
+}
+
+// GetCompanyConversionTerms returns the conversion terms of every class of a company
+func (k Keeper) GetCompanyConversionTerms(ctx sdk.Context, companyID uint64) []types.ConversionTerms {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetConversionTermsByCompanyPrefix(companyID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var terms []types.ConversionTerms
+	for ; iterator.Valid(); iterator.Next() {
+		var t types.ConversionTerms
+		if err := json.Unmarshal(iterator.Value(), &t); err != nil {
+			continue
+		}
+		terms = append(terms, t)
+	}
+	return terms
+}
+
+// GetNextConversionID returns the next conversion record ID and increments the counter
+func (k Keeper) GetNextConversionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConversionCounterKey)
+
+	var counter uint64 = 1
+	if bz != nil {
+		counter = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.ConversionCounterKey, sdk.Uint64ToBigEndian(counter+1))
+	return counter
+}
+
+// SetConversionRecord stores a conversion record and indexes it by company
+func (k Keeper) SetConversionRecord(ctx sdk.Context, record types.ConversionRecord) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal conversion record: %w", err)
+	}
+	store.Set(types.GetConversionRecordKey(record.ID), bz)
+	store.Set(types.GetConversionByCompanyKey(record.CompanyID, record.ID), sdk.Uint64ToBigEndian(record.ID))
+	return nil
+}
+
+// GetConversionRecord returns a conversion record by ID
+func (k Keeper) GetConversionRecord(ctx sdk.Context, conversionID uint64) (types.ConversionRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetConversionRecordKey(conversionID))
+	if bz == nil {
+		return types.ConversionRecord{}, false
+	}
+
+	var record types.ConversionRecord
+	if err := json.Unmarshal(bz, &record); err != nil {
+		return types.ConversionRecord{}, false
+	}
+	return record, true
+}
+
+// GetConversionsByCompany returns all conversion records for a company
+func (k Keeper) GetConversionsByCompany(ctx sdk.Context, companyID uint64) []types.ConversionRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetConversionsByCompanyPrefix(companyID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var records []types.ConversionRecord
+	for ; iterator.Valid(); iterator.Next() {
+		if record, found := k.GetConversionRecord(ctx, sdk.BigEndianToUint64(iterator.Value())); found {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// UpdateConversionTerms sets or replaces the conversion terms of a share class.
+// The source class must carry ConversionRights. Terms cannot change once a mandatory
+// conversion has fired, and an existing conversion price may only be lowered so that
+// anti-dilution adjustments already made are preserved. Once set, the ratio and
+// original issue price change only through ProposeConversionTerms.
+func (k Keeper) UpdateConversionTerms(ctx sdk.Context, terms types.ConversionTerms, setBy string) error {
+	if !k.CanProposeForCompany(ctx, terms.CompanyID, setBy) {
+		return types.ErrUnauthorized
+	}
+
+	terms.SetBy = setBy
+	terms.ProposalID = 0
+	if err := k.validateConversionTerms(ctx, terms); err != nil {
+		return err
+	}
+
+	if existing, found := k.GetConversionTerms(ctx, terms.CompanyID, terms.SourceClassID); found {
+		if !existing.HasSameBasis(terms) {
+			return types.ErrConversionBasisLocked
+		}
+		if existing.IsPriceBased() && terms.ConversionPrice.GT(existing.ConversionPrice) {
+			return types.ErrInvalidConversionTerms.Wrapf("conversion price cannot be raised above %s", existing.ConversionPrice)
+		}
+		terms.ProposalID = existing.ProposalID
+	}
+
+	return k.applyConversionTerms(ctx, terms)
+}
+
+// ProposeConversionTerms records new conversion terms for a share class, including
+// a new ratio or original issue price. The terms take effect only once a capital
+// structure proposal approves them.
+func (k Keeper) ProposeConversionTerms(ctx sdk.Context, terms types.ConversionTerms, proposedBy string) (uint64, error) {
+	if !k.CanProposeForCompany(ctx, terms.CompanyID, proposedBy) {
+		return 0, types.ErrUnauthorized
+	}
+
+	terms.SetBy = proposedBy
+	terms.ProposalID = 0
+	if err := k.validateConversionTerms(ctx, terms); err != nil {
+		return 0, err
+	}
+
+	return k.proposeCapitalChange(ctx, types.CapitalStructureChange{
+		CompanyID:       terms.CompanyID,
+		ClassID:         terms.SourceClassID,
+		ConversionTerms: &terms,
+		ProposedBy:      proposedBy,
+	})
+}
+
+// validateConversionTerms checks that conversion terms can be set on a share class:
+// the source class carries ConversionRights, the target class exists, and no
+// mandatory conversion has fired under the current terms
+func (k Keeper) validateConversionTerms(ctx sdk.Context, terms types.ConversionTerms) error {
+	sourceClass, found := k.getShareClass(ctx, terms.CompanyID, terms.SourceClassID)
+	if !found {
+		return types.ErrShareClassNotFound
+	}
+	if !sourceClass.ConversionRights {
+		return types.ErrConversionNotAllowed
+	}
+	if _, found := k.getShareClass(ctx, terms.CompanyID, terms.TargetClassID); !found {
+		return types.ErrShareClassNotFound.Wrapf("target class %s", terms.TargetClassID)
+	}
+
+	if existing, found := k.GetConversionTerms(ctx, terms.CompanyID, terms.SourceClassID); found && existing.MandatoryTriggered {
+		return types.ErrInvalidConversionTerms.Wrap("mandatory conversion has already been triggered")
+	}
+
+	if err := terms.Validate(); err != nil {
+		return types.ErrInvalidConversionTerms.Wrap(err.Error())
+	}
+	return nil
+}
+
+// applyConversionTerms stores validated conversion terms
+func (k Keeper) applyConversionTerms(ctx sdk.Context, terms types.ConversionTerms) error {
+	terms.MandatoryTriggered = false
+	terms.TriggeredAt = time.Time{}
+	terms.CreatedAt = ctx.BlockTime()
+	terms.UpdatedAt = ctx.BlockTime()
+	if existing, found := k.GetConversionTerms(ctx, terms.CompanyID, terms.SourceClassID); found {
+		terms.CreatedAt = existing.CreatedAt
+	}
+
+	if err := k.SetConversionTerms(ctx, terms); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConversionTermsSet,
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", terms.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeySourceClass, terms.SourceClassID),
+			sdk.NewAttribute(types.AttributeKeyTargetClass, terms.TargetClassID),
+			sdk.NewAttribute(types.AttributeKeyConversionRatio, terms.ConversionRatio().String()),
+			sdk.NewAttribute("voluntary", fmt.Sprintf("%t", terms.Voluntary)),
+			sdk.NewAttribute("set_by", terms.SetBy),
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", terms.ProposalID)),
+		),
+	)
+
+	return nil
+}
+
+// ConvertShares converts a holder's vested, unlocked shares of a source class into
+// the target class named by its conversion terms
+func (k Keeper) ConvertShares(
+	ctx sdk.Context,
+	holder string,
+	companyID uint64,
+	sourceClassID string,
+	shares math.Int,
+) (types.ConversionRecord, error) {
+	if shares.IsNil() || !shares.IsPositive() {
+		return types.ConversionRecord{}, types.ErrInsufficientShares
+	}
+
+	company, found := k.getCompany(ctx, companyID)
+	if !found {
+		return types.ConversionRecord{}, types.ErrCompanyNotFound
+	}
+	if company.Status != types.CompanyStatusActive {
+		return types.ConversionRecord{}, types.ErrCompanyNotActive
+	}
+
+	terms, found := k.GetConversionTerms(ctx, companyID, sourceClassID)
+	if !found {
+		return types.ConversionRecord{}, types.ErrConversionTermsNotFound
+	}
+	if !terms.CanConvertVoluntarily(ctx.BlockTime()) {
+		return types.ConversionRecord{}, types.ErrConversionWindowClosed
+	}
+
+	return k.convertHolding(ctx, terms, company.Symbol, holder, shares, types.ConversionTriggerVoluntary, 0)
+}
+
+// ExecuteGovernanceConversion converts every holder of a source class whose terms
+// allow a governance-mandated conversion (called by governance)
+func (k Keeper) ExecuteGovernanceConversion(ctx sdk.Context, companyID uint64, sourceClassID string, proposalID uint64) (int, error) {
+	terms, found := k.GetConversionTerms(ctx, companyID, sourceClassID)
+	if !found {
+		return 0, types.ErrConversionTermsNotFound
+	}
+	if !terms.MandatoryOnGovernance {
+		return 0, types.ErrMandatoryNotPermitted
+	}
+	if terms.MandatoryTriggered {
+		return 0, types.ErrInvalidConversionTerms.Wrap("mandatory conversion has already been triggered")
+	}
+
+	return k.executeMandatoryConversion(ctx, terms, types.ConversionTriggerGovernanceVote, proposalID)
+}
+
+// processQualifiedSaleConversions fires mandatory conversions for every class whose
+// qualified sale thresholds are met by a closed primary sale offer. A class that
+// fails to convert is logged and left unconverted so the offer can still close.
+func (k Keeper) processQualifiedSaleConversions(ctx sdk.Context, offer types.PrimarySaleOffer) {
+	proceeds := offer.PricePerShare.MulInt(offer.SharesSold).TruncateInt()
+
+	for _, terms := range k.GetCompanyConversionTerms(ctx, offer.CompanyID) {
+		if !terms.IsQualifiedSale(offer.PricePerShare, proceeds) {
+			continue
+		}
+
+		if _, err := k.executeMandatoryConversion(ctx, terms, types.ConversionTriggerQualifiedPrimarySale, offer.ID); err != nil {
+			k.Logger(ctx).Error("failed qualified sale conversion",
+				"company_id", offer.CompanyID,
+				"class_id", terms.SourceClassID,
+				"offer_id", offer.ID,
+				"error", err,
+			)
+		}
+	}
+}
+
+// executeMandatoryConversion converts the vested, unlocked shares of every holder of
+// the source class and marks the terms as triggered. Shares held by module accounts
+// (open orders, escrows, collateral) and locked or unvested shares stay in the source
+// class; their holders may convert them later regardless of the voluntary window.
+func (k Keeper) executeMandatoryConversion(
+	ctx sdk.Context,
+	terms types.ConversionTerms,
+	trigger types.ConversionTrigger,
+	referenceID uint64,
+) (int, error) {
+	company, found := k.getCompany(ctx, terms.CompanyID)
+	if !found {
+		return 0, types.ErrCompanyNotFound
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	converted := 0
+	for _, holding := range k.GetCompanyShareholdings(cacheCtx, terms.CompanyID, terms.SourceClassID) {
+		if k.isModuleAccount(holding.Owner) {
+			continue
+		}
+		available := holding.VestedShares.Sub(holding.LockedShares)
+		if !available.IsPositive() || terms.ConvertedShares(available).IsZero() {
+			continue
+		}
+		if _, err := k.convertHolding(cacheCtx, terms, company.Symbol, holding.Owner, available, trigger, referenceID); err != nil {
+			return 0, fmt.Errorf("failed to convert holding of %s: %w", holding.Owner, err)
+		}
+		converted++
+	}
+
+	terms.MandatoryTriggered = true
+	terms.TriggeredAt = ctx.BlockTime()
+	terms.UpdatedAt = ctx.BlockTime()
+	if err := k.SetConversionTerms(cacheCtx, terms); err != nil {
+		return 0, err
+	}
+
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMandatoryConversion,
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", terms.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeySourceClass, terms.SourceClassID),
+			sdk.NewAttribute(types.AttributeKeyTargetClass, terms.TargetClassID),
+			sdk.NewAttribute(types.AttributeKeyConversionTrigger, trigger.String()),
+			sdk.NewAttribute("reference_id", fmt.Sprintf("%d", referenceID)),
+			sdk.NewAttribute("holders_converted", fmt.Sprintf("%d", converted)),
+		),
+	)
+
+	k.Logger(ctx).Info("mandatory share conversion executed",
+		"company_id", terms.CompanyID,
+		"source_class", terms.SourceClassID,
+		"target_class", terms.TargetClassID,
+		"trigger", trigger.String(),
+		"holders", converted,
+	)
+
+	return converted, nil
+}
+
+// convertHolding burns source shares from a holding and issues the converted amount
+// of target shares to the same holder. Cost basis carries over in full. When the
+// holder's converted shares are represented as symbol coins, the balance is
+// minted or burned by the difference in share count.
+func (k Keeper) convertHolding(
+	ctx sdk.Context,
+	terms types.ConversionTerms,
+	symbol string,
+	holder string,
+	shares math.Int,
+	trigger types.ConversionTrigger,
+	referenceID uint64,
+) (types.ConversionRecord, error) {
+	holderAddr, err := sdk.AccAddressFromBech32(holder)
+	if err != nil {
+		return types.ConversionRecord{}, types.ErrInvalidAddress
+	}
+
+	sourceClass, found := k.getShareClass(ctx, terms.CompanyID, terms.SourceClassID)
+	if !found {
+		return types.ConversionRecord{}, types.ErrShareClassNotFound
+	}
+	if !sourceClass.ConversionRights {
+		return types.ConversionRecord{}, types.ErrConversionNotAllowed
+	}
+	targetClass, found := k.getShareClass(ctx, terms.CompanyID, terms.TargetClassID)
+	if !found {
+		return types.ConversionRecord{}, types.ErrShareClassNotFound
+	}
+
+	source, found := k.getShareholding(ctx, terms.CompanyID, terms.SourceClassID, holder)
+	if !found {
+		return types.ConversionRecord{}, types.ErrShareholdingNotFound
+	}
+	if source.VestedShares.Sub(source.LockedShares).LT(shares) {
+		return types.ConversionRecord{}, types.ErrInsufficientShares
+	}
+
+	targetShares := terms.ConvertedShares(shares)
+	if !targetShares.IsPositive() {
+		return types.ConversionRecord{}, types.ErrConversionTooSmall
+	}
+	if targetClass.IssuedShares.Add(targetShares).GT(targetClass.AuthorizedShares) {
+		return types.ConversionRecord{}, types.ErrExceedsAuthorized
+	}
+
+	// Burn the source shares
+	movedCost := source.CostBasis.MulInt(shares)
+	source.Shares = source.Shares.Sub(shares)
+	source.VestedShares = source.VestedShares.Sub(shares)
+	source.TotalCost = source.TotalCost.Sub(movedCost)
+	source.UpdatedAt = ctx.BlockTime()
+	if source.Shares.IsZero() {
+		k.DeleteShareholding(ctx, terms.CompanyID, terms.SourceClassID, holder)
+	} else if err := k.SetShareholding(ctx, source); err != nil {
+		return types.ConversionRecord{}, err
+	}
+
+	sourceClass.IssuedShares = sourceClass.IssuedShares.Sub(shares)
+	sourceClass.OutstandingShares = sourceClass.OutstandingShares.Sub(shares)
+	sourceClass.UpdatedAt = ctx.BlockTime()
+	if err := k.SetShareClass(ctx, sourceClass); err != nil {
+		return types.ConversionRecord{}, err
+	}
+
+	// Issue the target shares
+	target, exists := k.getShareholding(ctx, terms.CompanyID, terms.TargetClassID, holder)
+	if exists {
+		target.Shares = target.Shares.Add(targetShares)
+		target.VestedShares = target.VestedShares.Add(targetShares)
+		target.TotalCost = target.TotalCost.Add(movedCost)
+		target.CostBasis = target.TotalCost.QuoInt(target.Shares)
+		target.UpdatedAt = ctx.BlockTime()
+	} else {
+		target = types.NewShareholding(terms.CompanyID, terms.TargetClassID, holder, targetShares, movedCost.QuoInt(targetShares))
+		target.TotalCost = movedCost
+	}
+	if err := k.SetShareholding(ctx, target); err != nil {
+		return types.ConversionRecord{}, err
+	}
+
+	targetClass.IssuedShares = targetClass.IssuedShares.Add(targetShares)
+	targetClass.OutstandingShares = targetClass.OutstandingShares.Add(targetShares)
+	targetClass.UpdatedAt = ctx.BlockTime()
+	if err := k.SetShareClass(ctx, targetClass); err != nil {
+		return types.ConversionRecord{}, err
+	}
+
+	if err := k.rebalanceConvertedCoins(ctx, holderAddr, symbol, shares, targetShares); err != nil {
+		return types.ConversionRecord{}, err
+	}
+
+	record := types.ConversionRecord{
+		ID:            k.GetNextConversionID(ctx),
+		CompanyID:     terms.CompanyID,
+		SourceClassID: terms.SourceClassID,
+		TargetClassID: terms.TargetClassID,
+		Holder:        holder,
+		SourceShares:  shares,
+		TargetShares:  targetShares,
+		Ratio:         terms.ConversionRatio(),
+		Trigger:       trigger,
+		ReferenceID:   referenceID,
+		ConvertedAt:   ctx.BlockTime(),
+	}
+	if err := k.SetConversionRecord(ctx, record); err != nil {
+		return types.ConversionRecord{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSharesConverted,
+			sdk.NewAttribute(types.AttributeKeyConversionID, fmt.Sprintf("%d", record.ID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", terms.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareholder, holder),
+			sdk.NewAttribute(types.AttributeKeySourceClass, terms.SourceClassID),
+			sdk.NewAttribute(types.AttributeKeyTargetClass, terms.TargetClassID),
+			sdk.NewAttribute(types.AttributeKeySourceShares, shares.String()),
+			sdk.NewAttribute(types.AttributeKeyTargetShares, targetShares.String()),
+			sdk.NewAttribute(types.AttributeKeyConversionRatio, record.Ratio.String()),
+			sdk.NewAttribute(types.AttributeKeyConversionTrigger, trigger.String()),
+		),
+	)
+
+	return record, nil
+}
+
+// rebalanceConvertedCoins mints or burns the holder's symbol coins by the change in
+// share count. Classes share the company symbol as their denom, so this only applies
+// when the converted shares are held as coins.
+func (k Keeper) rebalanceConvertedCoins(ctx sdk.Context, holder sdk.AccAddress, symbol string, sourceShares, targetShares math.Int) error {
+	if k.bankKeeper.GetBalance(ctx, holder, symbol).Amount.LT(sourceShares) {
+		return nil
+	}
+
+	switch {
+	case targetShares.GT(sourceShares):
+		coins := sdk.NewCoins(sdk.NewCoin(symbol, targetShares.Sub(sourceShares)))
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, coins)
+	case targetShares.LT(sourceShares):
+		coins := sdk.NewCoins(sdk.NewCoin(symbol, sourceShares.Sub(targetShares)))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, coins); err != nil {
+			return err
+		}
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+	}
+	return nil
+}
+
+// AdjustConversionPrices lowers the conversion price of every class that converts
+// into the issued class and carries an active anti-dilution provision, when the
+// issuance was priced below the current conversion price
+func (k Keeper) AdjustConversionPrices(ctx sdk.Context, issuanceRecord types.IssuanceRecord) error {
+	targetClass, found := k.getShareClass(ctx, issuanceRecord.CompanyID, issuanceRecord.ClassID)
+	if !found {
+		return types.ErrShareClassNotFound
+	}
+	outstandingBefore := targetClass.OutstandingShares.Sub(issuanceRecord.SharesIssued)
+	if outstandingBefore.IsNegative() {
+		outstandingBefore = math.ZeroInt()
+	}
+
+	for _, terms := range k.GetCompanyConversionTerms(ctx, issuanceRecord.CompanyID) {
+		if terms.TargetClassID != issuanceRecord.ClassID || !terms.IsPriceBased() || terms.MandatoryTriggered {
+			continue
+		}
+
+		provision, found := k.GetAntiDilutionProvision(ctx, issuanceRecord.CompanyID, terms.SourceClassID)
+		if !found || !provision.IsActive {
+			continue
+		}
+
+		// Broad-based weighted average counts options and warrants outstanding (fully diluted)
+		sharesBefore := outstandingBefore
+		if provision.ProvisionType == types.AntiDilutionBroadBasedWeightedAverage {
+			sharesBefore = sharesBefore.Add(k.GetOutstandingGrantShares(ctx, issuanceRecord.CompanyID, issuanceRecord.ClassID))
+		}
+
+		oldPrice := terms.ConversionPrice
+		newPrice := terms.AdjustedConversionPrice(
+			provision.ProvisionType,
+			issuanceRecord.IssuePrice,
+			sharesBefore,
+			issuanceRecord.SharesIssued,
+			provision.MinimumPrice,
+		)
+		if newPrice.Equal(oldPrice) {
+			continue
+		}
+
+		terms.ConversionPrice = newPrice
+		terms.UpdatedAt = ctx.BlockTime()
+		if err := k.SetConversionTerms(ctx, terms); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeConversionPriceAdjusted,
+				sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", terms.CompanyID)),
+				sdk.NewAttribute(types.AttributeKeySourceClass, terms.SourceClassID),
+				sdk.NewAttribute(types.AttributeKeyTargetClass, terms.TargetClassID),
+				sdk.NewAttribute("issuance_id", fmt.Sprintf("%d", issuanceRecord.ID)),
+				sdk.NewAttribute("old_conversion_price", oldPrice.String()),
+				sdk.NewAttribute(types.AttributeKeyConversionPrice, newPrice.String()),
+				sdk.NewAttribute(types.AttributeKeyConversionRatio, terms.ConversionRatio().String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// TestConversionTerms tests conversion ratios, windows, triggers and anti-dilution price adjustment
+func TestConversionTerms(t *testing.T) {
+	founder := sdk.AccAddress([]byte("conversion_founder__")).String()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	terms := types.ConversionTerms{
+		CompanyID:                1,
+		SourceClassID:            "PREFERRED_A",
+		TargetClassID:            "COMMON",
+		OriginalIssuePrice:       math.LegacyNewDec(10),
+		ConversionPrice:          math.LegacyNewDec(10),
+		Voluntary:                true,
+		WindowStart:              now,
+		WindowEnd:                now.Add(365 * 24 * time.Hour),
+		MandatoryOnQualifiedSale: true,
+		QualifiedSaleMinPrice:    math.LegacyNewDec(20),
+		QualifiedSaleMinRaise:    math.NewInt(1_000_000),
+		SetBy:                    founder,
+	}
+	require.NoError(t, terms.Validate())
+
+	// 1:1 until adjusted
+	require.Equal(t, math.LegacyOneDec(), terms.ConversionRatio())
+	require.Equal(t, math.NewInt(100), terms.ConvertedShares(math.NewInt(100)))
+
+	// Window
+	require.False(t, terms.CanConvertVoluntarily(now.Add(-time.Hour)))
+	require.True(t, terms.CanConvertVoluntarily(now.Add(time.Hour)))
+	require.False(t, terms.CanConvertVoluntarily(now.Add(400*24*time.Hour)))
+
+	// Qualified sale thresholds
+	require.True(t, terms.IsQualifiedSale(math.LegacyNewDec(25), math.NewInt(2_000_000)))
+	require.False(t, terms.IsQualifiedSale(math.LegacyNewDec(15), math.NewInt(2_000_000)))
+	require.False(t, terms.IsQualifiedSale(math.LegacyNewDec(25), math.NewInt(500_000)))
+
+	// Once triggered, remaining holders may convert outside the window
+	triggered := terms
+	triggered.MandatoryTriggered = true
+	require.True(t, triggered.CanConvertVoluntarily(now.Add(400*24*time.Hour)))
+	require.False(t, triggered.IsQualifiedSale(math.LegacyNewDec(25), math.NewInt(2_000_000)))
+
+	// Full ratchet drops the conversion price to the down-round price
+	price := terms.AdjustedConversionPrice(types.AntiDilutionFullRatchet, math.LegacyNewDec(5), math.NewInt(1000), math.NewInt(100), math.LegacyZeroDec())
+	require.Equal(t, math.LegacyNewDec(5), price)
+	terms.ConversionPrice = price
+	require.Equal(t, math.LegacyNewDec(2), terms.ConversionRatio())
+	require.Equal(t, math.NewInt(200), terms.ConvertedShares(math.NewInt(100)))
+
+	// Weighted average: (5*900 + 2*100) / 1000 = 4.7
+	price = terms.AdjustedConversionPrice(types.AntiDilutionWeightedAverage, math.LegacyNewDec(2), math.NewInt(900), math.NewInt(100), math.LegacyZeroDec())
+	require.Equal(t, math.LegacyMustNewDecFromStr("4.7"), price)
+
+	// Minimum price floor, and no adjustment on an up round
+	price = terms.AdjustedConversionPrice(types.AntiDilutionFullRatchet, math.LegacyNewDec(1), math.NewInt(900), math.NewInt(100), math.LegacyNewDec(3))
+	require.Equal(t, math.LegacyNewDec(3), price)
+	price = terms.AdjustedConversionPrice(types.AntiDilutionFullRatchet, math.LegacyNewDec(8), math.NewInt(900), math.NewInt(100), math.LegacyZeroDec())
+	require.Equal(t, math.LegacyNewDec(5), price)
+
+	// Fixed-ratio terms are not price-adjusted
+	fixed := types.ConversionTerms{
+		CompanyID:     1,
+		SourceClassID: "PREFERRED_B",
+		TargetClassID: "COMMON",
+		Ratio:         math.LegacyMustNewDecFromStr("1.5"),
+		Voluntary:     true,
+		SetBy:         founder,
+	}
+	require.NoError(t, fixed.Validate())
+	require.Equal(t, math.NewInt(4), fixed.ConvertedShares(math.NewInt(3))) // 4.5 rounds down
+	require.False(t, fixed.IsPriceBased())
+
+	// Invalid terms
+	invalid := fixed
+	invalid.TargetClassID = "PREFERRED_B"
+	require.Error(t, invalid.Validate(), "same class")
+
+	invalid = fixed
+	invalid.Ratio = math.LegacyZeroDec()
+	require.Error(t, invalid.Validate(), "no ratio or price")
+
+	invalid = fixed
+	invalid.Voluntary = false
+	require.Error(t, invalid.Validate(), "no way to convert")
+}
+
+// TestConversionTermsBasisLocked tests that a class's ratio and original issue price
+// change only through an applied capital structure change
+func TestConversionTermsBasisLocked(t *testing.T) {
+	k, ctx, bank := setupKeeper(t)
+	founder := sdk.AccAddress([]byte("conversion_founder__")).String()
+	createTestCompany(t, k, ctx, bank, 1, "CNV", founder, map[string]int64{founder: 1000})
+
+	preferred := types.NewShareClass(1, "PREFERRED_A", "Preferred A", "preferred stock", true, false, false, true,
+		math.NewInt(100_000), math.LegacyOneDec(), true)
+	require.NoError(t, k.SetShareClass(ctx, preferred))
+
+	terms := types.ConversionTerms{
+		CompanyID:          1,
+		SourceClassID:      "PREFERRED_A",
+		TargetClassID:      "COMMON",
+		OriginalIssuePrice: math.LegacyNewDec(10),
+		ConversionPrice:    math.LegacyNewDec(10),
+		Voluntary:          true,
+	}
+	require.NoError(t, k.UpdateConversionTerms(ctx, terms, founder))
+
+	// Non-economic edits and price reductions still apply directly
+	edited := terms
+	edited.ConversionPrice = math.LegacyNewDec(8)
+	edited.MandatoryOnGovernance = true
+	require.NoError(t, k.UpdateConversionTerms(ctx, edited, founder))
+
+	// The issue price and the price basis are locked
+	repriced := edited
+	repriced.OriginalIssuePrice = math.LegacyNewDec(5)
+	require.ErrorIs(t, k.UpdateConversionTerms(ctx, repriced, founder), types.ErrConversionBasisLocked)
+
+	fixed := edited
+	fixed.ConversionPrice = math.LegacyZeroDec()
+	fixed.Ratio = math.LegacyMustNewDecFromStr("0.5")
+	require.ErrorIs(t, k.UpdateConversionTerms(ctx, fixed, founder), types.ErrConversionBasisLocked)
+
+	// A capital structure proposal can change them
+	changeID, err := k.ProposeConversionTerms(ctx, fixed, founder)
+	require.NoError(t, err)
+	current, _ := k.GetConversionTerms(ctx, 1, "PREFERRED_A")
+	require.Equal(t, math.LegacyNewDec(8), current.ConversionPrice)
+
+	require.NoError(t, k.ApplyCapitalStructureChange(ctx, changeID, 3))
+	current, _ = k.GetConversionTerms(ctx, 1, "PREFERRED_A")
+	require.False(t, current.IsPriceBased())
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.5"), current.ConversionRatio())
+	require.Equal(t, uint64(3), current.ProposalID)
+
+	// Once fixed, the ratio is locked in turn
+	fixed.Ratio = math.LegacyNewDec(2)
+	require.ErrorIs(t, k.UpdateConversionTerms(ctx, fixed, founder), types.ErrConversionBasisLocked)
+}
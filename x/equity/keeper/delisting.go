@@ -529,7 +529,7 @@ func (k Keeper) SubmitCompensationClaim(ctx sdk.Context, companyID uint64, claim
 	}
 
 	// During claim window, just register the claim (no immediate payment)
-	// Payment happens pro-rata after the claim window closes
+	// Payment happens through the liquidation waterfall after the claim window closes
 	if ctx.BlockTime().Before(comp.ClaimWindowEnd) {
 		claimID := k.GetNextClaimID(ctx)
 		claim := types.NewCompensationClaim(
@@ -539,7 +539,7 @@ func (k Keeper) SubmitCompensationClaim(ctx sdk.Context, companyID uint64, claim
 			claimant,
 			shareClass,
 			shareholding.Shares,
-			math.LegacyZeroDec(), // Don't calculate amount yet - will be pro-rata
+			math.LegacyZeroDec(), // Don't calculate amount yet - set by the waterfall distribution
 			ctx.BlockTime(),
 		)
 		claim.Status = "registered" // Not yet paid
@@ -555,7 +555,7 @@ func (k Keeper) SubmitCompensationClaim(ctx sdk.Context, companyID uint64, claim
 			return 0, err
 		}
 
-		k.Logger(ctx).Info("compensation claim registered (payment pending pro-rata distribution)",
+		k.Logger(ctx).Info("compensation claim registered (payment pending distribution)",
 			"claim_id", claimID,
 			"company_id", companyID,
 			"claimant", claimant,
@@ -734,10 +734,11 @@ func (k Keeper) ConfirmFraudAndDelist(ctx sdk.Context, companyID uint64, reportI
 }
 
 // =============================================================================
-// Pro-Rata Compensation Distribution
+// Compensation Distribution
 // =============================================================================
 
-// ProcessCompensationDistribution handles pro-rata distribution after claim window closes
+// ProcessCompensationDistribution distributes compensation pools through the liquidation
+// waterfall after the claim window closes
 // Called from EndBlock
 func (k Keeper) ProcessCompensationDistribution(ctx sdk.Context) {
 	// Find compensations ready for distribution
@@ -754,7 +755,7 @@ func (k Keeper) ProcessCompensationDistribution(ctx sdk.Context) {
 
 		// Check if distribution time has passed and not yet distributed
 		if ctx.BlockTime().After(comp.DistributionAt) && !comp.IsDistributed {
-			if err := k.distributeCompensationProRata(ctx, &comp); err != nil {
+			if err := k.distributeCompensationWaterfall(ctx, &comp); err != nil {
 				k.Logger(ctx).Error("failed to distribute compensation",
 					"compensation_id", comp.ID,
 					"error", err,
@@ -764,8 +765,10 @@ func (k Keeper) ProcessCompensationDistribution(ctx sdk.Context) {
 	}
 }
 
-// distributeCompensationProRata distributes compensation to all claimants pro-rata
-func (k Keeper) distributeCompensationProRata(ctx sdk.Context, comp *types.DelistingCompensation) error {
+// distributeCompensationWaterfall distributes compensation to all claimants. The pool is
+// split between share classes by the liquidation waterfall over the claimed shares, then
+// pro-rata within each class, so preferred classes are paid ahead of common.
+func (k Keeper) distributeCompensationWaterfall(ctx sdk.Context, comp *types.DelistingCompensation) error {
 	if comp.IsDistributed {
 		return fmt.Errorf("compensation already distributed")
 	}
@@ -784,21 +787,31 @@ func (k Keeper) distributeCompensationProRata(ctx sdk.Context, comp *types.Delis
 		return nil
 	}
 
-	// Calculate pro-rata compensation per share
-	// claimAmount = (userShares / totalClaimedShares) * totalPool
-	proRataPerShare := math.LegacyNewDecFromInt(comp.TotalPool).QuoInt(comp.TotalClaimedShares)
-
 	// Get all claims for this compensation
 	claims := k.getClaimsByCompensation(ctx, comp.ID)
 
+	// Run the waterfall over the shares claimed in each class
+	claimedByClass := make(map[string]math.Int)
+	for _, claim := range claims {
+		if claim.Status != "registered" {
+			continue
+		}
+		if existing, found := claimedByClass[claim.ShareClass]; found {
+			claimedByClass[claim.ShareClass] = existing.Add(claim.SharesHeld)
+		} else {
+			claimedByClass[claim.ShareClass] = claim.SharesHeld
+		}
+	}
+	waterfall := k.CalculateLiquidationWaterfall(ctx, comp.CompanyID, comp.TotalPool, claimedByClass)
+
 	// Pay each claim proportionally
 	for _, claim := range claims {
 		if claim.Status != "registered" {
 			continue // Skip already processed claims
 		}
 
-		// Calculate pro-rata amount
-		claimAmount := proRataPerShare.MulInt(claim.SharesHeld).TruncateInt()
+		// Pro-rata share of the class payout
+		claimAmount := waterfall.HolderAmount(claim.ShareClass, claim.SharesHeld)
 
 		// Transfer compensation to claimant
 		claimantAddr, err := sdk.AccAddressFromBech32(claim.Claimant)
@@ -853,7 +866,7 @@ func (k Keeper) distributeCompensationProRata(ctx sdk.Context, comp *types.Delis
 		comp.ClaimedAmount = comp.ClaimedAmount.Add(claimAmount)
 		comp.RemainingPool = comp.RemainingPool.Sub(claimAmount)
 
-		k.Logger(ctx).Info("compensation paid pro-rata",
+		k.Logger(ctx).Info("compensation paid",
 			"claim_id", claim.ID,
 			"claimant", claim.Claimant,
 			"shares", claim.SharesHeld.String(),
@@ -869,7 +882,7 @@ func (k Keeper) distributeCompensationProRata(ctx sdk.Context, comp *types.Delis
 		return err
 	}
 
-	k.Logger(ctx).Info("pro-rata distribution completed",
+	k.Logger(ctx).Info("compensation distribution completed",
 		"compensation_id", comp.ID,
 		"company_id", comp.CompanyID,
 		"total_paid", comp.ClaimedAmount.String(),
@@ -223,6 +223,7 @@ func (k Keeper) DeclareDividend(
 	description string,
 	paymentMethod string,
 	stockRatio math.LegacyDec,
+	terms types.DividendTerms, // Stock, property and scrip distribution terms
 	auditInfo types.AuditInfo, // MANDATORY audit document
 ) (uint64, error) {
 	// Get company to verify creator is authorized
@@ -276,11 +277,30 @@ func (k Keeper) DeclareDividend(
 	if dividendType == types.DividendTypeStock {
 		dividend.StockRatio = stockRatio
 	}
+	dividend.StockClassID = terms.StockClassID
+	if !terms.FractionalPrice.IsNil() {
+		dividend.FractionalPrice = terms.FractionalPrice
+	}
+	if dividendType == types.DividendTypeProperty {
+		dividend.PropertyCompanyID = terms.PropertyCompanyID
+		dividend.PropertyClassID = terms.PropertyClassID
+	}
+	if !terms.ScripPrice.IsNil() {
+		dividend.ScripPrice = terms.ScripPrice
+	}
 	
 	// Validate dividend
 	if err := dividend.Validate(); err != nil {
 		return 0, err
 	}
+
+	// Scrip elections are only offered by companies with dividend reinvestment enabled
+	if dividend.OffersScrip() {
+		policy, found := k.GetDividendPolicy(ctx, companyID)
+		if !found || !policy.ReinvestmentAvailable {
+			return 0, types.ErrScripNotOffered.Wrap("dividend reinvestment is not enabled for the company")
+		}
+	}
 	
 	// Calculate total dividend amount needed (simplified)
 	var totalShares math.Int
@@ -299,8 +319,8 @@ func (k Keeper) DeclareDividend(
 	dividend.RemainingAmount = dividend.TotalAmount
 	dividend.EligibleShares = totalShares
 
-	// For cash dividends, verify TREASURY has sufficient funds (NOT founder)
-	if dividendType == types.DividendTypeCash {
+	// For dividends paid in coins, verify TREASURY has sufficient funds (NOT founder)
+	if dividend.PaysCoins() {
 		treasury, found := k.GetCompanyTreasury(ctx, companyID)
 		if !found {
 			return 0, types.ErrTreasuryNotFound
@@ -333,6 +353,20 @@ func (k Keeper) DeclareDividend(
 		// Note: Funds are already in equity module account from treasury deposits
 		// We just deducted from treasury tracking, funds will be distributed from module
 	}
+
+	// For property dividends of another company's shares, reserve the treasury-held shares
+	if dividend.IsPropertyShares() {
+		if k.IsTreasuryFrozen(ctx, companyID) {
+			return 0, types.ErrTreasuryFrozenForInvestigation
+		}
+
+		requiredShares := dividend.TotalAmount.TruncateInt()
+		if err := k.LockTreasuryEquity(ctx, companyID, dividend.PropertyCompanyID, dividend.PropertyClassID, requiredShares); err != nil {
+			return 0, fmt.Errorf("%w: need %s shares of company %d class %s",
+				err, requiredShares.String(), dividend.PropertyCompanyID, dividend.PropertyClassID)
+		}
+		dividend.PropertySharesLocked = requiredShares
+	}
 	
 	// Create audit record for this dividend (MANDATORY)
 	auditID, err := k.CreateAuditForDividend(ctx, dividendID, companyID, creator, auditInfo)
@@ -546,7 +580,7 @@ func (k Keeper) ProcessDividendPayments(ctx sdk.Context, dividendID uint64, batc
 				ownerCompanyID, err := strconv.ParseUint(parts[1], 10, 64)
 				if err == nil {
 					// Credit dividend to treasury balance instead of sending to address
-					if dividend.Type == types.DividendTypeCash {
+					if dividend.PaysCoins() {
 						paymentCoins := sdk.NewCoins(sdk.NewCoin(dividend.Currency, netAmount.TruncateInt()))
 						if err := k.CreditTreasuryDividend(ctx, ownerCompanyID, dividend.CompanyID, paymentCoins); err != nil {
 							payment.Status = "failed"
@@ -563,10 +597,15 @@ func (k Keeper) ProcessDividendPayments(ctx sdk.Context, dividendID uint64, batc
 								"amount", netAmount.String(),
 							)
 						}
-					} else {
-						// Stock dividends to treasuries - add to investment holdings
+					} else if err := k.payTreasuryShareDividend(ctx, &dividend, &payment, ownerCompanyID); err != nil {
+						// Share dividends to treasuries - add to investment holdings
 						payment.Status = "skipped"
-						payment.FailureReason = "stock_dividend_to_treasury_not_supported"
+						payment.FailureReason = fmt.Sprintf("treasury share dividend failed: %v", err)
+					} else {
+						payment.Status = "paid_to_treasury"
+						payment.PaidAt = ctx.BlockTime()
+						totalPaid = totalPaid.Add(grossAmount)
+						paymentsProcessed++
 					}
 					if err := k.SetDividendPayment(ctx, payment); err != nil {
 						k.Logger(ctx).Error("failed to set dividend payment", "error", err)
@@ -605,7 +644,7 @@ func (k Keeper) ProcessDividendPayments(ctx sdk.Context, dividendID uint64, batc
 		}
 
 		// Process payment based on dividend type
-		if dividend.Type == types.DividendTypeCash {
+		if dividend.PaysCoins() {
 			// Transfer funds from module to recipient (shareholder or charity)
 			var err error
 			recipientAddr, err = sdk.AccAddressFromBech32(recipientAddrStr)
@@ -613,12 +652,18 @@ func (k Keeper) ProcessDividendPayments(ctx sdk.Context, dividendID uint64, batc
 				payment.Status = "failed"
 				payment.FailureReason = "invalid address"
 			} else {
-				paymentCoins := sdk.NewCoins(sdk.NewCoin(dividend.Currency, netAmount.TruncateInt()))
-				if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, paymentCoins); err != nil {
+				if !isRedirected && k.electsScrip(ctx, dividend, shareholderSnapshot.Shareholder) {
+					// Holder elected new shares instead of cash
+					err = k.payScripDividend(ctx, &dividend, &payment, recipientAddr)
+				} else {
+					paymentCoins := sdk.NewCoins(sdk.NewCoin(dividend.Currency, netAmount.TruncateInt()))
+					err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, paymentCoins)
+				}
+				if err != nil {
 					payment.Status = "failed"
 					payment.FailureReason = err.Error()
 				} else {
-					if !isRedirected {
+					if !isRedirected && payment.Status != types.DividendPaymentStatusReinvested {
 						payment.Status = "paid"
 					}
 					payment.PaidAt = ctx.BlockTime()
@@ -647,26 +692,30 @@ func (k Keeper) ProcessDividendPayments(ctx sdk.Context, dividendID uint64, batc
 				payment.Status = "skipped"
 				payment.FailureReason = "blacklisted_shareholder_stock_dividend"
 			} else {
-				// Issue new shares based on stock ratio
-				newShares := dividend.StockRatio.MulInt(shareholderSnapshot.SharesHeld).TruncateInt()
-				if err := k.IssueShares(
-					ctx,
-					dividend.CompanyID,
-					dividend.ClassID,
-					shareholderSnapshot.Shareholder,
-					newShares,
-					math.LegacyZeroDec(), // No cost for stock dividends
-					"",
-				); err != nil {
+				// Issue new shares based on stock ratio, with cash in lieu of fractions
+				if err := k.payStockDividend(ctx, &dividend, &payment); err != nil {
 					payment.Status = "failed"
 					payment.FailureReason = err.Error()
 				} else {
 					payment.Status = "paid"
 					payment.PaidAt = ctx.BlockTime()
-					dividend.NewSharesIssued = dividend.NewSharesIssued.Add(newShares)
 					paymentsProcessed++
 				}
 			}
+		} else if dividend.IsPropertyShares() {
+			// Shares of another company cannot be redirected to charity either
+			if isRedirected {
+				payment.Status = "skipped"
+				payment.FailureReason = "blacklisted_shareholder_property_dividend"
+			} else if err := k.payPropertySharesDividend(ctx, &dividend, &payment); err != nil {
+				payment.Status = "failed"
+				payment.FailureReason = err.Error()
+			} else {
+				payment.Status = "paid"
+				payment.PaidAt = ctx.BlockTime()
+				totalPaid = totalPaid.Add(grossAmount)
+				paymentsProcessed++
+			}
 		}
 
 		// Store payment record
@@ -688,6 +737,11 @@ func (k Keeper) ProcessDividendPayments(ctx sdk.Context, dividendID uint64, batc
 	if isComplete {
 		dividend.Status = types.DividendStatusPaid
 		dividend.CompletedAt = ctx.BlockTime()
+
+		// Shares reserved for skipped or failed property payments return to the treasury
+		if err := k.ReleaseUndistributedPropertyShares(ctx, &dividend); err != nil {
+			k.Logger(ctx).Error("failed to release property dividend shares", "dividend_id", dividendID, "error", err)
+		}
 	} else {
 		dividend.Status = types.DividendStatusProcessing
 	}
@@ -762,19 +816,25 @@ func (k Keeper) ClaimDividend(ctx sdk.Context, claimant string, dividendID uint6
 	payment.NetAmount = netAmount
 	
 	// Process payment
-	if dividend.Type == types.DividendTypeCash {
+	if dividend.PaysCoins() {
 		// Transfer funds from module to claimant
 		claimantAddr, err := sdk.AccAddressFromBech32(claimant)
 		if err != nil {
 			return math.LegacyZeroDec(), "", math.LegacyZeroDec(), math.LegacyZeroDec(), types.ErrUnauthorized
 		}
 		
-		paymentCoins := sdk.NewCoins(sdk.NewCoin(dividend.Currency, netAmount.TruncateInt()))
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, claimantAddr, paymentCoins); err != nil {
-			return math.LegacyZeroDec(), "", math.LegacyZeroDec(), math.LegacyZeroDec(), types.ErrDividendPaymentFailed
+		if k.electsScrip(ctx, dividend, claimant) {
+			if err := k.payScripDividend(ctx, &dividend, &payment, claimantAddr); err != nil {
+				return math.LegacyZeroDec(), "", math.LegacyZeroDec(), math.LegacyZeroDec(), types.ErrDividendPaymentFailed.Wrap(err.Error())
+			}
+		} else {
+			paymentCoins := sdk.NewCoins(sdk.NewCoin(dividend.Currency, netAmount.TruncateInt()))
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, claimantAddr, paymentCoins); err != nil {
+				return math.LegacyZeroDec(), "", math.LegacyZeroDec(), math.LegacyZeroDec(), types.ErrDividendPaymentFailed
+			}
+			payment.Status = "paid"
 		}
 		
-		payment.Status = "paid"
 		payment.PaidAt = ctx.BlockTime()
 	} else {
 		return math.LegacyZeroDec(), "", math.LegacyZeroDec(), math.LegacyZeroDec(), types.ErrInvalidPaymentMethod
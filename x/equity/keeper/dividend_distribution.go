@@ -0,0 +1,431 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// =============================================================================
+// DIVIDEND DISTRIBUTION
+// Stock dividends with cash in lieu of fractions, property dividends of bank denoms
+// or treasury-held shares of other companies, and per-dividend scrip elections
+// =============================================================================
+
+// SetDividendElection stores a holder's scrip election for a dividend
+func (k Keeper) SetDividendElection(ctx sdk.Context, election types.DividendElection) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(election)
+	if err != nil {
+		return fmt.Errorf("failed to marshal dividend election: %w", err)
+	}
+	store.Set(types.GetDividendElectionKey(election.DividendID, election.Holder), bz)
+	return nil
+}
+
+// GetDividendElection returns a holder's scrip election for a dividend
+func (k Keeper) GetDividendElection(ctx sdk.Context, dividendID uint64, holder string) (types.DividendElection, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDividendElectionKey(dividendID, holder))
+	if bz == nil {
+		return types.DividendElection{}, false
+	}
+
+	var election types.DividendElection
+	if err := json.Unmarshal(bz, &election); err != nil {
+		return types.DividendElection{}, false
+	}
+	return election, true
+}
+
+// GetDividendElections returns every election made for a dividend
+func (k Keeper) GetDividendElections(ctx sdk.Context, dividendID uint64) []types.DividendElection {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDividendElectionsPrefix(dividendID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var elections []types.DividendElection
+	for ; iterator.Valid(); iterator.Next() {
+		var election types.DividendElection
+		if err := json.Unmarshal(iterator.Value(), &election); err != nil {
+			continue
+		}
+		elections = append(elections, election)
+	}
+	return elections
+}
+
+// ElectDividendScrip records whether a holder takes a dividend in scrip shares or cash.
+// The election may be changed until payments start or the payment date is reached.
+func (k Keeper) ElectDividendScrip(ctx sdk.Context, holder string, dividendID uint64, scrip bool) error {
+	dividend, found := k.GetDividend(ctx, dividendID)
+	if !found {
+		return types.ErrDividendNotFound
+	}
+	if !dividend.OffersScrip() {
+		return types.ErrScripNotOffered
+	}
+	if !dividend.IsElectionOpen(ctx.BlockTime()) {
+		return types.ErrDividendElectionClosed
+	}
+
+	// Once the record date snapshot is taken only holders of record may elect
+	if _, taken := k.GetDividendSnapshot(ctx, dividendID); taken {
+		if _, found := k.GetShareholderSnapshot(ctx, dividendID, holder); !found {
+			return types.ErrDividendNotEligible
+		}
+	} else if !k.holdsDividendShares(ctx, dividend, holder) {
+		return types.ErrDividendNotEligible
+	}
+
+	election := types.DividendElection{
+		DividendID: dividendID,
+		Holder:     holder,
+		Scrip:      scrip,
+		ElectedAt:  ctx.BlockTime(),
+	}
+	if err := k.SetDividendElection(ctx, election); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDividendElection,
+			sdk.NewAttribute(types.AttributeKeyDividendID, fmt.Sprintf("%d", dividendID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", dividend.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareholder, holder),
+			sdk.NewAttribute(types.AttributeKeyScrip, fmt.Sprintf("%t", scrip)),
+		),
+	)
+
+	return nil
+}
+
+// holdsDividendShares reports whether an address currently holds shares the dividend is declared on
+func (k Keeper) holdsDividendShares(ctx sdk.Context, dividend types.Dividend, holder string) bool {
+	if dividend.ClassID != "" {
+		holding, found := k.getShareholding(ctx, dividend.CompanyID, dividend.ClassID, holder)
+		return found && holding.Shares.IsPositive()
+	}
+	for _, class := range k.GetCompanyShareClasses(ctx, dividend.CompanyID) {
+		if holding, found := k.getShareholding(ctx, dividend.CompanyID, class.ClassID, holder); found && holding.Shares.IsPositive() {
+			return true
+		}
+	}
+	return false
+}
+
+// electsScrip reports whether a holder takes a dividend in scrip. Holders without an
+// election follow the company's dividend reinvestment default.
+func (k Keeper) electsScrip(ctx sdk.Context, dividend types.Dividend, holder string) bool {
+	if !dividend.OffersScrip() {
+		return false
+	}
+	if election, found := k.GetDividendElection(ctx, dividend.ID, holder); found {
+		return election.Scrip
+	}
+	policy, found := k.GetDividendPolicy(ctx, dividend.CompanyID)
+	return found && policy.ReinvestmentAvailable && policy.AutoReinvestmentDefault
+}
+
+// payScripDividend pays a holder's net cash dividend in new shares at the scrip price,
+// sending any remainder below one share in cash. The cash reinvested in the new shares
+// stays with the company and is returned to its treasury.
+func (k Keeper) payScripDividend(ctx sdk.Context, dividend *types.Dividend, payment *types.DividendPayment, recipient sdk.AccAddress) error {
+	shares, remainder := dividend.ScripEntitlement(payment.NetAmount)
+
+	cacheCtx, write := ctx.CacheContext()
+	if shares.IsPositive() {
+		if err := k.IssueShares(
+			cacheCtx,
+			dividend.CompanyID,
+			dividend.NewShareClassID(),
+			payment.Shareholder,
+			shares,
+			dividend.ScripPrice,
+			dividend.Currency,
+		); err != nil {
+			return err
+		}
+
+		treasury, found := k.GetCompanyTreasury(cacheCtx, dividend.CompanyID)
+		if !found {
+			return types.ErrTreasuryNotFound
+		}
+		reinvested := sdk.NewCoins(sdk.NewCoin(dividend.Currency, payment.NetAmount.Sub(remainder).TruncateInt()))
+		treasury.Balance = treasury.Balance.Add(reinvested...)
+		treasury.UpdatedAt = ctx.BlockTime()
+		if err := k.SetCompanyTreasury(cacheCtx, treasury); err != nil {
+			return err
+		}
+	}
+
+	if cash := remainder.TruncateInt(); cash.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(dividend.Currency, cash))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, recipient, coins); err != nil {
+			return err
+		}
+	}
+	write()
+
+	payment.Status = types.DividendPaymentStatusReinvested
+	payment.SharesIssued = shares
+	dividend.ScripSharesIssued = dividend.ScripSharesIssued.Add(shares)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDividendReinvested,
+			sdk.NewAttribute(types.AttributeKeyDividendID, fmt.Sprintf("%d", dividend.ID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", dividend.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareholder, payment.Shareholder),
+			sdk.NewAttribute(types.AttributeKeyShareClass, dividend.NewShareClassID()),
+			sdk.NewAttribute("shares", shares.String()),
+			sdk.NewAttribute("scrip_price", dividend.ScripPrice.String()),
+			sdk.NewAttribute("cash_remainder", remainder.TruncateInt().String()),
+		),
+	)
+
+	return nil
+}
+
+// payStockDividend issues a holder's new shares and pays cash in lieu of the fraction
+func (k Keeper) payStockDividend(ctx sdk.Context, dividend *types.Dividend, payment *types.DividendPayment) error {
+	whole, cash := dividend.ShareEntitlement(payment.SharesHeld)
+
+	if whole.IsPositive() {
+		if err := k.IssueShares(
+			ctx,
+			dividend.CompanyID,
+			dividend.NewShareClassID(),
+			payment.Shareholder,
+			whole,
+			math.LegacyZeroDec(), // No cost for stock dividends
+			"",
+		); err != nil {
+			return err
+		}
+	}
+	payment.SharesIssued = whole
+	dividend.NewSharesIssued = dividend.NewSharesIssued.Add(whole)
+
+	k.payCashInLieu(ctx, dividend, payment, cash)
+	return nil
+}
+
+// payPropertySharesDividend transfers a holder's entitlement of treasury-held shares of
+// another company and pays cash in lieu of the fraction
+func (k Keeper) payPropertySharesDividend(ctx sdk.Context, dividend *types.Dividend, payment *types.DividendPayment) error {
+	whole, cash := dividend.ShareEntitlement(payment.SharesHeld)
+
+	if whole.IsPositive() {
+		cacheCtx, write := ctx.CacheContext()
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		if err := k.TransferShares(cacheCtx, dividend.PropertyCompanyID, dividend.PropertyClassID, moduleAddr.String(), payment.Shareholder, whole); err != nil {
+			return err
+		}
+		if err := k.releasePropertyShares(cacheCtx, dividend, whole, true); err != nil {
+			return err
+		}
+		write()
+	}
+	payment.SharesIssued = whole
+
+	k.payCashInLieu(ctx, dividend, payment, cash)
+	return nil
+}
+
+// payTreasuryShareDividend delivers a stock or property share dividend to a company
+// treasury holding the dividend payer's shares. The shares stay in the equity module
+// account and are booked as an investment of the receiving treasury; cash in lieu of
+// fractions is credited to its balance.
+func (k Keeper) payTreasuryShareDividend(ctx sdk.Context, dividend *types.Dividend, payment *types.DividendPayment, ownerCompanyID uint64) error {
+	whole, cash := dividend.ShareEntitlement(payment.SharesHeld)
+
+	holdingCompanyID, holdingClassID := dividend.CompanyID, dividend.NewShareClassID()
+	if dividend.IsPropertyShares() {
+		holdingCompanyID, holdingClassID = dividend.PropertyCompanyID, dividend.PropertyClassID
+		if ownerCompanyID == dividend.PropertyCompanyID {
+			return types.ErrInvalidInput.Wrap("company cannot receive its own shares into treasury")
+		}
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if whole.IsPositive() {
+		if dividend.IsPropertyShares() {
+			if err := k.releasePropertyShares(cacheCtx, dividend, whole, true); err != nil {
+				return err
+			}
+		} else {
+			moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+			if err := k.IssueShares(cacheCtx, dividend.CompanyID, holdingClassID, moduleAddr.String(), whole, math.LegacyZeroDec(), ""); err != nil {
+				return err
+			}
+			dividend.NewSharesIssued = dividend.NewSharesIssued.Add(whole)
+		}
+
+		treasury, found := k.GetCompanyTreasury(cacheCtx, ownerCompanyID)
+		if !found {
+			return types.ErrTreasuryNotFound
+		}
+		if treasury.InvestmentHoldings == nil {
+			treasury.InvestmentHoldings = make(map[uint64]map[string]math.Int)
+		}
+		if treasury.InvestmentHoldings[holdingCompanyID] == nil {
+			treasury.InvestmentHoldings[holdingCompanyID] = make(map[string]math.Int)
+		}
+		current := treasury.GetInvestmentShares(holdingCompanyID, holdingClassID)
+		treasury.InvestmentHoldings[holdingCompanyID][holdingClassID] = current.Add(whole)
+		treasury.UpdatedAt = ctx.BlockTime()
+		if err := k.SetCompanyTreasury(cacheCtx, treasury); err != nil {
+			return err
+		}
+	}
+	write()
+	payment.SharesIssued = whole
+
+	if cash.IsPositive() {
+		cacheCtx, write := ctx.CacheContext()
+		coins, err := k.debitCashInLieu(cacheCtx, *dividend, cash)
+		if err == nil {
+			err = k.CreditTreasuryDividend(cacheCtx, ownerCompanyID, dividend.CompanyID, coins)
+		}
+		if err != nil {
+			payment.FailureReason = fmt.Sprintf("cash in lieu not paid: %v", err)
+		} else {
+			write()
+			payment.CashInLieu = cash
+			dividend.CashInLieuPaid = dividend.CashInLieuPaid.Add(cash)
+		}
+	}
+
+	k.Logger(ctx).Info("share dividend paid to treasury",
+		"dividend_id", dividend.ID,
+		"recipient_treasury", ownerCompanyID,
+		"company_id", holdingCompanyID,
+		"class_id", holdingClassID,
+		"shares", whole.String(),
+	)
+
+	return nil
+}
+
+// payCashInLieu pays a holder cash for a fractional share from the paying company's
+// treasury. The share delivery stands if the treasury cannot fund it; the shortfall
+// is recorded on the payment.
+func (k Keeper) payCashInLieu(ctx sdk.Context, dividend *types.Dividend, payment *types.DividendPayment, cash math.Int) {
+	if !cash.IsPositive() {
+		return
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(payment.Shareholder)
+	if err != nil {
+		payment.FailureReason = "cash in lieu not paid: invalid address"
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	coins, err := k.debitCashInLieu(cacheCtx, *dividend, cash)
+	if err == nil {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, recipient, coins)
+	}
+	if err != nil {
+		payment.FailureReason = fmt.Sprintf("cash in lieu not paid: %v", err)
+		return
+	}
+	write()
+
+	payment.CashInLieu = cash
+	dividend.CashInLieuPaid = dividend.CashInLieuPaid.Add(cash)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDividendCashInLieu,
+			sdk.NewAttribute(types.AttributeKeyDividendID, fmt.Sprintf("%d", dividend.ID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", dividend.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareholder, payment.Shareholder),
+			sdk.NewAttribute(types.AttributeKeyTreasuryAmount, coins.String()),
+		),
+	)
+}
+
+// debitCashInLieu withdraws cash in lieu of fractions from the paying company's treasury
+func (k Keeper) debitCashInLieu(ctx sdk.Context, dividend types.Dividend, cash math.Int) (sdk.Coins, error) {
+	treasury, found := k.GetCompanyTreasury(ctx, dividend.CompanyID)
+	if !found {
+		return nil, types.ErrTreasuryNotFound
+	}
+	if k.IsTreasuryFrozen(ctx, dividend.CompanyID) {
+		return nil, types.ErrTreasuryFrozenForInvestigation
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(dividend.Currency, cash))
+	if balance := treasury.Balance.AmountOf(dividend.Currency); balance.LT(cash) {
+		return nil, fmt.Errorf("%w: need %s %s, treasury has %s",
+			types.ErrInsufficientTreasuryBalance, cash.String(), dividend.Currency, balance.String())
+	}
+
+	treasury.Balance = treasury.Balance.Sub(coins...)
+	treasury.TotalWithdrawn = treasury.TotalWithdrawn.Add(coins...)
+	treasury.UpdatedAt = ctx.BlockTime()
+	if err := k.SetCompanyTreasury(ctx, treasury); err != nil {
+		return nil, err
+	}
+	return coins, nil
+}
+
+// releasePropertyShares releases shares reserved for a property dividend from the paying
+// treasury. Distributed shares also leave its investment holdings; undistributed shares
+// only become available again.
+func (k Keeper) releasePropertyShares(ctx sdk.Context, dividend *types.Dividend, shares math.Int, distributed bool) error {
+	if !dividend.IsPropertyShares() || dividend.PropertySharesLocked.IsNil() || !shares.IsPositive() {
+		return nil
+	}
+	if shares.GT(dividend.PropertySharesLocked) {
+		return types.ErrInsufficientTreasuryShares
+	}
+
+	treasury, found := k.GetCompanyTreasury(ctx, dividend.CompanyID)
+	if !found {
+		return types.ErrTreasuryNotFound
+	}
+
+	if locked := treasury.LockedEquity[dividend.PropertyCompanyID]; locked != nil {
+		remaining := treasury.GetLockedEquity(dividend.PropertyCompanyID, dividend.PropertyClassID).Sub(shares)
+		if remaining.IsNegative() {
+			remaining = math.ZeroInt()
+		}
+		locked[dividend.PropertyClassID] = remaining
+	}
+	if distributed {
+		holdings := treasury.InvestmentHoldings[dividend.PropertyCompanyID]
+		if holdings == nil {
+			return types.ErrInsufficientTreasuryShares
+		}
+		remaining := treasury.GetInvestmentShares(dividend.PropertyCompanyID, dividend.PropertyClassID).Sub(shares)
+		if remaining.IsNegative() {
+			return types.ErrInsufficientTreasuryShares
+		}
+		holdings[dividend.PropertyClassID] = remaining
+	}
+	treasury.UpdatedAt = ctx.BlockTime()
+	if err := k.SetCompanyTreasury(ctx, treasury); err != nil {
+		return err
+	}
+
+	dividend.PropertySharesLocked = dividend.PropertySharesLocked.Sub(shares)
+	return nil
+}
+
+// ReleaseUndistributedPropertyShares makes treasury shares still reserved for a
+// completed or cancelled property dividend available again
+func (k Keeper) ReleaseUndistributedPropertyShares(ctx sdk.Context, dividend *types.Dividend) error {
+	if dividend.PropertySharesLocked.IsNil() {
+		return nil
+	}
+	return k.releasePropertyShares(ctx, dividend, dividend.PropertySharesLocked, false)
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// TestDividendDistribution tests stock dividend fractions, property share dividends,
+// scrip entitlements and the election window
+func TestDividendDistribution(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newDividend := func(dividendType types.DividendType) types.Dividend {
+		return types.NewDividend(1, 1, "COMMON", dividendType, "uhodl", math.LegacyZeroDec(),
+			now, now.AddDate(0, 0, 5), now.AddDate(0, 0, 7), now.AddDate(0, 0, 14), "declarant")
+	}
+
+	// 1 new share for every 20 held, fractions paid at 40 uhodl per share
+	stock := newDividend(types.DividendTypeStock)
+	stock.StockRatio = math.LegacyNewDecWithPrec(5, 2)
+	stock.StockClassID = "CLASS_B"
+	stock.FractionalPrice = math.LegacyNewDec(40)
+	require.NoError(t, stock.Validate())
+	require.False(t, stock.PaysCoins())
+	require.Equal(t, "CLASS_B", stock.NewShareClassID())
+
+	whole, cash := stock.ShareEntitlement(math.NewInt(110))
+	require.Equal(t, math.NewInt(5), whole) // 5.5 rounds down
+	require.Equal(t, math.NewInt(20), cash) // half a share at 40
+
+	whole, cash = stock.ShareEntitlement(math.NewInt(10))
+	require.True(t, whole.IsZero())
+	require.Equal(t, math.NewInt(20), cash)
+
+	// Without a fractional price the fraction is forfeited
+	stock.FractionalPrice = math.LegacyZeroDec()
+	_, cash = stock.ShareEntitlement(math.NewInt(110))
+	require.True(t, cash.IsZero())
+
+	// New shares default to the dividend class
+	stock.StockClassID = ""
+	require.Equal(t, "COMMON", stock.NewShareClassID())
+
+	// Property dividend of a bank denom pays coins
+	property := newDividend(types.DividendTypeProperty)
+	property.Currency = "ibc/USDC"
+	property.AmountPerShare = math.LegacyNewDec(2)
+	require.NoError(t, property.Validate())
+	require.True(t, property.PaysCoins())
+	require.False(t, property.IsPropertyShares())
+
+	// Property dividend of another company's shares: 1 share for every 4 held
+	shares := newDividend(types.DividendTypeProperty)
+	shares.Currency = "uhodl"
+	shares.AmountPerShare = math.LegacyNewDecWithPrec(25, 2)
+	shares.PropertyCompanyID = 7
+	shares.PropertyClassID = "COMMON"
+	shares.FractionalPrice = math.LegacyNewDec(100)
+	require.NoError(t, shares.Validate())
+	require.False(t, shares.PaysCoins())
+	require.True(t, shares.IsPropertyShares())
+
+	whole, cash = shares.ShareEntitlement(math.NewInt(10))
+	require.Equal(t, math.NewInt(2), whole)
+	require.Equal(t, math.NewInt(50), cash)
+
+	// Scrip election on a cash dividend at 12 uhodl per share
+	scrip := newDividend(types.DividendTypeCash)
+	scrip.AmountPerShare = math.LegacyNewDec(1)
+	scrip.ScripPrice = math.LegacyNewDec(12)
+	require.NoError(t, scrip.Validate())
+	require.True(t, scrip.OffersScrip())
+
+	newShares, remainder := scrip.ScripEntitlement(math.LegacyNewDec(100))
+	require.Equal(t, math.NewInt(8), newShares)
+	require.Equal(t, math.LegacyNewDec(4), remainder)
+
+	require.True(t, scrip.IsElectionOpen(now))
+	require.False(t, scrip.IsElectionOpen(scrip.PaymentDate))
+	scrip.Status = types.DividendStatusProcessing
+	require.False(t, scrip.IsElectionOpen(now))
+
+	// Cash dividends without a scrip price pay cash only
+	cashOnly := newDividend(types.DividendTypeCash)
+	require.False(t, cashOnly.OffersScrip())
+	newShares, remainder = cashOnly.ScripEntitlement(math.LegacyNewDec(100))
+	require.True(t, newShares.IsZero())
+	require.Equal(t, math.LegacyNewDec(100), remainder)
+
+	// Invalid distribution terms
+	invalid := newDividend(types.DividendTypeStock)
+	require.ErrorIs(t, invalid.Validate(), types.ErrInvalidDividendDistribution, "missing stock ratio")
+
+	invalid = shares
+	invalid.PropertyCompanyID = invalid.CompanyID
+	require.ErrorIs(t, invalid.Validate(), types.ErrInvalidDividendDistribution, "own shares as property")
+
+	invalid = shares
+	invalid.PropertyClassID = ""
+	require.ErrorIs(t, invalid.Validate(), types.ErrInvalidDividendDistribution, "missing property class")
+
+	invalid = property
+	invalid.Currency = ""
+	require.ErrorIs(t, invalid.Validate(), types.ErrInvalidDividendDistribution, "property without denom or shares")
+
+	invalid = shares
+	invalid.ScripPrice = math.LegacyNewDec(10)
+	require.ErrorIs(t, invalid.Validate(), types.ErrInvalidDividendDistribution, "scrip on a property dividend")
+
+	invalid = scrip
+	invalid.ScripPrice = math.LegacyNewDec(-1)
+	require.ErrorIs(t, invalid.Validate(), types.ErrInvalidDividendDistribution, "negative scrip price")
+}
@@ -34,14 +34,17 @@ type AccountKeeper interface {
 
 // Keeper of the equity store
 type Keeper struct {
-	cdc             codec.BinaryCodec
-	storeKey        storetypes.StoreKey
-	memKey          storetypes.StoreKey
-	bankKeeper      BankKeeper
-	accountKeeper   AccountKeeper
-	stakingKeeper   types.UniversalStakingKeeper // For tier checks and stake locks
-	validatorKeeper types.ValidatorKeeper        // For validator authorization checks
-	authority       string                       // Governance module address for privileged operations
+	cdc                codec.BinaryCodec
+	storeKey           storetypes.StoreKey
+	memKey             storetypes.StoreKey
+	bankKeeper         BankKeeper
+	accountKeeper      AccountKeeper
+	stakingKeeper      types.UniversalStakingKeeper // For tier checks and stake locks
+	validatorKeeper    types.ValidatorKeeper        // For validator authorization checks
+	shareSplitHooks    types.ShareSplitHooks        // Custody modules rescaling their records on stock splits
+	shareExchangeHooks types.ShareExchangeHooks     // Custody modules moving their records on mergers
+	dexKeeper          types.DexKeeper              // For cashless option and warrant exercise
+	authority          string                       // Governance module address for privileged operations
 }
 
 // NewKeeper creates a new equity Keeper instance
@@ -79,6 +82,21 @@ func (k *Keeper) SetValidatorKeeper(validatorKeeper types.ValidatorKeeper) {
 	k.validatorKeeper = validatorKeeper
 }
 
+// SetShareSplitHooks sets the hooks called when a stock split executes (for late binding during app initialization)
+func (k *Keeper) SetShareSplitHooks(hooks types.ShareSplitHooks) {
+	k.shareSplitHooks = hooks
+}
+
+// SetShareExchangeHooks sets the hooks called when a merger executes (for late binding during app initialization)
+func (k *Keeper) SetShareExchangeHooks(hooks types.ShareExchangeHooks) {
+	k.shareExchangeHooks = hooks
+}
+
+// SetDexKeeper sets the DEX keeper (for late binding during app initialization)
+func (k *Keeper) SetDexKeeper(dexKeeper types.DexKeeper) {
+	k.dexKeeper = dexKeeper
+}
+
 // Logger returns a module-specific logger
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -254,6 +272,8 @@ func (k Keeper) GetCompanyBySymbol(ctx sdk.Context, symbol string) (uint64, bool
 
 // SetShareClass stores a share class
 func (k Keeper) SetShareClass(ctx sdk.Context, shareClass types.ShareClass) error {
+	k.journalShareClass(ctx, shareClass.CompanyID, shareClass.ClassID, shareClass.IssuedShares, shareClass.OutstandingShares)
+
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetShareClassKey(shareClass.CompanyID, shareClass.ClassID)
 	bz, err := json.Marshal(shareClass)
@@ -266,6 +286,8 @@ func (k Keeper) SetShareClass(ctx sdk.Context, shareClass types.ShareClass) erro
 
 // DeleteShareClass removes a share class
 func (k Keeper) DeleteShareClass(ctx sdk.Context, companyID uint64, classID string) {
+	k.journalShareClass(ctx, companyID, classID, math.ZeroInt(), math.ZeroInt())
+
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetShareClassKey(companyID, classID)
 	store.Delete(key)
@@ -296,6 +318,8 @@ func (k Keeper) GetCompanyShareClasses(ctx sdk.Context, companyID uint64) []type
 
 // SetShareholding stores a shareholding
 func (k Keeper) SetShareholding(ctx sdk.Context, shareholding types.Shareholding) error {
+	k.journalShareholding(ctx, shareholding.CompanyID, shareholding.ClassID, shareholding.Owner, shareholding.Shares)
+
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetShareholdingKey(shareholding.CompanyID, shareholding.ClassID, shareholding.Owner)
 	bz, err := json.Marshal(shareholding)
@@ -308,6 +332,8 @@ func (k Keeper) SetShareholding(ctx sdk.Context, shareholding types.Shareholding
 
 // DeleteShareholding removes a shareholding
 func (k Keeper) DeleteShareholding(ctx sdk.Context, companyID uint64, classID, owner string) {
+	k.journalShareholding(ctx, companyID, classID, owner, math.ZeroInt())
+
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetShareholdingKey(companyID, classID, owner)
 	store.Delete(key)
@@ -453,6 +479,11 @@ func (k Keeper) TransferShares(
 	if availableShares.LT(shares) {
 		return types.ErrInsufficientShares
 	}
+
+	// Evaluate the class compliance rules
+	if err := k.CheckTransferCompliance(ctx, companyID, classID, from, to, shares); err != nil {
+		return err
+	}
 	
 	// Update from holding
 	fromHolding.Shares = fromHolding.Shares.Sub(shares)
@@ -490,6 +521,71 @@ func (k Keeper) TransferShares(
 	return nil
 }
 
+// CheckShareTransferAllowed verifies a transfer would satisfy the share class transfer
+// restrictions and compliance rules and the sender's lockup without moving any shares.
+// Used by modules that settle equity outside TransferShares (e.g. escrow-backed OTC
+// block trades).
+func (k Keeper) CheckShareTransferAllowed(
+	ctx sdk.Context,
+	companyID uint64,
+	classID string,
+	from, to string,
+	shares math.Int,
+) error {
+	if shares.IsNil() || !shares.IsPositive() {
+		return types.ErrInsufficientShares
+	}
+	if from == to {
+		return types.ErrTransferRestricted
+	}
+
+	shareClass, found := k.getShareClass(ctx, companyID, classID)
+	if !found {
+		return types.ErrShareClassNotFound
+	}
+	if !shareClass.Transferable {
+		return types.ErrTransferRestricted.Wrap("share class is not transferable")
+	}
+
+	// Restricted classes may only be held by allow-listed addresses
+	if len(shareClass.RestrictedTo) > 0 {
+		allowed := false
+		for _, addr := range shareClass.RestrictedTo {
+			if addr == to {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return types.ErrTransferRestricted.Wrapf("%s is not an allowed holder of class %s", to, classID)
+		}
+	}
+
+	holding, found := k.getShareholding(ctx, companyID, classID, from)
+	if !found {
+		return types.ErrShareholdingNotFound
+	}
+	if holding.TransferRestricted {
+		return types.ErrTransferRestricted.Wrap("shareholding is transfer restricted")
+	}
+
+	// Lockups: explicit expiry on the holding, or the class lockup from acquisition
+	now := ctx.BlockTime()
+	if !holding.LockupExpiry.IsZero() && now.Before(holding.LockupExpiry) {
+		return types.ErrSharesLocked.Wrapf("locked until %s", holding.LockupExpiry)
+	}
+	if shareClass.LockupPeriod > 0 && !holding.AcquisitionDate.IsZero() {
+		if unlock := holding.AcquisitionDate.Add(shareClass.LockupPeriod); now.Before(unlock) {
+			return types.ErrSharesLocked.Wrapf("locked until %s", unlock)
+		}
+	}
+
+	if holding.VestedShares.Sub(holding.LockedShares).LT(shares) {
+		return types.ErrInsufficientShares
+	}
+	return k.CheckTransferCompliance(ctx, companyID, classID, from, to, shares)
+}
+
 // GetTotalShares returns total outstanding shares for a share class
 func (k Keeper) GetTotalShares(ctx sdk.Context, companyID uint64, classID string) math.Int {
 	shareClass, found := k.getShareClass(ctx, companyID, classID)
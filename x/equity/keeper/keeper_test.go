@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cometbfttypes "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/keeper"
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// mockBankKeeper keeps balances in memory; module accounts are addressed by
+// authtypes.NewModuleAddress like the real bank keeper
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (m *mockBankKeeper) move(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance := m.balances[from.String()]
+	if !balance.IsAllGTE(amt) {
+		return fmt.Errorf("insufficient funds: %s < %s", balance, amt)
+	}
+	m.balances[from.String()] = balance.Sub(amt...)
+	m.balances[to.String()] = m.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.move(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.move(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (m *mockBankKeeper) SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.move(fromAddr, toAddr, amt)
+}
+
+func (m *mockBankKeeper) MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	m.balances[addr] = m.balances[addr].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	balance := m.balances[addr]
+	if !balance.IsAllGTE(amt) {
+		return fmt.Errorf("insufficient funds to burn: %s < %s", balance, amt)
+	}
+	m.balances[addr] = balance.Sub(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, m.balances[addr.String()].AmountOf(denom))
+}
+
+// mockAccountKeeper resolves module addresses only
+type mockAccountKeeper struct{}
+
+func (m mockAccountKeeper) GetAccount(context.Context, sdk.AccAddress) sdk.AccountI { return nil }
+
+func (m mockAccountKeeper) SetAccount(context.Context, sdk.AccountI) {}
+
+func (m mockAccountKeeper) NewAccount(_ context.Context, acc sdk.AccountI) sdk.AccountI { return acc }
+
+func (m mockAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+// setupKeeper returns an equity keeper on an in-memory store
+func setupKeeper(t *testing.T) (*keeper.Keeper, sdk.Context, *mockBankKeeper) {
+	t.Helper()
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	memKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(memKey, storetypes.StoreTypeMemory, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	bank := newMockBankKeeper()
+	authority := authtypes.NewModuleAddress("gov").String()
+	k := keeper.NewKeeper(cdc, storeKey, memKey, bank, mockAccountKeeper{}, authority)
+
+	header := cometbfttypes.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	ctx := sdk.NewContext(stateStore, header, false, log.NewNopLogger())
+
+	return k, ctx, bank
+}
+
+// createTestCompany stores an active company with one share class, its treasury,
+// and the given holders' shares and symbol balances
+func createTestCompany(
+	t *testing.T,
+	k *keeper.Keeper,
+	ctx sdk.Context,
+	bank *mockBankKeeper,
+	id uint64,
+	symbol, founder string,
+	holders map[string]int64,
+) {
+	t.Helper()
+
+	company := types.NewCompany(id, symbol+" Inc", symbol, "test company", founder, "", "tech", "US",
+		math.NewInt(1_000_000), math.NewInt(1_000_000), math.LegacyOneDec(), sdk.NewCoins())
+	company.Status = types.CompanyStatusActive
+	require.NoError(t, k.SetCompany(ctx, company))
+
+	issued := math.ZeroInt()
+	for holder, shares := range holders {
+		amount := math.NewInt(shares)
+		require.NoError(t, k.SetShareholding(ctx, types.NewShareholding(id, "COMMON", holder, amount, math.LegacyOneDec())))
+		addr, err := sdk.AccAddressFromBech32(holder)
+		require.NoError(t, err)
+		bank.balances[addr.String()] = bank.balances[addr.String()].Add(sdk.NewCoin(symbol, amount))
+		issued = issued.Add(amount)
+	}
+
+	shareClass := types.NewShareClass(id, "COMMON", "Common", "common stock", true, true, false, false,
+		math.NewInt(1_000_000), math.LegacyOneDec(), true)
+	shareClass.IssuedShares = issued
+	shareClass.OutstandingShares = issued
+	require.NoError(t, k.SetShareClass(ctx, shareClass))
+	require.NoError(t, k.SetCompanyTreasury(ctx, types.NewCompanyTreasury(id)))
+}
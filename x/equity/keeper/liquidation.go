@@ -0,0 +1,520 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// =============================================================================
+// LIQUIDATION PREFERENCES
+// Per-class preference terms and the waterfall used by delisting compensation
+// and voluntary dissolution
+// =============================================================================
+
+// SetLiquidationPreference stores the liquidation preference of a share class
+func (k Keeper) SetLiquidationPreference(ctx sdk.Context, pref types.LiquidationPreference) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(pref)
+	if err != nil {
+		return fmt.Errorf("failed to marshal liquidation preference: %w", err)
+	}
+	store.Set(types.GetLiquidationPreferenceKey(pref.CompanyID, pref.ClassID), bz)
+	return nil
+}
+
+// GetLiquidationPreference returns the liquidation preference of a share class
+func (k Keeper) GetLiquidationPreference(ctx sdk.Context, companyID uint64, classID string) (types.LiquidationPreference, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLiquidationPreferenceKey(companyID, classID))
+	if bz == nil {
+		return types.LiquidationPreference{}, false
+	}
+
+	var pref types.LiquidationPreference
+	if err := json.Unmarshal(bz, &pref); err != nil {
+		return types.LiquidationPreference{}, false
+	}
+	return pref, true
+}
+
+// ProposeLiquidationPreference records new preference terms for a share class.
+// The terms take effect only once a capital structure proposal approves them.
+func (k Keeper) ProposeLiquidationPreference(ctx sdk.Context, pref types.LiquidationPreference, proposedBy string) (uint64, error) {
+	if !k.CanProposeForCompany(ctx, pref.CompanyID, proposedBy) {
+		return 0, types.ErrUnauthorized
+	}
+
+	pref.SetBy = proposedBy
+	pref.ProposalID = 0
+	if err := k.validateLiquidationPreference(ctx, pref); err != nil {
+		return 0, err
+	}
+
+	return k.proposeCapitalChange(ctx, types.CapitalStructureChange{
+		CompanyID:             pref.CompanyID,
+		ClassID:               pref.ClassID,
+		LiquidationPreference: &pref,
+		ProposedBy:            proposedBy,
+	})
+}
+
+// validateLiquidationPreference checks that preference terms can be set on a
+// share class. The class must carry the LiquidationPreference flag, and terms
+// are frozen while a dissolution or delisting compensation pays out under them.
+func (k Keeper) validateLiquidationPreference(ctx sdk.Context, pref types.LiquidationPreference) error {
+	shareClass, found := k.getShareClass(ctx, pref.CompanyID, pref.ClassID)
+	if !found {
+		return types.ErrShareClassNotFound
+	}
+	if !shareClass.LiquidationPreference {
+		return types.ErrNoLiquidationPreference
+	}
+
+	if k.liquidationTermsLocked(ctx, pref.CompanyID) {
+		return types.ErrLiquidationTermsLocked
+	}
+
+	if err := pref.Validate(); err != nil {
+		return types.ErrInvalidLiquidationPreference.Wrap(err.Error())
+	}
+	return nil
+}
+
+// liquidationTermsLocked checks if a company has a dissolution or delisting
+// compensation open, whose payouts depend on the current preference terms
+func (k Keeper) liquidationTermsLocked(ctx sdk.Context, companyID uint64) bool {
+	if k.hasOpenDissolution(ctx, companyID) {
+		return true
+	}
+	comp, found := k.GetCompensationByCompany(ctx, companyID)
+	return found && (comp.Status == types.CompensationStatusPending || comp.Status == types.CompensationStatusProcessing)
+}
+
+// applyLiquidationPreference puts preference terms approved by a capital
+// structure proposal into effect
+func (k Keeper) applyLiquidationPreference(ctx sdk.Context, pref types.LiquidationPreference, proposalID uint64) error {
+	if err := k.validateLiquidationPreference(ctx, pref); err != nil {
+		return err
+	}
+
+	pref.ProposalID = proposalID
+	pref.CreatedAt = ctx.BlockTime()
+	pref.UpdatedAt = ctx.BlockTime()
+	if existing, found := k.GetLiquidationPreference(ctx, pref.CompanyID, pref.ClassID); found {
+		pref.CreatedAt = existing.CreatedAt
+	}
+
+	if err := k.SetLiquidationPreference(ctx, pref); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLiquidationPreferenceSet,
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", pref.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareClass, pref.ClassID),
+			sdk.NewAttribute("multiple", pref.Multiple.String()),
+			sdk.NewAttribute(types.AttributeKeySeniority, fmt.Sprintf("%d", pref.Seniority)),
+			sdk.NewAttribute("participating", fmt.Sprintf("%t", pref.Participating)),
+			sdk.NewAttribute("set_by", pref.SetBy),
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
+// CalculateLiquidationWaterfall runs the waterfall for a company over the given
+// shares per class. Classes with the LiquidationPreference flag and stored terms
+// take their preference; all other classes are treated as common.
+func (k Keeper) CalculateLiquidationWaterfall(
+	ctx sdk.Context,
+	companyID uint64,
+	proceeds math.Int,
+	sharesByClass map[string]math.Int,
+) types.LiquidationWaterfall {
+	classIDs := make([]string, 0, len(sharesByClass))
+	for classID := range sharesByClass {
+		classIDs = append(classIDs, classID)
+	}
+	sort.Strings(classIDs)
+
+	classes := make([]types.WaterfallClass, 0, len(classIDs))
+	for _, classID := range classIDs {
+		class := types.WaterfallClass{
+			ClassID: classID,
+			Shares:  sharesByClass[classID],
+		}
+		if shareClass, found := k.getShareClass(ctx, companyID, classID); found && shareClass.LiquidationPreference {
+			if pref, found := k.GetLiquidationPreference(ctx, companyID, classID); found {
+				class.Preference = &pref
+			}
+		}
+		classes = append(classes, class)
+	}
+
+	return types.CalculateLiquidationWaterfall(proceeds, classes)
+}
+
+// PreviewLiquidationWaterfall distributes proceeds across every vested holder of the
+// company. Shares in module custody are attributed to their beneficial owners,
+// treasury shares are excluded, and other companies' treasury investments appear as
+// "treasury_dividend:<company_id>" recipients, matching the dividend system.
+func (k Keeper) PreviewLiquidationWaterfall(
+	ctx sdk.Context,
+	companyID uint64,
+	proceeds math.Int,
+) (types.LiquidationWaterfall, []types.HolderPayout, error) {
+	if _, found := k.getCompany(ctx, companyID); !found {
+		return types.LiquidationWaterfall{}, nil, types.ErrCompanyNotFound
+	}
+
+	sharesByClass := make(map[string]math.Int)
+	var holders []types.HolderPayout
+	for _, shareClass := range k.GetCompanyShareClasses(ctx, companyID) {
+		recipients := k.GetBeneficialOwnersForDividend(ctx, companyID, shareClass.ClassID)
+		sort.Slice(recipients, func(i, j int) bool { return recipients[i].Address < recipients[j].Address })
+
+		total := math.ZeroInt()
+		for _, recipient := range recipients {
+			if !recipient.Shares.IsPositive() {
+				continue
+			}
+			total = total.Add(recipient.Shares)
+			holders = append(holders, types.HolderPayout{
+				Address: recipient.Address,
+				ClassID: shareClass.ClassID,
+				Shares:  recipient.Shares,
+			})
+		}
+		sharesByClass[shareClass.ClassID] = total
+	}
+
+	waterfall := k.CalculateLiquidationWaterfall(ctx, companyID, proceeds, sharesByClass)
+	for i, holder := range holders {
+		holders[i].Amount = waterfall.HolderAmount(holder.ClassID, holder.Shares)
+	}
+
+	return waterfall, holders, nil
+}
+
+// =============================================================================
+// Voluntary Dissolution
+// =============================================================================
+
+// GetNextDissolutionID returns the next dissolution ID and increments the counter
+func (k Keeper) GetNextDissolutionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DissolutionCounterKey)
+
+	var counter uint64 = 1
+	if bz != nil {
+		counter = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.DissolutionCounterKey, sdk.Uint64ToBigEndian(counter+1))
+	return counter
+}
+
+// SetDissolution stores a dissolution and indexes it by company
+func (k Keeper) SetDissolution(ctx sdk.Context, dissolution types.Dissolution) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(dissolution)
+	if err != nil {
+		return fmt.Errorf("failed to marshal dissolution: %w", err)
+	}
+	store.Set(types.GetDissolutionKey(dissolution.ID), bz)
+	store.Set(types.GetDissolutionByCompanyKey(dissolution.CompanyID, dissolution.ID), sdk.Uint64ToBigEndian(dissolution.ID))
+	return nil
+}
+
+// GetDissolution returns a dissolution by ID
+func (k Keeper) GetDissolution(ctx sdk.Context, dissolutionID uint64) (types.Dissolution, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDissolutionKey(dissolutionID))
+	if bz == nil {
+		return types.Dissolution{}, false
+	}
+
+	var dissolution types.Dissolution
+	if err := json.Unmarshal(bz, &dissolution); err != nil {
+		return types.Dissolution{}, false
+	}
+	return dissolution, true
+}
+
+// GetDissolutionsByCompany returns all dissolutions for a company
+func (k Keeper) GetDissolutionsByCompany(ctx sdk.Context, companyID uint64) []types.Dissolution {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDissolutionsByCompanyPrefix(companyID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var dissolutions []types.Dissolution
+	for ; iterator.Valid(); iterator.Next() {
+		if dissolution, found := k.GetDissolution(ctx, sdk.BigEndianToUint64(iterator.Value())); found {
+			dissolutions = append(dissolutions, dissolution)
+		}
+	}
+	return dissolutions
+}
+
+// hasOpenDissolution checks if a company already has a dissolution awaiting execution
+func (k Keeper) hasOpenDissolution(ctx sdk.Context, companyID uint64) bool {
+	for _, dissolution := range k.GetDissolutionsByCompany(ctx, companyID) {
+		if dissolution.Status == types.DissolutionStatusPending || dissolution.Status == types.DissolutionStatusApproved {
+			return true
+		}
+	}
+	return false
+}
+
+// ProposeDissolution creates a voluntary dissolution request (requires governance proposal)
+func (k Keeper) ProposeDissolution(ctx sdk.Context, companyID uint64, reason string, proposedBy string) (uint64, error) {
+	if !k.CanProposeForCompany(ctx, companyID, proposedBy) {
+		return 0, types.ErrUnauthorized
+	}
+
+	company, found := k.getCompany(ctx, companyID)
+	if !found {
+		return 0, types.ErrCompanyNotFound
+	}
+	if company.Status == types.CompanyStatusDelisted {
+		return 0, types.ErrCompanyAlreadyDelisted
+	}
+
+	if k.hasOpenDissolution(ctx, companyID) {
+		return 0, types.ErrDissolutionPending
+	}
+
+	dissolutionID := k.GetNextDissolutionID(ctx)
+	dissolution := types.NewDissolution(dissolutionID, companyID, reason, proposedBy, ctx.BlockTime())
+	if err := dissolution.Validate(); err != nil {
+		return 0, types.ErrInvalidDissolution.Wrap(err.Error())
+	}
+
+	if err := k.SetDissolution(ctx, dissolution); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDissolutionProposed,
+			sdk.NewAttribute(types.AttributeKeyDissolutionID, fmt.Sprintf("%d", dissolutionID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", companyID)),
+			sdk.NewAttribute(types.AttributeKeyCompanySymbol, company.Symbol),
+			sdk.NewAttribute("proposed_by", proposedBy),
+		),
+	)
+
+	k.Logger(ctx).Info("dissolution proposed",
+		"dissolution_id", dissolutionID,
+		"company_id", companyID,
+	)
+
+	return dissolutionID, nil
+}
+
+// ApproveDissolution marks a dissolution as approved (called by governance)
+func (k Keeper) ApproveDissolution(ctx sdk.Context, dissolutionID uint64, proposalID uint64) error {
+	dissolution, found := k.GetDissolution(ctx, dissolutionID)
+	if !found {
+		return types.ErrDissolutionNotFound
+	}
+
+	if dissolution.Status != types.DissolutionStatusPending {
+		return types.ErrDissolutionNotPending
+	}
+
+	dissolution.Status = types.DissolutionStatusApproved
+	dissolution.ProposalID = proposalID
+	dissolution.ApprovedAt = ctx.BlockTime()
+	if err := k.SetDissolution(ctx, dissolution); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDissolutionApproved,
+			sdk.NewAttribute(types.AttributeKeyDissolutionID, fmt.Sprintf("%d", dissolutionID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", dissolution.CompanyID)),
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
+// CancelDissolution cancels a dissolution that has not been executed
+func (k Keeper) CancelDissolution(ctx sdk.Context, dissolutionID uint64, reason string) error {
+	dissolution, found := k.GetDissolution(ctx, dissolutionID)
+	if !found {
+		return types.ErrDissolutionNotFound
+	}
+
+	if dissolution.Status != types.DissolutionStatusPending && dissolution.Status != types.DissolutionStatusApproved {
+		return types.ErrDissolutionNotPending
+	}
+
+	dissolution.Status = types.DissolutionStatusCancelled
+	if err := k.SetDissolution(ctx, dissolution); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDissolutionCancelled,
+			sdk.NewAttribute(types.AttributeKeyDissolutionID, fmt.Sprintf("%d", dissolutionID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", dissolution.CompanyID)),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+
+	return nil
+}
+
+// ExecuteDissolution distributes the company's available treasury balance through
+// the liquidation waterfall and delists the company. Everything runs against a
+// cached context and is discarded if any payment fails.
+func (k Keeper) ExecuteDissolution(ctx sdk.Context, dissolutionID uint64) error {
+	dissolution, found := k.GetDissolution(ctx, dissolutionID)
+	if !found {
+		return types.ErrDissolutionNotFound
+	}
+
+	if dissolution.Status != types.DissolutionStatusApproved {
+		return types.ErrDissolutionNotApproved
+	}
+
+	company, found := k.getCompany(ctx, dissolution.CompanyID)
+	if !found {
+		return types.ErrCompanyNotFound
+	}
+	if company.Status == types.CompanyStatusDelisted {
+		return types.ErrCompanyAlreadyDelisted
+	}
+	if k.IsTreasuryFrozen(ctx, dissolution.CompanyID) {
+		return types.ErrTreasuryFrozenForInvestigation
+	}
+
+	treasury, found := k.GetCompanyTreasury(ctx, dissolution.CompanyID)
+	if !found {
+		return types.ErrTreasuryNotFound
+	}
+	if treasury.IsLocked {
+		return types.ErrTreasuryLocked
+	}
+	proceeds := treasury.GetAvailableBalance().AmountOf(types.LiquidationDenom)
+
+	cacheCtx, write := ctx.CacheContext()
+
+	waterfall, holders, err := k.PreviewLiquidationWaterfall(cacheCtx, dissolution.CompanyID, proceeds)
+	if err != nil {
+		return err
+	}
+
+	totalPaid := math.ZeroInt()
+	var holdersPaid uint64
+	for _, holder := range holders {
+		if !holder.Amount.IsPositive() {
+			continue
+		}
+		if err := k.payLiquidationProceeds(cacheCtx, dissolution.CompanyID, holder); err != nil {
+			return fmt.Errorf("failed to pay %s: %w", holder.Address, err)
+		}
+		totalPaid = totalPaid.Add(holder.Amount)
+		holdersPaid++
+	}
+
+	// Deduct the payout from the dissolving company's treasury
+	treasury, _ = k.GetCompanyTreasury(cacheCtx, dissolution.CompanyID)
+	paid := sdk.NewCoins(sdk.NewCoin(types.LiquidationDenom, totalPaid))
+	treasury.Balance = treasury.Balance.Sub(paid...)
+	treasury.TotalWithdrawn = treasury.TotalWithdrawn.Add(paid...)
+	treasury.UpdatedAt = ctx.BlockTime()
+	if err := k.SetCompanyTreasury(cacheCtx, treasury); err != nil {
+		return err
+	}
+
+	if err := k.DelistCompany(cacheCtx, dissolution.CompanyID, dissolution.ProposedBy, "voluntary dissolution"); err != nil {
+		return err
+	}
+
+	write()
+
+	dissolution.Status = types.DissolutionStatusExecuted
+	dissolution.Waterfall = waterfall
+	dissolution.HoldersPaid = holdersPaid
+	dissolution.TotalPaid = totalPaid
+	dissolution.ExecutedAt = ctx.BlockTime()
+	if err := k.SetDissolution(ctx, dissolution); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDissolutionExecuted,
+			sdk.NewAttribute(types.AttributeKeyDissolutionID, fmt.Sprintf("%d", dissolutionID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", dissolution.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyCompanySymbol, company.Symbol),
+			sdk.NewAttribute("proceeds", proceeds.String()),
+			sdk.NewAttribute(types.AttributeKeyPayoutAmount, totalPaid.String()),
+			sdk.NewAttribute("holders_paid", fmt.Sprintf("%d", holdersPaid)),
+		),
+	)
+
+	k.Logger(ctx).Info("company dissolved",
+		"dissolution_id", dissolutionID,
+		"company_id", dissolution.CompanyID,
+		"proceeds", proceeds.String(),
+		"total_paid", totalPaid.String(),
+		"holders_paid", holdersPaid,
+	)
+
+	return nil
+}
+
+// payLiquidationProceeds pays one holder from the equity module. Treasury investors
+// are credited to their company treasury, which is held in the same module account.
+func (k Keeper) payLiquidationProceeds(ctx sdk.Context, companyID uint64, holder types.HolderPayout) error {
+	coins := sdk.NewCoins(sdk.NewCoin(types.LiquidationDenom, holder.Amount))
+
+	if strings.HasPrefix(holder.Address, "treasury_dividend:") {
+		ownerCompanyID, err := strconv.ParseUint(strings.TrimPrefix(holder.Address, "treasury_dividend:"), 10, 64)
+		if err != nil {
+			return err
+		}
+		if err := k.CreditTreasuryDividend(ctx, ownerCompanyID, companyID, coins); err != nil {
+			return err
+		}
+	} else {
+		addr, err := sdk.AccAddressFromBech32(holder.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLiquidationPayout,
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", companyID)),
+			sdk.NewAttribute(types.AttributeKeyShareholder, holder.Address),
+			sdk.NewAttribute(types.AttributeKeyShareClass, holder.ClassID),
+			sdk.NewAttribute(types.AttributeKeySharesHeld, holder.Shares.String()),
+			sdk.NewAttribute(types.AttributeKeyPayoutAmount, coins.String()),
+		),
+	)
+
+	return nil
+}
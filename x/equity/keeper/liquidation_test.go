@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// TestLiquidationWaterfall tests preference seniority, participation caps and conversion to common
+func TestLiquidationWaterfall(t *testing.T) {
+	founder := sdk.AccAddress([]byte("liquidation_founder_")).String()
+
+	preference := func(classID string, seniority uint32, issuePrice int64, participating bool, capMultiple int64) *types.LiquidationPreference {
+		return &types.LiquidationPreference{
+			CompanyID:          1,
+			ClassID:            classID,
+			Multiple:           math.LegacyOneDec(),
+			OriginalIssuePrice: math.LegacyNewDec(issuePrice),
+			Seniority:          seniority,
+			Participating:      participating,
+			Cap:                math.LegacyNewDec(capMultiple),
+			SetBy:              founder,
+		}
+	}
+	common := types.WaterfallClass{ClassID: "COMMON", Shares: math.NewInt(9000)}
+	total := func(w types.LiquidationWaterfall, classID string) math.Int {
+		payout, found := w.GetClassPayout(classID)
+		require.True(t, found)
+		return payout.Total
+	}
+
+	// 1x non-participating: preference first, converts to common once that pays more
+	seriesA := types.WaterfallClass{ClassID: "SERIES_A", Shares: math.NewInt(1000), Preference: preference("SERIES_A", 1, 10, false, 0)}
+	require.NoError(t, seriesA.Preference.Validate())
+
+	w := types.CalculateLiquidationWaterfall(math.NewInt(5_000), []types.WaterfallClass{common, seriesA})
+	require.Equal(t, math.NewInt(5_000), total(w, "SERIES_A"))
+	require.True(t, total(w, "COMMON").IsZero())
+
+	w = types.CalculateLiquidationWaterfall(math.NewInt(50_000), []types.WaterfallClass{common, seriesA})
+	require.Equal(t, math.NewInt(10_000), total(w, "SERIES_A"))
+	require.Equal(t, math.NewInt(40_000), total(w, "COMMON"))
+
+	w = types.CalculateLiquidationWaterfall(math.NewInt(200_000), []types.WaterfallClass{common, seriesA})
+	payout, _ := w.GetClassPayout("SERIES_A")
+	require.True(t, payout.Converted)
+	require.Equal(t, math.NewInt(20_000), payout.Total)
+	require.Equal(t, math.NewInt(180_000), total(w, "COMMON"))
+	require.True(t, w.Unallocated.IsZero())
+
+	// Seniority: senior rank is paid in full before junior
+	seriesB := types.WaterfallClass{ClassID: "SERIES_B", Shares: math.NewInt(500), Preference: preference("SERIES_B", 1, 20, false, 0)}
+	juniorA := seriesA
+	juniorA.Preference = preference("SERIES_A", 2, 10, false, 0)
+	w = types.CalculateLiquidationWaterfall(math.NewInt(15_000), []types.WaterfallClass{common, juniorA, seriesB})
+	require.Equal(t, math.NewInt(10_000), total(w, "SERIES_B"))
+	require.Equal(t, math.NewInt(5_000), total(w, "SERIES_A"))
+	require.True(t, total(w, "COMMON").IsZero())
+
+	// Equal rank shares a shortfall pro-rata to the preference owed
+	w = types.CalculateLiquidationWaterfall(math.NewInt(15_000), []types.WaterfallClass{common, seriesA, seriesB})
+	require.Equal(t, math.NewInt(7_500), total(w, "SERIES_B"))
+	require.Equal(t, math.NewInt(7_500), total(w, "SERIES_A"))
+
+	// Participating with a 3x cap
+	participating := types.WaterfallClass{ClassID: "SERIES_A", Shares: math.NewInt(1000), Preference: preference("SERIES_A", 1, 10, true, 3)}
+	require.NoError(t, participating.Preference.Validate())
+
+	w = types.CalculateLiquidationWaterfall(math.NewInt(100_000), []types.WaterfallClass{common, participating})
+	payout, _ = w.GetClassPayout("SERIES_A")
+	require.Equal(t, math.NewInt(10_000), payout.Preference)
+	require.Equal(t, math.NewInt(9_000), payout.Participation)
+	require.Equal(t, math.NewInt(81_000), total(w, "COMMON"))
+
+	w = types.CalculateLiquidationWaterfall(math.NewInt(250_000), []types.WaterfallClass{common, participating})
+	require.Equal(t, math.NewInt(30_000), total(w, "SERIES_A")) // capped
+	require.Equal(t, math.NewInt(220_000), total(w, "COMMON"))
+
+	w = types.CalculateLiquidationWaterfall(math.NewInt(1_000_000), []types.WaterfallClass{common, participating})
+	payout, _ = w.GetClassPayout("SERIES_A")
+	require.True(t, payout.Converted) // 100 per share as common beats the 30 per share cap
+	require.Equal(t, math.NewInt(100_000), payout.Total)
+
+	// Holder share of a class payout
+	require.Equal(t, math.NewInt(90_000), w.HolderAmount("COMMON", math.NewInt(900)))
+
+	// Without preferences the waterfall is pro-rata by shares
+	plain := types.WaterfallClass{ClassID: "SERIES_A", Shares: math.NewInt(1000)}
+	w = types.CalculateLiquidationWaterfall(math.NewInt(100_000), []types.WaterfallClass{common, plain})
+	require.Equal(t, math.NewInt(10_000), total(w, "SERIES_A"))
+	require.Equal(t, math.NewInt(90_000), total(w, "COMMON"))
+
+	// Invalid terms
+	invalid := *preference("SERIES_A", 0, 10, false, 0)
+	require.Error(t, invalid.Validate(), "zero seniority")
+	invalid = *preference("SERIES_A", 1, 10, false, 3)
+	require.Error(t, invalid.Validate(), "cap on non-participating")
+}
+
+// TestLiquidationPreferenceChanges tests that preference terms change only through an
+// applied capital structure change, and never while a dissolution is open
+func TestLiquidationPreferenceChanges(t *testing.T) {
+	k, ctx, bank := setupKeeper(t)
+	founder := sdk.AccAddress([]byte("liquidation_founder_")).String()
+	outsider := sdk.AccAddress([]byte("liquidation_outsider")).String()
+	createTestCompany(t, k, ctx, bank, 1, "LIQ", founder, map[string]int64{founder: 1000})
+
+	seriesA := types.NewShareClass(1, "SERIES_A", "Series A", "preferred stock", true, false, true, false,
+		math.NewInt(100_000), math.LegacyOneDec(), true)
+	require.NoError(t, k.SetShareClass(ctx, seriesA))
+
+	pref := types.LiquidationPreference{
+		CompanyID:          1,
+		ClassID:            "SERIES_A",
+		Multiple:           math.LegacyOneDec(),
+		OriginalIssuePrice: math.LegacyNewDec(10),
+		Seniority:          1,
+	}
+
+	_, err := k.ProposeLiquidationPreference(ctx, pref, outsider)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	noPreference := pref
+	noPreference.ClassID = "COMMON"
+	_, err = k.ProposeLiquidationPreference(ctx, noPreference, founder)
+	require.ErrorIs(t, err, types.ErrNoLiquidationPreference)
+
+	// Proposed terms do not take effect until governance applies them
+	changeID, err := k.ProposeLiquidationPreference(ctx, pref, founder)
+	require.NoError(t, err)
+	_, found := k.GetLiquidationPreference(ctx, 1, "SERIES_A")
+	require.False(t, found)
+
+	require.NoError(t, k.ApplyCapitalStructureChange(ctx, changeID, 7))
+	applied, found := k.GetLiquidationPreference(ctx, 1, "SERIES_A")
+	require.True(t, found)
+	require.Equal(t, uint64(7), applied.ProposalID)
+	require.Equal(t, founder, applied.SetBy)
+	change, _ := k.GetCapitalStructureChange(ctx, changeID)
+	require.Equal(t, types.CapitalChangeStatusApplied, change.Status)
+	require.ErrorIs(t, k.ApplyCapitalStructureChange(ctx, changeID, 8), types.ErrCapitalChangeNotPending)
+
+	// A change proposed before a dissolution opens cannot be applied during it
+	senior := pref
+	senior.Multiple = math.LegacyNewDec(3)
+	changeID, err = k.ProposeLiquidationPreference(ctx, senior, founder)
+	require.NoError(t, err)
+
+	_, err = k.ProposeDissolution(ctx, 1, "wind down", founder)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, k.ApplyCapitalStructureChange(ctx, changeID, 9), types.ErrLiquidationTermsLocked)
+	_, err = k.ProposeLiquidationPreference(ctx, senior, founder)
+	require.ErrorIs(t, err, types.ErrLiquidationTermsLocked)
+
+	current, _ := k.GetLiquidationPreference(ctx, 1, "SERIES_A")
+	require.Equal(t, math.LegacyOneDec(), current.Multiple)
+}
@@ -0,0 +1,1011 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// =============================================================================
+// MERGERS
+// Acquisitions of one listed company by another, approved through both companies'
+// governance, with a dissenter appraisal window before target shares are exchanged
+// =============================================================================
+
+// GetNextMergerID returns the next merger ID and increments the counter
+func (k Keeper) GetNextMergerID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MergerCounterKey)
+
+	var counter uint64 = 1
+	if bz != nil {
+		counter = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.MergerCounterKey, sdk.Uint64ToBigEndian(counter+1))
+	return counter
+}
+
+// SetMerger stores a merger and indexes it by both companies
+func (k Keeper) SetMerger(ctx sdk.Context, merger types.Merger) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(merger)
+	if err != nil {
+		return fmt.Errorf("failed to marshal merger: %w", err)
+	}
+	store.Set(types.GetMergerKey(merger.ID), bz)
+	store.Set(types.GetMergerByCompanyKey(merger.AcquirerID, merger.ID), sdk.Uint64ToBigEndian(merger.ID))
+	store.Set(types.GetMergerByCompanyKey(merger.TargetID, merger.ID), sdk.Uint64ToBigEndian(merger.ID))
+	return nil
+}
+
+// GetMerger returns a merger by ID
+func (k Keeper) GetMerger(ctx sdk.Context, mergerID uint64) (types.Merger, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMergerKey(mergerID))
+	if bz == nil {
+		return types.Merger{}, false
+	}
+
+	var merger types.Merger
+	if err := json.Unmarshal(bz, &merger); err != nil {
+		return types.Merger{}, false
+	}
+	return merger, true
+}
+
+// GetMergersByCompany returns all mergers a company is party to, as acquirer or target
+func (k Keeper) GetMergersByCompany(ctx sdk.Context, companyID uint64) []types.Merger {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetMergersByCompanyPrefix(companyID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var mergers []types.Merger
+	for ; iterator.Valid(); iterator.Next() {
+		if merger, found := k.GetMerger(ctx, sdk.BigEndianToUint64(iterator.Value())); found {
+			mergers = append(mergers, merger)
+		}
+	}
+	return mergers
+}
+
+// SetMergerDissent stores a holder's dissent from a merger
+func (k Keeper) SetMergerDissent(ctx sdk.Context, dissent types.MergerDissent) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(dissent)
+	if err != nil {
+		return fmt.Errorf("failed to marshal merger dissent: %w", err)
+	}
+	store.Set(types.GetMergerDissentKey(dissent.MergerID, dissent.Holder), bz)
+	return nil
+}
+
+// GetMergerDissent returns a holder's dissent from a merger
+func (k Keeper) GetMergerDissent(ctx sdk.Context, mergerID uint64, holder string) (types.MergerDissent, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMergerDissentKey(mergerID, holder))
+	if bz == nil {
+		return types.MergerDissent{}, false
+	}
+
+	var dissent types.MergerDissent
+	if err := json.Unmarshal(bz, &dissent); err != nil {
+		return types.MergerDissent{}, false
+	}
+	return dissent, true
+}
+
+// GetMergerDissents returns every dissent from a merger
+func (k Keeper) GetMergerDissents(ctx sdk.Context, mergerID uint64) []types.MergerDissent {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetMergerDissentsPrefix(mergerID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var dissents []types.MergerDissent
+	for ; iterator.Valid(); iterator.Next() {
+		var dissent types.MergerDissent
+		if err := json.Unmarshal(iterator.Value(), &dissent); err != nil {
+			continue
+		}
+		dissents = append(dissents, dissent)
+	}
+	return dissents
+}
+
+// GetAllMergers returns all mergers
+func (k Keeper) GetAllMergers(ctx sdk.Context) []types.Merger {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MergerPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var mergers []types.Merger
+	for ; iterator.Valid(); iterator.Next() {
+		var merger types.Merger
+		if err := json.Unmarshal(iterator.Value(), &merger); err != nil {
+			continue
+		}
+		mergers = append(mergers, merger)
+	}
+	return mergers
+}
+
+// hasOpenMerger checks if a company is party to a merger awaiting execution
+func (k Keeper) hasOpenMerger(ctx sdk.Context, companyID uint64) bool {
+	for _, merger := range k.GetMergersByCompany(ctx, companyID) {
+		if merger.Status == types.MergerStatusPending || merger.Status == types.MergerStatusApproved {
+			return true
+		}
+	}
+	return false
+}
+
+// ProposeMerger creates a merger of the target into the acquirer. Only acquirer
+// authorities may propose; the merger then needs the approval of both companies'
+// governance (see ApproveMerger).
+func (k Keeper) ProposeMerger(ctx sdk.Context, merger types.Merger, proposedBy string) (uint64, error) {
+	if !k.CanProposeForCompany(ctx, merger.AcquirerID, proposedBy) {
+		return 0, types.ErrUnauthorized
+	}
+
+	acquirer, found := k.getCompany(ctx, merger.AcquirerID)
+	if !found {
+		return 0, types.ErrCompanyNotFound
+	}
+	target, found := k.getCompany(ctx, merger.TargetID)
+	if !found {
+		return 0, types.ErrCompanyNotFound
+	}
+	if acquirer.Status != types.CompanyStatusActive || target.Status != types.CompanyStatusActive {
+		return 0, types.ErrCompanyNotActive
+	}
+	if _, found := k.getShareClass(ctx, merger.AcquirerID, merger.AcquirerClassID); !found {
+		return 0, types.ErrShareClassNotFound
+	}
+	if _, found := k.getShareClass(ctx, merger.TargetID, merger.TargetClassID); !found {
+		return 0, types.ErrShareClassNotFound
+	}
+
+	if k.hasOpenMerger(ctx, merger.AcquirerID) || k.hasOpenMerger(ctx, merger.TargetID) {
+		return 0, types.ErrMergerPending
+	}
+
+	merger.ID = k.GetNextMergerID(ctx)
+	merger.ProposedBy = proposedBy
+	merger.AcquirerProposalID = 0
+	merger.TargetProposalID = 0
+	merger.Status = types.MergerStatusPending
+	merger.HoldersExchanged = 0
+	merger.TargetSharesExchanged = math.ZeroInt()
+	merger.AcquirerSharesIssued = math.ZeroInt()
+	merger.CashPaid = math.ZeroInt()
+	merger.DissentingShares = math.ZeroInt()
+	merger.AppraisalPaid = math.ZeroInt()
+	merger.ProposedAt = ctx.BlockTime()
+	if err := merger.Validate(); err != nil {
+		return 0, types.ErrInvalidMerger.Wrap(err.Error())
+	}
+	if err := k.checkMergerPreconditions(ctx, merger); err != nil {
+		return 0, err
+	}
+
+	if err := k.SetMerger(ctx, merger); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMergerProposed,
+			sdk.NewAttribute(types.AttributeKeyMergerID, fmt.Sprintf("%d", merger.ID)),
+			sdk.NewAttribute(types.AttributeKeyAcquirerID, fmt.Sprintf("%d", merger.AcquirerID)),
+			sdk.NewAttribute(types.AttributeKeyTargetID, fmt.Sprintf("%d", merger.TargetID)),
+			sdk.NewAttribute(types.AttributeKeyConsideration, merger.Consideration().String()),
+			sdk.NewAttribute(types.AttributeKeyExchangeRatio, merger.Ratio()),
+			sdk.NewAttribute("cash_per_share", merger.CashPerShare.String()),
+			sdk.NewAttribute("appraisal_price", merger.AppraisalPrice.String()),
+			sdk.NewAttribute("proposed_by", proposedBy),
+		),
+	)
+
+	k.Logger(ctx).Info("merger proposed",
+		"merger_id", merger.ID,
+		"acquirer", acquirer.Symbol,
+		"target", target.Symbol,
+		"consideration", merger.Consideration().String(),
+		"ratio", merger.Ratio(),
+	)
+
+	return merger.ID, nil
+}
+
+// ApproveMerger records one company's approval of a merger (called by governance).
+// Once both the acquirer and the target approved, the appraisal window opens.
+func (k Keeper) ApproveMerger(ctx sdk.Context, mergerID uint64, companyID uint64, proposalID uint64) error {
+	merger, found := k.GetMerger(ctx, mergerID)
+	if !found {
+		return types.ErrMergerNotFound
+	}
+
+	if merger.Status != types.MergerStatusPending {
+		return types.ErrMergerNotPending
+	}
+
+	switch companyID {
+	case merger.AcquirerID:
+		if merger.AcquirerProposalID != 0 {
+			return types.ErrInvalidMerger.Wrapf("acquirer already approved merger %d", mergerID)
+		}
+		merger.AcquirerProposalID = proposalID
+		merger.AcquirerApprovedAt = ctx.BlockTime()
+	case merger.TargetID:
+		if merger.TargetProposalID != 0 {
+			return types.ErrInvalidMerger.Wrapf("target already approved merger %d", mergerID)
+		}
+		merger.TargetProposalID = proposalID
+		merger.TargetApprovedAt = ctx.BlockTime()
+	default:
+		return types.ErrInvalidMerger.Wrapf("company %d is not a party to merger %d", companyID, mergerID)
+	}
+
+	if merger.IsFullyApproved() {
+		merger.Status = types.MergerStatusApproved
+		merger.AppraisalDeadline = ctx.BlockTime().Add(merger.AppraisalPeriod)
+	}
+	if err := k.SetMerger(ctx, merger); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMergerApproved,
+			sdk.NewAttribute(types.AttributeKeyMergerID, fmt.Sprintf("%d", mergerID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", companyID)),
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute("status", merger.Status.String()),
+		),
+	)
+
+	k.Logger(ctx).Info("merger approved",
+		"merger_id", mergerID,
+		"company_id", companyID,
+		"proposal_id", proposalID,
+		"fully_approved", merger.IsFullyApproved(),
+	)
+
+	return nil
+}
+
+// DissentFromMerger demands appraisal for target shares during the appraisal window.
+// The shares are locked and bought out at the appraisal price when the merger
+// executes, instead of being exchanged.
+func (k Keeper) DissentFromMerger(ctx sdk.Context, mergerID uint64, holder string, shares math.Int) error {
+	merger, found := k.GetMerger(ctx, mergerID)
+	if !found {
+		return types.ErrMergerNotFound
+	}
+	if !merger.IsAppraisalOpen(ctx.BlockTime()) {
+		return types.ErrAppraisalWindowClosed
+	}
+	if shares.IsNil() || !shares.IsPositive() {
+		return types.ErrInsufficientShares
+	}
+
+	holding, found := k.getShareholding(ctx, merger.TargetID, merger.TargetClassID, holder)
+	if !found || holding.VestedShares.Sub(holding.LockedShares).LT(shares) {
+		return types.ErrInsufficientShares
+	}
+
+	holding.LockedShares = holding.LockedShares.Add(shares)
+	holding.UpdatedAt = ctx.BlockTime()
+	if err := k.SetShareholding(ctx, holding); err != nil {
+		return err
+	}
+
+	dissent, found := k.GetMergerDissent(ctx, mergerID, holder)
+	if !found {
+		dissent = types.MergerDissent{MergerID: mergerID, Holder: holder, Shares: math.ZeroInt()}
+	}
+	dissent.Shares = dissent.Shares.Add(shares)
+	dissent.DissentedAt = ctx.BlockTime()
+	if err := k.SetMergerDissent(ctx, dissent); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMergerDissent,
+			sdk.NewAttribute(types.AttributeKeyMergerID, fmt.Sprintf("%d", mergerID)),
+			sdk.NewAttribute(types.AttributeKeyShareholder, holder),
+			sdk.NewAttribute("shares", shares.String()),
+			sdk.NewAttribute(types.AttributeKeyAppraisalDeadline, merger.AppraisalDeadline.String()),
+		),
+	)
+
+	return nil
+}
+
+// CancelMerger cancels a merger that has not been executed and releases the shares
+// locked by dissenters. Authorities of either company may cancel.
+func (k Keeper) CancelMerger(ctx sdk.Context, mergerID uint64, cancelledBy string, reason string) error {
+	merger, found := k.GetMerger(ctx, mergerID)
+	if !found {
+		return types.ErrMergerNotFound
+	}
+
+	if merger.Status != types.MergerStatusPending && merger.Status != types.MergerStatusApproved {
+		return types.ErrMergerNotPending
+	}
+	if cancelledBy != k.authority &&
+		!k.CanProposeForCompany(ctx, merger.AcquirerID, cancelledBy) &&
+		!k.CanProposeForCompany(ctx, merger.TargetID, cancelledBy) {
+		return types.ErrUnauthorized
+	}
+
+	return k.cancelMerger(ctx, merger, cancelledBy, reason)
+}
+
+// cancelMerger releases the dissent locks and marks the merger cancelled
+func (k Keeper) cancelMerger(ctx sdk.Context, merger types.Merger, cancelledBy string, reason string) error {
+	for _, dissent := range k.GetMergerDissents(ctx, merger.ID) {
+		holding, found := k.getShareholding(ctx, merger.TargetID, merger.TargetClassID, dissent.Holder)
+		if !found {
+			continue
+		}
+		holding.LockedShares = math.MaxInt(holding.LockedShares.Sub(dissent.Shares), math.ZeroInt())
+		holding.UpdatedAt = ctx.BlockTime()
+		if err := k.SetShareholding(ctx, holding); err != nil {
+			return err
+		}
+	}
+
+	merger.Status = types.MergerStatusCancelled
+	if err := k.SetMerger(ctx, merger); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMergerCancelled,
+			sdk.NewAttribute(types.AttributeKeyMergerID, fmt.Sprintf("%d", merger.ID)),
+			sdk.NewAttribute(types.AttributeKeyAcquirerID, fmt.Sprintf("%d", merger.AcquirerID)),
+			sdk.NewAttribute(types.AttributeKeyTargetID, fmt.Sprintf("%d", merger.TargetID)),
+			sdk.NewAttribute("cancelled_by", cancelledBy),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+
+	return nil
+}
+
+// ExecuteMerger exchanges every target share for the merger consideration once the
+// appraisal window has closed, moves the target's custodied positions, treasury and
+// pending dividends over, and delists the target. The whole merger runs against a
+// cached context and is discarded if any step fails, including an acquirer treasury
+// too small for the cash consideration or too few authorized acquirer shares.
+func (k Keeper) ExecuteMerger(ctx sdk.Context, mergerID uint64) error {
+	merger, found := k.GetMerger(ctx, mergerID)
+	if !found {
+		return types.ErrMergerNotFound
+	}
+
+	if merger.Status != types.MergerStatusApproved {
+		return types.ErrMergerNotApproved
+	}
+	if ctx.BlockTime().Before(merger.AppraisalDeadline) {
+		return types.ErrAppraisalWindowOpen
+	}
+
+	acquirer, found := k.getCompany(ctx, merger.AcquirerID)
+	if !found {
+		return types.ErrCompanyNotFound
+	}
+	target, found := k.getCompany(ctx, merger.TargetID)
+	if !found {
+		return types.ErrCompanyNotFound
+	}
+	if target.Status == types.CompanyStatusDelisted {
+		return types.ErrCompanyAlreadyDelisted
+	}
+
+	if k.IsTreasuryFrozen(ctx, merger.AcquirerID) || k.IsTreasuryFrozen(ctx, merger.TargetID) {
+		return types.ErrTreasuryFrozenForInvestigation
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.applyMerger(cacheCtx, &merger, acquirer, target); err != nil {
+		return err
+	}
+	write()
+
+	merger.Status = types.MergerStatusExecuted
+	merger.ExecutedAt = ctx.BlockTime()
+	if err := k.SetMerger(ctx, merger); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMergerExecuted,
+			sdk.NewAttribute(types.AttributeKeyMergerID, fmt.Sprintf("%d", mergerID)),
+			sdk.NewAttribute(types.AttributeKeyAcquirerID, fmt.Sprintf("%d", merger.AcquirerID)),
+			sdk.NewAttribute(types.AttributeKeyTargetID, fmt.Sprintf("%d", merger.TargetID)),
+			sdk.NewAttribute(types.AttributeKeyConsideration, merger.Consideration().String()),
+			sdk.NewAttribute(types.AttributeKeyExchangeRatio, merger.Ratio()),
+			sdk.NewAttribute("target_shares_exchanged", merger.TargetSharesExchanged.String()),
+			sdk.NewAttribute("acquirer_shares_issued", merger.AcquirerSharesIssued.String()),
+			sdk.NewAttribute("cash_paid", merger.CashPaid.String()),
+			sdk.NewAttribute("appraisal_paid", merger.AppraisalPaid.String()),
+		),
+	)
+
+	k.Logger(ctx).Info("merger executed",
+		"merger_id", mergerID,
+		"acquirer", acquirer.Symbol,
+		"target", target.Symbol,
+		"holders_exchanged", merger.HoldersExchanged,
+		"acquirer_shares_issued", merger.AcquirerSharesIssued.String(),
+		"cash_paid", merger.CashPaid.String(),
+	)
+
+	return nil
+}
+
+// ProcessMergers executes approved mergers whose appraisal window has closed. A
+// merger that cannot be executed is cancelled.
+func (k Keeper) ProcessMergers(ctx sdk.Context) {
+	now := ctx.BlockTime()
+
+	for _, merger := range k.GetAllMergers(ctx) {
+		if merger.Status != types.MergerStatusApproved || now.Before(merger.AppraisalDeadline) {
+			continue
+		}
+		if err := k.ExecuteMerger(ctx, merger.ID); err != nil {
+			k.Logger(ctx).Error("failed to execute merger, cancelling", "merger_id", merger.ID, "error", err)
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.cancelMerger(cacheCtx, merger, k.authority, err.Error()); err != nil {
+				k.Logger(ctx).Error("failed to cancel merger", "merger_id", merger.ID, "error", err)
+				continue
+			}
+			write()
+		}
+	}
+}
+
+// checkMergerPreconditions rejects mergers the exchange cannot settle: the target
+// may have only the merging class outstanding (classes share the company symbol),
+// no undistributed stock dividend, and the acquirer's treasury may not hold target
+// shares, which would become the acquirer's own shares
+func (k Keeper) checkMergerPreconditions(ctx sdk.Context, merger types.Merger) error {
+	for _, shareClass := range k.GetCompanyShareClasses(ctx, merger.TargetID) {
+		if shareClass.ClassID != merger.TargetClassID && shareClass.OutstandingShares.IsPositive() {
+			return types.ErrInvalidMerger.Wrapf("target class %s has outstanding shares; convert or redeem them first", shareClass.ClassID)
+		}
+	}
+
+	for _, dividend := range k.GetDividendsByCompany(ctx, merger.TargetID) {
+		if dividend.Type != types.DividendTypeStock {
+			continue
+		}
+		switch dividend.Status {
+		case types.DividendStatusDeclared, types.DividendStatusRecorded, types.DividendStatusProcessing:
+			return types.ErrInvalidMerger.Wrapf("target stock dividend %d is not yet distributed", dividend.ID)
+		}
+	}
+
+	if treasury, found := k.GetCompanyTreasury(ctx, merger.AcquirerID); found {
+		if treasury.GetInvestmentShares(merger.TargetID, merger.TargetClassID).IsPositive() {
+			return types.ErrInvalidMerger.Wrap("acquirer treasury holds target shares; withdraw them first")
+		}
+	}
+	return nil
+}
+
+// applyMerger performs the exchange; callers must run it on a cached context
+func (k Keeper) applyMerger(ctx sdk.Context, merger *types.Merger, acquirer, target types.Company) error {
+	if err := k.checkMergerPreconditions(ctx, *merger); err != nil {
+		return err
+	}
+	targetClass, found := k.getShareClass(ctx, merger.TargetID, merger.TargetClassID)
+	if !found {
+		return types.ErrShareClassNotFound
+	}
+	acquirerClass, found := k.getShareClass(ctx, merger.AcquirerID, merger.AcquirerClassID)
+	if !found {
+		return types.ErrShareClassNotFound
+	}
+
+	// 1. Dividends the target declared are recorded against its holders at closing
+	if err := k.recordTargetDividendsForMerger(ctx, *merger); err != nil {
+		return err
+	}
+
+	dissents := make(map[string]math.Int)
+	for _, dissent := range k.GetMergerDissents(ctx, merger.ID) {
+		dissents[dissent.Holder] = dissent.Shares
+	}
+
+	cash := newSplitCashOut()
+	issued := math.ZeroInt()
+	exchanged := make(map[string]bool)
+	exchangeOnce := func(addr sdk.AccAddress, cancelled math.Int) error {
+		if exchanged[addr.String()] {
+			return nil
+		}
+		exchanged[addr.String()] = true
+		return k.exchangeMergerBalance(ctx, *merger, addr, target.Symbol, acquirer.Symbol, cancelled)
+	}
+
+	// 2. Direct shareholdings: dissenting shares are bought out, the rest exchanged
+	for _, holding := range k.GetCompanyShareholdings(ctx, merger.TargetID, merger.TargetClassID) {
+		if holding.ClassID != merger.TargetClassID {
+			continue
+		}
+		isModule := k.isModuleAccount(holding.Owner)
+		dissented := math.ZeroInt()
+		if shares, ok := dissents[holding.Owner]; ok && !isModule {
+			dissented = math.MinInt(shares, holding.Shares)
+		}
+		exchangeable := holding.Shares.Sub(dissented)
+
+		whole, owed := merger.Exchange(exchangeable)
+		if !isModule {
+			// Module accounts hold shares for beneficial owners, who are paid below
+			appraisal := merger.AppraisalValue(dissented)
+			cash.add(holding.Owner, owed.Add(appraisal))
+			merger.DissentingShares = merger.DissentingShares.Add(dissented)
+			merger.AppraisalPaid = merger.AppraisalPaid.Add(appraisal)
+		}
+
+		k.DeleteShareholding(ctx, merger.TargetID, merger.TargetClassID, holding.Owner)
+		if whole.IsPositive() {
+			vested := math.MinInt(merger.ExchangeShares(math.MinInt(holding.VestedShares, exchangeable)), whole)
+			locked := math.MinInt(merger.ExchangeShares(math.MaxInt(holding.LockedShares.Sub(dissented), math.ZeroInt())), whole)
+			if err := k.creditMergerShares(ctx, *merger, holding, whole, vested, locked); err != nil {
+				return err
+			}
+			issued = issued.Add(whole)
+		}
+
+		addr, err := sdk.AccAddressFromBech32(holding.Owner)
+		if err != nil {
+			return err
+		}
+		if err := exchangeOnce(addr, dissented); err != nil {
+			return err
+		}
+		merger.HoldersExchanged++
+		merger.TargetSharesExchanged = merger.TargetSharesExchanged.Add(exchangeable)
+	}
+
+	// 3. Equity held in custody by other modules moves to the acquirer's class
+	for _, ownership := range k.GetAllBeneficialOwnerships(ctx) {
+		if ownership.CompanyID != merger.TargetID || ownership.ClassID != merger.TargetClassID {
+			continue
+		}
+		whole, owed := merger.Exchange(ownership.Shares)
+		// Treasury investments are settled with the treasuries below
+		if _, err := sdk.AccAddressFromBech32(ownership.BeneficialOwner); err == nil {
+			cash.add(ownership.BeneficialOwner, owed)
+		}
+		if err := k.UnregisterBeneficialOwner(ctx, ownership.ModuleAccount, ownership.CompanyID, ownership.ClassID,
+			ownership.BeneficialOwner, ownership.ReferenceID); err != nil {
+			return err
+		}
+		if whole.IsPositive() {
+			if err := k.RegisterBeneficialOwner(ctx, ownership.ModuleAccount, merger.AcquirerID, merger.AcquirerClassID,
+				ownership.BeneficialOwner, whole, ownership.ReferenceID, ownership.ReferenceType); err != nil {
+				return err
+			}
+		}
+	}
+	for _, moduleName := range splitCustodyModules {
+		if addr := k.accountKeeper.GetModuleAddress(moduleName); addr != nil {
+			if err := exchangeOnce(addr, math.ZeroInt()); err != nil {
+				return err
+			}
+		}
+	}
+
+	// 4. Vesting schedules
+	if err := k.migrateVestingSchedulesForMerger(ctx, *merger); err != nil {
+		return err
+	}
+
+	// 5. Treasuries: the target's moves to the acquirer, investments in the target are exchanged
+	investorCash, err := k.exchangeTreasuriesForMerger(ctx, *merger)
+	if err != nil {
+		return err
+	}
+
+	// 6. Share class counts: target shares are cancelled, acquirer shares issued
+	acquirerClass.IssuedShares = acquirerClass.IssuedShares.Add(issued)
+	if acquirerClass.IssuedShares.GT(acquirerClass.AuthorizedShares) {
+		return types.ErrExceedsAuthorized.Wrapf("merger issues %s %s shares", issued, merger.AcquirerClassID)
+	}
+	acquirerClass.OutstandingShares = acquirerClass.OutstandingShares.Add(issued)
+	acquirerClass.UpdatedAt = ctx.BlockTime()
+	if err := k.SetShareClass(ctx, acquirerClass); err != nil {
+		return err
+	}
+	merger.AcquirerSharesIssued = issued
+
+	targetClass.IssuedShares = math.ZeroInt()
+	targetClass.OutstandingShares = math.ZeroInt()
+	targetClass.UpdatedAt = ctx.BlockTime()
+	if err := k.SetShareClass(ctx, targetClass); err != nil {
+		return err
+	}
+
+	// 7. Cash consideration, fractions and appraisal payments from the acquirer treasury
+	if err := k.payMergerConsideration(ctx, merger, cash, investorCash); err != nil {
+		return err
+	}
+
+	// 8. Let custody modules move their own records
+	if k.shareExchangeHooks != nil {
+		if err := k.shareExchangeHooks.AfterShareExchange(ctx,
+			merger.TargetID, merger.TargetClassID, target.Symbol,
+			merger.AcquirerID, merger.AcquirerClassID, acquirer.Symbol,
+			merger.ExchangeNumerator, merger.ExchangeDenominator,
+		); err != nil {
+			return err
+		}
+	}
+
+	// 9. Delist the target
+	if err := k.DelistCompany(ctx, merger.TargetID, k.authority, types.DelistingReasonMerger.String()); err != nil {
+		return err
+	}
+	k.DeleteTradingHalt(ctx, merger.TargetID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompanyDelisted,
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", merger.TargetID)),
+			sdk.NewAttribute(types.AttributeKeyCompanySymbol, target.Symbol),
+			sdk.NewAttribute(types.AttributeKeyDelistingReason, types.DelistingReasonMerger.String()),
+			sdk.NewAttribute(types.AttributeKeyMergerID, fmt.Sprintf("%d", merger.ID)),
+		),
+	)
+
+	return nil
+}
+
+// creditMergerShares adds acquirer shares received for a target holding. Cost basis
+// and, for new holdings, the acquisition date and restrictions carry over.
+func (k Keeper) creditMergerShares(ctx sdk.Context, merger types.Merger, source types.Shareholding, whole, vested, locked math.Int) error {
+	basis := merger.CarryoverBasis(source.CostBasis)
+	holding, found := k.getShareholding(ctx, merger.AcquirerID, merger.AcquirerClassID, source.Owner)
+	if !found {
+		holding = types.NewShareholding(merger.AcquirerID, merger.AcquirerClassID, source.Owner, math.ZeroInt(), basis)
+		holding.AcquisitionDate = source.AcquisitionDate
+		holding.TransferRestricted = source.TransferRestricted
+		holding.LockupExpiry = source.LockupExpiry
+	}
+
+	totalCost := basis.MulInt(whole)
+	if !holding.TotalCost.IsNil() {
+		totalCost = totalCost.Add(holding.TotalCost)
+	}
+	holding.Shares = holding.Shares.Add(whole)
+	holding.VestedShares = holding.VestedShares.Add(vested)
+	holding.LockedShares = holding.LockedShares.Add(locked)
+	holding.TotalCost = totalCost
+	holding.CostBasis = totalCost.QuoInt(holding.Shares)
+	holding.UpdatedAt = ctx.BlockTime()
+	return k.SetShareholding(ctx, holding)
+}
+
+// exchangeMergerBalance burns an account's target symbol balance and mints the
+// acquirer symbol for it. Cancelled coins (shares bought out from dissenters) are
+// burned without replacement.
+func (k Keeper) exchangeMergerBalance(ctx sdk.Context, merger types.Merger, addr sdk.AccAddress, targetSymbol, acquirerSymbol string, cancelled math.Int) error {
+	balance := k.bankKeeper.GetBalance(ctx, addr, targetSymbol).Amount
+	if !balance.IsPositive() {
+		return nil
+	}
+	isEquityModule := addr.Equals(k.accountKeeper.GetModuleAddress(types.ModuleName))
+
+	burned := sdk.NewCoins(sdk.NewCoin(targetSymbol, balance))
+	if !isEquityModule {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, burned); err != nil {
+			return err
+		}
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+		return err
+	}
+
+	minted := merger.ExchangeShares(balance.Sub(math.MinInt(cancelled, balance)))
+	if !minted.IsPositive() {
+		return nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(acquirerSymbol, minted))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if isEquityModule {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+}
+
+// recordTargetDividendsForMerger takes the record snapshot of every dividend the
+// target declared but has not recorded yet, bringing the record date forward to
+// closing so the target's holders keep their entitlement. Recorded dividends pay
+// out from their snapshots as usual.
+func (k Keeper) recordTargetDividendsForMerger(ctx sdk.Context, merger types.Merger) error {
+	now := ctx.BlockTime()
+	for _, dividend := range k.GetDividendsByCompany(ctx, merger.TargetID) {
+		if dividend.Status != types.DividendStatusDeclared {
+			continue
+		}
+		if _, exists := k.GetDividendSnapshot(ctx, dividend.ID); exists {
+			continue
+		}
+
+		if dividend.RecordDate.After(now) {
+			dividend.RecordDate = now
+			if dividend.ExDividendDate.After(now) {
+				dividend.ExDividendDate = now
+			}
+			dividend.UpdatedAt = now
+			if err := k.SetDividend(ctx, dividend); err != nil {
+				return err
+			}
+		}
+		if err := k.CreateRecordSnapshot(ctx, dividend.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateVestingSchedulesForMerger moves the target class's vesting schedules to the
+// acquirer class, adding them to any schedule the holder already has there
+func (k Keeper) migrateVestingSchedulesForMerger(ctx sdk.Context, merger types.Merger) error {
+	store := ctx.KVStore(k.storeKey)
+	targetStore := prefix.NewStore(store, types.GetVestingKey(merger.TargetID, merger.TargetClassID, ""))
+	iterator := targetStore.Iterator(nil, nil)
+
+	var keys [][]byte
+	var schedules []types.VestingSchedule
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.VestingSchedule
+		if err := json.Unmarshal(iterator.Value(), &schedule); err != nil {
+			k.Logger(ctx).Error("failed to unmarshal vesting schedule", "error", err)
+			continue
+		}
+		keys = append(keys, iterator.Key())
+		schedules = append(schedules, schedule)
+	}
+	iterator.Close()
+
+	// Write after iterating; the store cannot be modified under an open iterator
+	for i, schedule := range schedules {
+		targetStore.Delete(keys[i])
+
+		total := merger.ExchangeShares(schedule.TotalShares)
+		if !total.IsPositive() {
+			continue
+		}
+		vested := math.MinInt(merger.ExchangeShares(schedule.VestedShares), total)
+
+		key := types.GetVestingKey(merger.AcquirerID, merger.AcquirerClassID, schedule.Owner)
+		if bz := store.Get(key); bz != nil {
+			var existing types.VestingSchedule
+			if err := json.Unmarshal(bz, &existing); err == nil {
+				existing.TotalShares = existing.TotalShares.Add(total)
+				existing.VestedShares = existing.VestedShares.Add(vested)
+				schedule = existing
+				total, vested = existing.TotalShares, existing.VestedShares
+			}
+		}
+		schedule.CompanyID = merger.AcquirerID
+		schedule.ClassID = merger.AcquirerClassID
+		schedule.TotalShares = total
+		schedule.VestedShares = vested
+
+		bz, err := json.Marshal(schedule)
+		if err != nil {
+			return err
+		}
+		store.Set(key, bz)
+	}
+	return nil
+}
+
+// exchangeTreasuriesForMerger cancels the target's treasury shares of the class,
+// exchanges other companies' treasury investments in the target, and moves the
+// target's unreserved treasury funds and investments to the acquirer. It returns
+// the cash owed to each investing company.
+func (k Keeper) exchangeTreasuriesForMerger(ctx sdk.Context, merger types.Merger) (map[uint64]math.Int, error) {
+	investorCash := make(map[uint64]math.Int)
+
+	addInvestment := func(investments map[uint64]map[string]math.Int, companyID uint64, classID string, shares math.Int) {
+		if investments[companyID] == nil {
+			investments[companyID] = make(map[string]math.Int)
+		}
+		if current, ok := investments[companyID][classID]; ok {
+			shares = shares.Add(current)
+		}
+		investments[companyID][classID] = shares
+	}
+
+	for _, treasury := range k.GetAllCompanyTreasuries(ctx) {
+		changed := false
+
+		if treasury.CompanyID == merger.TargetID {
+			if _, ok := treasury.TreasuryShares[merger.TargetClassID]; ok {
+				delete(treasury.TreasuryShares, merger.TargetClassID)
+				changed = true
+			}
+		}
+
+		if holdings, ok := treasury.InvestmentHoldings[merger.TargetID]; ok {
+			if shares, ok := holdings[merger.TargetClassID]; ok {
+				whole, owed := merger.Exchange(shares)
+				delete(holdings, merger.TargetClassID)
+				if len(holdings) == 0 {
+					delete(treasury.InvestmentHoldings, merger.TargetID)
+				}
+				if whole.IsPositive() {
+					addInvestment(treasury.InvestmentHoldings, merger.AcquirerID, merger.AcquirerClassID, whole)
+				}
+				if owed.IsPositive() {
+					investorCash[treasury.CompanyID] = owed
+				}
+				changed = true
+			}
+		}
+		if locked, ok := treasury.LockedEquity[merger.TargetID]; ok {
+			if shares, ok := locked[merger.TargetClassID]; ok {
+				delete(locked, merger.TargetClassID)
+				if len(locked) == 0 {
+					delete(treasury.LockedEquity, merger.TargetID)
+				}
+				if whole := merger.ExchangeShares(shares); whole.IsPositive() {
+					addInvestment(treasury.LockedEquity, merger.AcquirerID, merger.AcquirerClassID, whole)
+				}
+				changed = true
+			}
+		}
+
+		if changed {
+			treasury.UpdatedAt = ctx.BlockTime()
+			if err := k.SetCompanyTreasury(ctx, treasury); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	targetTreasury, found := k.GetCompanyTreasury(ctx, merger.TargetID)
+	if !found {
+		return investorCash, nil
+	}
+	acquirerTreasury, found := k.GetCompanyTreasury(ctx, merger.AcquirerID)
+	if !found {
+		acquirerTreasury = types.NewCompanyTreasury(merger.AcquirerID)
+		acquirerTreasury.CreatedAt = ctx.BlockTime()
+	}
+
+	// Funds reserved for the target's pending proposals and dividends stay behind
+	if available := targetTreasury.GetAvailableBalance(); !available.IsZero() {
+		targetTreasury.Balance = targetTreasury.Balance.Sub(available...)
+		targetTreasury.TotalWithdrawn = targetTreasury.TotalWithdrawn.Add(available...)
+		acquirerTreasury.Balance = acquirerTreasury.Balance.Add(available...)
+		acquirerTreasury.TotalDeposited = acquirerTreasury.TotalDeposited.Add(available...)
+	}
+
+	companyIDs := make([]uint64, 0, len(targetTreasury.InvestmentHoldings))
+	for companyID := range targetTreasury.InvestmentHoldings {
+		companyIDs = append(companyIDs, companyID)
+	}
+	sort.Slice(companyIDs, func(i, j int) bool { return companyIDs[i] < companyIDs[j] })
+	for _, companyID := range companyIDs {
+		// The acquirer cannot hold its own shares as an investment
+		if companyID == merger.AcquirerID {
+			continue
+		}
+		for classID := range targetTreasury.InvestmentHoldings[companyID] {
+			available := targetTreasury.GetAvailableInvestmentShares(companyID, classID)
+			if !available.IsPositive() {
+				continue
+			}
+			targetTreasury.InvestmentHoldings[companyID][classID] = targetTreasury.InvestmentHoldings[companyID][classID].Sub(available)
+			if acquirerTreasury.InvestmentHoldings == nil {
+				acquirerTreasury.InvestmentHoldings = make(map[uint64]map[string]math.Int)
+			}
+			addInvestment(acquirerTreasury.InvestmentHoldings, companyID, classID, available)
+		}
+	}
+
+	targetTreasury.UpdatedAt = ctx.BlockTime()
+	acquirerTreasury.UpdatedAt = ctx.BlockTime()
+	if err := k.SetCompanyTreasury(ctx, targetTreasury); err != nil {
+		return nil, err
+	}
+	if err := k.SetCompanyTreasury(ctx, acquirerTreasury); err != nil {
+		return nil, err
+	}
+	return investorCash, nil
+}
+
+// payMergerConsideration pays the cash consideration, fractions and appraisal
+// value out of the acquirer treasury
+func (k Keeper) payMergerConsideration(
+	ctx sdk.Context,
+	merger *types.Merger,
+	cash *splitCashOut,
+	investorCash map[uint64]math.Int,
+) error {
+	total := cash.total()
+	investorIDs := make([]uint64, 0, len(investorCash))
+	for companyID, owed := range investorCash {
+		total = total.Add(owed)
+		investorIDs = append(investorIDs, companyID)
+	}
+	merger.CashPaid = total.Sub(merger.AppraisalPaid)
+	if total.IsZero() {
+		return nil
+	}
+
+	treasury, found := k.GetCompanyTreasury(ctx, merger.AcquirerID)
+	if !found {
+		return types.ErrTreasuryNotFound
+	}
+	payout := sdk.NewCoins(sdk.NewCoin(types.MergerConsiderationDenom, total))
+	if !treasury.CanWithdraw(payout) {
+		return types.ErrInsufficientTreasuryBalance.Wrapf("merger consideration requires %s", payout)
+	}
+
+	for _, recipient := range cash.recipients {
+		addr, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(types.MergerConsiderationDenom, cash.amounts[recipient]))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMergerPayment,
+				sdk.NewAttribute(types.AttributeKeyMergerID, fmt.Sprintf("%d", merger.ID)),
+				sdk.NewAttribute(types.AttributeKeyShareholder, recipient),
+				sdk.NewAttribute("amount", coins.String()),
+			),
+		)
+	}
+
+	treasury.Balance = treasury.Balance.Sub(payout...)
+	treasury.TotalWithdrawn = treasury.TotalWithdrawn.Add(payout...)
+	treasury.UpdatedAt = ctx.BlockTime()
+	if err := k.SetCompanyTreasury(ctx, treasury); err != nil {
+		return err
+	}
+
+	// Investing companies are credited inside the equity module, so no coins move
+	sort.Slice(investorIDs, func(i, j int) bool { return investorIDs[i] < investorIDs[j] })
+	for _, companyID := range investorIDs {
+		investor, found := k.GetCompanyTreasury(ctx, companyID)
+		if !found {
+			continue
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(types.MergerConsiderationDenom, investorCash[companyID]))
+		investor.Balance = investor.Balance.Add(coins...)
+		investor.TotalDeposited = investor.TotalDeposited.Add(coins...)
+		investor.UpdatedAt = ctx.BlockTime()
+		if err := k.SetCompanyTreasury(ctx, investor); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
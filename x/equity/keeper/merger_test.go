@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// TestMergerExchange tests merger validation, the share exchange with cash for
+// fractions, and dissenter appraisal
+func TestMergerExchange(t *testing.T) {
+	proposer := sdk.AccAddress([]byte("merger_proposer_____")).String()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// 2 acquirer shares for every 3 target shares plus 5 uhodl per target share
+	merger := types.Merger{
+		AcquirerID:          1,
+		AcquirerClassID:     "COMMON",
+		TargetID:            2,
+		TargetClassID:       "COMMON",
+		ExchangeNumerator:   2,
+		ExchangeDenominator: 3,
+		CashPerShare:        math.LegacyNewDec(5),
+		FractionalPrice:     math.LegacyNewDec(30),
+		AppraisalPrice:      math.LegacyNewDec(25),
+		AppraisalPeriod:     7 * 24 * time.Hour,
+		Reason:              "acquisition",
+		ProposedBy:          proposer,
+	}
+	require.NoError(t, merger.Validate())
+	require.Equal(t, types.MergerConsiderationMixed, merger.Consideration())
+	require.Equal(t, "2:3", merger.Ratio())
+
+	whole, cash := merger.Exchange(math.NewInt(100))
+	require.Equal(t, math.NewInt(66), whole) // 66.67 rounds down
+	require.Equal(t, math.NewInt(520), cash) // 100 x 5 + 2/3 of a share at 30
+	require.Equal(t, math.NewInt(66), merger.ExchangeShares(math.NewInt(100)))
+	require.Equal(t, math.NewInt(250), merger.AppraisalValue(math.NewInt(10)))
+
+	// Holders below one acquirer share receive only cash
+	whole, cash = merger.Exchange(math.NewInt(1))
+	require.True(t, whole.IsZero())
+	require.Equal(t, math.NewInt(25), cash) // 5 + 2/3 of a share at 30
+
+	// Cost basis carries over at the inverse ratio
+	require.Equal(t, math.LegacyNewDec(15), merger.CarryoverBasis(math.LegacyNewDec(10)))
+
+	// All-cash deals issue no acquirer shares
+	allCash := merger
+	allCash.ExchangeNumerator = 0
+	require.NoError(t, allCash.Validate())
+	require.Equal(t, types.MergerConsiderationCash, allCash.Consideration())
+	whole, cash = allCash.Exchange(math.NewInt(100))
+	require.True(t, whole.IsZero())
+	require.Equal(t, math.NewInt(500), cash)
+
+	// Appraisal window
+	merger.Status = types.MergerStatusApproved
+	merger.AppraisalDeadline = now.Add(merger.AppraisalPeriod)
+	require.True(t, merger.IsAppraisalOpen(now))
+	require.False(t, merger.IsAppraisalOpen(merger.AppraisalDeadline))
+	require.False(t, merger.IsFullyApproved())
+	merger.AcquirerProposalID, merger.TargetProposalID = 4, 9
+	require.True(t, merger.IsFullyApproved())
+
+	// Invalid mergers
+	invalid := merger
+	invalid.TargetID = invalid.AcquirerID
+	require.Error(t, invalid.Validate(), "self merger")
+
+	invalid = allCash
+	invalid.CashPerShare = math.LegacyZeroDec()
+	require.Error(t, invalid.Validate(), "no consideration")
+
+	invalid = merger
+	invalid.AppraisalPeriod = time.Hour
+	require.Error(t, invalid.Validate(), "appraisal period too short")
+
+	invalid = merger
+	invalid.ExchangeDenominator = types.MaxMergerRatioTerm + 1
+	require.Error(t, invalid.Validate(), "ratio term too large")
+}
+
+// TestMergerExecutesAfterBothApprovals tests that a merger waits for the governance
+// approval of both companies, then exchanges the target's shares once the appraisal
+// window closes
+func TestMergerExecutesAfterBothApprovals(t *testing.T) {
+	k, ctx, bank := setupKeeper(t)
+
+	founder := sdk.AccAddress([]byte("merger_founder______")).String()
+	holder := sdk.AccAddress([]byte("merger_holder_______")).String()
+	createTestCompany(t, k, ctx, bank, 1, "ACQ", founder, map[string]int64{founder: 1000})
+	createTestCompany(t, k, ctx, bank, 2, "TGT", founder, map[string]int64{holder: 300})
+
+	// 2 acquirer shares for every 3 target shares
+	mergerID, err := k.ProposeMerger(ctx, types.Merger{
+		AcquirerID:          1,
+		AcquirerClassID:     "COMMON",
+		TargetID:            2,
+		TargetClassID:       "COMMON",
+		ExchangeNumerator:   2,
+		ExchangeDenominator: 3,
+		CashPerShare:        math.LegacyZeroDec(),
+		FractionalPrice:     math.LegacyZeroDec(),
+		AppraisalPrice:      math.LegacyNewDec(1),
+		AppraisalPeriod:     types.MinAppraisalPeriod,
+		Reason:              "acquisition",
+	}, founder)
+	require.NoError(t, err)
+
+	// The acquirer's approval alone does not open the appraisal window
+	require.NoError(t, k.ApproveMerger(ctx, mergerID, 1, 10))
+	merger, found := k.GetMerger(ctx, mergerID)
+	require.True(t, found)
+	require.Equal(t, types.MergerStatusPending, merger.Status)
+	require.ErrorIs(t, k.ExecuteMerger(ctx, mergerID), types.ErrMergerNotApproved)
+
+	// The target's approval completes it
+	require.NoError(t, k.ApproveMerger(ctx, mergerID, 2, 11))
+	merger, _ = k.GetMerger(ctx, mergerID)
+	require.Equal(t, types.MergerStatusApproved, merger.Status)
+	require.ErrorIs(t, k.ExecuteMerger(ctx, mergerID), types.ErrAppraisalWindowOpen)
+
+	// EndBlock executes the merger after the appraisal window
+	ctx = ctx.WithBlockTime(merger.AppraisalDeadline)
+	k.ProcessMergers(ctx)
+
+	merger, _ = k.GetMerger(ctx, mergerID)
+	require.Equal(t, types.MergerStatusExecuted, merger.Status)
+	require.Equal(t, math.NewInt(200), merger.AcquirerSharesIssued)
+
+	holderAddr, err := sdk.AccAddressFromBech32(holder)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(200), bank.GetBalance(ctx, holderAddr, "ACQ").Amount)
+	require.True(t, bank.GetBalance(ctx, holderAddr, "TGT").Amount.IsZero())
+
+	target, found := k.GetCompany(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, types.CompanyStatusDelisted, target.(types.Company).Status)
+}
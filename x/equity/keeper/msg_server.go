@@ -622,6 +622,13 @@ func (k msgServer) DeclareDividend(goCtx context.Context, msg *types.MsgDeclareD
 		msg.Description,
 		msg.PaymentMethod,
 		msg.StockRatio,
+		types.DividendTerms{
+			StockClassID:      msg.StockClassID,
+			FractionalPrice:   msg.FractionalPrice,
+			PropertyCompanyID: msg.PropertyCompanyID,
+			PropertyClassID:   msg.PropertyClassID,
+			ScripPrice:        msg.ScripPrice,
+		},
 		msg.Audit, // Pass audit info to keeper
 	)
 	if err != nil {
@@ -752,8 +759,8 @@ func (k msgServer) CancelDividend(goCtx context.Context, msg *types.MsgCancelDiv
 	dividend.Notes = msg.Reason
 	k.SetDividend(ctx, dividend)
 
-	// If cash dividend, refund remaining funds back to treasury
-	if dividend.Type == types.DividendTypeCash && dividend.RemainingAmount.GT(math.LegacyZeroDec()) {
+	// If paid in coins, refund remaining funds back to treasury
+	if dividend.PaysCoins() && dividend.RemainingAmount.GT(math.LegacyZeroDec()) {
 		refundCoins := sdk.NewCoins(sdk.NewCoin(dividend.Currency, dividend.RemainingAmount.TruncateInt()))
 
 		// Get treasury and add funds back
@@ -773,6 +780,15 @@ func (k msgServer) CancelDividend(goCtx context.Context, msg *types.MsgCancelDiv
 		}
 	}
 
+	// If property shares, release the reserved treasury shares
+	if dividend.IsPropertyShares() {
+		if err := k.ReleaseUndistributedPropertyShares(ctx, &dividend); err != nil {
+			k.Logger(ctx).Error("failed to release property dividend shares", "error", err)
+		} else {
+			k.SetDividend(ctx, dividend)
+		}
+	}
+
 	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -931,6 +947,24 @@ func (k msgServer) EnableDividendReinvestment(goCtx context.Context, msg *types.
 	}, nil
 }
 
+// ElectDividendScrip handles a holder's election between cash and scrip for a dividend
+func (k msgServer) ElectDividendScrip(goCtx context.Context, msg *types.MsgElectDividendScrip) (*types.MsgElectDividendScripResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ElectDividendScrip(ctx, msg.Holder, msg.DividendID, msg.Scrip); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgElectDividendScripResponse{
+		Success: true,
+	}, nil
+}
+
 // =============================================================================
 // Anti-Dilution Message Handlers
 // =============================================================================
@@ -1708,3 +1742,651 @@ func (k msgServer) WithdrawPetition(goCtx context.Context, msg *types.SimpleMsgW
 		Success: true,
 	}, nil
 }
+
+// =============================================================================
+// Share Conversion Handlers
+// =============================================================================
+
+// SetConversionTerms handles setting the conversion terms of a share class
+func (k msgServer) SetConversionTerms(goCtx context.Context, msg *types.SimpleMsgSetConversionTerms) (*types.MsgSetConversionTermsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.UpdateConversionTerms(ctx, msg.Terms, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetConversionTermsResponse{
+		Success: true,
+	}, nil
+}
+
+// ProposeConversionTerms handles proposing conversion terms with a new ratio or issue price
+func (k msgServer) ProposeConversionTerms(goCtx context.Context, msg *types.SimpleMsgProposeConversionTerms) (*types.MsgProposeConversionTermsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	changeID, err := k.Keeper.ProposeConversionTerms(ctx, msg.Terms, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeConversionTermsResponse{
+		ChangeID: changeID,
+		Success:  true,
+	}, nil
+}
+
+// ConvertShares handles a holder converting shares into the target class
+func (k msgServer) ConvertShares(goCtx context.Context, msg *types.SimpleMsgConvertShares) (*types.MsgConvertSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	record, err := k.Keeper.ConvertShares(ctx, msg.Owner, msg.CompanyID, msg.ClassID, msg.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConvertSharesResponse{
+		ConversionID:  record.ID,
+		TargetClassID: record.TargetClassID,
+		SharesIssued:  record.TargetShares,
+		Success:       true,
+	}, nil
+}
+
+// =============================================================================
+// Liquidation Preference Handlers
+// =============================================================================
+
+// SetLiquidationPreference handles proposing the liquidation preference of a share class.
+// The terms take effect once a capital structure proposal approves the change.
+func (k msgServer) SetLiquidationPreference(goCtx context.Context, msg *types.SimpleMsgSetLiquidationPreference) (*types.MsgSetLiquidationPreferenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	changeID, err := k.Keeper.ProposeLiquidationPreference(ctx, msg.Preference, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetLiquidationPreferenceResponse{
+		ChangeID: changeID,
+		Success:  true,
+	}, nil
+}
+
+// GrantEquity grants an employee stock option or warrant
+func (k msgServer) GrantEquity(goCtx context.Context, msg *types.SimpleMsgGrantEquity) (*types.MsgGrantEquityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	grantID, err := k.Keeper.GrantEquity(ctx, msg.Grant, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantEquityResponse{
+		GrantID: grantID,
+		Success: true,
+	}, nil
+}
+
+// ExerciseGrant exercises shares of an option or warrant grant, optionally cashless
+func (k msgServer) ExerciseGrant(goCtx context.Context, msg *types.SimpleMsgExerciseGrant) (*types.MsgExerciseGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sold := math.ZeroInt()
+	if msg.Cashless {
+		var err error
+		if sold, err = k.Keeper.CashlessExerciseGrant(ctx, msg.Holder, msg.GrantID, msg.Shares); err != nil {
+			return nil, err
+		}
+	} else if _, err := k.Keeper.ExerciseGrant(ctx, msg.Holder, msg.GrantID, msg.Shares); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExerciseGrantResponse{
+		SharesExercised: msg.Shares,
+		SharesSold:      sold,
+		Success:         true,
+	}, nil
+}
+
+// TerminateGrant records the end of an option holder's service
+func (k msgServer) TerminateGrant(goCtx context.Context, msg *types.SimpleMsgTerminateGrant) (*types.MsgTerminateGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.TerminateGrant(ctx, msg.GrantID, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTerminateGrantResponse{
+		Success: true,
+	}, nil
+}
+
+// CreateTenderOffer opens an issuer tender offer
+func (k msgServer) CreateTenderOffer(goCtx context.Context, msg *types.SimpleMsgCreateTenderOffer) (*types.MsgCreateTenderOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	offerID, err := k.Keeper.CreateTenderOffer(ctx, msg.Offer, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateTenderOfferResponse{
+		OfferID: offerID,
+		Success: true,
+	}, nil
+}
+
+// SubmitTender tenders a holder's shares into an open offer
+func (k msgServer) SubmitTender(goCtx context.Context, msg *types.SimpleMsgSubmitTender) (*types.MsgSubmitTenderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SubmitTender(ctx, msg.Holder, msg.OfferID, msg.Shares, msg.Price); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitTenderResponse{
+		Success: true,
+	}, nil
+}
+
+// WithdrawTender withdraws a holder's tender
+func (k msgServer) WithdrawTender(goCtx context.Context, msg *types.SimpleMsgWithdrawTender) (*types.MsgWithdrawTenderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.WithdrawTender(ctx, msg.Holder, msg.OfferID); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawTenderResponse{
+		Success: true,
+	}, nil
+}
+
+// CancelTenderOffer cancels an open tender offer
+func (k msgServer) CancelTenderOffer(goCtx context.Context, msg *types.SimpleMsgCancelTenderOffer) (*types.MsgCancelTenderOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelTenderOffer(ctx, msg.OfferID, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelTenderOfferResponse{
+		Success: true,
+	}, nil
+}
+
+// CreateBuybackProgram authorizes an open-market buyback program
+func (k msgServer) CreateBuybackProgram(goCtx context.Context, msg *types.SimpleMsgCreateBuybackProgram) (*types.MsgCreateBuybackProgramResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	buybackID, err := k.Keeper.CreateBuybackProgram(ctx, msg.Program, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateBuybackProgramResponse{
+		BuybackID: buybackID,
+		Success:   true,
+	}, nil
+}
+
+// ExecuteBuyback buys shares on the DEX under a buyback program
+func (k msgServer) ExecuteBuyback(goCtx context.Context, msg *types.SimpleMsgExecuteBuyback) (*types.MsgExecuteBuybackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	bought, spent, err := k.Keeper.ExecuteBuyback(ctx, msg.BuybackID, msg.Shares, msg.LimitPrice, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteBuybackResponse{
+		SharesBought: bought,
+		AmountSpent:  spent,
+		Success:      true,
+	}, nil
+}
+
+// EndBuybackProgram stops a buyback program early
+func (k msgServer) EndBuybackProgram(goCtx context.Context, msg *types.SimpleMsgEndBuybackProgram) (*types.MsgEndBuybackProgramResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.EndBuybackProgram(ctx, msg.BuybackID, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgEndBuybackProgramResponse{
+		Success: true,
+	}, nil
+}
+
+// CreateRightsOffering opens a rights offering to existing holders
+func (k msgServer) CreateRightsOffering(goCtx context.Context, msg *types.SimpleMsgCreateRightsOffering) (*types.MsgCreateRightsOfferingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	offerID, err := k.Keeper.CreateRightsOffering(
+		ctx,
+		msg.CompanyID,
+		msg.ClassID,
+		msg.PricePerShare,
+		msg.QuoteDenom,
+		msg.RightsPerShare,
+		msg.EndTime,
+		msg.Creator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateRightsOfferingResponse{
+		OfferID: offerID,
+		Success: true,
+	}, nil
+}
+
+// ExerciseRights exercises subscription rights for new shares
+func (k msgServer) ExerciseRights(goCtx context.Context, msg *types.SimpleMsgExerciseRights) (*types.MsgExerciseRightsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	shares, err := k.Keeper.ExerciseRights(ctx, msg.OfferID, msg.Holder, msg.Rights)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExerciseRightsResponse{
+		SharesPurchased: shares,
+		Success:         true,
+	}, nil
+}
+
+// OversubscribeRights requests unexercised shares in the oversubscription round
+func (k msgServer) OversubscribeRights(goCtx context.Context, msg *types.SimpleMsgOversubscribeRights) (*types.MsgOversubscribeRightsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.Oversubscribe(ctx, msg.OfferID, msg.Subscriber, msg.Shares); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgOversubscribeRightsResponse{
+		Success: true,
+	}, nil
+}
+
+// =============================================================================
+// Transfer Compliance Handlers
+// =============================================================================
+
+// SetComplianceRules handles replacing the compliance rules of a share class
+func (k msgServer) SetComplianceRules(goCtx context.Context, msg *types.SimpleMsgSetComplianceRules) (*types.MsgSetComplianceRulesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.UpdateClassComplianceRules(ctx, msg.CompanyID, msg.ClassID, msg.Rules, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetComplianceRulesResponse{
+		Success: true,
+	}, nil
+}
+
+// AttestInvestor handles a company attesting an investor's jurisdiction or accreditation
+func (k msgServer) AttestInvestor(goCtx context.Context, msg *types.SimpleMsgAttestInvestor) (*types.MsgAttestInvestorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.AttestInvestor(ctx, msg.Attestation, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAttestInvestorResponse{
+		Success: true,
+	}, nil
+}
+
+// RevokeAttestation handles a company revoking an investor attestation
+func (k msgServer) RevokeAttestation(goCtx context.Context, msg *types.SimpleMsgRevokeAttestation) (*types.MsgRevokeAttestationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RevokeInvestorAttestation(ctx, msg.CompanyID, msg.Address, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeAttestationResponse{
+		Success: true,
+	}, nil
+}
+
+// =============================================================================
+// Dissolution Handlers
+// =============================================================================
+
+// ProposeDissolution handles proposing the voluntary dissolution of a company
+func (k msgServer) ProposeDissolution(goCtx context.Context, msg *types.SimpleMsgProposeDissolution) (*types.MsgProposeDissolutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	dissolutionID, err := k.Keeper.ProposeDissolution(ctx, msg.CompanyID, msg.Reason, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeDissolutionResponse{
+		DissolutionID: dissolutionID,
+		Success:       true,
+	}, nil
+}
+
+// CancelDissolution handles cancelling a dissolution that has not been executed
+func (k msgServer) CancelDissolution(goCtx context.Context, msg *types.SimpleMsgCancelDissolution) (*types.MsgCancelDissolutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	dissolution, found := k.Keeper.GetDissolution(ctx, msg.DissolutionID)
+	if !found {
+		return nil, types.ErrDissolutionNotFound
+	}
+	if !k.Keeper.CanProposeForCompany(ctx, dissolution.CompanyID, msg.Creator) {
+		return nil, types.ErrUnauthorized
+	}
+
+	if err := k.Keeper.CancelDissolution(ctx, msg.DissolutionID, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelDissolutionResponse{
+		Success: true,
+	}, nil
+}
+
+// =============================================================================
+// Stock Split Handlers
+// =============================================================================
+
+// ProposeStockSplit handles proposing a split of a share class
+func (k msgServer) ProposeStockSplit(goCtx context.Context, msg *types.SimpleMsgProposeStockSplit) (*types.MsgProposeStockSplitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	splitID, err := k.Keeper.ProposeStockSplit(ctx, msg.CompanyID, msg.ClassID, msg.Numerator, msg.Denominator,
+		msg.ReferencePrice, msg.Reason, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeStockSplitResponse{
+		SplitID: splitID,
+		Success: true,
+	}, nil
+}
+
+// CancelStockSplit handles cancelling a split that has not been executed
+func (k msgServer) CancelStockSplit(goCtx context.Context, msg *types.SimpleMsgCancelStockSplit) (*types.MsgCancelStockSplitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	split, found := k.Keeper.GetStockSplit(ctx, msg.SplitID)
+	if !found {
+		return nil, types.ErrStockSplitNotFound
+	}
+	if !k.Keeper.CanProposeForCompany(ctx, split.CompanyID, msg.Creator) {
+		return nil, types.ErrUnauthorized
+	}
+
+	if err := k.Keeper.CancelStockSplit(ctx, msg.SplitID, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelStockSplitResponse{
+		Success: true,
+	}, nil
+}
+
+// =============================================================================
+// Merger Handlers
+// =============================================================================
+
+// ProposeMerger handles proposing a merger of the target into the acquirer
+func (k msgServer) ProposeMerger(goCtx context.Context, msg *types.SimpleMsgProposeMerger) (*types.MsgProposeMergerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	mergerID, err := k.Keeper.ProposeMerger(ctx, msg.Merger, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeMergerResponse{
+		MergerID: mergerID,
+		Success:  true,
+	}, nil
+}
+
+// DissentFromMerger handles a target holder demanding appraisal
+func (k msgServer) DissentFromMerger(goCtx context.Context, msg *types.SimpleMsgDissentFromMerger) (*types.MsgDissentFromMergerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.DissentFromMerger(ctx, msg.MergerID, msg.Holder, msg.Shares); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDissentFromMergerResponse{
+		Success: true,
+	}, nil
+}
+
+// CancelMerger handles cancelling a merger that has not been executed
+func (k msgServer) CancelMerger(goCtx context.Context, msg *types.SimpleMsgCancelMerger) (*types.MsgCancelMergerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelMerger(ctx, msg.MergerID, msg.Creator, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelMergerResponse{
+		Success: true,
+	}, nil
+}
+
+// =============================================================================
+// Board Handlers
+// =============================================================================
+
+// ConfigureBoard handles creating or updating a company's board
+func (k msgServer) ConfigureBoard(goCtx context.Context, msg *types.SimpleMsgConfigureBoard) (*types.MsgConfigureBoardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ConfigureBoard(ctx, msg.Board, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConfigureBoardResponse{
+		Success: true,
+	}, nil
+}
+
+// AppointDirector handles the company owner seating an initial director
+func (k msgServer) AppointDirector(goCtx context.Context, msg *types.SimpleMsgAppointDirector) (*types.MsgAppointDirectorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.AppointDirector(ctx, msg.CompanyID, msg.Nominee, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAppointDirectorResponse{
+		Success: true,
+	}, nil
+}
+
+// SetDirectorCommittees handles replacing the committees a director sits on
+func (k msgServer) SetDirectorCommittees(goCtx context.Context, msg *types.SimpleMsgSetDirectorCommittees) (*types.MsgSetDirectorCommitteesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.UpdateDirectorCommittees(ctx, msg.CompanyID, msg.Director, msg.Committees, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDirectorCommitteesResponse{
+		Success: true,
+	}, nil
+}
+
+// ResignBoardSeat handles a director resigning their seat
+func (k msgServer) ResignBoardSeat(goCtx context.Context, msg *types.SimpleMsgResignBoardSeat) (*types.MsgResignBoardSeatResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ResignBoardSeat(ctx, msg.CompanyID, msg.Director); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResignBoardSeatResponse{
+		Success: true,
+	}, nil
+}
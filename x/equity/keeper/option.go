@@ -0,0 +1,609 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// =============================================================================
+// EMPLOYEE STOCK OPTIONS AND WARRANTS
+// Grants of the right to buy shares of a class at a strike price, vesting on the
+// existing VestingSchedule type and exercised from authorized or treasury shares
+// =============================================================================
+
+// GetNextEquityGrantID returns the next grant ID and increments the counter
+func (k Keeper) GetNextEquityGrantID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EquityGrantCounterKey)
+
+	var counter uint64 = 1
+	if bz != nil {
+		counter = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.EquityGrantCounterKey, sdk.Uint64ToBigEndian(counter+1))
+	return counter
+}
+
+// SetEquityGrant stores a grant and indexes it by company
+func (k Keeper) SetEquityGrant(ctx sdk.Context, grant types.EquityGrant) error {
+	k.journalEquityGrant(ctx, grant)
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(grant)
+	if err != nil {
+		return fmt.Errorf("failed to marshal equity grant: %w", err)
+	}
+	store.Set(types.GetEquityGrantKey(grant.ID), bz)
+	store.Set(types.GetEquityGrantByCompanyKey(grant.CompanyID, grant.ID), sdk.Uint64ToBigEndian(grant.ID))
+	return nil
+}
+
+// GetEquityGrant returns a grant by ID
+func (k Keeper) GetEquityGrant(ctx sdk.Context, grantID uint64) (types.EquityGrant, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEquityGrantKey(grantID))
+	if bz == nil {
+		return types.EquityGrant{}, false
+	}
+
+	var grant types.EquityGrant
+	if err := json.Unmarshal(bz, &grant); err != nil {
+		return types.EquityGrant{}, false
+	}
+	return grant, true
+}
+
+// GetEquityGrantsByCompany returns all option and warrant grants of a company
+func (k Keeper) GetEquityGrantsByCompany(ctx sdk.Context, companyID uint64) []types.EquityGrant {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEquityGrantsByCompanyPrefix(companyID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var grants []types.EquityGrant
+	for ; iterator.Valid(); iterator.Next() {
+		if grant, found := k.GetEquityGrant(ctx, sdk.BigEndianToUint64(iterator.Value())); found {
+			grants = append(grants, grant)
+		}
+	}
+	return grants
+}
+
+// GetAllEquityGrants returns every grant in the store
+func (k Keeper) GetAllEquityGrants(ctx sdk.Context) []types.EquityGrant {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EquityGrantPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var grants []types.EquityGrant
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.EquityGrant
+		if err := json.Unmarshal(iterator.Value(), &grant); err != nil {
+			continue
+		}
+		grants = append(grants, grant)
+	}
+	return grants
+}
+
+// GetOutstandingGrantShares returns the unexercised shares of a class under open
+// grants. These count toward the class's fully-diluted share count.
+func (k Keeper) GetOutstandingGrantShares(ctx sdk.Context, companyID uint64, classID string) math.Int {
+	total := math.ZeroInt()
+	for _, grant := range k.GetEquityGrantsByCompany(ctx, companyID) {
+		if grant.ClassID == classID && grant.IsOpen() {
+			total = total.Add(grant.OutstandingShares())
+		}
+	}
+	return total
+}
+
+// GetFullyDilutedShares returns a class's outstanding shares plus the shares reserved
+// for open option and warrant grants
+func (k Keeper) GetFullyDilutedShares(ctx sdk.Context, companyID uint64, classID string) math.Int {
+	shareClass, found := k.getShareClass(ctx, companyID, classID)
+	if !found {
+		return math.ZeroInt()
+	}
+	return shareClass.OutstandingShares.Add(k.GetOutstandingGrantShares(ctx, companyID, classID))
+}
+
+// reservedGrantShares returns the outstanding grant shares of a class backed by a source
+func (k Keeper) reservedGrantShares(ctx sdk.Context, companyID uint64, classID string, source types.GrantShareSource) math.Int {
+	total := math.ZeroInt()
+	for _, grant := range k.GetEquityGrantsByCompany(ctx, companyID) {
+		if grant.ClassID == classID && grant.Source == source && grant.IsOpen() {
+			total = total.Add(grant.OutstandingShares())
+		}
+	}
+	return total
+}
+
+// GrantEquity grants an option or warrant to a holder. The shares are reserved against
+// the class's unissued authorized shares or the company's treasury shares so the
+// company cannot grant more than it can deliver on exercise.
+func (k Keeper) GrantEquity(ctx sdk.Context, grant types.EquityGrant, grantedBy string) (uint64, error) {
+	if !k.CanProposeForCompany(ctx, grant.CompanyID, grantedBy) {
+		return 0, types.ErrUnauthorized
+	}
+
+	company, found := k.getCompany(ctx, grant.CompanyID)
+	if !found {
+		return 0, types.ErrCompanyNotFound
+	}
+	if company.Status != types.CompanyStatusActive {
+		return 0, types.ErrCompanyNotActive
+	}
+	shareClass, found := k.getShareClass(ctx, grant.CompanyID, grant.ClassID)
+	if !found {
+		return 0, types.ErrShareClassNotFound
+	}
+
+	grant.ID = 0
+	grant.ExercisedShares = math.ZeroInt()
+	grant.ForfeitedShares = math.ZeroInt()
+	grant.RepurchasedShares = math.ZeroInt()
+	grant.UnvestedHeld = math.ZeroInt()
+	grant.TerminatedAt = time.Time{}
+	grant.Status = types.GrantStatusActive
+	grant.GrantedBy = grantedBy
+	grant.GrantedAt = ctx.BlockTime()
+	grant.UpdatedAt = ctx.BlockTime()
+	if grant.Vesting != nil {
+		grant.Vesting.CompanyID = grant.CompanyID
+		grant.Vesting.ClassID = grant.ClassID
+		grant.Vesting.Owner = grant.Holder
+		grant.Vesting.VestedShares = math.ZeroInt()
+		grant.Vesting.CreatedAt = ctx.BlockTime()
+	}
+
+	if err := grant.Validate(); err != nil {
+		return 0, types.ErrInvalidEquityGrant.Wrap(err.Error())
+	}
+
+	reserved := k.reservedGrantShares(ctx, grant.CompanyID, grant.ClassID, grant.Source)
+	switch grant.Source {
+	case types.GrantShareSourceTreasury:
+		treasury, found := k.GetCompanyTreasury(ctx, grant.CompanyID)
+		if !found {
+			return 0, types.ErrTreasuryNotFound
+		}
+		if treasury.GetTreasuryShares(grant.ClassID).LT(reserved.Add(grant.Shares)) {
+			return 0, types.ErrGrantPoolExhausted.Wrapf("treasury holds %s, %s already reserved",
+				treasury.GetTreasuryShares(grant.ClassID), reserved)
+		}
+	default:
+		unissued := shareClass.AuthorizedShares.Sub(shareClass.IssuedShares)
+		if unissued.LT(reserved.Add(grant.Shares)) {
+			return 0, types.ErrGrantPoolExhausted.Wrapf("%s unissued authorized shares, %s already reserved", unissued, reserved)
+		}
+	}
+
+	grant.ID = k.GetNextEquityGrantID(ctx)
+	if err := k.SetEquityGrant(ctx, grant); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEquityGrantCreated,
+			sdk.NewAttribute(types.AttributeKeyGrantID, fmt.Sprintf("%d", grant.ID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", grant.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareClass, grant.ClassID),
+			sdk.NewAttribute(types.AttributeKeyGrantHolder, grant.Holder),
+			sdk.NewAttribute(types.AttributeKeyGrantType, grant.Type.String()),
+			sdk.NewAttribute("shares", grant.Shares.String()),
+			sdk.NewAttribute(types.AttributeKeyStrikePrice, grant.StrikePrice.String()),
+			sdk.NewAttribute("strike_denom", grant.StrikeDenom),
+			sdk.NewAttribute("source", grant.Source.String()),
+			sdk.NewAttribute("expires_at", grant.ExpiresAt.String()),
+		),
+	)
+
+	return grant.ID, nil
+}
+
+// ExerciseGrant exercises shares of a grant, paying the strike price from the holder's
+// balance into the company treasury
+func (k Keeper) ExerciseGrant(ctx sdk.Context, holder string, grantID uint64, shares math.Int) (math.Int, error) {
+	grant, err := k.exercisableGrant(ctx, holder, grantID, shares)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	if shares.GT(grant.ExercisableShares(ctx.BlockTime())) {
+		return math.ZeroInt(), types.ErrGrantNotExercisable.Wrapf("%s exercisable", grant.ExercisableShares(ctx.BlockTime()))
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	cost, err := k.exerciseGrant(cacheCtx, &grant, shares)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	write()
+
+	k.emitGrantExercised(ctx, grant, shares, cost, math.ZeroInt())
+	return cost, nil
+}
+
+// CashlessExerciseGrant exercises vested shares of a grant without up-front cash: enough
+// of the exercised shares to cover the strike payment are sold on the DEX at market in
+// the strike denom, and the holder keeps the rest. Any shortfall from slippage or fees
+// is paid from the holder's balance; if the sale does not fill in full nothing happens.
+// Returns the number of shares sold.
+func (k Keeper) CashlessExerciseGrant(ctx sdk.Context, holder string, grantID uint64, shares math.Int) (math.Int, error) {
+	if k.dexKeeper == nil {
+		return math.ZeroInt(), types.ErrDexUnavailable
+	}
+
+	grant, err := k.exercisableGrant(ctx, holder, grantID, shares)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	vestedUnexercised := grant.VestedShares(ctx.BlockTime()).Sub(grant.ExercisedShares)
+	if shares.GT(math.MinInt(grant.ExercisableShares(ctx.BlockTime()), vestedUnexercised)) {
+		return math.ZeroInt(), types.ErrGrantNotExercisable.Wrap("cashless exercise is limited to vested shares")
+	}
+
+	company, found := k.getCompany(ctx, grant.CompanyID)
+	if !found {
+		return math.ZeroInt(), types.ErrCompanyNotFound
+	}
+	price := k.dexKeeper.GetLastTradePrice(ctx, company.Symbol, grant.StrikeDenom)
+	toSell, ok := grant.SharesToCover(shares, price)
+	if !ok {
+		return math.ZeroInt(), types.ErrGrantOutOfTheMoney.Wrapf("strike %s, market %s", grant.StrikePrice, price)
+	}
+
+	holderAddr, err := sdk.AccAddressFromBech32(holder)
+	if err != nil {
+		return math.ZeroInt(), types.ErrInvalidAddress
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	// Deliver the shares to be sold as symbol coins and sell them at market
+	coins := sdk.NewCoins(sdk.NewCoin(company.Symbol, toSell))
+	if err := k.bankKeeper.MintCoins(cacheCtx, types.ModuleName, coins); err != nil {
+		return math.ZeroInt(), err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, holderAddr, coins); err != nil {
+		return math.ZeroInt(), err
+	}
+	marketSymbol := company.Symbol + "/" + grant.StrikeDenom
+	filled, proceeds, err := k.dexKeeper.PlaceMarketSellOrder(cacheCtx, holder, marketSymbol, toSell)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	if filled.LT(toSell) {
+		return math.ZeroInt(), types.ErrCashlessSaleIncomplete.Wrapf("filled %s of %s", filled, toSell)
+	}
+
+	cost, err := k.exerciseGrant(cacheCtx, &grant, shares)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	// The sold shares now trade as coins held by the buyers
+	holding, found := k.getShareholding(cacheCtx, grant.CompanyID, grant.ClassID, holder)
+	if !found {
+		return math.ZeroInt(), types.ErrShareholdingNotFound
+	}
+	holding.Shares = holding.Shares.Sub(toSell)
+	holding.VestedShares = holding.VestedShares.Sub(toSell)
+	holding.TotalCost = holding.TotalCost.Sub(holding.CostBasis.MulInt(toSell))
+	holding.UpdatedAt = ctx.BlockTime()
+	if holding.Shares.IsZero() {
+		k.DeleteShareholding(cacheCtx, grant.CompanyID, grant.ClassID, holder)
+	} else if err := k.SetShareholding(cacheCtx, holding); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	write()
+
+	k.Logger(ctx).Info("cashless grant exercise",
+		"grant_id", grant.ID,
+		"holder", holder,
+		"shares", shares.String(),
+		"shares_sold", toSell.String(),
+		"proceeds", proceeds.String(),
+		"cost", cost.String(),
+	)
+	k.emitGrantExercised(ctx, grant, shares, cost, toSell)
+	return toSell, nil
+}
+
+// exercisableGrant loads a grant for exercise by its holder
+func (k Keeper) exercisableGrant(ctx sdk.Context, holder string, grantID uint64, shares math.Int) (types.EquityGrant, error) {
+	if shares.IsNil() || !shares.IsPositive() {
+		return types.EquityGrant{}, types.ErrInsufficientShares
+	}
+	grant, found := k.GetEquityGrant(ctx, grantID)
+	if !found {
+		return types.EquityGrant{}, types.ErrEquityGrantNotFound
+	}
+	if grant.Holder != holder {
+		return types.EquityGrant{}, types.ErrUnauthorized
+	}
+	company, found := k.getCompany(ctx, grant.CompanyID)
+	if !found {
+		return types.EquityGrant{}, types.ErrCompanyNotFound
+	}
+	if company.Status != types.CompanyStatusActive {
+		return types.EquityGrant{}, types.ErrCompanyNotActive
+	}
+	if !grant.IsOpen() || ctx.BlockTime().After(grant.ExerciseDeadline()) {
+		return types.EquityGrant{}, types.ErrGrantNotExercisable.Wrapf("grant is %s", grant.Status)
+	}
+	return grant, nil
+}
+
+// exerciseGrant delivers exercised shares from the grant's source and collects the
+// strike payment. Option exercises are exempt issuances: they are not recorded as
+// priced rounds, so a strike below the last issue price never triggers anti-dilution.
+// Early-exercised shares that have not vested are held back from VestedShares.
+func (k Keeper) exerciseGrant(ctx sdk.Context, grant *types.EquityGrant, shares math.Int) (math.Int, error) {
+	cost := grant.ExerciseCost(shares)
+
+	switch grant.Source {
+	case types.GrantShareSourceTreasury:
+		if err := k.TransferSharesFromTreasury(ctx, grant.CompanyID, grant.ClassID, grant.Holder, shares, grant.StrikePrice, grant.StrikeDenom); err != nil {
+			return math.ZeroInt(), err
+		}
+	default:
+		treasury, found := k.GetCompanyTreasury(ctx, grant.CompanyID)
+		if !found {
+			return math.ZeroInt(), types.ErrTreasuryNotFound
+		}
+		if err := k.IssueShares(ctx, grant.CompanyID, grant.ClassID, grant.Holder, shares, grant.StrikePrice, grant.StrikeDenom); err != nil {
+			return math.ZeroInt(), err
+		}
+		if cost.IsPositive() {
+			holderAddr, err := sdk.AccAddressFromBech32(grant.Holder)
+			if err != nil {
+				return math.ZeroInt(), types.ErrInvalidAddress
+			}
+			payment := sdk.NewCoins(sdk.NewCoin(grant.StrikeDenom, cost))
+			if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holderAddr, types.ModuleName, payment); err != nil {
+				return math.ZeroInt(), types.ErrInsufficientFunds
+			}
+			treasury.Balance = treasury.Balance.Add(payment...)
+			treasury.TotalDeposited = treasury.TotalDeposited.Add(payment...)
+			treasury.UpdatedAt = ctx.BlockTime()
+			if err := k.SetCompanyTreasury(ctx, treasury); err != nil {
+				return math.ZeroInt(), err
+			}
+		}
+	}
+
+	now := ctx.BlockTime()
+	unvestedBefore := grant.UnvestedExercised(now)
+	grant.ExercisedShares = grant.ExercisedShares.Add(shares)
+	grant.UnvestedHeld = grant.UnvestedExercised(now)
+
+	if heldBack := grant.UnvestedHeld.Sub(unvestedBefore); heldBack.IsPositive() {
+		holding, found := k.getShareholding(ctx, grant.CompanyID, grant.ClassID, grant.Holder)
+		if !found {
+			return math.ZeroInt(), types.ErrShareholdingNotFound
+		}
+		holding.VestedShares = holding.VestedShares.Sub(heldBack)
+		holding.UpdatedAt = now
+		if err := k.SetShareholding(ctx, holding); err != nil {
+			return math.ZeroInt(), err
+		}
+	}
+
+	if grant.OutstandingShares().IsZero() && grant.UnvestedHeld.IsZero() {
+		grant.Status = types.GrantStatusExercised
+	}
+	grant.UpdatedAt = now
+	if err := k.SetEquityGrant(ctx, *grant); err != nil {
+		return math.ZeroInt(), err
+	}
+	return cost, nil
+}
+
+func (k Keeper) emitGrantExercised(ctx sdk.Context, grant types.EquityGrant, shares, cost, sold math.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEquityGrantExercised,
+			sdk.NewAttribute(types.AttributeKeyGrantID, fmt.Sprintf("%d", grant.ID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", grant.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareClass, grant.ClassID),
+			sdk.NewAttribute(types.AttributeKeyGrantHolder, grant.Holder),
+			sdk.NewAttribute(types.AttributeKeyExercisedShares, shares.String()),
+			sdk.NewAttribute(types.AttributeKeyExerciseCost, cost.String()),
+			sdk.NewAttribute(types.AttributeKeyCashless, fmt.Sprintf("%t", sold.IsPositive())),
+			sdk.NewAttribute(types.AttributeKeySharesSold, sold.String()),
+			sdk.NewAttribute("unvested_held", grant.UnvestedHeld.String()),
+		),
+	)
+}
+
+// TerminateGrant records the end of an option holder's service. Vesting stops,
+// unvested unexercised shares are forfeited, vested shares stay exercisable for the
+// post-termination window, and early-exercised shares that had not vested are
+// repurchased into treasury at the strike price.
+func (k Keeper) TerminateGrant(ctx sdk.Context, grantID uint64, authority string) error {
+	grant, found := k.GetEquityGrant(ctx, grantID)
+	if !found {
+		return types.ErrEquityGrantNotFound
+	}
+	if !k.CanProposeForCompany(ctx, grant.CompanyID, authority) {
+		return types.ErrUnauthorized
+	}
+	if grant.Type != types.GrantTypeOption {
+		return types.ErrInvalidEquityGrant.Wrap("only employee options can be terminated")
+	}
+	if grant.Status != types.GrantStatusActive {
+		return types.ErrInvalidEquityGrant.Wrapf("grant is %s", grant.Status)
+	}
+
+	now := ctx.BlockTime()
+	vested := grant.VestedShares(now)
+	repurchase := grant.UnvestedExercised(now)
+	forfeited := grant.Shares.Sub(math.MaxInt(grant.ExercisedShares, vested))
+
+	cacheCtx, write := ctx.CacheContext()
+
+	if repurchase.IsPositive() {
+		if err := k.repurchaseUnvestedShares(cacheCtx, grant, repurchase); err != nil {
+			return err
+		}
+	}
+
+	grant.TerminatedAt = now
+	grant.ForfeitedShares = grant.ForfeitedShares.Add(forfeited)
+	grant.RepurchasedShares = grant.RepurchasedShares.Add(repurchase)
+	grant.UnvestedHeld = math.ZeroInt()
+	grant.Status = types.GrantStatusTerminated
+	if grant.OutstandingShares().IsZero() {
+		grant.Status = types.GrantStatusExercised
+	}
+	grant.UpdatedAt = now
+	if err := k.SetEquityGrant(cacheCtx, grant); err != nil {
+		return err
+	}
+
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEquityGrantTerminated,
+			sdk.NewAttribute(types.AttributeKeyGrantID, fmt.Sprintf("%d", grant.ID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", grant.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyGrantHolder, grant.Holder),
+			sdk.NewAttribute(types.AttributeKeyForfeitedShares, forfeited.String()),
+			sdk.NewAttribute("repurchased_shares", repurchase.String()),
+			sdk.NewAttribute(types.AttributeKeyExerciseDeadline, grant.ExerciseDeadline().String()),
+			sdk.NewAttribute("terminated_by", authority),
+		),
+	)
+
+	return nil
+}
+
+// repurchaseUnvestedShares buys back unvested early-exercised shares at the strike
+// price, refunding the holder from the treasury and returning the shares to it
+func (k Keeper) repurchaseUnvestedShares(ctx sdk.Context, grant types.EquityGrant, shares math.Int) error {
+	holding, found := k.getShareholding(ctx, grant.CompanyID, grant.ClassID, grant.Holder)
+	if !found || holding.Shares.Sub(holding.VestedShares).LT(shares) {
+		return types.ErrInsufficientShares.Wrap("unvested early-exercised shares are no longer held")
+	}
+
+	refund := grant.ExerciseCost(shares)
+	if refund.IsPositive() {
+		treasury, found := k.GetCompanyTreasury(ctx, grant.CompanyID)
+		if !found {
+			return types.ErrTreasuryNotFound
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(grant.StrikeDenom, refund))
+		if !treasury.CanWithdraw(coins) {
+			return types.ErrInsufficientFunds.Wrap("treasury cannot fund the repurchase")
+		}
+		holderAddr, err := sdk.AccAddressFromBech32(grant.Holder)
+		if err != nil {
+			return types.ErrInvalidAddress
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holderAddr, coins); err != nil {
+			return err
+		}
+		treasury.Balance = treasury.Balance.Sub(coins...)
+		treasury.TotalWithdrawn = treasury.TotalWithdrawn.Add(coins...)
+		treasury.UpdatedAt = ctx.BlockTime()
+		if err := k.SetCompanyTreasury(ctx, treasury); err != nil {
+			return err
+		}
+	}
+
+	holding.Shares = holding.Shares.Sub(shares)
+	holding.TotalCost = holding.TotalCost.Sub(holding.CostBasis.MulInt(shares))
+	holding.UpdatedAt = ctx.BlockTime()
+	if holding.Shares.IsZero() {
+		k.DeleteShareholding(ctx, grant.CompanyID, grant.ClassID, grant.Holder)
+	} else if err := k.SetShareholding(ctx, holding); err != nil {
+		return err
+	}
+
+	shareClass, found := k.getShareClass(ctx, grant.CompanyID, grant.ClassID)
+	if !found {
+		return types.ErrShareClassNotFound
+	}
+	shareClass.IssuedShares = shareClass.IssuedShares.Sub(shares)
+	shareClass.OutstandingShares = shareClass.OutstandingShares.Sub(shares)
+	shareClass.UpdatedAt = ctx.BlockTime()
+	if err := k.SetShareClass(ctx, shareClass); err != nil {
+		return err
+	}
+
+	return k.AddSharesToTreasury(ctx, grant.CompanyID, grant.ClassID, shares)
+}
+
+// ProcessEquityGrants releases early-exercised shares as they vest and expires grants
+// whose exercise deadline has passed, forfeiting their unexercised shares
+func (k Keeper) ProcessEquityGrants(ctx sdk.Context) {
+	now := ctx.BlockTime()
+
+	for _, grant := range k.GetAllEquityGrants(ctx) {
+		changed := false
+
+		if grant.UnvestedHeld.IsPositive() {
+			held := grant.UnvestedExercised(now)
+			if released := grant.UnvestedHeld.Sub(held); released.IsPositive() {
+				if holding, found := k.getShareholding(ctx, grant.CompanyID, grant.ClassID, grant.Holder); found {
+					holding.VestedShares = math.MinInt(holding.VestedShares.Add(released), holding.Shares)
+					holding.UpdatedAt = now
+					if err := k.SetShareholding(ctx, holding); err != nil {
+						k.Logger(ctx).Error("failed to release vested early-exercised shares", "grant_id", grant.ID, "error", err)
+						continue
+					}
+				}
+				grant.UnvestedHeld = held
+				changed = true
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeEarlyExerciseVested,
+						sdk.NewAttribute(types.AttributeKeyGrantID, fmt.Sprintf("%d", grant.ID)),
+						sdk.NewAttribute(types.AttributeKeyGrantHolder, grant.Holder),
+						sdk.NewAttribute("vested_shares", released.String()),
+						sdk.NewAttribute("unvested_held", held.String()),
+					),
+				)
+			}
+		}
+
+		if grant.IsOpen() && now.After(grant.ExerciseDeadline()) {
+			forfeited := grant.OutstandingShares()
+			grant.ForfeitedShares = grant.ForfeitedShares.Add(forfeited)
+			grant.Status = types.GrantStatusExpired
+			changed = true
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEquityGrantExpired,
+					sdk.NewAttribute(types.AttributeKeyGrantID, fmt.Sprintf("%d", grant.ID)),
+					sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", grant.CompanyID)),
+					sdk.NewAttribute(types.AttributeKeyGrantHolder, grant.Holder),
+					sdk.NewAttribute(types.AttributeKeyForfeitedShares, forfeited.String()),
+				),
+			)
+		} else if grant.Status == types.GrantStatusActive && grant.OutstandingShares().IsZero() && grant.UnvestedHeld.IsZero() {
+			grant.Status = types.GrantStatusExercised
+			changed = true
+		}
+
+		if changed {
+			grant.UpdatedAt = now
+			if err := k.SetEquityGrant(ctx, grant); err != nil {
+				k.Logger(ctx).Error("failed to update equity grant", "grant_id", grant.ID, "error", err)
+			}
+		}
+	}
+}
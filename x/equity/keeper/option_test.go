@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// TestEquityGrantVesting tests option vesting, early exercise, termination windows and cashless sizing
+func TestEquityGrantVesting(t *testing.T) {
+	founder := sdk.AccAddress([]byte("option_founder______")).String()
+	employee := sdk.AccAddress([]byte("option_employee_____")).String()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	year := 365 * 24 * time.Hour
+
+	// 4 year vesting, 25% at a 1 year cliff, then quarterly
+	schedule := &types.VestingSchedule{
+		TotalShares:   math.NewInt(4800),
+		StartDate:     start,
+		CliffDate:     start.Add(year),
+		EndDate:       start.Add(4 * year),
+		CliffPercent:  math.LegacyMustNewDecFromStr("0.25"),
+		VestingPeriod: year / 4,
+	}
+	require.True(t, schedule.VestedAmount(start.Add(year-time.Hour)).IsZero())
+	require.Equal(t, math.NewInt(1200), schedule.VestedAmount(start.Add(year)))
+	require.Equal(t, math.NewInt(1200), schedule.VestedAmount(start.Add(year+year/8)))
+	require.Equal(t, math.NewInt(1500), schedule.VestedAmount(start.Add(year+year/4)))
+	require.Equal(t, math.NewInt(2400), schedule.VestedAmount(start.Add(2*year)))
+	require.Equal(t, math.NewInt(4800), schedule.VestedAmount(start.Add(5*year)))
+
+	grant := types.EquityGrant{
+		CompanyID:             1,
+		ClassID:               "COMMON",
+		Holder:                employee,
+		Type:                  types.GrantTypeOption,
+		Shares:                math.NewInt(4800),
+		ExercisedShares:       math.ZeroInt(),
+		ForfeitedShares:       math.ZeroInt(),
+		RepurchasedShares:     math.ZeroInt(),
+		UnvestedHeld:          math.ZeroInt(),
+		StrikePrice:           math.LegacyNewDec(2),
+		StrikeDenom:           "uhodl",
+		Vesting:               schedule,
+		GrantedAt:             start,
+		ExpiresAt:             start.Add(10 * year),
+		PostTerminationWindow: 90 * 24 * time.Hour,
+		GrantedBy:             founder,
+	}
+	require.NoError(t, grant.Validate())
+
+	// Only vested shares are exercisable without early exercise
+	require.True(t, grant.ExercisableShares(start.Add(time.Hour)).IsZero())
+	require.Equal(t, math.NewInt(2400), grant.ExercisableShares(start.Add(2*year)))
+	require.Equal(t, math.NewInt(4800), grant.OutstandingShares())
+
+	// Early exercise: everything is exercisable, exercised shares beyond vesting are held unvested
+	early := grant
+	early.EarlyExercise = true
+	require.Equal(t, math.NewInt(4800), early.ExercisableShares(start.Add(time.Hour)))
+	early.ExercisedShares = math.NewInt(4800)
+	require.Equal(t, math.NewInt(4800), early.UnvestedExercised(start.Add(time.Hour)))
+	require.Equal(t, math.NewInt(2400), early.UnvestedExercised(start.Add(2*year)))
+	require.True(t, early.OutstandingShares().IsZero())
+
+	// Termination stops vesting and opens the post-termination window
+	terminated := grant
+	terminated.TerminatedAt = start.Add(2 * year)
+	terminated.Status = types.GrantStatusTerminated
+	terminated.ForfeitedShares = math.NewInt(2400)
+	require.Equal(t, math.NewInt(2400), terminated.VestedShares(start.Add(3*year)))
+	require.Equal(t, start.Add(2*year+90*24*time.Hour), terminated.ExerciseDeadline())
+	require.Equal(t, math.NewInt(2400), terminated.ExercisableShares(start.Add(2*year+30*24*time.Hour)))
+	require.True(t, terminated.ExercisableShares(start.Add(2*year+91*24*time.Hour)).IsZero())
+
+	// The post-termination window never extends past expiry
+	terminated.TerminatedAt = start.Add(10*year - 24*time.Hour)
+	require.Equal(t, grant.ExpiresAt, terminated.ExerciseDeadline())
+
+	// Cashless exercise sells just enough shares to cover the strike
+	toSell, ok := grant.SharesToCover(math.NewInt(1000), math.LegacyNewDec(8))
+	require.True(t, ok)
+	require.Equal(t, math.NewInt(250), toSell) // 2000 / 8
+	toSell, ok = grant.SharesToCover(math.NewInt(1000), math.LegacyNewDec(3))
+	require.True(t, ok)
+	require.Equal(t, math.NewInt(667), toSell) // 2000 / 3 rounded up
+	_, ok = grant.SharesToCover(math.NewInt(1000), math.LegacyNewDec(2))
+	require.False(t, ok, "at the money")
+
+	// Warrants without a schedule are fully vested
+	warrant := grant
+	warrant.Type = types.GrantTypeWarrant
+	warrant.Vesting = nil
+	require.NoError(t, warrant.Validate())
+	require.Equal(t, math.NewInt(4800), warrant.ExercisableShares(start.Add(time.Hour)))
+
+	// Invalid grants
+	invalid := warrant
+	invalid.EarlyExercise = true
+	require.Error(t, invalid.Validate(), "early exercise without vesting")
+
+	invalid = grant
+	invalid.ExpiresAt = start
+	require.Error(t, invalid.Validate(), "expiry not after grant")
+
+	invalid = grant
+	mismatched := *schedule
+	mismatched.TotalShares = math.NewInt(100)
+	invalid.Vesting = &mismatched
+	require.Error(t, invalid.Validate(), "schedule does not cover the grant")
+}
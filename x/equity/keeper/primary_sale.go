@@ -326,6 +326,17 @@ func (k Keeper) ClosePrimarySaleOffer(ctx sdk.Context, offerID uint64, closer st
 		return fmt.Errorf("offer is not open")
 	}
 
+	// Rights offerings run for their full subscription period, then allocate
+	// unexercised shares to oversubscribers before closing
+	if offer.Rights != nil {
+		if closer != "" && ctx.BlockTime().Before(offer.EndTime) {
+			return types.ErrInvalidRightsOffering.Wrap("subscription period has not ended")
+		}
+		if !offer.Rights.Settled {
+			k.settleRightsOffering(ctx, &offer)
+		}
+	}
+
 	// Update status
 	offer.Status = types.OfferStatusClosed
 	if err := k.SetPrimarySaleOffer(ctx, offer); err != nil {
@@ -335,6 +346,9 @@ func (k Keeper) ClosePrimarySaleOffer(ctx sdk.Context, offerID uint64, closer st
 	// Remove from active offers index
 	k.RemoveActiveOfferIndex(ctx, offerID, company.Symbol)
 
+	// A qualified sale may trigger mandatory conversion of other classes
+	k.processQualifiedSaleConversions(ctx, offer)
+
 	// Calculate total revenue
 	totalRevenue := offer.PricePerShare.MulInt(offer.SharesSold)
 
@@ -523,6 +537,11 @@ func (k Keeper) ValidatePurchase(
 	quantity math.Int,
 	currentTime time.Time,
 ) error {
+	// Rights offering shares are bought by exercising subscription rights
+	if offer.Rights != nil {
+		return types.ErrRightsOfferingRequired
+	}
+
 	// Check offer is active
 	if !offer.IsActive(currentTime) {
 		if offer.Status != types.OfferStatusOpen {
@@ -347,6 +347,52 @@ func (q queryServer) CompanyCapTable(goCtx context.Context, req *types.QueryComp
 	}, nil
 }
 
+// CapTable returns issued, outstanding, treasury and fully-diluted totals with holder
+// and beneficial-owner breakdowns at a height, optionally exported for auditors
+func (q queryServer) CapTable(goCtx context.Context, req *types.QueryCapTableRequest) (*types.QueryCapTableResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	table, err := q.BuildCapTable(ctx, req.CompanyID, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.QueryCapTableResponse{CapTable: table}
+	if req.Format != "" {
+		if resp.Export, err = ExportCapTable(table, req.Format); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// =============================================================================
+// Liquidation Queries
+// =============================================================================
+
+// LiquidationWaterfall previews liquidation payouts per class and holder
+func (q queryServer) LiquidationWaterfall(goCtx context.Context, req *types.QueryLiquidationWaterfallRequest) (*types.QueryLiquidationWaterfallResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proceeds := req.Proceeds
+	if proceeds.IsNil() || proceeds.IsZero() {
+		proceeds = math.ZeroInt()
+		if treasury, found := q.GetCompanyTreasury(ctx, req.CompanyID); found {
+			proceeds = treasury.GetAvailableBalance().AmountOf(types.LiquidationDenom)
+		}
+	}
+
+	waterfall, holders, err := q.PreviewLiquidationWaterfall(ctx, req.CompanyID, proceeds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLiquidationWaterfallResponse{
+		Waterfall: waterfall,
+		Holders:   holders,
+	}, nil
+}
+
 // =============================================================================
 // Shareholder Alert Queries
 // =============================================================================
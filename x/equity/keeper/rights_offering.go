@@ -0,0 +1,419 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// =============================================================================
+// RIGHTS OFFERINGS
+// Primary sales reserved for existing holders through transferable subscription
+// rights, with unexercised shares allocated in an oversubscription round
+// =============================================================================
+
+// SetRightsOversubscription stores a subscriber's oversubscription request
+func (k Keeper) SetRightsOversubscription(ctx sdk.Context, request types.RightsOversubscription) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal oversubscription request: %w", err)
+	}
+	store.Set(types.GetRightsOversubscriptionKey(request.OfferID, request.Subscriber), bz)
+	return nil
+}
+
+// GetRightsOversubscription returns a subscriber's oversubscription request
+func (k Keeper) GetRightsOversubscription(ctx sdk.Context, offerID uint64, subscriber string) (types.RightsOversubscription, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRightsOversubscriptionKey(offerID, subscriber))
+	if bz == nil {
+		return types.RightsOversubscription{}, false
+	}
+
+	var request types.RightsOversubscription
+	if err := json.Unmarshal(bz, &request); err != nil {
+		return types.RightsOversubscription{}, false
+	}
+	return request, true
+}
+
+// GetRightsOversubscriptions returns every oversubscription request of a rights offering
+func (k Keeper) GetRightsOversubscriptions(ctx sdk.Context, offerID uint64) []types.RightsOversubscription {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRightsOversubscriptionsByOfferPrefix(offerID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var requests []types.RightsOversubscription
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.RightsOversubscription
+		if err := json.Unmarshal(iterator.Value(), &request); err != nil {
+			continue
+		}
+		requests = append(requests, request)
+	}
+	return requests
+}
+
+// CreateRightsOffering opens a rights offering for a share class. Holders are
+// snapshotted with the dividend record machinery (beneficial owners included,
+// treasury excluded) and receive one subscription right coin per share held, which
+// they can exercise, transfer or trade on the DEX until the offer ends.
+func (k Keeper) CreateRightsOffering(
+	ctx sdk.Context,
+	companyID uint64,
+	classID string,
+	pricePerShare math.LegacyDec,
+	quoteDenom string,
+	rightsPerShare math.Int,
+	endTime time.Time,
+	creator string,
+) (uint64, error) {
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return 0, types.ErrUnauthorized
+	}
+	company, found := k.getCompany(ctx, companyID)
+	if !found {
+		return 0, types.ErrCompanyNotFound
+	}
+	if !k.CanProposeForCompany(ctx, companyID, creator) {
+		return 0, types.ErrNotAuthorizedProposer
+	}
+	if company.Status != types.CompanyStatusActive {
+		return 0, types.ErrCompanyNotActive
+	}
+	shareClass, found := k.getShareClass(ctx, companyID, classID)
+	if !found {
+		return 0, types.ErrShareClassNotFound
+	}
+	for _, offer := range k.GetOffersByCompany(ctx, companyID) {
+		if offer.ClassID == classID && offer.Status == types.OfferStatusOpen {
+			return 0, types.ErrPrimarySaleAlreadyExists
+		}
+	}
+	if rightsPerShare.IsNil() || !rightsPerShare.IsPositive() {
+		return 0, types.ErrInvalidRightsOffering.Wrap("rights per share must be positive")
+	}
+	if !endTime.After(ctx.BlockTime()) {
+		return 0, types.ErrInvalidRightsOffering.Wrap("end time must be in the future")
+	}
+
+	// Snapshot holders on record
+	recipients := k.GetBeneficialOwnersForDividend(ctx, companyID, classID)
+	rightsIssued := math.ZeroInt()
+	var holders uint64
+	for _, recipient := range recipients {
+		if recipient.Shares.IsPositive() {
+			rightsIssued = rightsIssued.Add(recipient.Shares)
+			holders++
+		}
+	}
+	sharesOffered := rightsIssued.Quo(rightsPerShare)
+	if !sharesOffered.IsPositive() {
+		return 0, types.ErrInvalidRightsOffering.Wrap("holders on record cannot subscribe for any shares")
+	}
+
+	treasury, found := k.GetCompanyTreasury(ctx, companyID)
+	if !found {
+		return 0, types.ErrTreasuryNotFound
+	}
+	if treasury.GetTreasuryShares(classID).LT(sharesOffered) {
+		return 0, types.ErrInsufficientTreasuryShares
+	}
+	if shareClass.IssuedShares.Add(sharesOffered).GT(shareClass.AuthorizedShares) {
+		return 0, types.ErrExceedsAuthorized
+	}
+
+	offerID := k.GetNextOfferID(ctx)
+	offer := types.NewPrimarySaleOffer(
+		offerID,
+		companyID,
+		classID,
+		sharesOffered,
+		pricePerShare,
+		quoteDenom,
+		math.ZeroInt(),
+		math.ZeroInt(),
+		ctx.BlockTime(),
+		endTime,
+		creator,
+	)
+	offer.Status = types.OfferStatusOpen
+	offer.CreatedAt = ctx.BlockTime()
+	offer.Rights = &types.RightsTerms{
+		RightsDenom:     types.RightsDenom(company.Symbol, offerID),
+		RightsPerShare:  rightsPerShare,
+		RecordDate:      ctx.BlockTime(),
+		HoldersOnRecord: holders,
+		RightsIssued:    rightsIssued,
+		RightsExercised: math.ZeroInt(),
+		SharesRequested: math.ZeroInt(),
+		SharesAllocated: math.ZeroInt(),
+	}
+	if err := offer.Validate(); err != nil {
+		return 0, types.ErrInvalidRightsOffering.Wrap(err.Error())
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	// Mint the rights and distribute them pro-rata to holdings
+	minted := sdk.NewCoins(sdk.NewCoin(offer.Rights.RightsDenom, rightsIssued))
+	if err := k.bankKeeper.MintCoins(cacheCtx, types.ModuleName, minted); err != nil {
+		return 0, err
+	}
+	for _, recipient := range recipients {
+		if !recipient.Shares.IsPositive() {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return 0, types.ErrInvalidAddress
+		}
+		rights := sdk.NewCoins(sdk.NewCoin(offer.Rights.RightsDenom, recipient.Shares))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, addr, rights); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := k.SetPrimarySaleOffer(cacheCtx, offer); err != nil {
+		return 0, err
+	}
+	k.SetActiveOfferIndex(cacheCtx, offerID, company.Symbol)
+
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRightsOfferingCreated,
+			sdk.NewAttribute(types.AttributeKeyOfferID, fmt.Sprintf("%d", offerID)),
+			sdk.NewAttribute(types.AttributeKeyTreasuryCompanyID, fmt.Sprintf("%d", companyID)),
+			sdk.NewAttribute(types.AttributeKeyOfferClassID, classID),
+			sdk.NewAttribute(types.AttributeKeyRightsDenom, offer.Rights.RightsDenom),
+			sdk.NewAttribute(types.AttributeKeyRightsIssued, rightsIssued.String()),
+			sdk.NewAttribute("rights_per_share", rightsPerShare.String()),
+			sdk.NewAttribute(types.AttributeKeySharesOffered, sharesOffered.String()),
+			sdk.NewAttribute(types.AttributeKeyOfferPrice, pricePerShare.String()),
+			sdk.NewAttribute(types.AttributeKeyOfferEndTime, endTime.Format(time.RFC3339)),
+		),
+	)
+
+	k.Logger(ctx).Info("rights offering created",
+		"offer_id", offerID,
+		"company_id", companyID,
+		"class_id", classID,
+		"holders_on_record", holders,
+		"rights_issued", rightsIssued.String(),
+		"shares_offered", sharesOffered.String(),
+	)
+
+	return offerID, nil
+}
+
+// getOpenRightsOffering loads a rights offering that is still accepting subscriptions
+func (k Keeper) getOpenRightsOffering(ctx sdk.Context, offerID uint64) (types.PrimarySaleOffer, error) {
+	offer, found := k.GetPrimarySaleOffer(ctx, offerID)
+	if !found {
+		return types.PrimarySaleOffer{}, types.ErrPrimarySaleNotFound
+	}
+	if offer.Rights == nil {
+		return types.PrimarySaleOffer{}, types.ErrNotRightsOffering
+	}
+	if !offer.IsActive(ctx.BlockTime()) {
+		return types.PrimarySaleOffer{}, types.ErrRightsExpired
+	}
+	return offer, nil
+}
+
+// ExerciseRights burns the holder's subscription rights and sells them the shares
+// those rights subscribe for out of treasury at the offer price. Rights beyond a
+// whole multiple of RightsPerShare are left with the holder. Returns the shares bought.
+func (k Keeper) ExerciseRights(ctx sdk.Context, offerID uint64, holder string, rights math.Int) (math.Int, error) {
+	holderAddr, err := sdk.AccAddressFromBech32(holder)
+	if err != nil {
+		return math.ZeroInt(), types.ErrUnauthorized
+	}
+	offer, err := k.getOpenRightsOffering(ctx, offerID)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	shares := offer.Rights.SharesForRights(rights)
+	if !shares.IsPositive() {
+		return math.ZeroInt(), types.ErrInsufficientRights.Wrapf("%s rights are needed per share", offer.Rights.RightsPerShare)
+	}
+	if shares.GT(offer.RemainingShares()) {
+		return math.ZeroInt(), types.ErrInsufficientSharesAvailable
+	}
+	used := shares.Mul(offer.Rights.RightsPerShare)
+	if k.bankKeeper.GetBalance(ctx, holderAddr, offer.Rights.RightsDenom).Amount.LT(used) {
+		return math.ZeroInt(), types.ErrInsufficientRights
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	usedRights := sdk.NewCoins(sdk.NewCoin(offer.Rights.RightsDenom, used))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(cacheCtx, holderAddr, types.ModuleName, usedRights); err != nil {
+		return math.ZeroInt(), err
+	}
+	if err := k.bankKeeper.BurnCoins(cacheCtx, types.ModuleName, usedRights); err != nil {
+		return math.ZeroInt(), err
+	}
+	if err := k.TransferSharesFromTreasury(cacheCtx, offer.CompanyID, offer.ClassID, holder, shares, offer.PricePerShare, offer.QuoteDenom); err != nil {
+		return math.ZeroInt(), fmt.Errorf("failed to transfer shares from treasury: %w", err)
+	}
+
+	offer.SharesSold = offer.SharesSold.Add(shares)
+	offer.Rights.RightsExercised = offer.Rights.RightsExercised.Add(used)
+	if err := k.SetPrimarySaleOffer(cacheCtx, offer); err != nil {
+		return math.ZeroInt(), err
+	}
+	if err := k.addBuyerPurchaseAmount(cacheCtx, offerID, holder, shares); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRightsExercised,
+			sdk.NewAttribute(types.AttributeKeyOfferID, fmt.Sprintf("%d", offerID)),
+			sdk.NewAttribute(types.AttributeKeyTreasuryCompanyID, fmt.Sprintf("%d", offer.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyOfferBuyer, holder),
+			sdk.NewAttribute(types.AttributeKeyRightsExercised, used.String()),
+			sdk.NewAttribute(types.AttributeKeyOfferSharesPurchased, shares.String()),
+			sdk.NewAttribute(types.AttributeKeySharesRemaining, offer.RemainingShares().String()),
+		),
+	)
+
+	return shares, nil
+}
+
+// Oversubscribe requests shares beyond the subscriber's rights from whatever is left
+// unexercised when the offer ends. Only holders who have exercised rights in the
+// offering may oversubscribe; the cost at the offer price is escrowed until settlement.
+func (k Keeper) Oversubscribe(ctx sdk.Context, offerID uint64, subscriber string, shares math.Int) error {
+	subscriberAddr, err := sdk.AccAddressFromBech32(subscriber)
+	if err != nil {
+		return types.ErrUnauthorized
+	}
+	if shares.IsNil() || !shares.IsPositive() {
+		return types.ErrInsufficientShares
+	}
+	offer, err := k.getOpenRightsOffering(ctx, offerID)
+	if err != nil {
+		return err
+	}
+	if !k.GetBuyerPurchaseAmount(ctx, offerID, subscriber).IsPositive() {
+		return types.ErrOversubscriptionIneligible
+	}
+
+	cost := offer.PricePerShare.MulInt(shares).Ceil().TruncateInt()
+	escrow := sdk.NewCoins(sdk.NewCoin(offer.QuoteDenom, cost))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, subscriberAddr, types.ModuleName, escrow); err != nil {
+		return types.ErrInsufficientFunds
+	}
+
+	request, found := k.GetRightsOversubscription(ctx, offerID, subscriber)
+	if !found {
+		request = types.RightsOversubscription{
+			OfferID:    offerID,
+			Subscriber: subscriber,
+			Shares:     math.ZeroInt(),
+			Escrowed:   math.ZeroInt(),
+			Allocated:  math.ZeroInt(),
+		}
+	}
+	request.Shares = request.Shares.Add(shares)
+	request.Escrowed = request.Escrowed.Add(cost)
+	request.SubmittedAt = ctx.BlockTime()
+	if err := k.SetRightsOversubscription(ctx, request); err != nil {
+		return err
+	}
+
+	offer.Rights.SharesRequested = offer.Rights.SharesRequested.Add(shares)
+	if err := k.SetPrimarySaleOffer(ctx, offer); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRightsOversubscribed,
+			sdk.NewAttribute(types.AttributeKeyOfferID, fmt.Sprintf("%d", offerID)),
+			sdk.NewAttribute(types.AttributeKeyOfferBuyer, subscriber),
+			sdk.NewAttribute(types.AttributeKeySharesRequested, request.Shares.String()),
+			sdk.NewAttribute("escrowed", request.Escrowed.String()),
+		),
+	)
+
+	return nil
+}
+
+// settleRightsOffering runs the oversubscription round when a rights offering
+// closes: every escrow is refunded and the unexercised shares are sold to the
+// oversubscribers pro-rata to their requests. Subscriptions that cannot be
+// delivered are skipped and logged; their escrow has already been returned.
+func (k Keeper) settleRightsOffering(ctx sdk.Context, offer *types.PrimarySaleOffer) {
+	requests := k.GetRightsOversubscriptions(ctx, offer.ID)
+	allocations := types.AllocateOversubscription(offer.RemainingShares(), requests)
+
+	for _, allocation := range allocations {
+		subscriberAddr, err := sdk.AccAddressFromBech32(allocation.Subscriber)
+		if err != nil {
+			continue
+		}
+		if allocation.Escrowed.IsPositive() {
+			refund := sdk.NewCoins(sdk.NewCoin(offer.QuoteDenom, allocation.Escrowed))
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, subscriberAddr, refund); err != nil {
+				k.Logger(ctx).Error("failed to refund oversubscription escrow",
+					"offer_id", offer.ID,
+					"subscriber", allocation.Subscriber,
+					"error", err,
+				)
+				continue
+			}
+		}
+
+		delivered := math.ZeroInt()
+		if allocation.Allocated.IsPositive() {
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.TransferSharesFromTreasury(cacheCtx, offer.CompanyID, offer.ClassID, allocation.Subscriber, allocation.Allocated, offer.PricePerShare, offer.QuoteDenom); err != nil {
+				k.Logger(ctx).Error("failed to deliver oversubscription allocation",
+					"offer_id", offer.ID,
+					"subscriber", allocation.Subscriber,
+					"shares", allocation.Allocated.String(),
+					"error", err,
+				)
+			} else {
+				write()
+				delivered = allocation.Allocated
+			}
+		}
+
+		allocation.Allocated = delivered
+		allocation.Escrowed = math.ZeroInt()
+		if err := k.SetRightsOversubscription(ctx, allocation); err != nil {
+			k.Logger(ctx).Error("failed to record oversubscription allocation", "offer_id", offer.ID, "error", err)
+		}
+		offer.SharesSold = offer.SharesSold.Add(delivered)
+		offer.Rights.SharesAllocated = offer.Rights.SharesAllocated.Add(delivered)
+	}
+	offer.Rights.Settled = true
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRightsOfferingSettled,
+			sdk.NewAttribute(types.AttributeKeyOfferID, fmt.Sprintf("%d", offer.ID)),
+			sdk.NewAttribute(types.AttributeKeyTreasuryCompanyID, fmt.Sprintf("%d", offer.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyRightsExercised, offer.Rights.RightsExercised.String()),
+			sdk.NewAttribute(types.AttributeKeySharesRequested, offer.Rights.SharesRequested.String()),
+			sdk.NewAttribute(types.AttributeKeySharesAllocated, offer.Rights.SharesAllocated.String()),
+			sdk.NewAttribute(types.AttributeKeySharesSold, offer.SharesSold.String()),
+		),
+	)
+}
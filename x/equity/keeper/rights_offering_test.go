@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// TestRightsOffering tests rights terms, subscription ratios and the oversubscription round
+func TestRightsOffering(t *testing.T) {
+	founder := sdk.AccAddress([]byte("rights_founder______")).String()
+	alice := sdk.AccAddress([]byte("rights_alice________")).String()
+	bob := sdk.AccAddress([]byte("rights_bob__________")).String()
+	carol := sdk.AccAddress([]byte("rights_carol________")).String()
+	start := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	// One new share for every four held
+	terms := types.RightsTerms{
+		RightsDenom:     types.RightsDenom("ACME", 7),
+		RightsPerShare:  math.NewInt(4),
+		RecordDate:      start,
+		RightsIssued:    math.NewInt(10000),
+		RightsExercised: math.ZeroInt(),
+		SharesRequested: math.ZeroInt(),
+		SharesAllocated: math.ZeroInt(),
+	}
+	require.Equal(t, "ACME-R7", terms.RightsDenom)
+	require.NoError(t, sdk.ValidateDenom(terms.RightsDenom), "rights must be a valid bank denom")
+	require.NoError(t, terms.Validate())
+	require.Equal(t, math.NewInt(25), terms.SharesForRights(math.NewInt(100)))
+	require.Equal(t, math.NewInt(25), terms.SharesForRights(math.NewInt(103)), "partial rights stay with the holder")
+	require.True(t, terms.SharesForRights(math.NewInt(3)).IsZero())
+
+	offer := types.NewPrimarySaleOffer(7, 1, "COMMON", math.NewInt(2500), math.LegacyNewDec(2), "uhodl",
+		math.ZeroInt(), math.ZeroInt(), start, start.Add(14*24*time.Hour), founder)
+	offer.Rights = &terms
+	require.NoError(t, offer.Validate())
+
+	invalid := terms
+	invalid.RightsExercised = math.NewInt(10001)
+	offer.Rights = &invalid
+	require.Error(t, offer.Validate(), "more rights exercised than issued")
+
+	invalid = terms
+	invalid.RightsPerShare = math.ZeroInt()
+	require.Error(t, invalid.Validate())
+
+	// Undersubscribed round: every request is filled
+	requests := []types.RightsOversubscription{
+		{Subscriber: bob, Shares: math.NewInt(100)},
+		{Subscriber: alice, Shares: math.NewInt(50)},
+	}
+	allocations := types.AllocateOversubscription(math.NewInt(500), requests)
+	require.Len(t, allocations, 2)
+	require.Less(t, allocations[0].Subscriber, allocations[1].Subscriber, "allocations are in subscriber order")
+	for _, allocation := range allocations {
+		require.Equal(t, allocation.Shares, allocation.Allocated)
+	}
+
+	// Oversubscribed round: pro-rata to requests, leftovers one share at a time
+	requests = []types.RightsOversubscription{
+		{Subscriber: alice, Shares: math.NewInt(300)},
+		{Subscriber: bob, Shares: math.NewInt(300)},
+		{Subscriber: carol, Shares: math.NewInt(400)},
+	}
+	allocations = types.AllocateOversubscription(math.NewInt(100), requests)
+	total := math.ZeroInt()
+	byHolder := map[string]math.Int{}
+	for _, allocation := range allocations {
+		total = total.Add(allocation.Allocated)
+		byHolder[allocation.Subscriber] = allocation.Allocated
+	}
+	require.Equal(t, math.NewInt(100), total)
+	require.Equal(t, math.NewInt(30), byHolder[alice])
+	require.Equal(t, math.NewInt(30), byHolder[bob])
+	require.Equal(t, math.NewInt(40), byHolder[carol])
+
+	allocations = types.AllocateOversubscription(math.NewInt(10), requests[:2])
+	require.Equal(t, math.NewInt(5), allocations[0].Allocated)
+	require.Equal(t, math.NewInt(5), allocations[1].Allocated)
+
+	allocations = types.AllocateOversubscription(math.NewInt(7), requests)
+	total = math.ZeroInt()
+	for _, allocation := range allocations {
+		total = total.Add(allocation.Allocated)
+		require.True(t, allocation.Allocated.LTE(allocation.Shares))
+	}
+	require.Equal(t, math.NewInt(7), total)
+
+	// Nothing left unexercised: nothing allocated
+	allocations = types.AllocateOversubscription(math.ZeroInt(), requests)
+	for _, allocation := range allocations {
+		require.True(t, allocation.Allocated.IsZero())
+	}
+}
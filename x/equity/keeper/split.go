@@ -0,0 +1,618 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// =============================================================================
+// STOCK SPLITS
+// Forward and reverse splits of a share class, approved through company governance
+// =============================================================================
+
+// splitCustodyModules are the module accounts that hold equity on behalf of users.
+// Their symbol balances are rescaled in bulk; the users' positions are rescaled
+// through the beneficial owner registry and the ShareSplitHooks.
+var splitCustodyModules = []string{"dex", "escrow", "lending", types.ModuleName}
+
+// GetNextStockSplitID returns the next stock split ID and increments the counter
+func (k Keeper) GetNextStockSplitID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.StockSplitCounterKey)
+
+	var counter uint64 = 1
+	if bz != nil {
+		counter = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.StockSplitCounterKey, sdk.Uint64ToBigEndian(counter+1))
+	return counter
+}
+
+// SetStockSplit stores a stock split and indexes it by company
+func (k Keeper) SetStockSplit(ctx sdk.Context, split types.StockSplit) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(split)
+	if err != nil {
+		return fmt.Errorf("failed to marshal stock split: %w", err)
+	}
+	store.Set(types.GetStockSplitKey(split.ID), bz)
+	store.Set(types.GetStockSplitByCompanyKey(split.CompanyID, split.ID), sdk.Uint64ToBigEndian(split.ID))
+	return nil
+}
+
+// GetStockSplit returns a stock split by ID
+func (k Keeper) GetStockSplit(ctx sdk.Context, splitID uint64) (types.StockSplit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStockSplitKey(splitID))
+	if bz == nil {
+		return types.StockSplit{}, false
+	}
+
+	var split types.StockSplit
+	if err := json.Unmarshal(bz, &split); err != nil {
+		return types.StockSplit{}, false
+	}
+	return split, true
+}
+
+// GetStockSplitsByCompany returns all stock splits for a company
+func (k Keeper) GetStockSplitsByCompany(ctx sdk.Context, companyID uint64) []types.StockSplit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetStockSplitsByCompanyPrefix(companyID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var splits []types.StockSplit
+	for ; iterator.Valid(); iterator.Next() {
+		if split, found := k.GetStockSplit(ctx, sdk.BigEndianToUint64(iterator.Value())); found {
+			splits = append(splits, split)
+		}
+	}
+	return splits
+}
+
+// hasOpenStockSplit checks if a share class already has a split awaiting execution
+func (k Keeper) hasOpenStockSplit(ctx sdk.Context, companyID uint64, classID string) bool {
+	for _, split := range k.GetStockSplitsByCompany(ctx, companyID) {
+		if split.ClassID != classID {
+			continue
+		}
+		if split.Status == types.StockSplitStatusPending || split.Status == types.StockSplitStatusApproved {
+			return true
+		}
+	}
+	return false
+}
+
+// ProposeStockSplit creates a split request for a share class (requires governance proposal).
+// numerator:denominator is new shares per old share; referencePrice is the uhodl paid
+// per pre-split share for fractional remainders.
+func (k Keeper) ProposeStockSplit(
+	ctx sdk.Context,
+	companyID uint64,
+	classID string,
+	numerator, denominator uint64,
+	referencePrice math.LegacyDec,
+	reason string,
+	proposedBy string,
+) (uint64, error) {
+	if !k.CanProposeForCompany(ctx, companyID, proposedBy) {
+		return 0, types.ErrUnauthorized
+	}
+
+	company, found := k.getCompany(ctx, companyID)
+	if !found {
+		return 0, types.ErrCompanyNotFound
+	}
+	if _, found := k.getShareClass(ctx, companyID, classID); !found {
+		return 0, types.ErrShareClassNotFound
+	}
+
+	if k.hasOpenStockSplit(ctx, companyID, classID) {
+		return 0, types.ErrStockSplitPending
+	}
+
+	splitID := k.GetNextStockSplitID(ctx)
+	split := types.NewStockSplit(splitID, companyID, classID, numerator, denominator, referencePrice, reason, proposedBy, ctx.BlockTime())
+	if err := split.Validate(); err != nil {
+		return 0, types.ErrInvalidStockSplit.Wrap(err.Error())
+	}
+
+	if err := k.SetStockSplit(ctx, split); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStockSplitProposed,
+			sdk.NewAttribute(types.AttributeKeySplitID, fmt.Sprintf("%d", splitID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", companyID)),
+			sdk.NewAttribute(types.AttributeKeyCompanySymbol, company.Symbol),
+			sdk.NewAttribute(types.AttributeKeyShareClass, classID),
+			sdk.NewAttribute(types.AttributeKeySplitRatio, split.Ratio()),
+			sdk.NewAttribute("reference_price", referencePrice.String()),
+			sdk.NewAttribute("proposed_by", proposedBy),
+		),
+	)
+
+	k.Logger(ctx).Info("stock split proposed",
+		"split_id", splitID,
+		"company_id", companyID,
+		"class_id", classID,
+		"ratio", split.Ratio(),
+	)
+
+	return splitID, nil
+}
+
+// ApproveStockSplit marks a split as approved (called by governance)
+func (k Keeper) ApproveStockSplit(ctx sdk.Context, splitID uint64, proposalID uint64) error {
+	split, found := k.GetStockSplit(ctx, splitID)
+	if !found {
+		return types.ErrStockSplitNotFound
+	}
+
+	if split.Status != types.StockSplitStatusPending {
+		return types.ErrStockSplitNotPending
+	}
+
+	split.Status = types.StockSplitStatusApproved
+	split.ProposalID = proposalID
+	split.ApprovedAt = ctx.BlockTime()
+	if err := k.SetStockSplit(ctx, split); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStockSplitApproved,
+			sdk.NewAttribute(types.AttributeKeySplitID, fmt.Sprintf("%d", splitID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", split.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareClass, split.ClassID),
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	k.Logger(ctx).Info("stock split approved",
+		"split_id", splitID,
+		"company_id", split.CompanyID,
+		"proposal_id", proposalID,
+	)
+
+	return nil
+}
+
+// CancelStockSplit cancels a split that has not been executed
+func (k Keeper) CancelStockSplit(ctx sdk.Context, splitID uint64, reason string) error {
+	split, found := k.GetStockSplit(ctx, splitID)
+	if !found {
+		return types.ErrStockSplitNotFound
+	}
+
+	if split.Status != types.StockSplitStatusPending && split.Status != types.StockSplitStatusApproved {
+		return types.ErrStockSplitNotPending
+	}
+
+	split.Status = types.StockSplitStatusCancelled
+	if err := k.SetStockSplit(ctx, split); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStockSplitCancelled,
+			sdk.NewAttribute(types.AttributeKeySplitID, fmt.Sprintf("%d", splitID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", split.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyShareClass, split.ClassID),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+
+	return nil
+}
+
+// ExecuteStockSplit rescales every record of the share class by the approved ratio.
+// The whole split runs against a cached context and is discarded if any step fails,
+// including an insufficient treasury balance for the fractional cash-out.
+func (k Keeper) ExecuteStockSplit(ctx sdk.Context, splitID uint64) error {
+	split, found := k.GetStockSplit(ctx, splitID)
+	if !found {
+		return types.ErrStockSplitNotFound
+	}
+
+	if split.Status != types.StockSplitStatusApproved {
+		return types.ErrStockSplitNotApproved
+	}
+
+	company, found := k.getCompany(ctx, split.CompanyID)
+	if !found {
+		return types.ErrCompanyNotFound
+	}
+
+	if k.IsTreasuryFrozen(ctx, split.CompanyID) {
+		return types.ErrTreasuryFrozenForInvestigation
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.applyStockSplit(cacheCtx, &split, company); err != nil {
+		return err
+	}
+	write()
+
+	split.Status = types.StockSplitStatusExecuted
+	split.ExecutedAt = ctx.BlockTime()
+	if err := k.SetStockSplit(ctx, split); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStockSplitExecuted,
+			sdk.NewAttribute(types.AttributeKeySplitID, fmt.Sprintf("%d", splitID)),
+			sdk.NewAttribute(types.AttributeKeyCompanyID, fmt.Sprintf("%d", split.CompanyID)),
+			sdk.NewAttribute(types.AttributeKeyCompanySymbol, company.Symbol),
+			sdk.NewAttribute(types.AttributeKeyShareClass, split.ClassID),
+			sdk.NewAttribute(types.AttributeKeySplitRatio, split.Ratio()),
+			sdk.NewAttribute(types.AttributeKeySharesBefore, split.SharesBefore.String()),
+			sdk.NewAttribute(types.AttributeKeySharesAfter, split.SharesAfter.String()),
+			sdk.NewAttribute(types.AttributeKeyCashOut, split.FractionalCashOut.String()),
+		),
+	)
+
+	k.Logger(ctx).Info("stock split executed",
+		"split_id", splitID,
+		"company_id", split.CompanyID,
+		"class_id", split.ClassID,
+		"ratio", split.Ratio(),
+		"holders_adjusted", split.HoldersAdjusted,
+		"fractional_cash_out", split.FractionalCashOut.String(),
+	)
+
+	return nil
+}
+
+// splitCashOut accumulates cash-in-lieu payments in first-seen order
+type splitCashOut struct {
+	recipients []string
+	amounts    map[string]math.Int
+}
+
+func newSplitCashOut() *splitCashOut {
+	return &splitCashOut{amounts: make(map[string]math.Int)}
+}
+
+func (c *splitCashOut) add(recipient string, amount math.Int) {
+	if !amount.IsPositive() {
+		return
+	}
+	if current, ok := c.amounts[recipient]; ok {
+		c.amounts[recipient] = current.Add(amount)
+		return
+	}
+	c.recipients = append(c.recipients, recipient)
+	c.amounts[recipient] = amount
+}
+
+func (c *splitCashOut) total() math.Int {
+	total := math.ZeroInt()
+	for _, recipient := range c.recipients {
+		total = total.Add(c.amounts[recipient])
+	}
+	return total
+}
+
+// applyStockSplit performs the rescaling; callers must run it on a cached context
+func (k Keeper) applyStockSplit(ctx sdk.Context, split *types.StockSplit, company types.Company) error {
+	shareClass, found := k.getShareClass(ctx, split.CompanyID, split.ClassID)
+	if !found {
+		return types.ErrShareClassNotFound
+	}
+
+	cash := newSplitCashOut()
+	rescaled := make(map[string]bool)
+	rescaleOnce := func(addr sdk.AccAddress) error {
+		if rescaled[addr.String()] {
+			return nil
+		}
+		rescaled[addr.String()] = true
+		return k.rescaleSplitBalance(ctx, *split, addr, company.Symbol)
+	}
+
+	// 1. Direct shareholdings and their bank balances
+	heldBefore, heldAfter := math.ZeroInt(), math.ZeroInt()
+	for _, holding := range k.GetCompanyShareholdings(ctx, split.CompanyID, split.ClassID) {
+		whole, remainder := split.SplitShares(holding.Shares)
+		heldBefore = heldBefore.Add(holding.Shares)
+		heldAfter = heldAfter.Add(whole)
+		isModule := k.isModuleAccount(holding.Owner)
+		if !isModule {
+			// Module accounts hold shares for beneficial owners, who are paid below
+			cash.add(holding.Owner, split.CashInLieu(remainder))
+		}
+
+		if whole.IsZero() {
+			k.DeleteShareholding(ctx, split.CompanyID, split.ClassID, holding.Owner)
+		} else {
+			holding.Shares = whole
+			holding.VestedShares = math.MinInt(split.ScaleShares(holding.VestedShares), whole)
+			holding.LockedShares = math.MinInt(split.ScaleShares(holding.LockedShares), whole)
+			holding.CostBasis = split.ScalePrice(holding.CostBasis)
+			holding.UpdatedAt = ctx.BlockTime()
+			if err := k.SetShareholding(ctx, holding); err != nil {
+				return err
+			}
+		}
+
+		addr, err := sdk.AccAddressFromBech32(holding.Owner)
+		if err != nil {
+			return err
+		}
+		if err := rescaleOnce(addr); err != nil {
+			return err
+		}
+		split.HoldersAdjusted++
+	}
+
+	// 2. Equity held in custody by other modules
+	for _, ownership := range k.GetAllBeneficialOwnerships(ctx) {
+		if ownership.CompanyID != split.CompanyID || ownership.ClassID != split.ClassID {
+			continue
+		}
+		whole, remainder := split.SplitShares(ownership.Shares)
+		cash.add(ownership.BeneficialOwner, split.CashInLieu(remainder))
+		if err := k.UpdateBeneficialOwnerShares(ctx, ownership.ModuleAccount, ownership.CompanyID, ownership.ClassID,
+			ownership.BeneficialOwner, ownership.ReferenceID, whole); err != nil {
+			return err
+		}
+	}
+	for _, moduleName := range splitCustodyModules {
+		if addr := k.accountKeeper.GetModuleAddress(moduleName); addr != nil {
+			if err := rescaleOnce(addr); err != nil {
+				return err
+			}
+		}
+	}
+
+	// 3. Vesting schedules
+	if err := k.rescaleVestingSchedules(ctx, *split); err != nil {
+		return err
+	}
+
+	// 4. Treasury shares, and other treasuries holding the class as an investment
+	investorCash, err := k.rescaleTreasuriesForSplit(ctx, *split)
+	if err != nil {
+		return err
+	}
+
+	// 5. Share class counts and prices. Fractions cashed out above are cancelled,
+	// so issued and outstanding follow the whole post-split holdings.
+	split.SharesBefore = shareClass.IssuedShares
+	shareClass.AuthorizedShares = split.ScaleShares(shareClass.AuthorizedShares)
+	shareClass.IssuedShares = split.ScaleClassTotal(shareClass.IssuedShares, heldBefore, heldAfter)
+	shareClass.OutstandingShares = split.ScaleClassTotal(shareClass.OutstandingShares, heldBefore, heldAfter)
+	shareClass.ParValue = split.ScalePrice(shareClass.ParValue)
+	shareClass.CurrentPrice = split.ScalePrice(shareClass.CurrentPrice)
+	shareClass.UpdatedAt = ctx.BlockTime()
+	if err := k.SetShareClass(ctx, shareClass); err != nil {
+		return err
+	}
+	split.SharesAfter = shareClass.IssuedShares
+
+	if price, found := k.GetLastIssuancePrice(ctx, split.CompanyID, split.ClassID); found {
+		k.SetLastIssuancePrice(ctx, split.CompanyID, split.ClassID, split.ScalePrice(price))
+	}
+	if provision, found := k.GetAntiDilutionProvision(ctx, split.CompanyID, split.ClassID); found {
+		provision.MinimumPrice = split.ScalePrice(provision.MinimumPrice)
+		provision.UpdatedAt = ctx.BlockTime()
+		if err := k.SetAntiDilutionProvision(ctx, provision); err != nil {
+			return err
+		}
+	}
+
+	// 6. Cash in lieu of fractional shares, paid from the company treasury
+	if err := k.payFractionalCashOut(ctx, split, company, cash, investorCash); err != nil {
+		return err
+	}
+
+	// 7. Let custody modules rescale their own records
+	if k.shareSplitHooks != nil {
+		if err := k.shareSplitHooks.AfterShareSplit(ctx, split.CompanyID, split.ClassID, company.Symbol, split.Numerator, split.Denominator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rescaleSplitBalance mints or burns the difference between an account's symbol
+// balance and its post-split balance
+func (k Keeper) rescaleSplitBalance(ctx sdk.Context, split types.StockSplit, addr sdk.AccAddress, symbol string) error {
+	balance := k.bankKeeper.GetBalance(ctx, addr, symbol).Amount
+	if !balance.IsPositive() {
+		return nil
+	}
+
+	target := split.ScaleShares(balance)
+	isEquityModule := addr.Equals(k.accountKeeper.GetModuleAddress(types.ModuleName))
+
+	switch {
+	case target.GT(balance):
+		coins := sdk.NewCoins(sdk.NewCoin(symbol, target.Sub(balance)))
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+		if !isEquityModule {
+			return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+		}
+	case target.LT(balance):
+		coins := sdk.NewCoins(sdk.NewCoin(symbol, balance.Sub(target)))
+		if !isEquityModule {
+			if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, coins); err != nil {
+				return err
+			}
+		}
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+	}
+	return nil
+}
+
+// rescaleVestingSchedules rescales stored vesting schedules for the share class
+func (k Keeper) rescaleVestingSchedules(ctx sdk.Context, split types.StockSplit) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetVestingKey(split.CompanyID, split.ClassID, ""))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys, values [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.VestingSchedule
+		if err := json.Unmarshal(iterator.Value(), &schedule); err != nil {
+			k.Logger(ctx).Error("failed to unmarshal vesting schedule", "error", err)
+			continue
+		}
+		schedule.TotalShares = split.ScaleShares(schedule.TotalShares)
+		schedule.VestedShares = math.MinInt(split.ScaleShares(schedule.VestedShares), schedule.TotalShares)
+		bz, err := json.Marshal(schedule)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, iterator.Key())
+		values = append(values, bz)
+	}
+
+	// Write after iterating; the store cannot be modified under an open iterator
+	for i := range keys {
+		store.Set(keys[i], values[i])
+	}
+	return nil
+}
+
+// rescaleTreasuriesForSplit rescales the company's treasury shares and any other
+// company treasury holding the class as an investment. It returns the cash in lieu
+// owed to each investing company.
+func (k Keeper) rescaleTreasuriesForSplit(ctx sdk.Context, split types.StockSplit) (map[uint64]math.Int, error) {
+	investorCash := make(map[uint64]math.Int)
+
+	for _, treasury := range k.GetAllCompanyTreasuries(ctx) {
+		changed := false
+
+		if treasury.CompanyID == split.CompanyID {
+			if shares, ok := treasury.TreasuryShares[split.ClassID]; ok {
+				treasury.TreasuryShares[split.ClassID] = split.ScaleShares(shares)
+				changed = true
+			}
+			if sold, ok := treasury.SharesSoldTotal[split.ClassID]; ok {
+				treasury.SharesSoldTotal[split.ClassID] = split.ScaleShares(sold)
+				changed = true
+			}
+		}
+
+		if holdings, ok := treasury.InvestmentHoldings[split.CompanyID]; ok {
+			if shares, ok := holdings[split.ClassID]; ok {
+				whole, remainder := split.SplitShares(shares)
+				holdings[split.ClassID] = whole
+				if owed := split.CashInLieu(remainder); owed.IsPositive() {
+					investorCash[treasury.CompanyID] = owed
+				}
+				changed = true
+			}
+		}
+		if locked, ok := treasury.LockedEquity[split.CompanyID]; ok {
+			if shares, ok := locked[split.ClassID]; ok {
+				locked[split.ClassID] = split.ScaleShares(shares)
+				changed = true
+			}
+		}
+
+		if changed {
+			treasury.UpdatedAt = ctx.BlockTime()
+			if err := k.SetCompanyTreasury(ctx, treasury); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return investorCash, nil
+}
+
+// payFractionalCashOut pays cash in lieu of fractional shares out of the company treasury
+func (k Keeper) payFractionalCashOut(
+	ctx sdk.Context,
+	split *types.StockSplit,
+	company types.Company,
+	cash *splitCashOut,
+	investorCash map[uint64]math.Int,
+) error {
+	total := cash.total()
+	investorIDs := make([]uint64, 0, len(investorCash))
+	for companyID, owed := range investorCash {
+		total = total.Add(owed)
+		investorIDs = append(investorIDs, companyID)
+	}
+	split.FractionalCashOut = total
+	if total.IsZero() {
+		return nil
+	}
+
+	treasury, found := k.GetCompanyTreasury(ctx, split.CompanyID)
+	if !found {
+		return types.ErrTreasuryNotFound
+	}
+	payout := sdk.NewCoins(sdk.NewCoin(types.SplitCashOutDenom, total))
+	if !treasury.CanWithdraw(payout) {
+		return types.ErrInsufficientTreasuryBalance.Wrapf("fractional share cash-out requires %s", payout)
+	}
+
+	for _, recipient := range cash.recipients {
+		amount := cash.amounts[recipient]
+		addr, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(types.SplitCashOutDenom, amount))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFractionalCashOut,
+				sdk.NewAttribute(types.AttributeKeySplitID, fmt.Sprintf("%d", split.ID)),
+				sdk.NewAttribute(types.AttributeKeyCompanySymbol, company.Symbol),
+				sdk.NewAttribute(types.AttributeKeyShareholder, recipient),
+				sdk.NewAttribute(types.AttributeKeyCashOut, coins.String()),
+			),
+		)
+	}
+
+	treasury.Balance = treasury.Balance.Sub(payout...)
+	treasury.TotalWithdrawn = treasury.TotalWithdrawn.Add(payout...)
+	treasury.UpdatedAt = ctx.BlockTime()
+	if err := k.SetCompanyTreasury(ctx, treasury); err != nil {
+		return err
+	}
+
+	// Investing companies are credited inside the equity module, so no coins move
+	sort.Slice(investorIDs, func(i, j int) bool { return investorIDs[i] < investorIDs[j] })
+	for _, companyID := range investorIDs {
+		investor, found := k.GetCompanyTreasury(ctx, companyID)
+		if !found {
+			continue
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(types.SplitCashOutDenom, investorCash[companyID]))
+		investor.Balance = investor.Balance.Add(coins...)
+		investor.TotalDeposited = investor.TotalDeposited.Add(coins...)
+		investor.UpdatedAt = ctx.BlockTime()
+		if err := k.SetCompanyTreasury(ctx, investor); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// TestStockSplitRatios tests forward and reverse split share and price scaling
+func TestStockSplitRatios(t *testing.T) {
+	proposer := sdk.AccAddress([]byte("split_proposer______")).String()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// 3:2 forward split
+	forward := types.NewStockSplit(1, 1, "COMMON", 3, 2, math.LegacyNewDec(30), "forward split", proposer, now)
+	require.NoError(t, forward.Validate())
+	require.False(t, forward.IsReverse())
+
+	whole, remainder := forward.SplitShares(math.NewInt(101))
+	require.Equal(t, math.NewInt(151), whole) // 151.5 rounds down
+	require.Equal(t, math.NewInt(1), remainder)
+	require.Equal(t, math.NewInt(10), forward.CashInLieu(remainder)) // half a new share at 20 = 10
+	require.Equal(t, math.LegacyNewDec(20), forward.ScalePrice(math.LegacyNewDec(30)))
+
+	// 1:10 reverse split
+	reverse := types.NewStockSplit(2, 1, "COMMON", 1, 10, math.LegacyNewDec(5), "reverse split", proposer, now)
+	require.NoError(t, reverse.Validate())
+	require.True(t, reverse.IsReverse())
+
+	whole, remainder = reverse.SplitShares(math.NewInt(1234))
+	require.Equal(t, math.NewInt(123), whole)
+	require.Equal(t, math.NewInt(4), remainder)
+	require.Equal(t, math.NewInt(20), reverse.CashInLieu(remainder)) // 4 old shares at 5
+	require.Equal(t, math.LegacyNewDec(50), reverse.ScalePrice(math.LegacyNewDec(5)))
+
+	// Holders below one post-split share are fully cashed out
+	whole, remainder = reverse.SplitShares(math.NewInt(7))
+	require.True(t, whole.IsZero())
+	require.Equal(t, math.NewInt(35), reverse.CashInLieu(remainder))
+
+	// Invalid ratios
+	invalid := forward
+	invalid.Denominator = 3
+	require.Error(t, invalid.Validate(), "1:1 ratio")
+
+	invalid = forward
+	invalid.Numerator = 0
+	require.Error(t, invalid.Validate(), "zero numerator")
+
+	invalid = forward
+	invalid.Numerator = types.MaxSplitRatioTerm + 1
+	require.Error(t, invalid.Validate(), "ratio term too large")
+}
+
+// TestStockSplitClassTotals tests that cashed-out fractions are cancelled from the
+// class totals, so issued shares never exceed the whole post-split holdings
+func TestStockSplitClassTotals(t *testing.T) {
+	proposer := sdk.AccAddress([]byte("split_proposer______")).String()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	reverse := types.NewStockSplit(1, 1, "COMMON", 1, 10, math.LegacyNewDec(5), "reverse split", proposer, now)
+
+	// Three holders of 15 shares each plus 30 treasury shares
+	holdings := []math.Int{math.NewInt(15), math.NewInt(15), math.NewInt(15)}
+	heldBefore, heldAfter := math.ZeroInt(), math.ZeroInt()
+	for _, shares := range holdings {
+		heldBefore = heldBefore.Add(shares)
+		heldAfter = heldAfter.Add(reverse.ScaleShares(shares))
+	}
+	require.Equal(t, math.NewInt(3), heldAfter)
+
+	// Scaling the 75 issued shares naively would give 7 against 3 + 3 held
+	issued := math.NewInt(75)
+	require.Equal(t, math.NewInt(7), reverse.ScaleShares(issued))
+	require.Equal(t, math.NewInt(6), reverse.ScaleClassTotal(issued, heldBefore, heldAfter))
+
+	// Outstanding excludes treasury shares and follows the holdings exactly
+	outstanding := math.NewInt(45)
+	require.Equal(t, heldAfter, reverse.ScaleClassTotal(outstanding, heldBefore, heldAfter))
+
+	// A total below the holdings never exceeds them after the split
+	require.Equal(t, math.NewInt(3), reverse.ScaleClassTotal(math.NewInt(40), heldBefore, heldAfter))
+}
@@ -18,6 +18,8 @@ import (
 
 // SetCompanyTreasury stores a company treasury record
 func (k Keeper) SetCompanyTreasury(ctx sdk.Context, treasury types.CompanyTreasury) error {
+	k.journalTreasury(ctx, treasury)
+
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetCompanyTreasuryKey(treasury.CompanyID)
 	bz, err := json.Marshal(treasury)
@@ -704,6 +706,12 @@ func (k Keeper) TransferSharesFromTreasury(
 		return types.ErrInsufficientTreasuryShares
 	}
 
+	// Treasury sales are transfers out of the treasury for compliance purposes
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.CheckTransferCompliance(ctx, companyID, classID, moduleAddr.String(), buyer, shares); err != nil {
+		return err
+	}
+
 	// Calculate total cost
 	totalCost := pricePerShare.MulInt(shares)
 	payment := sdk.NewCoins(sdk.NewCoin(paymentDenom, totalCost.TruncateInt()))
@@ -138,7 +138,8 @@ func (AppModule) ConsensusVersion() uint64 { return 1 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the equity module.
 func (am AppModule) BeginBlock(ctx context.Context) error {
-	// BeginBlock logic - none needed for now
+	// Cap tables replay from the first block journaling runs in
+	am.keeper.ActivateCapTableJournal(sdk.UnwrapSDKContext(ctx))
 	return nil
 }
 
@@ -155,6 +156,18 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	// Process shareholder petition thresholds
 	am.processPetitionEndBlock(sdkCtx)
 
+	// Release vested early-exercised shares and expire lapsed option and warrant grants
+	am.keeper.ProcessEquityGrants(sdkCtx)
+
+	// Settle closed tender offers and end expired buyback programs
+	am.keeper.ProcessBuybacks(sdkCtx)
+
+	// Execute approved mergers whose appraisal window has closed
+	am.keeper.ProcessMergers(sdkCtx)
+
+	// Vacate the seats of directors whose term has ended
+	am.keeper.ProcessBoardTerms(sdkCtx)
+
 	return nil
 }
 
@@ -0,0 +1,307 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Board limits
+const (
+	MaxBoardSeats   = 25                       // Largest board a company may configure
+	MinBoardTerm    = 30 * 24 * time.Hour      // Shortest director term
+	MaxBoardTerm    = 5 * 365 * 24 * time.Hour // Longest director term
+	MaxBoardNominee = 50                       // Most candidates a single election may nominate
+)
+
+// Board committees
+const (
+	BoardCommitteeAudit        = "audit"        // Independent directors only
+	BoardCommitteeCompensation = "compensation" // Independent directors only
+	BoardCommitteeNominating   = "nominating"
+	BoardCommitteeRisk         = "risk"
+)
+
+// IsValidBoardCommittee reports whether a committee name is recognised
+func IsValidBoardCommittee(committee string) bool {
+	switch committee {
+	case BoardCommitteeAudit, BoardCommitteeCompensation, BoardCommitteeNominating, BoardCommitteeRisk:
+		return true
+	default:
+		return false
+	}
+}
+
+// committeeRequiresIndependence reports whether only independent directors may sit on a committee
+func committeeRequiresIndependence(committee string) bool {
+	return committee == BoardCommitteeAudit || committee == BoardCommitteeCompensation
+}
+
+// Board is a company's board of directors. Directors hold seats for TermLength from
+// their election; board resolutions on company proposals need ResolutionThreshold
+// director signatures (M of the Seats N) before shareholders vote.
+type Board struct {
+	CompanyID           uint64        `json:"company_id"`
+	Seats               uint32        `json:"seats"`                // N: director seats
+	ResolutionThreshold uint32        `json:"resolution_threshold"` // M: director signatures a board resolution needs
+	MinIndependent      uint32        `json:"min_independent"`      // Seats elections must fill with independent directors
+	TermLength          time.Duration `json:"term_length"`
+	CumulativeVoting    bool          `json:"cumulative_voting"` // Holders may concentrate shares x seats votes on candidates
+
+	LastElectionID uint64    `json:"last_election_id,omitempty"` // Company proposal of the latest election; zero while the founder appoints
+	UpdatedBy      string    `json:"updated_by"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// Validate validates a board configuration
+func (b Board) Validate() error {
+	if b.CompanyID == 0 {
+		return fmt.Errorf("company ID cannot be zero")
+	}
+	if b.Seats == 0 || b.Seats > MaxBoardSeats {
+		return fmt.Errorf("board seats must be between 1 and %d", MaxBoardSeats)
+	}
+	if b.ResolutionThreshold == 0 || b.ResolutionThreshold > b.Seats {
+		return fmt.Errorf("resolution threshold must be between 1 and the number of seats")
+	}
+	if b.MinIndependent > b.Seats {
+		return fmt.Errorf("independent seats cannot exceed the number of seats")
+	}
+	if b.TermLength < MinBoardTerm || b.TermLength > MaxBoardTerm {
+		return fmt.Errorf("term length must be between %s and %s", MinBoardTerm, MaxBoardTerm)
+	}
+	return nil
+}
+
+// IsElected reports whether the board has been seated by a shareholder election.
+// Until then the company owner appoints the initial directors.
+func (b Board) IsElected() bool {
+	return b.LastElectionID != 0
+}
+
+// BoardSeat is a director's seat on a company board
+type BoardSeat struct {
+	CompanyID   uint64    `json:"company_id"`
+	Director    string    `json:"director"`
+	Independent bool      `json:"independent"`
+	Committees  []string  `json:"committees,omitempty"`
+	ProposalID  uint64    `json:"proposal_id,omitempty"` // Electing company proposal; zero for founder appointments
+	TermStart   time.Time `json:"term_start"`
+	TermEnd     time.Time `json:"term_end"`
+}
+
+// Validate validates a board seat
+func (s BoardSeat) Validate() error {
+	if s.CompanyID == 0 {
+		return fmt.Errorf("company ID cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Director); err != nil {
+		return fmt.Errorf("invalid director address: %v", err)
+	}
+	if !s.TermEnd.After(s.TermStart) {
+		return fmt.Errorf("term must end after it starts")
+	}
+	return ValidateBoardCommittees(s.Committees, s.Independent)
+}
+
+// IsActive reports whether the director's term covers the given time
+func (s BoardSeat) IsActive(now time.Time) bool {
+	return !now.Before(s.TermStart) && now.Before(s.TermEnd)
+}
+
+// OnCommittee reports whether the director sits on a committee
+func (s BoardSeat) OnCommittee(committee string) bool {
+	for _, c := range s.Committees {
+		if c == committee {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBoardCommittees checks committee names, duplicates, and that audit and
+// compensation committees only seat independent directors
+func ValidateBoardCommittees(committees []string, independent bool) error {
+	seen := make(map[string]bool, len(committees))
+	for _, committee := range committees {
+		if !IsValidBoardCommittee(committee) {
+			return fmt.Errorf("unknown committee %q", committee)
+		}
+		if seen[committee] {
+			return fmt.Errorf("duplicate committee %q", committee)
+		}
+		seen[committee] = true
+		if committeeRequiresIndependence(committee) && !independent {
+			return fmt.Errorf("%s committee members must be independent", committee)
+		}
+	}
+	return nil
+}
+
+// BoardNominee is a candidate standing in a board election, with the independence
+// and committees the seat carries if elected
+type BoardNominee struct {
+	Candidate   string   `json:"candidate"`
+	Independent bool     `json:"independent"`
+	Committees  []string `json:"committees,omitempty"`
+}
+
+// BoardCandidate is a nominee with the votes received so far
+type BoardCandidate struct {
+	BoardNominee
+	Votes math.Int `json:"votes"`
+}
+
+// BoardBallotEntry is the votes a holder casts for one candidate
+type BoardBallotEntry struct {
+	Candidate string   `json:"candidate"`
+	Votes     math.Int `json:"votes"`
+}
+
+// ValidateBoardNominees checks an election slate: valid unique candidates with valid committees
+func ValidateBoardNominees(nominees []BoardNominee) error {
+	if len(nominees) == 0 {
+		return fmt.Errorf("election needs at least one nominee")
+	}
+	if len(nominees) > MaxBoardNominee {
+		return fmt.Errorf("election cannot nominate more than %d candidates", MaxBoardNominee)
+	}
+	seen := make(map[string]bool, len(nominees))
+	for _, n := range nominees {
+		if _, err := sdk.AccAddressFromBech32(n.Candidate); err != nil {
+			return fmt.Errorf("invalid candidate address: %v", err)
+		}
+		if seen[n.Candidate] {
+			return fmt.Errorf("candidate %s nominated twice", n.Candidate)
+		}
+		seen[n.Candidate] = true
+		if err := ValidateBoardCommittees(n.Committees, n.Independent); err != nil {
+			return fmt.Errorf("candidate %s: %v", n.Candidate, err)
+		}
+	}
+	return nil
+}
+
+// NewBoardCandidates returns the nominees of an election with no votes
+func NewBoardCandidates(nominees []BoardNominee) []BoardCandidate {
+	candidates := make([]BoardCandidate, len(nominees))
+	for i, n := range nominees {
+		candidates[i] = BoardCandidate{BoardNominee: n, Votes: math.ZeroInt()}
+	}
+	return candidates
+}
+
+// BoardBallotAllowance returns the votes a holder with the given voting power may
+// cast in an election for the given number of seats. Under straight voting at most
+// power votes go to each of up to seats candidates; under cumulative voting the
+// holder distributes power x seats votes freely, including all on one candidate.
+func BoardBallotAllowance(power math.Int, seats uint32) math.Int {
+	return power.Mul(math.NewInt(int64(seats)))
+}
+
+// ValidateBoardBallot checks a holder's ballot in an election
+func ValidateBoardBallot(candidates []BoardCandidate, seats uint32, cumulative bool, power math.Int, ballot []BoardBallotEntry) error {
+	if len(ballot) == 0 {
+		return fmt.Errorf("ballot is empty")
+	}
+	if !cumulative && len(ballot) > int(seats) {
+		return fmt.Errorf("straight voting allows at most %d candidates", seats)
+	}
+
+	nominated := make(map[string]bool, len(candidates))
+	for _, c := range candidates {
+		nominated[c.Candidate] = true
+	}
+
+	total := math.ZeroInt()
+	seen := make(map[string]bool, len(ballot))
+	for _, entry := range ballot {
+		if !nominated[entry.Candidate] {
+			return fmt.Errorf("%s is not a candidate", entry.Candidate)
+		}
+		if seen[entry.Candidate] {
+			return fmt.Errorf("candidate %s appears twice", entry.Candidate)
+		}
+		seen[entry.Candidate] = true
+		if entry.Votes.IsNil() || !entry.Votes.IsPositive() {
+			return fmt.Errorf("votes for %s must be positive", entry.Candidate)
+		}
+		if !cumulative && entry.Votes.GT(power) {
+			return fmt.Errorf("straight voting allows at most %s votes per candidate", power)
+		}
+		total = total.Add(entry.Votes)
+	}
+
+	if allowance := BoardBallotAllowance(power, seats); total.GT(allowance) {
+		return fmt.Errorf("ballot casts %s votes, more than the %s allowed", total, allowance)
+	}
+	return nil
+}
+
+// ElectBoardCandidates returns the winners of an election: the candidates with the
+// most votes, up to seats. Ties go to the earlier nomination and candidates without
+// votes are never elected. When fewer than minIndependent winners are independent,
+// the lowest-ranked other winners give way to the highest-ranked independent
+// runners-up.
+func ElectBoardCandidates(candidates []BoardCandidate, seats uint32, minIndependent uint32) []BoardCandidate {
+	ranked := make([]BoardCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		if !c.Votes.IsNil() && c.Votes.IsPositive() {
+			ranked = append(ranked, c)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Votes.GT(ranked[j].Votes)
+	})
+
+	n := int(seats)
+	if n > len(ranked) {
+		n = len(ranked)
+	}
+	winners := append([]BoardCandidate{}, ranked[:n]...)
+	runnersUp := ranked[n:]
+
+	independent := 0
+	for _, w := range winners {
+		if w.Independent {
+			independent++
+		}
+	}
+
+	next := 0
+	for i := len(winners) - 1; i >= 0 && independent < int(minIndependent); i-- {
+		if winners[i].Independent {
+			continue
+		}
+		for next < len(runnersUp) && !runnersUp[next].Independent {
+			next++
+		}
+		if next == len(runnersUp) {
+			break
+		}
+		winners[i] = runnersUp[next]
+		next++
+		independent++
+	}
+
+	sort.SliceStable(winners, func(i, j int) bool {
+		return winners[i].Votes.GT(winners[j].Votes)
+	})
+	return winners
+}
+
+// Board event types
+const (
+	EventTypeBoardConfigured = "board_configured"
+	EventTypeDirectorSeated  = "director_seated"
+	EventTypeDirectorVacated = "director_vacated"
+	EventTypeBoardCommittees = "board_committees_updated"
+	EventTypeBoardElected    = "board_elected"
+
+	AttributeKeyDirector    = "director"
+	AttributeKeyIndependent = "independent"
+	AttributeKeyTermEnd     = "term_end"
+)
@@ -0,0 +1,384 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BuybackDenom is the denom tender offers and open-market buybacks pay in
+const BuybackDenom = "uhodl"
+
+// TenderOfferType defines how the purchase price of a tender offer is set
+type TenderOfferType int32
+
+const (
+	TenderOfferFixedPrice   TenderOfferType = iota // Every accepted share is bought at one announced price
+	TenderOfferDutchAuction                        // Holders name a price within a range; the lowest clearing price wins
+)
+
+func (t TenderOfferType) String() string {
+	switch t {
+	case TenderOfferFixedPrice:
+		return "fixed_price"
+	case TenderOfferDutchAuction:
+		return "dutch_auction"
+	default:
+		return "unknown"
+	}
+}
+
+// BuybackStatus represents the status of a tender offer or buyback program
+type BuybackStatus int32
+
+const (
+	BuybackStatusOpen      BuybackStatus = iota // Accepting tenders / executing purchases
+	BuybackStatusCompleted                      // Settled or ended
+	BuybackStatusCancelled                      // Withdrawn by the company
+)
+
+func (s BuybackStatus) String() string {
+	switch s {
+	case BuybackStatusOpen:
+		return "open"
+	case BuybackStatusCompleted:
+		return "completed"
+	case BuybackStatusCancelled:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
+// TenderOffer is an issuer offer to repurchase up to MaxShares of a class from its
+// holders. The maximum cost is locked in the treasury while the offer is open.
+type TenderOffer struct {
+	ID        uint64          `json:"id"`
+	CompanyID uint64          `json:"company_id"`
+	ClassID   string          `json:"class_id"`
+	Type      TenderOfferType `json:"type"`
+
+	// Price: fixed offers use Price, Dutch auctions accept tenders in [MinPrice, MaxPrice]
+	Price    math.LegacyDec `json:"price"`
+	MinPrice math.LegacyDec `json:"min_price"`
+	MaxPrice math.LegacyDec `json:"max_price"`
+
+	MaxShares    math.Int  `json:"max_shares"`    // Shares sought; oversubscription is accepted pro-rata
+	RetireShares bool      `json:"retire_shares"` // Retire repurchased shares instead of holding them in treasury
+	StartTime    time.Time `json:"start_time"`
+	EndTime      time.Time `json:"end_time"`
+	LockedAmount sdk.Coins `json:"locked_amount"` // Treasury funds reserved for settlement
+
+	// Settlement
+	Status         BuybackStatus  `json:"status"`
+	ClearingPrice  math.LegacyDec `json:"clearing_price"`
+	SharesTendered math.Int       `json:"shares_tendered"`
+	SharesAccepted math.Int       `json:"shares_accepted"`
+	TotalPaid      math.Int       `json:"total_paid"`
+	SettledAt      time.Time      `json:"settled_at,omitempty"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Validate validates a tender offer
+func (o TenderOffer) Validate() error {
+	if o.CompanyID == 0 {
+		return fmt.Errorf("company ID cannot be zero")
+	}
+	if o.ClassID == "" {
+		return fmt.Errorf("class ID cannot be empty")
+	}
+	switch o.Type {
+	case TenderOfferFixedPrice:
+		if o.Price.IsNil() || !o.Price.IsPositive() {
+			return fmt.Errorf("fixed price must be positive")
+		}
+	case TenderOfferDutchAuction:
+		if o.MinPrice.IsNil() || !o.MinPrice.IsPositive() {
+			return fmt.Errorf("minimum price must be positive")
+		}
+		if o.MaxPrice.IsNil() || o.MaxPrice.LT(o.MinPrice) {
+			return fmt.Errorf("maximum price must be at least the minimum price")
+		}
+	default:
+		return fmt.Errorf("invalid tender offer type")
+	}
+	if o.MaxShares.IsNil() || !o.MaxShares.IsPositive() {
+		return fmt.Errorf("maximum shares must be positive")
+	}
+	if !o.EndTime.After(o.StartTime) {
+		return fmt.Errorf("end time must be after start time")
+	}
+	if _, err := sdk.AccAddressFromBech32(o.CreatedBy); err != nil {
+		return fmt.Errorf("invalid creator address: %v", err)
+	}
+	return nil
+}
+
+// HighestPrice returns the most the offer can pay per share
+func (o TenderOffer) HighestPrice() math.LegacyDec {
+	if o.Type == TenderOfferDutchAuction {
+		return o.MaxPrice
+	}
+	return o.Price
+}
+
+// MaxCost returns the most the offer can pay in total
+func (o TenderOffer) MaxCost() math.Int {
+	return o.HighestPrice().MulInt(o.MaxShares).Ceil().TruncateInt()
+}
+
+// IsAcceptingTenders reports whether holders may tender at the given time
+func (o TenderOffer) IsAcceptingTenders(now time.Time) bool {
+	return o.Status == BuybackStatusOpen && !now.Before(o.StartTime) && now.Before(o.EndTime)
+}
+
+// ValidTenderPrice reports whether a tender price is acceptable for the offer
+func (o TenderOffer) ValidTenderPrice(price math.LegacyDec) bool {
+	if o.Type != TenderOfferDutchAuction {
+		return true
+	}
+	return !price.IsNil() && price.GTE(o.MinPrice) && price.LTE(o.MaxPrice)
+}
+
+// Tender is a holder's offer to sell shares into a tender offer. Tendered shares are
+// locked in the holder's shareholding until the offer settles or the tender is withdrawn.
+type Tender struct {
+	OfferID     uint64         `json:"offer_id"`
+	Holder      string         `json:"holder"`
+	Shares      math.Int       `json:"shares"`
+	Price       math.LegacyDec `json:"price"` // Lowest acceptable price (Dutch auction only)
+	SubmittedAt time.Time      `json:"submitted_at"`
+}
+
+// TenderAllocation is the settled outcome of one tender
+type TenderAllocation struct {
+	Holder   string   `json:"holder"`
+	Tendered math.Int `json:"tendered"`
+	Accepted math.Int `json:"accepted"`
+}
+
+// TenderClearing is the result of clearing a tender offer
+type TenderClearing struct {
+	ClearingPrice  math.LegacyDec     `json:"clearing_price"`
+	SharesTendered math.Int           `json:"shares_tendered"`
+	SharesAccepted math.Int           `json:"shares_accepted"`
+	Allocations    []TenderAllocation `json:"allocations"`
+}
+
+// ClearTenderOffer settles tenders against an offer.
+//
+// A fixed price offer buys every tender at the offer price. A Dutch auction buys at
+// the lowest price at which the tenders at or below it fill MaxShares (or at the
+// highest tendered price when undersubscribed); every accepted share is bought at
+// that single clearing price. Tenders below the clearing price are accepted in full
+// and tenders at the clearing price share the remainder pro-rata, with the shares
+// lost to rounding handed out one at a time in tender order.
+func ClearTenderOffer(offer TenderOffer, tenders []Tender) TenderClearing {
+	sorted := make([]Tender, len(tenders))
+	copy(sorted, tenders)
+	if offer.Type == TenderOfferDutchAuction {
+		sort.SliceStable(sorted, func(i, j int) bool {
+			if !sorted[i].Price.Equal(sorted[j].Price) {
+				return sorted[i].Price.LT(sorted[j].Price)
+			}
+			return sorted[i].Holder < sorted[j].Holder
+		})
+	} else {
+		sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Holder < sorted[j].Holder })
+	}
+
+	result := TenderClearing{
+		ClearingPrice:  offer.Price,
+		SharesTendered: math.ZeroInt(),
+		SharesAccepted: math.ZeroInt(),
+	}
+	for _, tender := range sorted {
+		result.SharesTendered = result.SharesTendered.Add(tender.Shares)
+	}
+	if len(sorted) == 0 {
+		return result
+	}
+
+	if offer.Type == TenderOfferDutchAuction {
+		result.ClearingPrice = sorted[len(sorted)-1].Price
+		cumulative := math.ZeroInt()
+		for _, tender := range sorted {
+			cumulative = cumulative.Add(tender.Shares)
+			if cumulative.GTE(offer.MaxShares) {
+				result.ClearingPrice = tender.Price
+				break
+			}
+		}
+	}
+
+	marginal := func(tender Tender) bool {
+		return offer.Type != TenderOfferDutchAuction || tender.Price.Equal(result.ClearingPrice)
+	}
+
+	// Shares below the clearing price are taken in full
+	remaining := offer.MaxShares
+	accepted := make([]math.Int, len(sorted))
+	atMargin := math.ZeroInt()
+	for i, tender := range sorted {
+		accepted[i] = math.ZeroInt()
+		switch {
+		case marginal(tender):
+			atMargin = atMargin.Add(tender.Shares)
+		case tender.Price.LT(result.ClearingPrice):
+			accepted[i] = tender.Shares
+			remaining = remaining.Sub(tender.Shares)
+		}
+	}
+
+	// Tenders at the clearing price share what is left pro-rata
+	if atMargin.LTE(remaining) {
+		for i, tender := range sorted {
+			if marginal(tender) {
+				accepted[i] = tender.Shares
+			}
+		}
+	} else if remaining.IsPositive() {
+		allocated := math.ZeroInt()
+		for i, tender := range sorted {
+			if marginal(tender) {
+				accepted[i] = tender.Shares.Mul(remaining).Quo(atMargin)
+				allocated = allocated.Add(accepted[i])
+			}
+		}
+		for i, tender := range sorted {
+			if !allocated.LT(remaining) {
+				break
+			}
+			if marginal(tender) && accepted[i].LT(tender.Shares) {
+				accepted[i] = accepted[i].Add(math.OneInt())
+				allocated = allocated.Add(math.OneInt())
+			}
+		}
+	}
+
+	for i, tender := range sorted {
+		result.SharesAccepted = result.SharesAccepted.Add(accepted[i])
+		result.Allocations = append(result.Allocations, TenderAllocation{
+			Holder:   tender.Holder,
+			Tendered: tender.Shares,
+			Accepted: accepted[i],
+		})
+	}
+	return result
+}
+
+// BuybackProgram is an open-market repurchase program. Purchases are placed as DEX
+// buy orders owned by the equity module on behalf of the treasury, limited by a
+// total budget, a per-share price ceiling and a daily share volume cap.
+type BuybackProgram struct {
+	ID        uint64 `json:"id"`
+	CompanyID uint64 `json:"company_id"`
+	ClassID   string `json:"class_id"`
+
+	Budget       math.Int       `json:"budget"`        // Maximum total spend
+	MaxPrice     math.LegacyDec `json:"max_price"`     // Highest price per share the program pays
+	DailyCap     math.Int       `json:"daily_cap"`     // Maximum shares bought per day
+	RetireShares bool           `json:"retire_shares"` // Retire repurchased shares instead of holding them in treasury
+	StartTime    time.Time      `json:"start_time"`
+	EndTime      time.Time      `json:"end_time"`
+
+	// Progress
+	Status            BuybackStatus `json:"status"`
+	Spent             math.Int      `json:"spent"`
+	SharesRepurchased math.Int      `json:"shares_repurchased"`
+	BoughtToday       math.Int      `json:"bought_today"`
+	CurrentDay        time.Time     `json:"current_day"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Validate validates a buyback program
+func (p BuybackProgram) Validate() error {
+	if p.CompanyID == 0 {
+		return fmt.Errorf("company ID cannot be zero")
+	}
+	if p.ClassID == "" {
+		return fmt.Errorf("class ID cannot be empty")
+	}
+	if p.Budget.IsNil() || !p.Budget.IsPositive() {
+		return fmt.Errorf("budget must be positive")
+	}
+	if p.MaxPrice.IsNil() || !p.MaxPrice.IsPositive() {
+		return fmt.Errorf("maximum price must be positive")
+	}
+	if p.DailyCap.IsNil() || !p.DailyCap.IsPositive() {
+		return fmt.Errorf("daily cap must be positive")
+	}
+	if !p.EndTime.After(p.StartTime) {
+		return fmt.Errorf("end time must be after start time")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.CreatedBy); err != nil {
+		return fmt.Errorf("invalid creator address: %v", err)
+	}
+	return nil
+}
+
+// IsActive reports whether the program may buy at the given time
+func (p BuybackProgram) IsActive(now time.Time) bool {
+	return p.Status == BuybackStatusOpen && !now.Before(p.StartTime) && now.Before(p.EndTime)
+}
+
+// RemainingToday returns the shares the program may still buy on the day of now
+func (p BuybackProgram) RemainingToday(now time.Time) math.Int {
+	bought := p.BoughtToday
+	if !p.CurrentDay.Equal(now.Truncate(24 * time.Hour)) {
+		bought = math.ZeroInt()
+	}
+	remaining := p.DailyCap.Sub(bought)
+	if remaining.IsNegative() {
+		return math.ZeroInt()
+	}
+	return remaining
+}
+
+// RemainingBudget returns the unspent budget
+func (p BuybackProgram) RemainingBudget() math.Int {
+	remaining := p.Budget.Sub(p.Spent)
+	if remaining.IsNegative() {
+		return math.ZeroInt()
+	}
+	return remaining
+}
+
+// RecordPurchase adds a fill to the program's totals and daily volume
+func (p *BuybackProgram) RecordPurchase(now time.Time, shares, cost math.Int) {
+	day := now.Truncate(24 * time.Hour)
+	if !p.CurrentDay.Equal(day) {
+		p.CurrentDay = day
+		p.BoughtToday = math.ZeroInt()
+	}
+	p.BoughtToday = p.BoughtToday.Add(shares)
+	p.SharesRepurchased = p.SharesRepurchased.Add(shares)
+	p.Spent = p.Spent.Add(cost)
+}
+
+// Tender offer and buyback event types
+const (
+	EventTypeTenderOfferCreated   = "tender_offer_created"
+	EventTypeTenderSubmitted      = "tender_submitted"
+	EventTypeTenderWithdrawn      = "tender_withdrawn"
+	EventTypeTenderOfferSettled   = "tender_offer_settled"
+	EventTypeTenderOfferCancelled = "tender_offer_cancelled"
+	EventTypeBuybackCreated       = "buyback_program_created"
+	EventTypeBuybackExecuted      = "buyback_executed"
+	EventTypeBuybackEnded         = "buyback_program_ended"
+	EventTypeSharesRepurchased    = "shares_repurchased"
+
+	AttributeKeyTenderOfferID  = "tender_offer_id"
+	AttributeKeyBuybackID      = "buyback_id"
+	AttributeKeyClearingPrice  = "clearing_price"
+	AttributeKeySharesAccepted = "shares_accepted"
+	AttributeKeySharesTendered = "shares_tendered"
+	AttributeKeyRetired        = "retired"
+)
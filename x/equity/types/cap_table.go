@@ -0,0 +1,294 @@
+package types
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// CapTableJournalKind identifies the ownership figure a journal entry records
+type CapTableJournalKind int32
+
+const (
+	CapTableJournalHolding     CapTableJournalKind = iota // Shares held by an address
+	CapTableJournalIssued                                 // Issued shares of the class
+	CapTableJournalOutstanding                            // Outstanding shares of the class
+	CapTableJournalTreasury                               // Shares of the class held in the company treasury
+	CapTableJournalGrant                                  // Unexercised shares of an open option or warrant grant
+	CapTableJournalBeneficial                             // Shares a module account holds for a beneficial owner
+)
+
+func (k CapTableJournalKind) String() string {
+	switch k {
+	case CapTableJournalHolding:
+		return "holding"
+	case CapTableJournalIssued:
+		return "issued"
+	case CapTableJournalOutstanding:
+		return "outstanding"
+	case CapTableJournalTreasury:
+		return "treasury"
+	case CapTableJournalGrant:
+		return "grant"
+	case CapTableJournalBeneficial:
+		return "beneficial"
+	default:
+		return "unknown"
+	}
+}
+
+// CapTableJournalEntry records the new value of one ownership figure of a company.
+// Replaying a company's entries up to a height reconstructs its cap table at that height.
+type CapTableJournalEntry struct {
+	ID        uint64              `json:"id"`
+	CompanyID uint64              `json:"company_id"`
+	ClassID   string              `json:"class_id"`
+	Kind      CapTableJournalKind `json:"kind"`
+
+	Holder          string `json:"holder,omitempty"`           // Holding/Grant: holder; Beneficial: custodian module
+	BeneficialOwner string `json:"beneficial_owner,omitempty"` // Beneficial: owner of the custodied shares
+	ReferenceID     uint64 `json:"reference_id,omitempty"`     // Grant: grant ID; Beneficial: custody reference
+
+	Balance math.Int  `json:"balance"` // Value after the change
+	Height  int64     `json:"height"`
+	Time    time.Time `json:"time"`
+}
+
+func (e CapTableJournalEntry) figureKey() string {
+	return fmt.Sprintf("%d|%s|%s|%s|%d", e.Kind, e.ClassID, e.Holder, e.BeneficialOwner, e.ReferenceID)
+}
+
+// CapTable is a company's ownership at a block height
+type CapTable struct {
+	CompanyID          uint64          `json:"company_id"`
+	Height             int64           `json:"height"`
+	IssuedShares       math.Int        `json:"issued_shares"`
+	OutstandingShares  math.Int        `json:"outstanding_shares"`
+	TreasuryShares     math.Int        `json:"treasury_shares"`
+	FullyDilutedShares math.Int        `json:"fully_diluted_shares"`
+	Classes            []CapTableClass `json:"classes"`
+}
+
+// CapTableClass is the ownership of one share class. Fully-diluted shares are the
+// outstanding shares plus the unexercised shares of open option and warrant grants.
+type CapTableClass struct {
+	ClassID            string                    `json:"class_id"`
+	IssuedShares       math.Int                  `json:"issued_shares"`
+	OutstandingShares  math.Int                  `json:"outstanding_shares"`
+	TreasuryShares     math.Int                  `json:"treasury_shares"`
+	GrantShares        math.Int                  `json:"grant_shares"`
+	FullyDilutedShares math.Int                  `json:"fully_diluted_shares"`
+	Holders            []CapTableHolder          `json:"holders"`
+	BeneficialOwners   []CapTableBeneficialOwner `json:"beneficial_owners,omitempty"`
+}
+
+// CapTableHolder is one holder's position in a share class
+type CapTableHolder struct {
+	Address             string         `json:"address"`
+	Shares              math.Int       `json:"shares"`
+	GrantShares         math.Int       `json:"grant_shares"`          // Unexercised option and warrant shares
+	OutstandingPercent  math.LegacyDec `json:"outstanding_percent"`   // Shares / class outstanding
+	FullyDilutedPercent math.LegacyDec `json:"fully_diluted_percent"` // (Shares + grants) / class fully diluted
+}
+
+// CapTableBeneficialOwner attributes shares held by a custodian module account
+// (escrow, lending, DEX) to their beneficial owner
+type CapTableBeneficialOwner struct {
+	Custodian   string   `json:"custodian"`
+	Owner       string   `json:"owner"`
+	ReferenceID uint64   `json:"reference_id"`
+	Shares      math.Int `json:"shares"`
+}
+
+// ReplayCapTable builds a company's cap table from its journal entries at or below
+// the given height. Entries must be in journal order; later entries for the same
+// figure replace earlier ones.
+func ReplayCapTable(companyID uint64, height int64, entries []CapTableJournalEntry) CapTable {
+	latest := make(map[string]CapTableJournalEntry)
+	for _, entry := range entries {
+		if entry.CompanyID != companyID || entry.Height > height {
+			continue
+		}
+		latest[entry.figureKey()] = entry
+	}
+
+	classes := make(map[string]*CapTableClass)
+	holders := make(map[string]map[string]*CapTableHolder)
+	class := func(classID string) *CapTableClass {
+		if c, ok := classes[classID]; ok {
+			return c
+		}
+		c := &CapTableClass{
+			ClassID:           classID,
+			IssuedShares:      math.ZeroInt(),
+			OutstandingShares: math.ZeroInt(),
+			TreasuryShares:    math.ZeroInt(),
+			GrantShares:       math.ZeroInt(),
+		}
+		classes[classID] = c
+		holders[classID] = make(map[string]*CapTableHolder)
+		return c
+	}
+	holder := func(classID, address string) *CapTableHolder {
+		class(classID)
+		if h, ok := holders[classID][address]; ok {
+			return h
+		}
+		h := &CapTableHolder{Address: address, Shares: math.ZeroInt(), GrantShares: math.ZeroInt()}
+		holders[classID][address] = h
+		return h
+	}
+
+	for _, entry := range latest {
+		if entry.Balance.IsNil() || !entry.Balance.IsPositive() {
+			continue
+		}
+		c := class(entry.ClassID)
+		switch entry.Kind {
+		case CapTableJournalHolding:
+			h := holder(entry.ClassID, entry.Holder)
+			h.Shares = h.Shares.Add(entry.Balance)
+		case CapTableJournalIssued:
+			c.IssuedShares = entry.Balance
+		case CapTableJournalOutstanding:
+			c.OutstandingShares = entry.Balance
+		case CapTableJournalTreasury:
+			c.TreasuryShares = entry.Balance
+		case CapTableJournalGrant:
+			h := holder(entry.ClassID, entry.Holder)
+			h.GrantShares = h.GrantShares.Add(entry.Balance)
+			c.GrantShares = c.GrantShares.Add(entry.Balance)
+		case CapTableJournalBeneficial:
+			c.BeneficialOwners = append(c.BeneficialOwners, CapTableBeneficialOwner{
+				Custodian:   entry.Holder,
+				Owner:       entry.BeneficialOwner,
+				ReferenceID: entry.ReferenceID,
+				Shares:      entry.Balance,
+			})
+		}
+	}
+
+	table := CapTable{
+		CompanyID:          companyID,
+		Height:             height,
+		IssuedShares:       math.ZeroInt(),
+		OutstandingShares:  math.ZeroInt(),
+		TreasuryShares:     math.ZeroInt(),
+		FullyDilutedShares: math.ZeroInt(),
+	}
+	classIDs := make([]string, 0, len(classes))
+	for classID := range classes {
+		classIDs = append(classIDs, classID)
+	}
+	sort.Strings(classIDs)
+
+	for _, classID := range classIDs {
+		c := classes[classID]
+		c.FullyDilutedShares = c.OutstandingShares.Add(c.GrantShares)
+		for _, h := range holders[classID] {
+			h.OutstandingPercent = percentOf(h.Shares, c.OutstandingShares)
+			h.FullyDilutedPercent = percentOf(h.Shares.Add(h.GrantShares), c.FullyDilutedShares)
+			c.Holders = append(c.Holders, *h)
+		}
+		sort.Slice(c.Holders, func(i, j int) bool {
+			if !c.Holders[i].Shares.Equal(c.Holders[j].Shares) {
+				return c.Holders[i].Shares.GT(c.Holders[j].Shares)
+			}
+			return c.Holders[i].Address < c.Holders[j].Address
+		})
+		sort.Slice(c.BeneficialOwners, func(i, j int) bool {
+			a, b := c.BeneficialOwners[i], c.BeneficialOwners[j]
+			if a.Custodian != b.Custodian {
+				return a.Custodian < b.Custodian
+			}
+			if a.Owner != b.Owner {
+				return a.Owner < b.Owner
+			}
+			return a.ReferenceID < b.ReferenceID
+		})
+
+		table.IssuedShares = table.IssuedShares.Add(c.IssuedShares)
+		table.OutstandingShares = table.OutstandingShares.Add(c.OutstandingShares)
+		table.TreasuryShares = table.TreasuryShares.Add(c.TreasuryShares)
+		table.FullyDilutedShares = table.FullyDilutedShares.Add(c.FullyDilutedShares)
+		table.Classes = append(table.Classes, *c)
+	}
+	return table
+}
+
+func percentOf(part, whole math.Int) math.LegacyDec {
+	if !whole.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyNewDecFromInt(part).QuoInt(whole).MulInt64(100)
+}
+
+// CapTableCSVHeader is the column layout of a cap table CSV export
+var CapTableCSVHeader = []string{
+	"company_id", "height", "row_type", "class_id", "address", "custodian", "reference_id",
+	"issued_shares", "outstanding_shares", "treasury_shares", "grant_shares", "fully_diluted_shares",
+	"shares", "outstanding_percent", "fully_diluted_percent",
+}
+
+// ExportCSV renders the cap table as CSV: one "class" row with the class totals,
+// followed by a "holder" row per holder and a "beneficial" row per custodied position
+func (t CapTable) ExportCSV() (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(CapTableCSVHeader); err != nil {
+		return "", err
+	}
+
+	company := fmt.Sprintf("%d", t.CompanyID)
+	height := fmt.Sprintf("%d", t.Height)
+	for _, c := range t.Classes {
+		rows := [][]string{{
+			company, height, "class", c.ClassID, "", "", "",
+			c.IssuedShares.String(), c.OutstandingShares.String(), c.TreasuryShares.String(),
+			c.GrantShares.String(), c.FullyDilutedShares.String(), "", "", "",
+		}}
+		for _, h := range c.Holders {
+			rows = append(rows, []string{
+				company, height, "holder", c.ClassID, h.Address, "", "",
+				"", "", "", h.GrantShares.String(), "",
+				h.Shares.String(), h.OutstandingPercent.String(), h.FullyDilutedPercent.String(),
+			})
+		}
+		for _, b := range c.BeneficialOwners {
+			rows = append(rows, []string{
+				company, height, "beneficial", c.ClassID, b.Owner, b.Custodian, fmt.Sprintf("%d", b.ReferenceID),
+				"", "", "", "", "",
+				b.Shares.String(), percentOf(b.Shares, c.OutstandingShares).String(), "",
+			})
+		}
+		if err := w.WriteAll(rows); err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// ExportJSON renders the cap table as indented JSON
+func (t CapTable) ExportJSON() (string, error) {
+	bz, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// Cap table export formats
+const (
+	CapTableFormatJSON = "json"
+	CapTableFormatCSV  = "csv"
+)
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// =============================================================================
+// CAPITAL STRUCTURE CHANGES
+// Changes to the economic terms of a share class are proposed by the company
+// and take effect only once shareholders pass a capital structure proposal
+// approving them
+// =============================================================================
+
+// CapitalChangeStatus represents the status of a proposed capital structure change
+type CapitalChangeStatus int32
+
+const (
+	CapitalChangeStatusPending   CapitalChangeStatus = iota // Proposed, awaiting a capital structure proposal
+	CapitalChangeStatusApplied                              // Approved by governance and in effect
+	CapitalChangeStatusCancelled                            // Withdrawn before approval
+)
+
+func (s CapitalChangeStatus) String() string {
+	switch s {
+	case CapitalChangeStatusPending:
+		return "pending"
+	case CapitalChangeStatusApplied:
+		return "applied"
+	case CapitalChangeStatusCancelled:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
+// CapitalStructureChange is a proposed change to the terms of a share class
+type CapitalStructureChange struct {
+	ID        uint64 `json:"id"`
+	CompanyID uint64 `json:"company_id"`
+	ClassID   string `json:"class_id"`
+
+	// New terms of the class; exactly one is set
+	LiquidationPreference *LiquidationPreference `json:"liquidation_preference,omitempty"`
+	ConversionTerms       *ConversionTerms       `json:"conversion_terms,omitempty"`
+
+	Status     CapitalChangeStatus `json:"status"`
+	ProposedBy string              `json:"proposed_by"`
+	ProposalID uint64              `json:"proposal_id"` // Capital structure proposal that approved the change
+	CreatedAt  time.Time           `json:"created_at"`
+	AppliedAt  time.Time           `json:"applied_at"`
+}
+
+// Validate validates the capital structure change
+func (c CapitalStructureChange) Validate() error {
+	if c.CompanyID == 0 {
+		return fmt.Errorf("company ID cannot be zero")
+	}
+	if c.ClassID == "" {
+		return fmt.Errorf("class ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.ProposedBy); err != nil {
+		return fmt.Errorf("invalid proposer address: %v", err)
+	}
+	switch {
+	case c.LiquidationPreference != nil && c.ConversionTerms != nil:
+		return fmt.Errorf("change can set only one kind of terms")
+	case c.LiquidationPreference != nil:
+		pref := c.LiquidationPreference
+		if pref.CompanyID != c.CompanyID || pref.ClassID != c.ClassID {
+			return fmt.Errorf("liquidation preference is for another share class")
+		}
+		return pref.Validate()
+	case c.ConversionTerms != nil:
+		terms := c.ConversionTerms
+		if terms.CompanyID != c.CompanyID || terms.SourceClassID != c.ClassID {
+			return fmt.Errorf("conversion terms are for another share class")
+		}
+		return terms.Validate()
+	default:
+		return fmt.Errorf("change sets no terms")
+	}
+}
+
+// Capital structure change event types
+const (
+	EventTypeCapitalChangeProposed = "capital_change_proposed"
+	EventTypeCapitalChangeApplied  = "capital_change_applied"
+
+	AttributeKeyCapitalChangeID = "capital_change_id"
+)
@@ -0,0 +1,256 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ComplianceRuleType identifies a transfer-restriction rule of a share class
+type ComplianceRuleType int32
+
+const (
+	ComplianceRuleMaxHolders    ComplianceRuleType = iota // Caps the number of holders of the class
+	ComplianceRuleOwnershipCap                            // Caps a single holder's share of the outstanding class
+	ComplianceRuleJurisdiction                            // Recipient must attest to an allowed jurisdiction
+	ComplianceRuleAccreditation                           // Recipient must hold a current accredited-investor attestation
+	ComplianceRuleHoldingPeriod                           // Sender must have held the class for a minimum period
+	ComplianceRuleWhitelist                               // Recipient must be on the class whitelist
+)
+
+func (t ComplianceRuleType) String() string {
+	switch t {
+	case ComplianceRuleMaxHolders:
+		return "max_holders"
+	case ComplianceRuleOwnershipCap:
+		return "ownership_cap"
+	case ComplianceRuleJurisdiction:
+		return "jurisdiction"
+	case ComplianceRuleAccreditation:
+		return "accreditation"
+	case ComplianceRuleHoldingPeriod:
+		return "holding_period"
+	case ComplianceRuleWhitelist:
+		return "whitelist"
+	default:
+		return "unknown"
+	}
+}
+
+// ComplianceRule is one transfer-restriction rule. Only the fields of its Type are used.
+type ComplianceRule struct {
+	Type ComplianceRuleType `json:"type"`
+
+	MaxHolders       uint64         `json:"max_holders,omitempty"`        // MaxHolders: holder limit
+	MaxOwnership     math.LegacyDec `json:"max_ownership"`                // OwnershipCap: fraction of outstanding shares
+	Jurisdictions    []string       `json:"jurisdictions,omitempty"`      // Jurisdiction: allowed jurisdiction codes
+	MinHoldingPeriod time.Duration  `json:"min_holding_period,omitempty"` // HoldingPeriod: time since acquisition
+	Whitelist        []string       `json:"whitelist,omitempty"`          // Whitelist: allowed holders
+}
+
+// Validate validates a compliance rule
+func (r ComplianceRule) Validate() error {
+	switch r.Type {
+	case ComplianceRuleMaxHolders:
+		if r.MaxHolders == 0 {
+			return fmt.Errorf("max holders must be positive")
+		}
+	case ComplianceRuleOwnershipCap:
+		if r.MaxOwnership.IsNil() || !r.MaxOwnership.IsPositive() || r.MaxOwnership.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("max ownership must be in (0, 1]")
+		}
+	case ComplianceRuleJurisdiction:
+		if len(r.Jurisdictions) == 0 {
+			return fmt.Errorf("at least one jurisdiction is required")
+		}
+	case ComplianceRuleAccreditation:
+	case ComplianceRuleHoldingPeriod:
+		if r.MinHoldingPeriod <= 0 {
+			return fmt.Errorf("holding period must be positive")
+		}
+	case ComplianceRuleWhitelist:
+		if len(r.Whitelist) == 0 {
+			return fmt.Errorf("whitelist cannot be empty")
+		}
+		for _, addr := range r.Whitelist {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return fmt.Errorf("invalid whitelist address %s: %w", addr, err)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown compliance rule type %d", r.Type)
+	}
+	return nil
+}
+
+// ClassComplianceRules is the rule set every transfer of a share class is checked against
+type ClassComplianceRules struct {
+	CompanyID uint64           `json:"company_id"`
+	ClassID   string           `json:"class_id"`
+	Rules     []ComplianceRule `json:"rules"`
+	UpdatedBy string           `json:"updated_by"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+// Validate validates a class rule set
+func (c ClassComplianceRules) Validate() error {
+	if c.CompanyID == 0 {
+		return fmt.Errorf("company ID cannot be zero")
+	}
+	if c.ClassID == "" {
+		return fmt.Errorf("class ID cannot be empty")
+	}
+	seen := make(map[ComplianceRuleType]bool)
+	for _, rule := range c.Rules {
+		if seen[rule.Type] {
+			return fmt.Errorf("duplicate %s rule", rule.Type)
+		}
+		seen[rule.Type] = true
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("%s rule: %w", rule.Type, err)
+		}
+	}
+	return nil
+}
+
+// InvestorAttestation records the jurisdiction and accreditation of an investor,
+// attested by the company for use by its compliance rules
+type InvestorAttestation struct {
+	CompanyID       uint64    `json:"company_id"`
+	Address         string    `json:"address"`
+	Jurisdiction    string    `json:"jurisdiction"` // e.g. ISO 3166 country code
+	Accredited      bool      `json:"accredited"`
+	AccreditedUntil time.Time `json:"accredited_until,omitempty"` // Zero means no expiry
+	AttestedBy      string    `json:"attested_by"`
+	AttestedAt      time.Time `json:"attested_at"`
+}
+
+// Validate validates an investor attestation
+func (a InvestorAttestation) Validate() error {
+	if a.CompanyID == 0 {
+		return fmt.Errorf("company ID cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return fmt.Errorf("invalid investor address: %w", err)
+	}
+	if a.Jurisdiction == "" && !a.Accredited {
+		return fmt.Errorf("attestation must record a jurisdiction or accreditation")
+	}
+	return nil
+}
+
+// IsAccredited returns true if the attestation shows accreditation at the given time
+func (a InvestorAttestation) IsAccredited(now time.Time) bool {
+	return a.Accredited && (a.AccreditedUntil.IsZero() || now.Before(a.AccreditedUntil))
+}
+
+// ComplianceInput is the state a transfer is evaluated against
+type ComplianceInput struct {
+	From              string
+	To                string
+	Shares            math.Int
+	Now               time.Time
+	FromBalance       math.Int             // Sender's shares before the transfer
+	FromAcquiredAt    time.Time            // When the sender acquired the shares
+	ToBalance         math.Int             // Recipient's shares before the transfer
+	Holders           uint64               // Current holders of the class
+	OutstandingShares math.Int             // Outstanding shares of the class
+	ToAttestation     *InvestorAttestation // Recipient's attestation, nil if none
+}
+
+// ComplianceRejection is the structured reason a transfer was rejected
+type ComplianceRejection struct {
+	Rule    ComplianceRuleType `json:"rule"`
+	Address string             `json:"address"` // Party that failed the rule
+	Reason  string             `json:"reason"`
+}
+
+func (r ComplianceRejection) Error() string {
+	return fmt.Sprintf("%s: %s", r.Rule, r.Reason)
+}
+
+// Evaluate checks a transfer against every rule of the class and returns the first
+// rejection, or nil if the transfer is compliant. Rules are applied in the order set.
+func (c ClassComplianceRules) Evaluate(in ComplianceInput) *ComplianceRejection {
+	for _, rule := range c.Rules {
+		if rejection := rule.evaluate(in); rejection != nil {
+			return rejection
+		}
+	}
+	return nil
+}
+
+func (r ComplianceRule) evaluate(in ComplianceInput) *ComplianceRejection {
+	reject := func(address, format string, args ...interface{}) *ComplianceRejection {
+		return &ComplianceRejection{Rule: r.Type, Address: address, Reason: fmt.Sprintf(format, args...)}
+	}
+
+	switch r.Type {
+	case ComplianceRuleMaxHolders:
+		// Only a transfer to a new holder that leaves the sender holding adds a holder
+		if !in.ToBalance.IsZero() || in.FromBalance.Equal(in.Shares) {
+			return nil
+		}
+		if in.Holders+1 > r.MaxHolders {
+			return reject(in.To, "class would have %d holders, limit is %d", in.Holders+1, r.MaxHolders)
+		}
+
+	case ComplianceRuleOwnershipCap:
+		if !in.OutstandingShares.IsPositive() {
+			return nil
+		}
+		ownership := math.LegacyNewDecFromInt(in.ToBalance.Add(in.Shares)).QuoInt(in.OutstandingShares)
+		if ownership.GT(r.MaxOwnership) {
+			return reject(in.To, "would own %s of the class, cap is %s", ownership, r.MaxOwnership)
+		}
+
+	case ComplianceRuleJurisdiction:
+		if in.ToAttestation == nil || in.ToAttestation.Jurisdiction == "" {
+			return reject(in.To, "no jurisdiction attested")
+		}
+		for _, jurisdiction := range r.Jurisdictions {
+			if jurisdiction == in.ToAttestation.Jurisdiction {
+				return nil
+			}
+		}
+		return reject(in.To, "jurisdiction %s is not allowed", in.ToAttestation.Jurisdiction)
+
+	case ComplianceRuleAccreditation:
+		if in.ToAttestation == nil || !in.ToAttestation.IsAccredited(in.Now) {
+			return reject(in.To, "no current accredited-investor attestation")
+		}
+
+	case ComplianceRuleHoldingPeriod:
+		if in.FromAcquiredAt.IsZero() {
+			return nil
+		}
+		if eligible := in.FromAcquiredAt.Add(r.MinHoldingPeriod); in.Now.Before(eligible) {
+			return reject(in.From, "shares held until %s before they can be transferred", eligible.Format(time.RFC3339))
+		}
+
+	case ComplianceRuleWhitelist:
+		for _, addr := range r.Whitelist {
+			if addr == in.To {
+				return nil
+			}
+		}
+		return reject(in.To, "not on the class whitelist")
+	}
+	return nil
+}
+
+// Compliance event types
+const (
+	EventTypeComplianceRulesSet = "compliance_rules_set"
+	EventTypeInvestorAttested   = "investor_attested"
+	EventTypeTransferRejected   = "transfer_rejected"
+
+	AttributeKeyComplianceRule  = "compliance_rule"
+	AttributeKeyRejectionReason = "rejection_reason"
+	AttributeKeyRejectedAddress = "rejected_address"
+	AttributeKeyJurisdiction    = "jurisdiction"
+	AttributeKeyAccredited      = "accredited"
+	AttributeKeyComplianceRules = "rule_count"
+)
@@ -0,0 +1,252 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConversionTrigger identifies what caused a share conversion
+type ConversionTrigger int32
+
+const (
+	ConversionTriggerVoluntary            ConversionTrigger = iota // Holder elected to convert
+	ConversionTriggerQualifiedPrimarySale                          // Mandatory: a primary sale met the qualified thresholds
+	ConversionTriggerGovernanceVote                                // Mandatory: approved by a governance proposal
+)
+
+func (t ConversionTrigger) String() string {
+	switch t {
+	case ConversionTriggerVoluntary:
+		return "voluntary"
+	case ConversionTriggerQualifiedPrimarySale:
+		return "qualified_primary_sale"
+	case ConversionTriggerGovernanceVote:
+		return "governance_vote"
+	default:
+		return "unknown"
+	}
+}
+
+// ConversionTerms defines how shares of a class with ConversionRights convert into
+// another class of the same company.
+//
+// The ratio (target shares per source share) is OriginalIssuePrice / ConversionPrice
+// when a conversion price is set, so down-round anti-dilution protection lowers the
+// conversion price and raises the ratio. Terms without a conversion price use the
+// fixed Ratio and are not price-adjusted.
+type ConversionTerms struct {
+	CompanyID     uint64 `json:"company_id"`
+	SourceClassID string `json:"source_class_id"`
+	TargetClassID string `json:"target_class_id"`
+
+	// Ratio
+	Ratio              math.LegacyDec `json:"ratio"`                // Fixed target shares per source share
+	OriginalIssuePrice math.LegacyDec `json:"original_issue_price"` // Price the source class was sold at
+	ConversionPrice    math.LegacyDec `json:"conversion_price"`     // Anti-dilution adjusted conversion price
+
+	// Voluntary conversion
+	Voluntary   bool      `json:"voluntary"`              // Holders may convert at will
+	WindowStart time.Time `json:"window_start,omitempty"` // Zero = no start restriction
+	WindowEnd   time.Time `json:"window_end,omitempty"`   // Zero = no end restriction
+
+	// Mandatory conversion triggers
+	MandatoryOnQualifiedSale bool           `json:"mandatory_on_qualified_sale"`
+	QualifiedSaleMinPrice    math.LegacyDec `json:"qualified_sale_min_price"` // Minimum price per share of the sale
+	QualifiedSaleMinRaise    math.Int       `json:"qualified_sale_min_raise"` // Minimum total proceeds of the sale
+	MandatoryOnGovernance    bool           `json:"mandatory_on_governance"`
+
+	// Set once a mandatory trigger fires; holders may then convert any remaining
+	// shares regardless of Voluntary or the window
+	MandatoryTriggered bool      `json:"mandatory_triggered"`
+	TriggeredAt        time.Time `json:"triggered_at,omitempty"`
+
+	SetBy      string    `json:"set_by"`
+	ProposalID uint64    `json:"proposal_id"` // Capital structure proposal that approved the ratio or issue price
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Validate validates conversion terms
+func (t ConversionTerms) Validate() error {
+	if t.CompanyID == 0 {
+		return fmt.Errorf("company ID cannot be zero")
+	}
+	if t.SourceClassID == "" || t.TargetClassID == "" {
+		return fmt.Errorf("source and target class IDs cannot be empty")
+	}
+	if t.SourceClassID == t.TargetClassID {
+		return fmt.Errorf("source and target class must differ")
+	}
+
+	hasPrice := !t.ConversionPrice.IsNil() && !t.ConversionPrice.IsZero()
+	if hasPrice {
+		if t.ConversionPrice.IsNegative() {
+			return fmt.Errorf("conversion price cannot be negative")
+		}
+		if t.OriginalIssuePrice.IsNil() || !t.OriginalIssuePrice.IsPositive() {
+			return fmt.Errorf("original issue price must be positive when a conversion price is set")
+		}
+	} else if t.Ratio.IsNil() || !t.Ratio.IsPositive() {
+		return fmt.Errorf("either a positive ratio or a conversion price is required")
+	}
+
+	if !t.WindowStart.IsZero() && !t.WindowEnd.IsZero() && !t.WindowEnd.After(t.WindowStart) {
+		return fmt.Errorf("conversion window end must be after start")
+	}
+
+	if t.MandatoryOnQualifiedSale {
+		if t.QualifiedSaleMinPrice.IsNil() || t.QualifiedSaleMinPrice.IsNegative() {
+			return fmt.Errorf("qualified sale minimum price cannot be negative")
+		}
+		if t.QualifiedSaleMinRaise.IsNil() || t.QualifiedSaleMinRaise.IsNegative() {
+			return fmt.Errorf("qualified sale minimum raise cannot be negative")
+		}
+	}
+
+	if !t.Voluntary && !t.MandatoryOnQualifiedSale && !t.MandatoryOnGovernance {
+		return fmt.Errorf("terms must allow voluntary conversion or define a mandatory trigger")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(t.SetBy); err != nil {
+		return fmt.Errorf("invalid address: %v", err)
+	}
+	return nil
+}
+
+// IsPriceBased reports whether the ratio is derived from a conversion price
+func (t ConversionTerms) IsPriceBased() bool {
+	return !t.ConversionPrice.IsNil() && t.ConversionPrice.IsPositive()
+}
+
+// HasSameBasis reports whether other keeps the fixed ratio, or the original issue
+// price a conversion price applies to. Once terms are set, their basis only changes
+// through keeper adjustments or a capital structure proposal.
+func (t ConversionTerms) HasSameBasis(other ConversionTerms) bool {
+	if t.IsPriceBased() != other.IsPriceBased() {
+		return false
+	}
+	if t.IsPriceBased() {
+		return !other.OriginalIssuePrice.IsNil() && t.OriginalIssuePrice.Equal(other.OriginalIssuePrice)
+	}
+	return !other.Ratio.IsNil() && t.Ratio.Equal(other.Ratio)
+}
+
+// ConversionRatio returns the number of target shares per source share
+func (t ConversionTerms) ConversionRatio() math.LegacyDec {
+	if t.IsPriceBased() {
+		return t.OriginalIssuePrice.Quo(t.ConversionPrice)
+	}
+	return t.Ratio
+}
+
+// ConvertedShares returns the whole target shares received for an amount of source
+// shares. Fractions of a target share are forfeited.
+func (t ConversionTerms) ConvertedShares(shares math.Int) math.Int {
+	if shares.IsNil() || !shares.IsPositive() {
+		return math.ZeroInt()
+	}
+	return t.ConversionRatio().MulInt(shares).TruncateInt()
+}
+
+// IsWithinWindow reports whether voluntary conversion is open at the given time
+func (t ConversionTerms) IsWithinWindow(now time.Time) bool {
+	if !t.WindowStart.IsZero() && now.Before(t.WindowStart) {
+		return false
+	}
+	if !t.WindowEnd.IsZero() && now.After(t.WindowEnd) {
+		return false
+	}
+	return true
+}
+
+// CanConvertVoluntarily reports whether a holder may convert at the given time
+func (t ConversionTerms) CanConvertVoluntarily(now time.Time) bool {
+	if t.MandatoryTriggered {
+		return true
+	}
+	return t.Voluntary && t.IsWithinWindow(now)
+}
+
+// IsQualifiedSale reports whether a primary sale at price raising proceeds meets the
+// qualified sale thresholds
+func (t ConversionTerms) IsQualifiedSale(price math.LegacyDec, proceeds math.Int) bool {
+	if !t.MandatoryOnQualifiedSale || t.MandatoryTriggered {
+		return false
+	}
+	if price.IsNil() || proceeds.IsNil() {
+		return false
+	}
+	return price.GTE(t.QualifiedSaleMinPrice) && proceeds.GTE(t.QualifiedSaleMinRaise)
+}
+
+// AdjustedConversionPrice returns the conversion price after a target class issuance
+// of newShares at issuePrice, given outstandingBefore target shares. Full ratchet
+// drops the price to the issue price; weighted average blends the two by share
+// count. The result never rises and never falls below minimumPrice.
+func (t ConversionTerms) AdjustedConversionPrice(
+	provisionType AntiDilutionType,
+	issuePrice math.LegacyDec,
+	outstandingBefore, newShares math.Int,
+	minimumPrice math.LegacyDec,
+) math.LegacyDec {
+	current := t.ConversionPrice
+	if !t.IsPriceBased() || issuePrice.IsNil() || !issuePrice.IsPositive() || issuePrice.GTE(current) {
+		return current
+	}
+
+	adjusted := current
+	switch provisionType {
+	case AntiDilutionFullRatchet:
+		adjusted = issuePrice
+	case AntiDilutionWeightedAverage, AntiDilutionBroadBasedWeightedAverage:
+		total := outstandingBefore.Add(newShares)
+		if total.IsPositive() {
+			adjusted = current.MulInt(outstandingBefore).Add(issuePrice.MulInt(newShares)).QuoInt(total)
+		}
+	default:
+		return current
+	}
+
+	if !minimumPrice.IsNil() && adjusted.LT(minimumPrice) {
+		adjusted = minimumPrice
+	}
+	if adjusted.GT(current) {
+		return current
+	}
+	return adjusted
+}
+
+// ConversionRecord records a completed conversion of one holder's shares
+type ConversionRecord struct {
+	ID            uint64            `json:"id"`
+	CompanyID     uint64            `json:"company_id"`
+	SourceClassID string            `json:"source_class_id"`
+	TargetClassID string            `json:"target_class_id"`
+	Holder        string            `json:"holder"`
+	SourceShares  math.Int          `json:"source_shares"`
+	TargetShares  math.Int          `json:"target_shares"`
+	Ratio         math.LegacyDec    `json:"ratio"`
+	Trigger       ConversionTrigger `json:"trigger"`
+	ReferenceID   uint64            `json:"reference_id,omitempty"` // Primary sale offer or governance proposal
+	ConvertedAt   time.Time         `json:"converted_at"`
+}
+
+// Share conversion event types
+const (
+	EventTypeConversionTermsSet      = "conversion_terms_set"
+	EventTypeSharesConverted         = "shares_converted"
+	EventTypeMandatoryConversion     = "mandatory_conversion"
+	EventTypeConversionPriceAdjusted = "conversion_price_adjusted"
+
+	AttributeKeyConversionID      = "conversion_id"
+	AttributeKeySourceClass       = "source_class"
+	AttributeKeyTargetClass       = "target_class"
+	AttributeKeyConversionRatio   = "conversion_ratio"
+	AttributeKeyConversionPrice   = "conversion_price"
+	AttributeKeyConversionTrigger = "trigger"
+	AttributeKeySourceShares      = "source_shares"
+	AttributeKeyTargetShares      = "target_shares"
+)
@@ -18,6 +18,7 @@ const (
 	DelistingReasonGovernance                             // Governance proposal passed
 	DelistingReasonRegulatoryIssue                        // Regulatory compliance failure
 	DelistingReasonAbandonment                            // Company abandoned (no activity)
+	DelistingReasonMerger                                 // Acquired in a merger; shares exchanged for the acquirer's
 )
 
 func (r DelistingReason) String() string {
@@ -34,6 +35,8 @@ func (r DelistingReason) String() string {
 		return "regulatory_issue"
 	case DelistingReasonAbandonment:
 		return "abandonment"
+	case DelistingReasonMerger:
+		return "merger"
 	default:
 		return "unknown"
 	}
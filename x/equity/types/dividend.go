@@ -89,6 +89,18 @@ type Dividend struct {
 	// For stock dividends
 	StockRatio          math.LegacyDec `json:"stock_ratio,omitempty"`          // New shares per existing share
 	NewSharesIssued     math.Int       `json:"new_shares_issued,omitempty"`    // Total new shares issued
+	StockClassID        string         `json:"stock_class_id,omitempty"`       // Class of the new shares, defaults to ClassID
+	FractionalPrice     math.LegacyDec `json:"fractional_price,omitempty"`     // Currency per whole distributed share, paid for fractions
+	CashInLieuPaid      math.Int       `json:"cash_in_lieu_paid,omitempty"`    // Total cash paid for fractions
+
+	// For property dividends of shares held in the treasury (bank denoms use Currency)
+	PropertyCompanyID    uint64   `json:"property_company_id,omitempty"`    // Company whose shares are distributed
+	PropertyClassID      string   `json:"property_class_id,omitempty"`      // Share class distributed
+	PropertySharesLocked math.Int `json:"property_shares_locked,omitempty"` // Treasury shares still reserved for the dividend
+
+	// Scrip election for cash dividends
+	ScripPrice        math.LegacyDec `json:"scrip_price,omitempty"`         // Currency per share for holders electing scrip, zero if not offered
+	ScripSharesIssued math.Int       `json:"scrip_shares_issued,omitempty"` // Total shares issued in lieu of cash
 	
 	// Administrative
 	Declarant    string    `json:"declarant"`     // Address that declared the dividend
@@ -118,8 +130,10 @@ type DividendPayment struct {
 	NetAmount    math.LegacyDec `json:"net_amount"`    // Net amount paid
 	PaidAt       time.Time      `json:"paid_at"`
 	TxHash       string         `json:"tx_hash"`       // Transaction hash of payment
-	Status       string         `json:"status"`        // "pending", "paid", "failed"
+	Status       string         `json:"status"`        // "pending", "paid", "reinvested", "failed"
 	FailureReason string        `json:"failure_reason,omitempty"`
+	SharesIssued math.Int       `json:"shares_issued,omitempty"` // Stock, property or scrip shares delivered
+	CashInLieu   math.Int       `json:"cash_in_lieu,omitempty"`  // Cash paid for fractional shares
 	CreatedAt    time.Time      `json:"created_at"`
 }
 
@@ -195,6 +209,11 @@ func NewDividend(
 		ShareholdersPaid:     0,
 		StockRatio:      math.LegacyZeroDec(),
 		NewSharesIssued: math.ZeroInt(),
+		FractionalPrice: math.LegacyZeroDec(),
+		CashInLieuPaid:  math.ZeroInt(),
+		PropertySharesLocked: math.ZeroInt(),
+		ScripPrice:        math.LegacyZeroDec(),
+		ScripSharesIssued: math.ZeroInt(),
 		Declarant:       declarant,
 		TaxRate:         math.LegacyZeroDec(),
 		CreatedAt:       now,
@@ -227,6 +246,10 @@ func (d Dividend) Validate() error {
 	if d.Type == DividendTypeCash && d.Currency == "" {
 		return ErrInvalidDividendDate // Reuse error
 	}
+
+	if err := d.validateDistribution(); err != nil {
+		return ErrInvalidDividendDistribution.Wrap(err.Error())
+	}
 	
 	return nil
 }
@@ -284,6 +307,8 @@ func NewDividendPayment(
 		TaxWithheld: math.LegacyZeroDec(),
 		NetAmount:   amount,
 		Status:      "pending",
+		SharesIssued: math.ZeroInt(),
+		CashInLieu:   math.ZeroInt(),
 		CreatedAt:   time.Now(),
 	}
 }
@@ -0,0 +1,170 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// DividendTerms are the declaration options of stock, property and scrip dividends
+type DividendTerms struct {
+	StockClassID      string         `json:"stock_class_id,omitempty"`      // Stock dividends: class of the new shares, defaults to the dividend class
+	FractionalPrice   math.LegacyDec `json:"fractional_price,omitempty"`    // Stock and property share dividends: cash per whole share for fractions
+	PropertyCompanyID uint64         `json:"property_company_id,omitempty"` // Property dividends: company whose treasury-held shares are distributed
+	PropertyClassID   string         `json:"property_class_id,omitempty"`
+	ScripPrice        math.LegacyDec `json:"scrip_price,omitempty"` // Cash dividends: price per share for holders electing scrip
+}
+
+// DividendElection records a holder's choice between cash and scrip for a dividend
+// that offers a scrip election. Holders without an election follow the company's
+// dividend reinvestment default.
+type DividendElection struct {
+	DividendID uint64    `json:"dividend_id"`
+	Holder     string    `json:"holder"`
+	Scrip      bool      `json:"scrip"`
+	ElectedAt  time.Time `json:"elected_at"`
+}
+
+// Dividend distribution payment statuses
+const (
+	DividendPaymentStatusReinvested = "reinvested" // Paid in scrip shares, with any remainder in cash
+)
+
+// Dividend distribution event types
+const (
+	EventTypeDividendElection   = "dividend_election"
+	EventTypeDividendReinvested = "dividend_reinvested"
+	EventTypeDividendCashInLieu = "dividend_cash_in_lieu"
+
+	AttributeKeyDividendID = "dividend_id"
+	AttributeKeyScrip      = "scrip"
+)
+
+// PaysCoins reports whether the dividend is paid in bank coins: cash and special
+// dividends, and property dividends of a bank denom
+func (d Dividend) PaysCoins() bool {
+	switch d.Type {
+	case DividendTypeCash, DividendTypeSpecial:
+		return true
+	case DividendTypeProperty:
+		return d.PropertyCompanyID == 0
+	default:
+		return false
+	}
+}
+
+// IsPropertyShares reports whether the dividend distributes another company's shares
+// held in the treasury
+func (d Dividend) IsPropertyShares() bool {
+	return d.Type == DividendTypeProperty && d.PropertyCompanyID != 0
+}
+
+// NewShareClassID returns the class stock and scrip shares are issued in
+func (d Dividend) NewShareClassID() string {
+	if d.StockClassID != "" {
+		return d.StockClassID
+	}
+	return d.ClassID
+}
+
+// OffersScrip reports whether holders may take the dividend in shares instead of cash
+func (d Dividend) OffersScrip() bool {
+	return d.Type == DividendTypeCash && !d.ScripPrice.IsNil() && d.ScripPrice.IsPositive()
+}
+
+// IsElectionOpen reports whether holders may still change their scrip election.
+// Elections close once payments start or the payment date is reached.
+func (d Dividend) IsElectionOpen(now time.Time) bool {
+	if !d.OffersScrip() {
+		return false
+	}
+	if d.Status != DividendStatusDeclared && d.Status != DividendStatusRecorded {
+		return false
+	}
+	return now.Before(d.PaymentDate)
+}
+
+// ShareEntitlement returns the whole shares distributed for shares held on the record
+// date (StockRatio for stock dividends, AmountPerShare for property share dividends)
+// and the cash paid for the fraction at FractionalPrice
+func (d Dividend) ShareEntitlement(shares math.Int) (math.Int, math.Int) {
+	ratio := d.StockRatio
+	if d.IsPropertyShares() {
+		ratio = d.AmountPerShare
+	}
+	if shares.IsNil() || !shares.IsPositive() || ratio.IsNil() || !ratio.IsPositive() {
+		return math.ZeroInt(), math.ZeroInt()
+	}
+
+	exact := ratio.MulInt(shares)
+	whole := exact.TruncateInt()
+	cash := math.ZeroInt()
+	if !d.FractionalPrice.IsNil() && d.FractionalPrice.IsPositive() {
+		cash = exact.Sub(math.LegacyNewDecFromInt(whole)).Mul(d.FractionalPrice).TruncateInt()
+	}
+	return whole, cash
+}
+
+// ScripEntitlement splits a net cash payment into whole scrip shares at ScripPrice
+// and the cash remainder
+func (d Dividend) ScripEntitlement(net math.LegacyDec) (math.Int, math.LegacyDec) {
+	if !d.OffersScrip() || net.IsNil() || !net.IsPositive() {
+		return math.ZeroInt(), net
+	}
+	shares := net.Quo(d.ScripPrice).TruncateInt()
+	return shares, net.Sub(d.ScripPrice.MulInt(shares))
+}
+
+// validateDistribution checks the stock, property and scrip terms of a dividend
+func (d Dividend) validateDistribution() error {
+	if !d.FractionalPrice.IsNil() && d.FractionalPrice.IsNegative() {
+		return fmt.Errorf("fractional price cannot be negative")
+	}
+	if !d.ScripPrice.IsNil() && d.ScripPrice.IsNegative() {
+		return fmt.Errorf("scrip price cannot be negative")
+	}
+	paysFractions := !d.FractionalPrice.IsNil() && d.FractionalPrice.IsPositive()
+
+	switch d.Type {
+	case DividendTypeStock:
+		if d.StockRatio.IsNil() || !d.StockRatio.IsPositive() {
+			return fmt.Errorf("stock dividend requires a positive stock ratio")
+		}
+		if d.NewShareClassID() == "" {
+			return fmt.Errorf("stock dividend requires a share class for the new shares")
+		}
+		if paysFractions && d.Currency == "" {
+			return fmt.Errorf("cash in lieu of fractions requires a currency")
+		}
+	case DividendTypeProperty:
+		if d.PropertyCompanyID == 0 {
+			if d.Currency == "" {
+				return fmt.Errorf("property dividend requires a denom or a company's shares")
+			}
+			break
+		}
+		if d.PropertyCompanyID == d.CompanyID {
+			return fmt.Errorf("property dividend cannot distribute the company's own shares; use a stock dividend")
+		}
+		if d.PropertyClassID == "" {
+			return fmt.Errorf("property dividend requires the class of the distributed shares")
+		}
+		if d.AmountPerShare.IsNil() || !d.AmountPerShare.IsPositive() {
+			return fmt.Errorf("property dividend requires a positive amount per share")
+		}
+		if paysFractions && d.Currency == "" {
+			return fmt.Errorf("cash in lieu of fractions requires a currency")
+		}
+	}
+
+	if !d.ScripPrice.IsNil() && d.ScripPrice.IsPositive() {
+		if d.Type != DividendTypeCash {
+			return fmt.Errorf("only cash dividends offer a scrip election")
+		}
+		if d.NewShareClassID() == "" {
+			return fmt.Errorf("scrip election requires a share class for the new shares")
+		}
+	}
+	return nil
+}
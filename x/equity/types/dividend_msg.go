@@ -25,6 +25,13 @@ type MsgDeclareDividend struct {
 	PaymentMethod   string         `json:"payment_method"`     // "automatic", "manual", "claim"
 	StockRatio      math.LegacyDec `json:"stock_ratio,omitempty"` // For stock dividends: new shares per existing share
 
+	// Stock, property and scrip distribution terms (see DividendTerms)
+	StockClassID      string         `json:"stock_class_id,omitempty"`      // For stock dividends: class of the new shares
+	FractionalPrice   math.LegacyDec `json:"fractional_price,omitempty"`    // Cash in lieu per whole share for fractions
+	PropertyCompanyID uint64         `json:"property_company_id,omitempty"` // For property dividends of treasury-held shares
+	PropertyClassID   string         `json:"property_class_id,omitempty"`
+	ScripPrice        math.LegacyDec `json:"scrip_price,omitempty"` // For cash dividends: price of scrip shares, zero if not offered
+
 	// Audit document (MANDATORY for dividend declaration)
 	// This ensures company owners provide proper documentation before distributing dividends
 	Audit           AuditInfo      `json:"audit"`              // Audit document information
@@ -339,6 +346,41 @@ type MsgEnableDividendReinvestmentResponse struct {
 	Success bool `json:"success"`
 }
 
+// MsgElectDividendScrip represents a holder's election between cash and scrip for a dividend
+type MsgElectDividendScrip struct {
+	Holder     string `json:"holder"`
+	DividendID uint64 `json:"dividend_id"`
+	Scrip      bool   `json:"scrip"` // true for new shares, false for cash
+}
+
+// GetSigners implements the Msg interface
+func (msg MsgElectDividendScrip) GetSigners() []sdk.AccAddress {
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{holder}
+}
+
+// ValidateBasic implements the Msg interface
+func (msg MsgElectDividendScrip) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return ErrUnauthorized
+	}
+
+	if msg.DividendID == 0 {
+		return ErrDividendNotFound
+	}
+
+	return nil
+}
+
+// MsgElectDividendScripResponse is the response type for MsgElectDividendScrip
+type MsgElectDividendScripResponse struct {
+	Success bool `json:"success"`
+}
+
 // Helper functions
 
 // NewMsgDeclareDividend creates a new MsgDeclareDividend instance
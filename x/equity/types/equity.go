@@ -142,6 +142,42 @@ type VestingSchedule struct {
 	LastVested    time.Time `json:"last_vested"`
 }
 
+// VestedAmount returns the shares vested at the given time. Nothing vests before the
+// cliff, CliffPercent of the total vests at the cliff, and the remainder vests linearly
+// until EndDate in steps of VestingPeriod (continuously when the period is zero).
+func (v VestingSchedule) VestedAmount(now time.Time) math.Int {
+	if v.TotalShares.IsNil() || !v.TotalShares.IsPositive() {
+		return math.ZeroInt()
+	}
+	if now.Before(v.StartDate) || (!v.CliffDate.IsZero() && now.Before(v.CliffDate)) {
+		return math.ZeroInt()
+	}
+	if !now.Before(v.EndDate) {
+		return v.TotalShares
+	}
+
+	from := v.StartDate
+	atCliff := math.ZeroInt()
+	if !v.CliffDate.IsZero() {
+		from = v.CliffDate
+		if !v.CliffPercent.IsNil() && v.CliffPercent.IsPositive() {
+			atCliff = math.MinInt(v.CliffPercent.MulInt(v.TotalShares).TruncateInt(), v.TotalShares)
+		}
+	}
+
+	elapsed := now.Sub(from)
+	if v.VestingPeriod > 0 {
+		elapsed = elapsed / v.VestingPeriod * v.VestingPeriod
+	}
+	span := v.EndDate.Sub(from)
+	if span <= 0 {
+		return v.TotalShares
+	}
+
+	linear := v.TotalShares.Sub(atCliff).Mul(math.NewInt(int64(elapsed))).Quo(math.NewInt(int64(span)))
+	return math.MinInt(atCliff.Add(linear), v.TotalShares)
+}
+
 // Validation methods
 
 // Validate validates a Company
@@ -190,4 +190,96 @@ var (
 	ErrNotShareholder        = errors.Register(ModuleName, 304, "must be a shareholder to participate in petition")
 	ErrNotPetitionCreator    = errors.Register(ModuleName, 305, "only petition creator can perform this action")
 	ErrInvalidAddress        = errors.Register(ModuleName, 306, "invalid address")
-)
\ No newline at end of file
+
+	// Stock split errors
+	ErrStockSplitNotFound     = errors.Register(ModuleName, 310, "stock split not found")
+	ErrInvalidStockSplit      = errors.Register(ModuleName, 311, "invalid stock split")
+	ErrStockSplitPending      = errors.Register(ModuleName, 312, "a stock split is already pending for this share class")
+	ErrStockSplitNotPending   = errors.Register(ModuleName, 313, "stock split is not pending")
+	ErrStockSplitNotApproved  = errors.Register(ModuleName, 314, "stock split has not been approved")
+
+	// Share conversion errors
+	ErrConversionTermsNotFound = errors.Register(ModuleName, 320, "conversion terms not found")
+	ErrInvalidConversionTerms  = errors.Register(ModuleName, 321, "invalid conversion terms")
+	ErrConversionNotAllowed    = errors.Register(ModuleName, 322, "share class does not have conversion rights")
+	ErrConversionWindowClosed  = errors.Register(ModuleName, 323, "conversion is not open for this share class")
+	ErrConversionTooSmall      = errors.Register(ModuleName, 324, "conversion would yield no target shares")
+	ErrMandatoryNotPermitted   = errors.Register(ModuleName, 325, "mandatory conversion trigger not permitted by terms")
+
+	// Liquidation preference and dissolution errors
+	ErrInvalidLiquidationPreference = errors.Register(ModuleName, 330, "invalid liquidation preference")
+	ErrNoLiquidationPreference      = errors.Register(ModuleName, 331, "share class does not carry a liquidation preference")
+	ErrDissolutionNotFound          = errors.Register(ModuleName, 332, "dissolution not found")
+	ErrInvalidDissolution           = errors.Register(ModuleName, 333, "invalid dissolution")
+	ErrDissolutionPending           = errors.Register(ModuleName, 334, "a dissolution is already pending for this company")
+	ErrDissolutionNotPending        = errors.Register(ModuleName, 335, "dissolution is not pending")
+	ErrDissolutionNotApproved       = errors.Register(ModuleName, 336, "dissolution has not been approved")
+
+	// Option and warrant errors
+	ErrEquityGrantNotFound    = errors.Register(ModuleName, 340, "equity grant not found")
+	ErrInvalidEquityGrant     = errors.Register(ModuleName, 341, "invalid equity grant")
+	ErrGrantNotExercisable    = errors.Register(ModuleName, 342, "grant shares are not exercisable")
+	ErrGrantPoolExhausted     = errors.Register(ModuleName, 343, "not enough unreserved shares to back the grant")
+	ErrGrantOutOfTheMoney     = errors.Register(ModuleName, 344, "grant is not in the money for a cashless exercise")
+	ErrCashlessSaleIncomplete = errors.Register(ModuleName, 345, "cashless exercise sale was not fully filled")
+	ErrDexUnavailable         = errors.Register(ModuleName, 346, "dex keeper not available")
+
+	// Tender offer and buyback errors
+	ErrTenderOfferNotFound  = errors.Register(ModuleName, 350, "tender offer not found")
+	ErrInvalidTenderOffer   = errors.Register(ModuleName, 351, "invalid tender offer")
+	ErrTenderOfferClosed    = errors.Register(ModuleName, 352, "tender offer is not accepting tenders")
+	ErrTenderOfferOpen      = errors.Register(ModuleName, 353, "a tender offer is already open for this share class")
+	ErrInvalidTender        = errors.Register(ModuleName, 354, "invalid tender")
+	ErrTenderNotFound       = errors.Register(ModuleName, 355, "tender not found")
+	ErrBuybackNotFound      = errors.Register(ModuleName, 356, "buyback program not found")
+	ErrInvalidBuyback       = errors.Register(ModuleName, 357, "invalid buyback program")
+	ErrBuybackInactive      = errors.Register(ModuleName, 358, "buyback program is not active")
+	ErrBuybackLimitExceeded = errors.Register(ModuleName, 359, "buyback exceeds daily cap or budget")
+
+	// Rights offering errors
+	ErrRightsOfferingRequired     = errors.Register(ModuleName, 360, "rights offering shares can only be bought by exercising subscription rights")
+	ErrNotRightsOffering          = errors.Register(ModuleName, 361, "offer is not a rights offering")
+	ErrInvalidRightsOffering      = errors.Register(ModuleName, 362, "invalid rights offering")
+	ErrRightsExpired              = errors.Register(ModuleName, 363, "subscription rights are not exercisable")
+	ErrInsufficientRights         = errors.Register(ModuleName, 364, "insufficient subscription rights")
+	ErrOversubscriptionIneligible = errors.Register(ModuleName, 365, "only holders who exercised rights may oversubscribe")
+
+	// Transfer compliance errors
+	ErrComplianceViolation   = errors.Register(ModuleName, 370, "transfer violates share class compliance rules")
+	ErrInvalidComplianceRule = errors.Register(ModuleName, 371, "invalid compliance rule")
+	ErrAttestationNotFound   = errors.Register(ModuleName, 372, "investor attestation not found")
+
+	// Cap table errors
+	ErrCapTableUnavailable = errors.Register(ModuleName, 380, "cap table history unavailable at height")
+	ErrInvalidExportFormat = errors.Register(ModuleName, 381, "invalid cap table export format")
+
+	// Merger errors
+	ErrMergerNotFound        = errors.Register(ModuleName, 390, "merger not found")
+	ErrInvalidMerger         = errors.Register(ModuleName, 391, "invalid merger")
+	ErrMergerPending         = errors.Register(ModuleName, 392, "a merger is already open for this company")
+	ErrMergerNotPending      = errors.Register(ModuleName, 393, "merger is not pending")
+	ErrMergerNotApproved     = errors.Register(ModuleName, 394, "merger has not been approved by both companies")
+	ErrAppraisalWindowClosed = errors.Register(ModuleName, 395, "merger appraisal window is closed")
+	ErrAppraisalWindowOpen   = errors.Register(ModuleName, 396, "merger appraisal window is still open")
+
+	// Dividend distribution errors
+	ErrInvalidDividendDistribution = errors.Register(ModuleName, 400, "invalid dividend distribution terms")
+	ErrScripNotOffered             = errors.Register(ModuleName, 401, "dividend does not offer a scrip election")
+	ErrDividendElectionClosed      = errors.Register(ModuleName, 402, "dividend election window is closed")
+
+	// Board errors
+	ErrBoardNotFound        = errors.Register(ModuleName, 410, "company has no board")
+	ErrInvalidBoard         = errors.Register(ModuleName, 411, "invalid board")
+	ErrNotDirector          = errors.Register(ModuleName, 412, "address is not a director of the company")
+	ErrDirectorSeated       = errors.Register(ModuleName, 413, "director already holds a seat")
+	ErrBoardFull            = errors.Register(ModuleName, 414, "all board seats are filled")
+	ErrBoardElected         = errors.Register(ModuleName, 415, "board is elected; seats change only through shareholder elections")
+	ErrInvalidBoardElection = errors.Register(ModuleName, 416, "invalid board election")
+
+	// Capital structure change errors
+	ErrCapitalChangeNotFound   = errors.Register(ModuleName, 420, "capital structure change not found")
+	ErrCapitalChangeNotPending = errors.Register(ModuleName, 421, "capital structure change is not pending")
+	ErrLiquidationTermsLocked  = errors.Register(ModuleName, 422, "liquidation preferences cannot change while a dissolution or delisting compensation is open")
+	ErrInvalidCapitalChange    = errors.Register(ModuleName, 423, "invalid capital structure change")
+	ErrConversionBasisLocked   = errors.Register(ModuleName, 424, "conversion ratio and original issue price change only through a capital structure proposal")
+)
@@ -96,4 +96,82 @@ type BeneficialOwnerKeeper interface {
 type ValidatorKeeper interface {
 	// IsValidator checks if an address is currently a validator
 	IsValidator(ctx sdk.Context, addr sdk.AccAddress) bool
-}
\ No newline at end of file
+}
+
+// DexKeeper defines the expected DEX keeper interface
+// Used to sell shares at market for cashless option and warrant exercise and to
+// place treasury-owned buy orders for open-market buybacks
+type DexKeeper interface {
+	// GetLastTradePrice returns the last traded price of symbol quoted in quoteSymbol
+	GetLastTradePrice(ctx sdk.Context, symbol, quoteSymbol string) math.LegacyDec
+	// PlaceMarketSellOrder sells quantity of the market's base asset immediately and
+	// returns the quantity filled and the net quote proceeds received by the seller
+	PlaceMarketSellOrder(ctx sdk.Context, seller string, marketSymbol string, quantity math.Int) (math.Int, math.Int, error)
+	// PlaceLimitBuyOrder buys up to quantity of the market's base asset immediately at or
+	// below price and returns the quantity filled and the quote amount spent
+	PlaceLimitBuyOrder(ctx sdk.Context, buyer string, marketSymbol string, quantity math.Int, price math.LegacyDec) (math.Int, math.Int, error)
+}
+
+// ShareSplitHooks lets modules that hold equity on behalf of users (DEX orders and
+// pools, escrows, loan collateral) rescale their own records when a split executes.
+// Implementations must round down with the same rule as StockSplit.ScaleShares so
+// their records stay consistent with the beneficial owner registry.
+type ShareSplitHooks interface {
+	AfterShareSplit(ctx sdk.Context, companyID uint64, classID, symbol string, numerator, denominator uint64) error
+}
+
+// MultiShareSplitHooks combines multiple share split hooks
+type MultiShareSplitHooks []ShareSplitHooks
+
+// NewMultiShareSplitHooks creates a hook set called in order
+func NewMultiShareSplitHooks(hooks ...ShareSplitHooks) MultiShareSplitHooks {
+	return hooks
+}
+
+func (h MultiShareSplitHooks) AfterShareSplit(ctx sdk.Context, companyID uint64, classID, symbol string, numerator, denominator uint64) error {
+	for _, hook := range h {
+		if err := hook.AfterShareSplit(ctx, companyID, classID, symbol, numerator, denominator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ShareExchangeHooks lets modules that hold equity on behalf of users (DEX orders and
+// pools, escrows, loan collateral) move their records of a target share class to the
+// acquirer's class when a merger executes. A custodied amount of target shares becomes
+// amount*numerator/denominator acquirer shares, rounded down as in Merger.ExchangeShares;
+// a zero numerator means the deal is all cash. The equity module has already exchanged
+// the symbol balances held by the module accounts and the beneficial owner registry,
+// and paid any cash consideration to the beneficial owners.
+type ShareExchangeHooks interface {
+	AfterShareExchange(
+		ctx sdk.Context,
+		targetID uint64, targetClassID, targetSymbol string,
+		acquirerID uint64, acquirerClassID, acquirerSymbol string,
+		numerator, denominator uint64,
+	) error
+}
+
+// MultiShareExchangeHooks combines multiple share exchange hooks
+type MultiShareExchangeHooks []ShareExchangeHooks
+
+// NewMultiShareExchangeHooks creates a hook set called in order
+func NewMultiShareExchangeHooks(hooks ...ShareExchangeHooks) MultiShareExchangeHooks {
+	return hooks
+}
+
+func (h MultiShareExchangeHooks) AfterShareExchange(
+	ctx sdk.Context,
+	targetID uint64, targetClassID, targetSymbol string,
+	acquirerID uint64, acquirerClassID, acquirerSymbol string,
+	numerator, denominator uint64,
+) error {
+	for _, hook := range h {
+		if err := hook.AfterShareExchange(ctx, targetID, targetClassID, targetSymbol,
+			acquirerID, acquirerClassID, acquirerSymbol, numerator, denominator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -131,6 +131,67 @@ var (
 	PetitionCounterKey       = []byte{0x81}  // global counter for petition IDs
 	PetitionByCompanyPrefix  = []byte{0x82}  // company_id -> []petition_id (index)
 	PetitionByCreatorPrefix  = []byte{0x83}  // creator -> []petition_id (index)
+
+	// Stock split prefixes
+	StockSplitPrefix          = []byte{0x90}  // split_id -> StockSplit
+	StockSplitCounterKey      = []byte{0x91}  // global counter for split IDs
+	StockSplitByCompanyPrefix = []byte{0x92}  // company_id -> []split_id (index)
+
+	// Share conversion prefixes
+	ConversionTermsPrefix         = []byte{0x93}  // company_id + source_class -> ConversionTerms
+	ConversionRecordPrefix        = []byte{0x94}  // conversion_id -> ConversionRecord
+	ConversionCounterKey          = []byte{0x95}  // global counter for conversion IDs
+	ConversionByCompanyPrefix     = []byte{0x96}  // company_id -> []conversion_id (index)
+
+	// Liquidation preference and dissolution prefixes
+	LiquidationPreferencePrefix = []byte{0x97}  // company_id + class_id -> LiquidationPreference
+	DissolutionPrefix           = []byte{0x98}  // dissolution_id -> Dissolution
+	DissolutionCounterKey       = []byte{0x99}  // global counter for dissolution IDs
+	DissolutionByCompanyPrefix  = []byte{0x9A}  // company_id -> []dissolution_id (index)
+
+	// Option and warrant grant prefixes
+	EquityGrantPrefix          = []byte{0x9B}  // grant_id -> EquityGrant
+	EquityGrantCounterKey      = []byte{0x9C}  // global counter for grant IDs
+	EquityGrantByCompanyPrefix = []byte{0x9D}  // company_id -> []grant_id (index)
+
+	// Tender offer and buyback program prefixes
+	TenderOfferPrefix             = []byte{0x9E}  // offer_id -> TenderOffer
+	TenderOfferCounterKey         = []byte{0x9F}  // global counter for tender offer IDs
+	TenderOfferByCompanyPrefix    = []byte{0xA0}  // company_id -> []offer_id (index)
+	TenderPrefix                  = []byte{0xA1}  // offer_id + holder -> Tender
+	BuybackProgramPrefix          = []byte{0xA2}  // buyback_id -> BuybackProgram
+	BuybackProgramCounterKey      = []byte{0xA3}  // global counter for buyback program IDs
+	BuybackProgramByCompanyPrefix = []byte{0xA4}  // company_id -> []buyback_id (index)
+
+	// Rights offering prefixes
+	RightsOversubscriptionPrefix = []byte{0xA5} // offer_id + subscriber -> RightsOversubscription
+
+	// Transfer compliance prefixes
+	ClassComplianceRulesPrefix = []byte{0xA6} // company_id + class_id -> ClassComplianceRules
+	InvestorAttestationPrefix  = []byte{0xA7} // company_id + address -> InvestorAttestation
+
+	// Cap table journal prefixes
+	CapTableJournalPrefix        = []byte{0xA8} // company_id + entry_id -> CapTableJournalEntry
+	CapTableJournalCounterKey    = []byte{0xA9} // global counter for journal entry IDs
+	CapTableJournalStartPrefix   = []byte{0xAA} // company_id -> height the company's journal starts at
+	CapTableJournalActivationKey = []byte{0xB4} // height cap table journaling was activated at
+
+	// Merger prefixes
+	MergerPrefix          = []byte{0xAB} // merger_id -> Merger
+	MergerCounterKey      = []byte{0xAC} // global counter for merger IDs
+	MergerByCompanyPrefix = []byte{0xAD} // company_id -> []merger_id (index, acquirer and target)
+	MergerDissentPrefix   = []byte{0xAE} // merger_id + holder -> MergerDissent
+
+	// Dividend election prefixes
+	DividendElectionPrefix = []byte{0xAF} // dividend_id + holder -> DividendElection
+
+	// Board of directors prefixes
+	BoardPrefix     = []byte{0xB0} // company_id -> Board
+	BoardSeatPrefix = []byte{0xB1} // company_id + director -> BoardSeat
+
+	// Capital structure change prefixes
+	CapitalChangePrefix     = []byte{0xB2} // change_id -> CapitalStructureChange
+	CapitalChangeCounterKey = []byte{0xB3} // global counter for capital structure change IDs
 )
 
 // GetCompanyKey returns the store key for a company
@@ -543,4 +604,230 @@ func GetFreezeWarningByCompanyKey(companyID uint64) []byte {
 // GetTreasuryWithdrawalLimitKey returns the store key for a treasury withdrawal limit
 func GetTreasuryWithdrawalLimitKey(companyID uint64) []byte {
 	return append(TreasuryWithdrawalLimitPrefix, sdk.Uint64ToBigEndian(companyID)...)
-}
\ No newline at end of file
+}
+
+// =============================================================================
+// Stock Split Key Functions
+// =============================================================================
+
+// GetStockSplitKey returns the store key for a stock split
+func GetStockSplitKey(splitID uint64) []byte {
+	return append(StockSplitPrefix, sdk.Uint64ToBigEndian(splitID)...)
+}
+
+// GetStockSplitsByCompanyPrefix returns the prefix for iterating stock splits by company
+func GetStockSplitsByCompanyPrefix(companyID uint64) []byte {
+	return append(StockSplitByCompanyPrefix, sdk.Uint64ToBigEndian(companyID)...)
+}
+
+// GetStockSplitByCompanyKey returns the index key for company -> stock split
+func GetStockSplitByCompanyKey(companyID uint64, splitID uint64) []byte {
+	key := append(StockSplitByCompanyPrefix, sdk.Uint64ToBigEndian(companyID)...)
+	return append(key, sdk.Uint64ToBigEndian(splitID)...)
+}
+
+// GetConversionTermsKey returns the store key for a share class's conversion terms
+func GetConversionTermsKey(companyID uint64, sourceClassID string) []byte {
+	key := append(ConversionTermsPrefix, sdk.Uint64ToBigEndian(companyID)...)
+	return append(key, []byte(sourceClassID)...)
+}
+
+// GetConversionTermsByCompanyPrefix returns the prefix for iterating a company's conversion terms
+func GetConversionTermsByCompanyPrefix(companyID uint64) []byte {
+	return append(ConversionTermsPrefix, sdk.Uint64ToBigEndian(companyID)...)
+}
+
+// GetConversionRecordKey returns the store key for a conversion record
+func GetConversionRecordKey(conversionID uint64) []byte {
+	return append(ConversionRecordPrefix, sdk.Uint64ToBigEndian(conversionID)...)
+}
+
+// GetConversionsByCompanyPrefix returns the prefix for iterating conversion records by company
+func GetConversionsByCompanyPrefix(companyID uint64) []byte {
+	return append(ConversionByCompanyPrefix, sdk.Uint64ToBigEndian(companyID)...)
+}
+
+// GetConversionByCompanyKey returns the index key for company -> conversion record
+func GetConversionByCompanyKey(companyID uint64, conversionID uint64) []byte {
+	key := append(ConversionByCompanyPrefix, sdk.Uint64ToBigEndian(companyID)...)
+	return append(key, sdk.Uint64ToBigEndian(conversionID)...)
+}
+
+// GetLiquidationPreferenceKey returns the store key for a share class's liquidation preference
+func GetLiquidationPreferenceKey(companyID uint64, classID string) []byte {
+	key := append(LiquidationPreferencePrefix, sdk.Uint64ToBigEndian(companyID)...)
+	return append(key, []byte(classID)...)
+}
+
+// GetDissolutionKey returns the store key for a dissolution
+func GetDissolutionKey(dissolutionID uint64) []byte {
+	return append(DissolutionPrefix, sdk.Uint64ToBigEndian(dissolutionID)...)
+}
+
+// GetDissolutionsByCompanyPrefix returns the prefix for iterating dissolutions by company
+func GetDissolutionsByCompanyPrefix(companyID uint64) []byte {
+	return append(DissolutionByCompanyPrefix, sdk.Uint64ToBigEndian(companyID)...)
+}
+
+// GetDissolutionByCompanyKey returns the index key for company -> dissolution
+func GetDissolutionByCompanyKey(companyID uint64, dissolutionID uint64) []byte {
+	key := append(DissolutionByCompanyPrefix, sdk.Uint64ToBigEndian(companyID)...)
+	return append(key, sdk.Uint64ToBigEndian(dissolutionID)...)
+}
+
+// GetCapitalChangeKey returns the store key for a capital structure change
+func GetCapitalChangeKey(changeID uint64) []byte {
+	return append(CapitalChangePrefix, sdk.Uint64ToBigEndian(changeID)...)
+}
+
+// GetEquityGrantKey returns the store key for an option or warrant grant
+func GetEquityGrantKey(grantID uint64) []byte {
+	return append(EquityGrantPrefix, sdk.Uint64ToBigEndian(grantID)...)
+}
+
+// GetEquityGrantsByCompanyPrefix returns the prefix for iterating grants by company
+func GetEquityGrantsByCompanyPrefix(companyID uint64) []byte {
+	return append(EquityGrantByCompanyPrefix, sdk.Uint64ToBigEndian(companyID)...)
+}
+
+// GetEquityGrantByCompanyKey returns the index key for company -> grant
+func GetEquityGrantByCompanyKey(companyID uint64, grantID uint64) []byte {
+	key := append(EquityGrantByCompanyPrefix, sdk.Uint64ToBigEndian(companyID)...)
+	return append(key, sdk.Uint64ToBigEndian(grantID)...)
+}
+
+// GetTenderOfferKey returns the store key for a tender offer
+func GetTenderOfferKey(offerID uint64) []byte {
+	return append(TenderOfferPrefix, sdk.Uint64ToBigEndian(offerID)...)
+}
+
+// GetTenderOffersByCompanyPrefix returns the prefix for iterating tender offers by company
+func GetTenderOffersByCompanyPrefix(companyID uint64) []byte {
+	return append(TenderOfferByCompanyPrefix, sdk.Uint64ToBigEndian(companyID)...)
+}
+
+// GetTenderOfferByCompanyKey returns the index key for company -> tender offer
+func GetTenderOfferByCompanyKey(companyID uint64, offerID uint64) []byte {
+	key := append(TenderOfferByCompanyPrefix, sdk.Uint64ToBigEndian(companyID)...)
+	return append(key, sdk.Uint64ToBigEndian(offerID)...)
+}
+
+// GetTendersByOfferPrefix returns the prefix for iterating the tenders into an offer
+func GetTendersByOfferPrefix(offerID uint64) []byte {
+	return append(TenderPrefix, sdk.Uint64ToBigEndian(offerID)...)
+}
+
+// GetTenderKey returns the store key for a holder's tender into an offer
+func GetTenderKey(offerID uint64, holder string) []byte {
+	key := append(TenderPrefix, sdk.Uint64ToBigEndian(offerID)...)
+	return append(key, []byte(holder)...)
+}
+
+// GetBuybackProgramKey returns the store key for a buyback program
+func GetBuybackProgramKey(buybackID uint64) []byte {
+	return append(BuybackProgramPrefix, sdk.Uint64ToBigEndian(buybackID)...)
+}
+
+// GetBuybackProgramsByCompanyPrefix returns the prefix for iterating buyback programs by company
+func GetBuybackProgramsByCompanyPrefix(companyID uint64) []byte {
+	return append(BuybackProgramByCompanyPrefix, sdk.Uint64ToBigEndian(companyID)...)
+}
+
+// GetBuybackProgramByCompanyKey returns the index key for company -> buyback program
+func GetBuybackProgramByCompanyKey(companyID uint64, buybackID uint64) []byte {
+	key := append(BuybackProgramByCompanyPrefix, sdk.Uint64ToBigEndian(companyID)...)
+	return append(key, sdk.Uint64ToBigEndian(buybackID)...)
+}
+
+// GetRightsOversubscriptionsByOfferPrefix returns the prefix for iterating the oversubscription requests of a rights offering
+func GetRightsOversubscriptionsByOfferPrefix(offerID uint64) []byte {
+	return append(RightsOversubscriptionPrefix, sdk.Uint64ToBigEndian(offerID)...)
+}
+
+// GetRightsOversubscriptionKey returns the store key for a subscriber's oversubscription request
+func GetRightsOversubscriptionKey(offerID uint64, subscriber string) []byte {
+	key := append(RightsOversubscriptionPrefix, sdk.Uint64ToBigEndian(offerID)...)
+	return append(key, []byte(subscriber)...)
+}
+
+// GetClassComplianceRulesKey returns the store key for the compliance rules of a share class
+func GetClassComplianceRulesKey(companyID uint64, classID string) []byte {
+	key := append(ClassComplianceRulesPrefix, sdk.Uint64ToBigEndian(companyID)...)
+	return append(key, []byte(classID)...)
+}
+
+// GetInvestorAttestationKey returns the store key for an investor attestation
+func GetInvestorAttestationKey(companyID uint64, address string) []byte {
+	key := append(InvestorAttestationPrefix, sdk.Uint64ToBigEndian(companyID)...)
+	return append(key, []byte(address)...)
+}
+
+// GetCapTableJournalByCompanyPrefix returns the prefix for iterating a company's cap table journal
+func GetCapTableJournalByCompanyPrefix(companyID uint64) []byte {
+	return append(CapTableJournalPrefix, sdk.Uint64ToBigEndian(companyID)...)
+}
+
+// GetCapTableJournalKey returns the store key for a cap table journal entry
+func GetCapTableJournalKey(companyID uint64, entryID uint64) []byte {
+	key := append(CapTableJournalPrefix, sdk.Uint64ToBigEndian(companyID)...)
+	return append(key, sdk.Uint64ToBigEndian(entryID)...)
+}
+
+// GetCapTableJournalStartKey returns the store key for the start height of a company's cap table journal
+func GetCapTableJournalStartKey(companyID uint64) []byte {
+	return append(CapTableJournalStartPrefix, sdk.Uint64ToBigEndian(companyID)...)
+}
+
+// GetMergerKey returns the store key for a merger
+func GetMergerKey(mergerID uint64) []byte {
+	return append(MergerPrefix, sdk.Uint64ToBigEndian(mergerID)...)
+}
+
+// GetMergersByCompanyPrefix returns the prefix for iterating the mergers a company is party to
+func GetMergersByCompanyPrefix(companyID uint64) []byte {
+	return append(MergerByCompanyPrefix, sdk.Uint64ToBigEndian(companyID)...)
+}
+
+// GetMergerByCompanyKey returns the index key for company -> merger
+func GetMergerByCompanyKey(companyID uint64, mergerID uint64) []byte {
+	key := append(MergerByCompanyPrefix, sdk.Uint64ToBigEndian(companyID)...)
+	return append(key, sdk.Uint64ToBigEndian(mergerID)...)
+}
+
+// GetMergerDissentsPrefix returns the prefix for iterating the dissents of a merger
+func GetMergerDissentsPrefix(mergerID uint64) []byte {
+	return append(MergerDissentPrefix, sdk.Uint64ToBigEndian(mergerID)...)
+}
+
+// GetMergerDissentKey returns the store key for a holder's dissent from a merger
+func GetMergerDissentKey(mergerID uint64, holder string) []byte {
+	key := append(MergerDissentPrefix, sdk.Uint64ToBigEndian(mergerID)...)
+	return append(key, []byte(holder)...)
+}
+
+// GetDividendElectionsPrefix returns the prefix for iterating the elections of a dividend
+func GetDividendElectionsPrefix(dividendID uint64) []byte {
+	return append(DividendElectionPrefix, sdk.Uint64ToBigEndian(dividendID)...)
+}
+
+// GetDividendElectionKey returns the store key for a holder's election on a dividend
+func GetDividendElectionKey(dividendID uint64, holder string) []byte {
+	key := append(DividendElectionPrefix, sdk.Uint64ToBigEndian(dividendID)...)
+	return append(key, []byte(holder)...)
+}
+
+// GetBoardKey returns the store key for a company's board
+func GetBoardKey(companyID uint64) []byte {
+	return append(BoardPrefix, sdk.Uint64ToBigEndian(companyID)...)
+}
+
+// GetBoardSeatsPrefix returns the prefix for iterating the seats of a company's board
+func GetBoardSeatsPrefix(companyID uint64) []byte {
+	return append(BoardSeatPrefix, sdk.Uint64ToBigEndian(companyID)...)
+}
+
+// GetBoardSeatKey returns the store key for a director's seat
+func GetBoardSeatKey(companyID uint64, director string) []byte {
+	key := append(BoardSeatPrefix, sdk.Uint64ToBigEndian(companyID)...)
+	return append(key, []byte(director)...)
+}
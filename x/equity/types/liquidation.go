@@ -0,0 +1,403 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LiquidationDenom is the denom liquidation proceeds are paid in
+const LiquidationDenom = "uhodl"
+
+// LiquidationPreference defines the payout priority of a share class with
+// LiquidationPreference set. Classes without terms are treated as common and share
+// whatever remains after every preference has been paid.
+type LiquidationPreference struct {
+	CompanyID uint64 `json:"company_id"`
+	ClassID   string `json:"class_id"`
+
+	// Preference amount per share = Multiple × OriginalIssuePrice
+	Multiple           math.LegacyDec `json:"multiple"`             // e.g. 1x, 2x
+	OriginalIssuePrice math.LegacyDec `json:"original_issue_price"` // uhodl paid per share
+
+	// Seniority rank; 1 is the most senior. Classes of equal rank are paid pari passu.
+	Seniority uint32 `json:"seniority"`
+
+	// Participating classes also share the residual with common. Cap limits their
+	// total return to Cap × OriginalIssuePrice per share (zero = uncapped).
+	Participating bool           `json:"participating"`
+	Cap           math.LegacyDec `json:"cap"`
+
+	SetBy      string    `json:"set_by"`
+	ProposalID uint64    `json:"proposal_id"` // Capital structure proposal that approved the terms
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Validate validates liquidation preference terms
+func (p LiquidationPreference) Validate() error {
+	if p.CompanyID == 0 {
+		return fmt.Errorf("company ID cannot be zero")
+	}
+	if p.ClassID == "" {
+		return fmt.Errorf("class ID cannot be empty")
+	}
+	if p.Multiple.IsNil() || !p.Multiple.IsPositive() {
+		return fmt.Errorf("preference multiple must be positive")
+	}
+	if p.OriginalIssuePrice.IsNil() || !p.OriginalIssuePrice.IsPositive() {
+		return fmt.Errorf("original issue price must be positive")
+	}
+	if p.Seniority == 0 {
+		return fmt.Errorf("seniority rank must be at least 1")
+	}
+	if !p.Cap.IsNil() && !p.Cap.IsZero() {
+		if !p.Participating {
+			return fmt.Errorf("participation cap only applies to participating classes")
+		}
+		if p.Cap.LT(p.Multiple) {
+			return fmt.Errorf("participation cap cannot be below the preference multiple")
+		}
+	}
+	if _, err := sdk.AccAddressFromBech32(p.SetBy); err != nil {
+		return fmt.Errorf("invalid address: %v", err)
+	}
+	return nil
+}
+
+// PreferenceAmount returns the preference owed on an amount of shares
+func (p LiquidationPreference) PreferenceAmount(shares math.Int) math.LegacyDec {
+	return p.Multiple.Mul(p.OriginalIssuePrice).MulInt(shares)
+}
+
+// IsCapped reports whether participation is capped
+func (p LiquidationPreference) IsCapped() bool {
+	return p.Participating && !p.Cap.IsNil() && p.Cap.IsPositive()
+}
+
+// CapAmount returns the maximum total return on an amount of shares
+func (p LiquidationPreference) CapAmount(shares math.Int) math.LegacyDec {
+	return p.Cap.Mul(p.OriginalIssuePrice).MulInt(shares)
+}
+
+// =============================================================================
+// Waterfall Calculator
+// =============================================================================
+
+// WaterfallClass is one share class entering the waterfall
+type WaterfallClass struct {
+	ClassID    string
+	Shares     math.Int
+	Preference *LiquidationPreference // nil = common
+}
+
+// ClassPayout is a share class's result from the waterfall
+type ClassPayout struct {
+	ClassID       string         `json:"class_id"`
+	Shares        math.Int       `json:"shares"`
+	Seniority     uint32         `json:"seniority,omitempty"` // 0 = common
+	Preference    math.Int       `json:"preference"`          // Paid from the preference stage
+	Participation math.Int       `json:"participation"`       // Paid from the residual
+	Total         math.Int       `json:"total"`
+	PerShare      math.LegacyDec `json:"per_share"`
+	Converted     bool           `json:"converted"` // Took the as-converted common payout instead of its preference
+}
+
+// LiquidationWaterfall is the class-level result of distributing proceeds
+type LiquidationWaterfall struct {
+	Proceeds    math.Int      `json:"proceeds"`
+	Classes     []ClassPayout `json:"classes"`
+	Unallocated math.Int      `json:"unallocated"` // Rounding dust, or residual with no common class to receive it
+}
+
+// HolderPayout is one holder's share of a class payout
+type HolderPayout struct {
+	Address string   `json:"address"`
+	ClassID string   `json:"class_id"`
+	Shares  math.Int `json:"shares"`
+	Amount  math.Int `json:"amount"`
+}
+
+// GetClassPayout returns the payout for a class
+func (w LiquidationWaterfall) GetClassPayout(classID string) (ClassPayout, bool) {
+	for _, c := range w.Classes {
+		if c.ClassID == classID {
+			return c, true
+		}
+	}
+	return ClassPayout{}, false
+}
+
+// HolderAmount returns a holder's pro-rata share of their class payout
+func (w LiquidationWaterfall) HolderAmount(classID string, shares math.Int) math.Int {
+	class, found := w.GetClassPayout(classID)
+	if !found || shares.IsNil() || !class.Shares.IsPositive() {
+		return math.ZeroInt()
+	}
+	return class.Total.Mul(shares).Quo(class.Shares)
+}
+
+// CalculateLiquidationWaterfall distributes proceeds across share classes.
+//
+// Preferences are paid in seniority order (pro-rata within a rank when proceeds run
+// short). The residual is shared by share count among common classes and
+// participating preferred, with capped classes stopping at their cap and the excess
+// flowing to the others. A non-participating or capped class converts to common when
+// that pays more than its preference; conversions are evaluated one class at a time
+// until no class would be better off converting.
+func CalculateLiquidationWaterfall(proceeds math.Int, classes []WaterfallClass) LiquidationWaterfall {
+	if proceeds.IsNil() || proceeds.IsNegative() {
+		proceeds = math.ZeroInt()
+	}
+
+	converted := make([]bool, len(classes))
+	totals := runWaterfall(proceeds, classes, converted)
+
+	for iteration := 0; iteration < len(classes); iteration++ {
+		changed := false
+		for i, class := range classes {
+			p := class.Preference
+			if p == nil || converted[i] || (p.Participating && !p.IsCapped()) {
+				continue
+			}
+			converted[i] = true
+			trial := runWaterfall(proceeds, classes, converted)
+			if trial[i].total().GT(totals[i].total()) {
+				totals = trial
+				changed = true
+				break
+			}
+			converted[i] = false
+		}
+		if !changed {
+			break
+		}
+	}
+
+	result := LiquidationWaterfall{
+		Proceeds:    proceeds,
+		Classes:     make([]ClassPayout, len(classes)),
+		Unallocated: proceeds,
+	}
+	for i, class := range classes {
+		payout := ClassPayout{
+			ClassID:       class.ClassID,
+			Shares:        class.Shares,
+			Preference:    totals[i].preference.TruncateInt(),
+			Participation: totals[i].participation.TruncateInt(),
+			PerShare:      math.LegacyZeroDec(),
+			Converted:     converted[i],
+		}
+		if class.Preference != nil {
+			payout.Seniority = class.Preference.Seniority
+		}
+		payout.Total = payout.Preference.Add(payout.Participation)
+		if class.Shares.IsPositive() {
+			payout.PerShare = math.LegacyNewDecFromInt(payout.Total).QuoInt(class.Shares)
+		}
+		result.Classes[i] = payout
+		result.Unallocated = result.Unallocated.Sub(payout.Total)
+	}
+	return result
+}
+
+type waterfallTotals struct {
+	preference    math.LegacyDec
+	participation math.LegacyDec
+}
+
+func (t waterfallTotals) total() math.LegacyDec {
+	return t.preference.Add(t.participation)
+}
+
+// runWaterfall runs one pass of the waterfall with the given classes converted to common
+func runWaterfall(proceeds math.Int, classes []WaterfallClass, converted []bool) []waterfallTotals {
+	totals := make([]waterfallTotals, len(classes))
+	for i := range totals {
+		totals[i] = waterfallTotals{preference: math.LegacyZeroDec(), participation: math.LegacyZeroDec()}
+	}
+	remaining := math.LegacyNewDecFromInt(proceeds)
+
+	// Preference stage, most senior rank first
+	var ranks []uint32
+	seen := make(map[uint32]bool)
+	for i, class := range classes {
+		if class.Preference == nil || converted[i] || seen[class.Preference.Seniority] {
+			continue
+		}
+		seen[class.Preference.Seniority] = true
+		ranks = append(ranks, class.Preference.Seniority)
+	}
+	sort.Slice(ranks, func(i, j int) bool { return ranks[i] < ranks[j] })
+
+	for _, rank := range ranks {
+		owed := math.LegacyZeroDec()
+		for i, class := range classes {
+			if class.Preference != nil && !converted[i] && class.Preference.Seniority == rank {
+				owed = owed.Add(class.Preference.PreferenceAmount(class.Shares))
+			}
+		}
+		if !owed.IsPositive() {
+			continue
+		}
+
+		paid := owed
+		if remaining.LT(owed) {
+			paid = remaining
+		}
+		for i, class := range classes {
+			if class.Preference != nil && !converted[i] && class.Preference.Seniority == rank {
+				totals[i].preference = class.Preference.PreferenceAmount(class.Shares).Mul(paid).Quo(owed)
+			}
+		}
+		remaining = remaining.Sub(paid)
+	}
+
+	// Participation stage: common, converted and participating classes share by shares
+	active := make([]bool, len(classes))
+	for i, class := range classes {
+		p := class.Preference
+		active[i] = class.Shares.IsPositive() && (p == nil || converted[i] || p.Participating)
+	}
+
+	for remaining.IsPositive() {
+		totalShares := math.ZeroInt()
+		for i, class := range classes {
+			if active[i] {
+				totalShares = totalShares.Add(class.Shares)
+			}
+		}
+		if totalShares.IsZero() {
+			break
+		}
+		shareOf := func(shares math.Int) math.LegacyDec {
+			return remaining.MulInt(shares).QuoInt(totalShares)
+		}
+
+		// A capped class that would pass its cap takes only its room; redistribute the rest
+		capped := false
+		for i, class := range classes {
+			p := class.Preference
+			if !active[i] || p == nil || converted[i] || !p.IsCapped() {
+				continue
+			}
+			room := p.CapAmount(class.Shares).Sub(totals[i].total())
+			if room.IsNegative() {
+				room = math.LegacyZeroDec()
+			}
+			if shareOf(class.Shares).GT(room) {
+				totals[i].participation = totals[i].participation.Add(room)
+				remaining = remaining.Sub(room)
+				active[i] = false
+				capped = true
+				break
+			}
+		}
+		if capped {
+			continue
+		}
+
+		for i, class := range classes {
+			if active[i] {
+				totals[i].participation = totals[i].participation.Add(shareOf(class.Shares))
+			}
+		}
+		break
+	}
+
+	return totals
+}
+
+// =============================================================================
+// Voluntary Dissolution
+// =============================================================================
+
+// DissolutionStatus represents the status of a voluntary dissolution
+type DissolutionStatus int32
+
+const (
+	DissolutionStatusPending   DissolutionStatus = iota // Proposed, awaiting governance approval
+	DissolutionStatusApproved                           // Approved by governance, ready to execute
+	DissolutionStatusExecuted                           // Proceeds distributed and company delisted
+	DissolutionStatusCancelled                          // Cancelled before execution
+)
+
+func (s DissolutionStatus) String() string {
+	switch s {
+	case DissolutionStatusPending:
+		return "pending"
+	case DissolutionStatusApproved:
+		return "approved"
+	case DissolutionStatusExecuted:
+		return "executed"
+	case DissolutionStatusCancelled:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
+// Dissolution is a voluntary wind-down of a company. On execution the available
+// treasury balance is distributed to shareholders through the liquidation waterfall
+// and the company is delisted.
+type Dissolution struct {
+	ID         uint64            `json:"id"`
+	CompanyID  uint64            `json:"company_id"`
+	Reason     string            `json:"reason"`
+	ProposedBy string            `json:"proposed_by"`
+	ProposalID uint64            `json:"proposal_id,omitempty"` // Approving governance proposal
+	Status     DissolutionStatus `json:"status"`
+
+	// Execution results
+	Waterfall   LiquidationWaterfall `json:"waterfall"`
+	HoldersPaid uint64               `json:"holders_paid"`
+	TotalPaid   math.Int             `json:"total_paid"`
+
+	ProposedAt time.Time `json:"proposed_at"`
+	ApprovedAt time.Time `json:"approved_at,omitempty"`
+	ExecutedAt time.Time `json:"executed_at,omitempty"`
+}
+
+// NewDissolution creates a new pending dissolution
+func NewDissolution(id, companyID uint64, reason, proposedBy string, blockTime time.Time) Dissolution {
+	return Dissolution{
+		ID:         id,
+		CompanyID:  companyID,
+		Reason:     reason,
+		ProposedBy: proposedBy,
+		Status:     DissolutionStatusPending,
+		TotalPaid:  math.ZeroInt(),
+		ProposedAt: blockTime,
+	}
+}
+
+// Validate validates a dissolution
+func (d Dissolution) Validate() error {
+	if d.CompanyID == 0 {
+		return fmt.Errorf("company ID cannot be zero")
+	}
+	if d.Reason == "" {
+		return fmt.Errorf("reason cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(d.ProposedBy); err != nil {
+		return fmt.Errorf("invalid proposer address: %v", err)
+	}
+	return nil
+}
+
+// Liquidation event types
+const (
+	EventTypeLiquidationPreferenceSet = "liquidation_preference_set"
+	EventTypeDissolutionProposed      = "dissolution_proposed"
+	EventTypeDissolutionApproved      = "dissolution_approved"
+	EventTypeDissolutionExecuted      = "dissolution_executed"
+	EventTypeDissolutionCancelled     = "dissolution_cancelled"
+	EventTypeLiquidationPayout        = "liquidation_payout"
+
+	AttributeKeyDissolutionID = "dissolution_id"
+	AttributeKeyPayoutAmount  = "payout_amount"
+	AttributeKeySeniority     = "seniority"
+)
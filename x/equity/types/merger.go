@@ -0,0 +1,247 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Merger limits
+const (
+	MaxMergerRatioTerm       = 1000000             // Largest numerator or denominator an exchange ratio may use
+	MinAppraisalPeriod       = 24 * time.Hour      // Shortest dissenter appraisal window
+	MaxAppraisalPeriod       = 90 * 24 * time.Hour // Longest dissenter appraisal window
+	MergerConsiderationDenom = "uhodl"             // Cash consideration and appraisal payments are made in this denom
+)
+
+// MergerConsideration is what target holders receive for their shares
+type MergerConsideration int32
+
+const (
+	MergerConsiderationShares MergerConsideration = iota // Acquirer shares at a fixed exchange ratio
+	MergerConsiderationCash                              // HODL per target share
+	MergerConsiderationMixed                             // Acquirer shares plus HODL per target share
+)
+
+func (c MergerConsideration) String() string {
+	switch c {
+	case MergerConsiderationShares:
+		return "shares"
+	case MergerConsiderationCash:
+		return "cash"
+	case MergerConsiderationMixed:
+		return "mixed"
+	default:
+		return "unknown"
+	}
+}
+
+// MergerStatus represents the status of a merger corporate action
+type MergerStatus int32
+
+const (
+	MergerStatusPending   MergerStatus = iota // Proposed, awaiting approval by both companies' governance
+	MergerStatusApproved                      // Approved by both companies, appraisal window open until the deadline
+	MergerStatusExecuted                      // Target shares exchanged and the target delisted
+	MergerStatusCancelled                     // Cancelled before execution
+)
+
+func (s MergerStatus) String() string {
+	switch s {
+	case MergerStatusPending:
+		return "pending"
+	case MergerStatusApproved:
+		return "approved"
+	case MergerStatusExecuted:
+		return "executed"
+	case MergerStatusCancelled:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
+// Merger is an acquisition of one listed company by another. Every share of the
+// target class is exchanged for ExchangeNumerator/ExchangeDenominator acquirer
+// shares plus CashPerShare uhodl; a zero numerator is an all-cash deal. Acquirer
+// shares are rounded down and the fraction is paid in cash at FractionalPrice.
+// Holders who dissent during the appraisal window are bought out at AppraisalPrice
+// instead. All cash comes out of the acquirer's treasury.
+type Merger struct {
+	ID              uint64 `json:"id"`
+	AcquirerID      uint64 `json:"acquirer_id"`
+	AcquirerClassID string `json:"acquirer_class_id"`
+	TargetID        uint64 `json:"target_id"`
+	TargetClassID   string `json:"target_class_id"`
+
+	// Consideration per target share
+	ExchangeNumerator   uint64         `json:"exchange_numerator"` // Acquirer shares per ExchangeDenominator target shares
+	ExchangeDenominator uint64         `json:"exchange_denominator"`
+	CashPerShare        math.LegacyDec `json:"cash_per_share"`   // uhodl per target share
+	FractionalPrice     math.LegacyDec `json:"fractional_price"` // uhodl per acquirer share, paid for fractions
+
+	// Dissenter appraisal
+	AppraisalPrice    math.LegacyDec `json:"appraisal_price"` // uhodl per target share
+	AppraisalPeriod   time.Duration  `json:"appraisal_period"`
+	AppraisalDeadline time.Time      `json:"appraisal_deadline,omitempty"` // Set once both companies approve
+
+	Reason             string       `json:"reason"`
+	ProposedBy         string       `json:"proposed_by"`
+	AcquirerProposalID uint64       `json:"acquirer_proposal_id,omitempty"` // Approving proposal of the acquirer
+	TargetProposalID   uint64       `json:"target_proposal_id,omitempty"`   // Approving proposal of the target
+	Status             MergerStatus `json:"status"`
+
+	// Execution results
+	HoldersExchanged      uint64   `json:"holders_exchanged"`
+	TargetSharesExchanged math.Int `json:"target_shares_exchanged"`
+	AcquirerSharesIssued  math.Int `json:"acquirer_shares_issued"`
+	CashPaid              math.Int `json:"cash_paid"` // Cash consideration and fractions
+	DissentingShares      math.Int `json:"dissenting_shares"`
+	AppraisalPaid         math.Int `json:"appraisal_paid"`
+
+	ProposedAt         time.Time `json:"proposed_at"`
+	AcquirerApprovedAt time.Time `json:"acquirer_approved_at,omitempty"`
+	TargetApprovedAt   time.Time `json:"target_approved_at,omitempty"`
+	ExecutedAt         time.Time `json:"executed_at,omitempty"`
+}
+
+// Validate validates a merger
+func (m Merger) Validate() error {
+	if m.AcquirerID == 0 || m.TargetID == 0 {
+		return fmt.Errorf("acquirer and target IDs cannot be zero")
+	}
+	if m.AcquirerID == m.TargetID {
+		return fmt.Errorf("a company cannot merge with itself")
+	}
+	if m.AcquirerClassID == "" || m.TargetClassID == "" {
+		return fmt.Errorf("class IDs cannot be empty")
+	}
+	if m.ExchangeDenominator == 0 {
+		return fmt.Errorf("exchange ratio denominator must be positive")
+	}
+	if m.ExchangeNumerator > MaxMergerRatioTerm || m.ExchangeDenominator > MaxMergerRatioTerm {
+		return fmt.Errorf("exchange ratio terms cannot exceed %d", MaxMergerRatioTerm)
+	}
+	if m.CashPerShare.IsNil() || m.CashPerShare.IsNegative() {
+		return fmt.Errorf("cash per share cannot be negative")
+	}
+	if m.FractionalPrice.IsNil() || m.FractionalPrice.IsNegative() {
+		return fmt.Errorf("fractional price cannot be negative")
+	}
+	if m.ExchangeNumerator == 0 && !m.CashPerShare.IsPositive() {
+		return fmt.Errorf("merger must offer acquirer shares, cash, or both")
+	}
+	if m.AppraisalPrice.IsNil() || !m.AppraisalPrice.IsPositive() {
+		return fmt.Errorf("appraisal price must be positive")
+	}
+	if m.AppraisalPeriod < MinAppraisalPeriod || m.AppraisalPeriod > MaxAppraisalPeriod {
+		return fmt.Errorf("appraisal period must be between %s and %s", MinAppraisalPeriod, MaxAppraisalPeriod)
+	}
+	if m.Reason == "" {
+		return fmt.Errorf("reason cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ProposedBy); err != nil {
+		return fmt.Errorf("invalid proposer address: %v", err)
+	}
+	return nil
+}
+
+// Consideration classifies what target holders receive
+func (m Merger) Consideration() MergerConsideration {
+	switch {
+	case m.ExchangeNumerator > 0 && m.CashPerShare.IsPositive():
+		return MergerConsiderationMixed
+	case m.ExchangeNumerator > 0:
+		return MergerConsiderationShares
+	default:
+		return MergerConsiderationCash
+	}
+}
+
+// Ratio returns the exchange ratio as "numerator:denominator"
+func (m Merger) Ratio() string {
+	return fmt.Sprintf("%d:%d", m.ExchangeNumerator, m.ExchangeDenominator)
+}
+
+// IsFullyApproved reports whether both companies' governance approved the merger
+func (m Merger) IsFullyApproved() bool {
+	return m.AcquirerProposalID != 0 && m.TargetProposalID != 0
+}
+
+// IsAppraisalOpen reports whether target holders may still dissent
+func (m Merger) IsAppraisalOpen(now time.Time) bool {
+	return m.Status == MergerStatusApproved && now.Before(m.AppraisalDeadline)
+}
+
+// ExchangeShares returns the whole acquirer shares for an amount of target shares.
+// Custody modules use the same rounding for their own records.
+func (m Merger) ExchangeShares(shares math.Int) math.Int {
+	whole, _ := m.Exchange(shares)
+	return whole
+}
+
+// Exchange returns the whole acquirer shares and the uhodl owed for an amount of
+// target shares: the cash consideration plus the value of the fractional acquirer
+// share at FractionalPrice, truncated once
+func (m Merger) Exchange(shares math.Int) (math.Int, math.Int) {
+	if shares.IsNil() || !shares.IsPositive() || m.ExchangeDenominator == 0 {
+		return math.ZeroInt(), math.ZeroInt()
+	}
+	scaled := shares.Mul(math.NewIntFromUint64(m.ExchangeNumerator))
+	den := math.NewIntFromUint64(m.ExchangeDenominator)
+	whole, remainder := scaled.Quo(den), scaled.Mod(den)
+
+	cash := math.LegacyZeroDec()
+	if !m.CashPerShare.IsNil() {
+		cash = m.CashPerShare.MulInt(shares)
+	}
+	if remainder.IsPositive() && !m.FractionalPrice.IsNil() {
+		cash = cash.Add(m.FractionalPrice.MulInt(remainder).QuoInt(den))
+	}
+	return whole, cash.TruncateInt()
+}
+
+// AppraisalValue returns the uhodl paid to a dissenter for an amount of target shares
+func (m Merger) AppraisalValue(shares math.Int) math.Int {
+	if shares.IsNil() || !shares.IsPositive() || m.AppraisalPrice.IsNil() {
+		return math.ZeroInt()
+	}
+	return m.AppraisalPrice.MulInt(shares).TruncateInt()
+}
+
+// CarryoverBasis returns the per-share cost basis of acquirer shares received for
+// target shares bought at costBasis
+func (m Merger) CarryoverBasis(costBasis math.LegacyDec) math.LegacyDec {
+	if costBasis.IsNil() || m.ExchangeNumerator == 0 {
+		return math.LegacyZeroDec()
+	}
+	return costBasis.MulInt64(int64(m.ExchangeDenominator)).QuoInt64(int64(m.ExchangeNumerator))
+}
+
+// MergerDissent records target shares whose holder demanded appraisal. The shares
+// stay locked until the merger executes or is cancelled.
+type MergerDissent struct {
+	MergerID    uint64    `json:"merger_id"`
+	Holder      string    `json:"holder"`
+	Shares      math.Int  `json:"shares"`
+	DissentedAt time.Time `json:"dissented_at"`
+}
+
+// Merger event types
+const (
+	EventTypeMergerProposed  = "merger_proposed"
+	EventTypeMergerApproved  = "merger_approved"
+	EventTypeMergerDissent   = "merger_dissent"
+	EventTypeMergerExecuted  = "merger_executed"
+	EventTypeMergerCancelled = "merger_cancelled"
+	EventTypeMergerPayment   = "merger_payment"
+
+	AttributeKeyMergerID          = "merger_id"
+	AttributeKeyAcquirerID        = "acquirer_id"
+	AttributeKeyTargetID          = "target_id"
+	AttributeKeyExchangeRatio     = "exchange_ratio"
+	AttributeKeyConsideration     = "consideration"
+	AttributeKeyAppraisalDeadline = "appraisal_deadline"
+)
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -734,3 +736,951 @@ func (msg SimpleMsgWithdrawPetition) ValidateBasic() error {
 	}
 	return nil
 }
+
+// =============================================================================
+// Share Conversion Message Types
+// =============================================================================
+
+// SimpleMsgSetConversionTerms sets the conversion terms of a share class
+type SimpleMsgSetConversionTerms struct {
+	Creator string          `json:"creator"`
+	Terms   ConversionTerms `json:"terms"`
+}
+
+// SimpleMsgProposeConversionTerms proposes conversion terms that change a class's ratio
+// or original issue price; they take effect once a capital structure proposal passes
+type SimpleMsgProposeConversionTerms struct {
+	Creator string          `json:"creator"`
+	Terms   ConversionTerms `json:"terms"`
+}
+
+// SimpleMsgConvertShares converts shares of a class into its conversion target class
+type SimpleMsgConvertShares struct {
+	Owner     string   `json:"owner"`
+	CompanyID uint64   `json:"company_id"`
+	ClassID   string   `json:"class_id"` // Source class
+	Shares    math.Int `json:"shares"`
+}
+
+// Response types
+
+type MsgSetConversionTermsResponse struct {
+	Success bool `json:"success"`
+}
+
+type MsgProposeConversionTermsResponse struct {
+	ChangeID uint64 `json:"change_id"` // Capital structure change awaiting a governance proposal
+	Success  bool   `json:"success"`
+}
+
+type MsgConvertSharesResponse struct {
+	ConversionID  uint64   `json:"conversion_id"`
+	TargetClassID string   `json:"target_class_id"`
+	SharesIssued  math.Int `json:"shares_issued"`
+	Success       bool     `json:"success"`
+}
+
+// Validation
+
+func (msg SimpleMsgSetConversionTerms) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.Terms.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	if msg.Terms.SourceClassID == "" || msg.Terms.TargetClassID == "" {
+		return ErrShareClassNotFound
+	}
+	return nil
+}
+
+func (msg SimpleMsgProposeConversionTerms) ValidateBasic() error {
+	return SimpleMsgSetConversionTerms(msg).ValidateBasic()
+}
+
+func (msg SimpleMsgConvertShares) ValidateBasic() error {
+	if msg.Owner == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	if msg.ClassID == "" {
+		return ErrShareClassNotFound
+	}
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return ErrInsufficientShares
+	}
+	return nil
+}
+
+// =============================================================================
+// Liquidation Preference Message Types
+// =============================================================================
+
+// SimpleMsgSetLiquidationPreference proposes the liquidation preference terms of a share class
+type SimpleMsgSetLiquidationPreference struct {
+	Creator    string                `json:"creator"`
+	Preference LiquidationPreference `json:"preference"`
+}
+
+type MsgSetLiquidationPreferenceResponse struct {
+	ChangeID uint64 `json:"change_id"` // Capital structure change awaiting a governance proposal
+	Success  bool   `json:"success"`
+}
+
+func (msg SimpleMsgSetLiquidationPreference) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.Preference.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	if msg.Preference.ClassID == "" {
+		return ErrShareClassNotFound
+	}
+	return nil
+}
+
+// =============================================================================
+// Option and Warrant Message Types
+// =============================================================================
+
+// SimpleMsgGrantEquity grants an employee stock option or warrant
+type SimpleMsgGrantEquity struct {
+	Creator string      `json:"creator"`
+	Grant   EquityGrant `json:"grant"`
+}
+
+// SimpleMsgExerciseGrant exercises shares of an option or warrant grant. Cashless
+// exercise sells enough of the shares on the DEX to pay the strike price.
+type SimpleMsgExerciseGrant struct {
+	Holder   string   `json:"holder"`
+	GrantID  uint64   `json:"grant_id"`
+	Shares   math.Int `json:"shares"`
+	Cashless bool     `json:"cashless"`
+}
+
+// SimpleMsgTerminateGrant records the end of an option holder's service
+type SimpleMsgTerminateGrant struct {
+	Creator string `json:"creator"`
+	GrantID uint64 `json:"grant_id"`
+}
+
+// Response types
+
+type MsgGrantEquityResponse struct {
+	GrantID uint64 `json:"grant_id"`
+	Success bool   `json:"success"`
+}
+
+type MsgExerciseGrantResponse struct {
+	SharesExercised math.Int `json:"shares_exercised"`
+	SharesSold      math.Int `json:"shares_sold"`
+	Success         bool     `json:"success"`
+}
+
+type MsgTerminateGrantResponse struct {
+	Success bool `json:"success"`
+}
+
+// Validation
+
+func (msg SimpleMsgGrantEquity) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.Grant.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	if msg.Grant.ClassID == "" {
+		return ErrShareClassNotFound
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grant.Holder); err != nil {
+		return ErrInvalidAddress
+	}
+	if msg.Grant.Shares.IsNil() || !msg.Grant.Shares.IsPositive() {
+		return ErrInsufficientShares
+	}
+	return nil
+}
+
+func (msg SimpleMsgExerciseGrant) ValidateBasic() error {
+	if msg.Holder == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.GrantID == 0 {
+		return ErrEquityGrantNotFound
+	}
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return ErrInsufficientShares
+	}
+	return nil
+}
+
+func (msg SimpleMsgTerminateGrant) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.GrantID == 0 {
+		return ErrEquityGrantNotFound
+	}
+	return nil
+}
+
+// =============================================================================
+// Buyback Message Types
+// =============================================================================
+
+// SimpleMsgCreateTenderOffer opens a fixed-price or Dutch-auction tender offer
+type SimpleMsgCreateTenderOffer struct {
+	Creator string      `json:"creator"`
+	Offer   TenderOffer `json:"offer"`
+}
+
+// SimpleMsgSubmitTender tenders shares into an open offer. Price is the holder's
+// lowest acceptable price and is ignored for fixed-price offers.
+type SimpleMsgSubmitTender struct {
+	Holder  string         `json:"holder"`
+	OfferID uint64         `json:"offer_id"`
+	Shares  math.Int       `json:"shares"`
+	Price   math.LegacyDec `json:"price"`
+}
+
+// SimpleMsgWithdrawTender withdraws a tender before the offer closes
+type SimpleMsgWithdrawTender struct {
+	Holder  string `json:"holder"`
+	OfferID uint64 `json:"offer_id"`
+}
+
+// SimpleMsgCancelTenderOffer cancels an open tender offer
+type SimpleMsgCancelTenderOffer struct {
+	Creator string `json:"creator"`
+	OfferID uint64 `json:"offer_id"`
+}
+
+// SimpleMsgCreateBuybackProgram authorizes an open-market buyback program
+type SimpleMsgCreateBuybackProgram struct {
+	Creator string         `json:"creator"`
+	Program BuybackProgram `json:"program"`
+}
+
+// SimpleMsgExecuteBuyback buys shares on the DEX under a buyback program. A zero
+// limit price uses the program's maximum price.
+type SimpleMsgExecuteBuyback struct {
+	Creator    string         `json:"creator"`
+	BuybackID  uint64         `json:"buyback_id"`
+	Shares     math.Int       `json:"shares"`
+	LimitPrice math.LegacyDec `json:"limit_price"`
+}
+
+// SimpleMsgEndBuybackProgram stops a buyback program early
+type SimpleMsgEndBuybackProgram struct {
+	Creator   string `json:"creator"`
+	BuybackID uint64 `json:"buyback_id"`
+}
+
+// Response types
+
+type MsgCreateTenderOfferResponse struct {
+	OfferID uint64 `json:"offer_id"`
+	Success bool   `json:"success"`
+}
+
+type MsgSubmitTenderResponse struct {
+	Success bool `json:"success"`
+}
+
+type MsgWithdrawTenderResponse struct {
+	Success bool `json:"success"`
+}
+
+type MsgCancelTenderOfferResponse struct {
+	Success bool `json:"success"`
+}
+
+type MsgCreateBuybackProgramResponse struct {
+	BuybackID uint64 `json:"buyback_id"`
+	Success   bool   `json:"success"`
+}
+
+type MsgExecuteBuybackResponse struct {
+	SharesBought math.Int `json:"shares_bought"`
+	AmountSpent  math.Int `json:"amount_spent"`
+	Success      bool     `json:"success"`
+}
+
+type MsgEndBuybackProgramResponse struct {
+	Success bool `json:"success"`
+}
+
+// Validation
+
+func (msg SimpleMsgCreateTenderOffer) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.Offer.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	if msg.Offer.ClassID == "" {
+		return ErrShareClassNotFound
+	}
+	if msg.Offer.MaxShares.IsNil() || !msg.Offer.MaxShares.IsPositive() {
+		return ErrInsufficientShares
+	}
+	return nil
+}
+
+func (msg SimpleMsgSubmitTender) ValidateBasic() error {
+	if msg.Holder == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.OfferID == 0 {
+		return ErrTenderOfferNotFound
+	}
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return ErrInsufficientShares
+	}
+	if !msg.Price.IsNil() && msg.Price.IsNegative() {
+		return ErrInvalidTender
+	}
+	return nil
+}
+
+func (msg SimpleMsgWithdrawTender) ValidateBasic() error {
+	if msg.Holder == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.OfferID == 0 {
+		return ErrTenderOfferNotFound
+	}
+	return nil
+}
+
+func (msg SimpleMsgCancelTenderOffer) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.OfferID == 0 {
+		return ErrTenderOfferNotFound
+	}
+	return nil
+}
+
+func (msg SimpleMsgCreateBuybackProgram) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.Program.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	if msg.Program.ClassID == "" {
+		return ErrShareClassNotFound
+	}
+	if msg.Program.Budget.IsNil() || !msg.Program.Budget.IsPositive() {
+		return ErrInvalidBuyback
+	}
+	return nil
+}
+
+func (msg SimpleMsgExecuteBuyback) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.BuybackID == 0 {
+		return ErrBuybackNotFound
+	}
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return ErrInsufficientShares
+	}
+	if !msg.LimitPrice.IsNil() && msg.LimitPrice.IsNegative() {
+		return ErrInvalidBuyback
+	}
+	return nil
+}
+
+func (msg SimpleMsgEndBuybackProgram) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.BuybackID == 0 {
+		return ErrBuybackNotFound
+	}
+	return nil
+}
+
+// =============================================================================
+// Rights Offering Message Types
+// =============================================================================
+
+// SimpleMsgCreateRightsOffering opens a rights offering to the class's holders on record
+type SimpleMsgCreateRightsOffering struct {
+	Creator        string         `json:"creator"`
+	CompanyID      uint64         `json:"company_id"`
+	ClassID        string         `json:"class_id"`
+	PricePerShare  math.LegacyDec `json:"price_per_share"`
+	QuoteDenom     string         `json:"quote_denom"`
+	RightsPerShare math.Int       `json:"rights_per_share"`
+	EndTime        time.Time      `json:"end_time"`
+}
+
+// SimpleMsgExerciseRights exercises subscription rights for new shares
+type SimpleMsgExerciseRights struct {
+	Holder  string   `json:"holder"`
+	OfferID uint64   `json:"offer_id"`
+	Rights  math.Int `json:"rights"`
+}
+
+// SimpleMsgOversubscribeRights requests unexercised shares in the oversubscription round
+type SimpleMsgOversubscribeRights struct {
+	Subscriber string   `json:"subscriber"`
+	OfferID    uint64   `json:"offer_id"`
+	Shares     math.Int `json:"shares"`
+}
+
+// Response types
+
+type MsgCreateRightsOfferingResponse struct {
+	OfferID uint64 `json:"offer_id"`
+	Success bool   `json:"success"`
+}
+
+type MsgExerciseRightsResponse struct {
+	SharesPurchased math.Int `json:"shares_purchased"`
+	Success         bool     `json:"success"`
+}
+
+type MsgOversubscribeRightsResponse struct {
+	Success bool `json:"success"`
+}
+
+// Validation
+
+func (msg SimpleMsgCreateRightsOffering) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	if msg.ClassID == "" {
+		return ErrShareClassNotFound
+	}
+	if msg.PricePerShare.IsNil() || !msg.PricePerShare.IsPositive() {
+		return ErrInvalidOfferPrice
+	}
+	if msg.QuoteDenom == "" {
+		return ErrInvalidRightsOffering
+	}
+	if msg.RightsPerShare.IsNil() || !msg.RightsPerShare.IsPositive() {
+		return ErrInvalidRightsOffering
+	}
+	return nil
+}
+
+func (msg SimpleMsgExerciseRights) ValidateBasic() error {
+	if msg.Holder == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.OfferID == 0 {
+		return ErrPrimarySaleNotFound
+	}
+	if msg.Rights.IsNil() || !msg.Rights.IsPositive() {
+		return ErrInsufficientRights
+	}
+	return nil
+}
+
+func (msg SimpleMsgOversubscribeRights) ValidateBasic() error {
+	if msg.Subscriber == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Subscriber); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.OfferID == 0 {
+		return ErrPrimarySaleNotFound
+	}
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return ErrInsufficientShares
+	}
+	return nil
+}
+
+// =============================================================================
+// Transfer Compliance Message Types
+// =============================================================================
+
+// SimpleMsgSetComplianceRules replaces the compliance rules of a share class
+type SimpleMsgSetComplianceRules struct {
+	Creator   string           `json:"creator"`
+	CompanyID uint64           `json:"company_id"`
+	ClassID   string           `json:"class_id"`
+	Rules     []ComplianceRule `json:"rules"` // Empty removes every rule
+}
+
+// SimpleMsgAttestInvestor records a company's attestation of an investor
+type SimpleMsgAttestInvestor struct {
+	Creator     string              `json:"creator"`
+	Attestation InvestorAttestation `json:"attestation"`
+}
+
+// SimpleMsgRevokeAttestation removes a company's attestation of an investor
+type SimpleMsgRevokeAttestation struct {
+	Creator   string `json:"creator"`
+	CompanyID uint64 `json:"company_id"`
+	Address   string `json:"address"`
+}
+
+// Response types
+
+type MsgSetComplianceRulesResponse struct {
+	Success bool `json:"success"`
+}
+
+type MsgAttestInvestorResponse struct {
+	Success bool `json:"success"`
+}
+
+type MsgRevokeAttestationResponse struct {
+	Success bool `json:"success"`
+}
+
+// Validation
+
+func (msg SimpleMsgSetComplianceRules) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	if msg.ClassID == "" {
+		return ErrShareClassNotFound
+	}
+	for _, rule := range msg.Rules {
+		if err := rule.Validate(); err != nil {
+			return ErrInvalidComplianceRule.Wrap(err.Error())
+		}
+	}
+	return nil
+}
+
+func (msg SimpleMsgAttestInvestor) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if err := msg.Attestation.Validate(); err != nil {
+		return ErrInvalidComplianceRule.Wrap(err.Error())
+	}
+	return nil
+}
+
+func (msg SimpleMsgRevokeAttestation) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return ErrAttestationNotFound
+	}
+	return nil
+}
+
+// =============================================================================
+// Dissolution Message Types
+// =============================================================================
+
+// SimpleMsgProposeDissolution proposes the voluntary dissolution of a company.
+// It takes effect once a dissolution company proposal referencing it passes.
+type SimpleMsgProposeDissolution struct {
+	Creator   string `json:"creator"`
+	CompanyID uint64 `json:"company_id"`
+	Reason    string `json:"reason"`
+}
+
+// SimpleMsgCancelDissolution cancels a dissolution that has not been executed
+type SimpleMsgCancelDissolution struct {
+	Creator       string `json:"creator"`
+	DissolutionID uint64 `json:"dissolution_id"`
+	Reason        string `json:"reason"`
+}
+
+// Response types
+
+type MsgProposeDissolutionResponse struct {
+	DissolutionID uint64 `json:"dissolution_id"`
+	Success       bool   `json:"success"`
+}
+
+type MsgCancelDissolutionResponse struct {
+	Success bool `json:"success"`
+}
+
+// Validation
+
+func (msg SimpleMsgProposeDissolution) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	if msg.Reason == "" {
+		return ErrInvalidDissolution.Wrap("reason cannot be empty")
+	}
+	return nil
+}
+
+func (msg SimpleMsgCancelDissolution) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.DissolutionID == 0 {
+		return ErrDissolutionNotFound
+	}
+	if msg.Reason == "" {
+		return ErrInvalidDissolution.Wrap("reason cannot be empty")
+	}
+	return nil
+}
+
+// =============================================================================
+// Stock Split Message Types
+// =============================================================================
+
+// SimpleMsgProposeStockSplit proposes a forward or reverse split of a share class.
+// The split takes effect once a ShareSplit company proposal referencing it passes.
+type SimpleMsgProposeStockSplit struct {
+	Creator        string         `json:"creator"`
+	CompanyID      uint64         `json:"company_id"`
+	ClassID        string         `json:"class_id"`
+	Numerator      uint64         `json:"numerator"`      // New shares per Denominator old shares
+	Denominator    uint64         `json:"denominator"`
+	ReferencePrice math.LegacyDec `json:"reference_price"` // uhodl per pre-split share for fractional cash-out
+	Reason         string         `json:"reason"`
+}
+
+// SimpleMsgCancelStockSplit cancels a split that has not been executed
+type SimpleMsgCancelStockSplit struct {
+	Creator string `json:"creator"`
+	SplitID uint64 `json:"split_id"`
+	Reason  string `json:"reason"`
+}
+
+// Response types
+
+type MsgProposeStockSplitResponse struct {
+	SplitID uint64 `json:"split_id"`
+	Success bool   `json:"success"`
+}
+
+type MsgCancelStockSplitResponse struct {
+	Success bool `json:"success"`
+}
+
+// Validation
+
+func (msg SimpleMsgProposeStockSplit) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	if msg.ClassID == "" {
+		return ErrShareClassNotFound
+	}
+	if msg.Numerator == 0 || msg.Denominator == 0 || msg.Numerator == msg.Denominator {
+		return ErrInvalidStockSplit.Wrap("split ratio must be positive and not 1:1")
+	}
+	if msg.ReferencePrice.IsNil() || msg.ReferencePrice.IsNegative() {
+		return ErrInvalidStockSplit.Wrap("reference price cannot be negative")
+	}
+	return nil
+}
+
+func (msg SimpleMsgCancelStockSplit) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.SplitID == 0 {
+		return ErrStockSplitNotFound
+	}
+	if msg.Reason == "" {
+		return ErrInvalidStockSplit.Wrap("reason cannot be empty")
+	}
+	return nil
+}
+
+// =============================================================================
+// Merger Message Types
+// =============================================================================
+
+// SimpleMsgProposeMerger proposes a merger of the target into the acquirer
+type SimpleMsgProposeMerger struct {
+	Creator string `json:"creator"`
+	Merger  Merger `json:"merger"`
+}
+
+// SimpleMsgDissentFromMerger demands appraisal for target shares instead of the
+// merger consideration
+type SimpleMsgDissentFromMerger struct {
+	Holder   string   `json:"holder"`
+	MergerID uint64   `json:"merger_id"`
+	Shares   math.Int `json:"shares"`
+}
+
+// SimpleMsgCancelMerger cancels a merger that has not been executed
+type SimpleMsgCancelMerger struct {
+	Creator  string `json:"creator"`
+	MergerID uint64 `json:"merger_id"`
+	Reason   string `json:"reason"`
+}
+
+// Response types
+
+type MsgProposeMergerResponse struct {
+	MergerID uint64 `json:"merger_id"`
+	Success  bool   `json:"success"`
+}
+
+type MsgDissentFromMergerResponse struct {
+	Success bool `json:"success"`
+}
+
+type MsgCancelMergerResponse struct {
+	Success bool `json:"success"`
+}
+
+// Validation
+
+func (msg SimpleMsgProposeMerger) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.Merger.AcquirerID == 0 || msg.Merger.TargetID == 0 {
+		return ErrCompanyNotFound
+	}
+	if msg.Merger.AcquirerClassID == "" || msg.Merger.TargetClassID == "" {
+		return ErrShareClassNotFound
+	}
+	return nil
+}
+
+func (msg SimpleMsgDissentFromMerger) ValidateBasic() error {
+	if msg.Holder == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.MergerID == 0 {
+		return ErrMergerNotFound
+	}
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return ErrInsufficientShares
+	}
+	return nil
+}
+
+func (msg SimpleMsgCancelMerger) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.MergerID == 0 {
+		return ErrMergerNotFound
+	}
+	if msg.Reason == "" {
+		return ErrInvalidMerger.Wrap("reason cannot be empty")
+	}
+	return nil
+}
+
+// =============================================================================
+// Board Message Types
+// =============================================================================
+
+// SimpleMsgConfigureBoard creates or updates a company's board
+type SimpleMsgConfigureBoard struct {
+	Creator string `json:"creator"`
+	Board   Board  `json:"board"`
+}
+
+// SimpleMsgAppointDirector seats an initial director before the first board election
+type SimpleMsgAppointDirector struct {
+	Creator   string       `json:"creator"`
+	CompanyID uint64       `json:"company_id"`
+	Nominee   BoardNominee `json:"nominee"`
+}
+
+// SimpleMsgSetDirectorCommittees replaces the committees a director sits on
+type SimpleMsgSetDirectorCommittees struct {
+	Creator    string   `json:"creator"`
+	CompanyID  uint64   `json:"company_id"`
+	Director   string   `json:"director"`
+	Committees []string `json:"committees"`
+}
+
+// SimpleMsgResignBoardSeat vacates the sender's board seat
+type SimpleMsgResignBoardSeat struct {
+	Director  string `json:"director"`
+	CompanyID uint64 `json:"company_id"`
+}
+
+// Response types
+
+type MsgConfigureBoardResponse struct {
+	Success bool `json:"success"`
+}
+
+type MsgAppointDirectorResponse struct {
+	Success bool `json:"success"`
+}
+
+type MsgSetDirectorCommitteesResponse struct {
+	Success bool `json:"success"`
+}
+
+type MsgResignBoardSeatResponse struct {
+	Success bool `json:"success"`
+}
+
+// Validation
+
+func (msg SimpleMsgConfigureBoard) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.Board.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	if msg.Board.Seats == 0 {
+		return ErrInvalidBoard.Wrap("board needs at least one seat")
+	}
+	return nil
+}
+
+func (msg SimpleMsgAppointDirector) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Nominee.Candidate); err != nil {
+		return ErrInvalidBoard.Wrapf("invalid director address: %v", err)
+	}
+	return nil
+}
+
+func (msg SimpleMsgSetDirectorCommittees) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Director); err != nil {
+		return ErrNotDirector
+	}
+	return nil
+}
+
+func (msg SimpleMsgResignBoardSeat) ValidateBasic() error {
+	if msg.Director == "" {
+		return ErrUnauthorized
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Director); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.CompanyID == 0 {
+		return ErrCompanyNotFound
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GrantType distinguishes employee stock options from warrants
+type GrantType int32
+
+const (
+	GrantTypeOption  GrantType = iota // Employee stock option, subject to termination
+	GrantTypeWarrant                  // Warrant issued to an investor, lender or partner
+)
+
+func (t GrantType) String() string {
+	switch t {
+	case GrantTypeOption:
+		return "option"
+	case GrantTypeWarrant:
+		return "warrant"
+	default:
+		return "unknown"
+	}
+}
+
+// GrantStatus represents the lifecycle of an option or warrant grant
+type GrantStatus int32
+
+const (
+	GrantStatusActive     GrantStatus = iota // Vesting and/or exercisable
+	GrantStatusTerminated                    // Holder left; vested shares exercisable until the post-termination deadline
+	GrantStatusExercised                     // Every grantable share was exercised
+	GrantStatusExpired                       // Exercise deadline passed; the remainder was forfeited
+)
+
+func (s GrantStatus) String() string {
+	switch s {
+	case GrantStatusActive:
+		return "active"
+	case GrantStatusTerminated:
+		return "terminated"
+	case GrantStatusExercised:
+		return "exercised"
+	case GrantStatusExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+// GrantShareSource is where exercised shares come from
+type GrantShareSource int32
+
+const (
+	GrantShareSourceAuthorized GrantShareSource = iota // Newly issued from the class's authorized shares
+	GrantShareSourceTreasury                           // Sold out of treasury shares via TransferSharesFromTreasury
+)
+
+func (s GrantShareSource) String() string {
+	switch s {
+	case GrantShareSourceAuthorized:
+		return "authorized"
+	case GrantShareSourceTreasury:
+		return "treasury"
+	default:
+		return "unknown"
+	}
+}
+
+// EquityGrant is an option or warrant to buy shares of a class at a strike price.
+//
+// Unexercised shares of active and terminated grants are outstanding and count toward
+// the fully-diluted share count. With early exercise the holder may exercise before
+// vesting; those shares are held unvested and vest on the grant's schedule, and are
+// repurchased by the company at the strike price if the holder is terminated first.
+type EquityGrant struct {
+	ID        uint64    `json:"id"`
+	CompanyID uint64    `json:"company_id"`
+	ClassID   string    `json:"class_id"`
+	Holder    string    `json:"holder"`
+	Type      GrantType `json:"type"`
+
+	// Shares
+	Shares            math.Int         `json:"shares"`             // Total shares granted
+	ExercisedShares   math.Int         `json:"exercised_shares"`   // Shares exercised so far
+	ForfeitedShares   math.Int         `json:"forfeited_shares"`   // Unexercised shares lost on termination or expiry
+	RepurchasedShares math.Int         `json:"repurchased_shares"` // Early-exercised unvested shares bought back on termination
+	UnvestedHeld      math.Int         `json:"unvested_held"`      // Early-exercised shares currently held unvested
+	Source            GrantShareSource `json:"source"`
+
+	// Price
+	StrikePrice math.LegacyDec `json:"strike_price"`
+	StrikeDenom string         `json:"strike_denom"`
+
+	// Vesting; nil means fully vested at grant
+	Vesting       *VestingSchedule `json:"vesting,omitempty"`
+	EarlyExercise bool             `json:"early_exercise"`
+
+	// Lifetime
+	GrantedAt             time.Time     `json:"granted_at"`
+	ExpiresAt             time.Time     `json:"expires_at"`
+	PostTerminationWindow time.Duration `json:"post_termination_window"` // Exercise window for vested shares after termination
+	TerminatedAt          time.Time     `json:"terminated_at,omitempty"`
+
+	Status    GrantStatus `json:"status"`
+	GrantedBy string      `json:"granted_by"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// Validate validates an equity grant
+func (g EquityGrant) Validate() error {
+	if g.CompanyID == 0 {
+		return fmt.Errorf("company ID cannot be zero")
+	}
+	if g.ClassID == "" {
+		return fmt.Errorf("class ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Holder); err != nil {
+		return fmt.Errorf("invalid holder address: %v", err)
+	}
+	if g.Type != GrantTypeOption && g.Type != GrantTypeWarrant {
+		return fmt.Errorf("invalid grant type")
+	}
+	if g.Source != GrantShareSourceAuthorized && g.Source != GrantShareSourceTreasury {
+		return fmt.Errorf("invalid share source")
+	}
+	if g.Shares.IsNil() || !g.Shares.IsPositive() {
+		return fmt.Errorf("granted shares must be positive")
+	}
+	if g.StrikePrice.IsNil() || g.StrikePrice.IsNegative() {
+		return fmt.Errorf("strike price cannot be negative")
+	}
+	if g.StrikeDenom == "" {
+		return fmt.Errorf("strike denom cannot be empty")
+	}
+	if !g.ExpiresAt.After(g.GrantedAt) {
+		return fmt.Errorf("expiry must be after the grant date")
+	}
+	if g.PostTerminationWindow < 0 {
+		return fmt.Errorf("post-termination window cannot be negative")
+	}
+	if g.Vesting != nil {
+		if g.Vesting.TotalShares.IsNil() || !g.Vesting.TotalShares.Equal(g.Shares) {
+			return fmt.Errorf("vesting schedule must cover exactly the granted shares")
+		}
+		if !g.Vesting.EndDate.After(g.Vesting.StartDate) {
+			return fmt.Errorf("vesting end must be after start")
+		}
+		if !g.Vesting.CliffDate.IsZero() && (g.Vesting.CliffDate.Before(g.Vesting.StartDate) || g.Vesting.CliffDate.After(g.Vesting.EndDate)) {
+			return fmt.Errorf("vesting cliff must fall between start and end")
+		}
+		if !g.Vesting.CliffPercent.IsNil() && (g.Vesting.CliffPercent.IsNegative() || g.Vesting.CliffPercent.GT(math.LegacyOneDec())) {
+			return fmt.Errorf("cliff percent must be between 0 and 1")
+		}
+		if g.Vesting.VestingPeriod < 0 {
+			return fmt.Errorf("vesting period cannot be negative")
+		}
+	} else if g.EarlyExercise {
+		return fmt.Errorf("early exercise requires a vesting schedule")
+	}
+	if _, err := sdk.AccAddressFromBech32(g.GrantedBy); err != nil {
+		return fmt.Errorf("invalid granter address: %v", err)
+	}
+	return nil
+}
+
+// vestingTime returns the time vesting is measured at; vesting stops on termination
+func (g EquityGrant) vestingTime(now time.Time) time.Time {
+	if !g.TerminatedAt.IsZero() && g.TerminatedAt.Before(now) {
+		return g.TerminatedAt
+	}
+	return now
+}
+
+// VestedShares returns the granted shares vested at the given time
+func (g EquityGrant) VestedShares(now time.Time) math.Int {
+	if g.Vesting == nil {
+		return g.Shares
+	}
+	return g.Vesting.VestedAmount(g.vestingTime(now))
+}
+
+// OutstandingShares returns the granted shares not yet exercised or forfeited
+func (g EquityGrant) OutstandingShares() math.Int {
+	outstanding := g.Shares.Sub(g.ExercisedShares).Sub(g.ForfeitedShares)
+	if outstanding.IsNegative() {
+		return math.ZeroInt()
+	}
+	return outstanding
+}
+
+// ExerciseDeadline returns the last moment the grant may be exercised
+func (g EquityGrant) ExerciseDeadline() time.Time {
+	if g.TerminatedAt.IsZero() {
+		return g.ExpiresAt
+	}
+	deadline := g.TerminatedAt.Add(g.PostTerminationWindow)
+	if deadline.After(g.ExpiresAt) {
+		return g.ExpiresAt
+	}
+	return deadline
+}
+
+// IsOpen reports whether the grant still has shares that may be exercised later
+func (g EquityGrant) IsOpen() bool {
+	return g.Status == GrantStatusActive || g.Status == GrantStatusTerminated
+}
+
+// ExercisableShares returns the shares the holder may exercise at the given time.
+// Early exercise makes every outstanding share exercisable while the holder is active.
+func (g EquityGrant) ExercisableShares(now time.Time) math.Int {
+	if !g.IsOpen() || now.After(g.ExerciseDeadline()) {
+		return math.ZeroInt()
+	}
+	outstanding := g.OutstandingShares()
+	if g.EarlyExercise && g.Status == GrantStatusActive {
+		return outstanding
+	}
+	vestedUnexercised := g.VestedShares(now).Sub(g.ExercisedShares)
+	if !vestedUnexercised.IsPositive() {
+		return math.ZeroInt()
+	}
+	return math.MinInt(vestedUnexercised, outstanding)
+}
+
+// UnvestedExercised returns the exercised shares, net of any repurchase, that have not
+// yet vested at the given time
+func (g EquityGrant) UnvestedExercised(now time.Time) math.Int {
+	unvested := g.ExercisedShares.Sub(g.RepurchasedShares).Sub(g.VestedShares(now))
+	if unvested.IsNegative() {
+		return math.ZeroInt()
+	}
+	return unvested
+}
+
+// ExerciseCost returns the strike payment owed for exercising the given shares
+func (g EquityGrant) ExerciseCost(shares math.Int) math.Int {
+	return g.StrikePrice.MulInt(shares).TruncateInt()
+}
+
+// SharesToCover returns the whole shares that must be sold at price to raise the
+// strike payment for exercising the given shares, and false when the grant is not
+// in the money at that price
+func (g EquityGrant) SharesToCover(shares math.Int, price math.LegacyDec) (math.Int, bool) {
+	if price.IsNil() || !price.IsPositive() || price.LTE(g.StrikePrice) {
+		return math.ZeroInt(), false
+	}
+	cost := g.ExerciseCost(shares)
+	toSell := math.LegacyNewDecFromInt(cost).Quo(price).Ceil().TruncateInt()
+	if toSell.GTE(shares) {
+		return math.ZeroInt(), false
+	}
+	return toSell, true
+}
+
+// Option and warrant event types
+const (
+	EventTypeEquityGrantCreated    = "equity_grant_created"
+	EventTypeEquityGrantExercised  = "equity_grant_exercised"
+	EventTypeEquityGrantTerminated = "equity_grant_terminated"
+	EventTypeEquityGrantExpired    = "equity_grant_expired"
+	EventTypeEarlyExerciseVested   = "early_exercise_vested"
+
+	AttributeKeyGrantID          = "grant_id"
+	AttributeKeyGrantType        = "grant_type"
+	AttributeKeyGrantHolder      = "holder"
+	AttributeKeyStrikePrice      = "strike_price"
+	AttributeKeyExercisedShares  = "exercised_shares"
+	AttributeKeyForfeitedShares  = "forfeited_shares"
+	AttributeKeyExerciseCost     = "exercise_cost"
+	AttributeKeyCashless         = "cashless"
+	AttributeKeyExerciseDeadline = "exercise_deadline"
+)
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// RightsTerms turns a primary sale offer into a rights offering. Holders on record
+// receive one transferable subscription right coin per share; RightsPerShare rights
+// buy one new share at the offer price until the offer ends. Shares left unexercised
+// go to holders who exercised and asked for more, pro-rata to their requests.
+type RightsTerms struct {
+	RightsDenom     string    `json:"rights_denom"`      // Bank denom of the subscription rights
+	RightsPerShare  math.Int  `json:"rights_per_share"`  // Rights exercised per new share
+	RecordDate      time.Time `json:"record_date"`       // When holders were snapshotted
+	HoldersOnRecord uint64    `json:"holders_on_record"` // Holders that received rights
+	RightsIssued    math.Int  `json:"rights_issued"`     // Rights minted at the record date
+	RightsExercised math.Int  `json:"rights_exercised"`  // Rights burned by exercise
+
+	// Oversubscription round
+	SharesRequested math.Int `json:"shares_requested"` // Additional shares requested by exercising holders
+	SharesAllocated math.Int `json:"shares_allocated"` // Unexercised shares allocated to them at settlement
+	Settled         bool     `json:"settled"`
+}
+
+// Validate validates rights offering terms
+func (t RightsTerms) Validate() error {
+	if t.RightsDenom == "" {
+		return fmt.Errorf("rights denom cannot be empty")
+	}
+	if t.RightsPerShare.IsNil() || !t.RightsPerShare.IsPositive() {
+		return fmt.Errorf("rights per share must be positive")
+	}
+	if t.RightsIssued.IsNil() || t.RightsIssued.IsNegative() {
+		return fmt.Errorf("rights issued cannot be negative")
+	}
+	if t.RightsExercised.IsNil() || t.RightsExercised.GT(t.RightsIssued) {
+		return fmt.Errorf("rights exercised cannot exceed rights issued")
+	}
+	return nil
+}
+
+// SharesForRights returns the whole shares the given rights subscribe for
+func (t RightsTerms) SharesForRights(rights math.Int) math.Int {
+	return rights.Quo(t.RightsPerShare)
+}
+
+// RightsDenom returns the denom of the subscription rights of a rights offering
+func RightsDenom(symbol string, offerID uint64) string {
+	return fmt.Sprintf("%s-R%d", symbol, offerID)
+}
+
+// RightsOversubscription is an exercising holder's request for shares beyond their
+// rights. The full cost at the offer price is escrowed until settlement.
+type RightsOversubscription struct {
+	OfferID     uint64    `json:"offer_id"`
+	Subscriber  string    `json:"subscriber"`
+	Shares      math.Int  `json:"shares"`    // Additional shares requested
+	Escrowed    math.Int  `json:"escrowed"`  // Quote amount held until settlement
+	Allocated   math.Int  `json:"allocated"` // Shares received at settlement
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+// AllocateOversubscription splits the available shares among oversubscription
+// requests pro-rata to the shares requested. Requests are filled in full when there
+// is enough; shares lost to rounding are handed out one at a time in subscriber order.
+func AllocateOversubscription(available math.Int, requests []RightsOversubscription) []RightsOversubscription {
+	sorted := make([]RightsOversubscription, len(requests))
+	copy(sorted, requests)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Subscriber < sorted[j].Subscriber })
+
+	requested := math.ZeroInt()
+	for _, request := range sorted {
+		requested = requested.Add(request.Shares)
+	}
+
+	allocated := math.ZeroInt()
+	for i, request := range sorted {
+		switch {
+		case !available.IsPositive():
+			sorted[i].Allocated = math.ZeroInt()
+		case requested.LTE(available):
+			sorted[i].Allocated = request.Shares
+		default:
+			sorted[i].Allocated = request.Shares.Mul(available).Quo(requested)
+		}
+		allocated = allocated.Add(sorted[i].Allocated)
+	}
+
+	for i, request := range sorted {
+		if !allocated.LT(available) || requested.LTE(available) {
+			break
+		}
+		if sorted[i].Allocated.LT(request.Shares) {
+			sorted[i].Allocated = sorted[i].Allocated.Add(math.OneInt())
+			allocated = allocated.Add(math.OneInt())
+		}
+	}
+	return sorted
+}
+
+// Rights offering event types
+const (
+	EventTypeRightsOfferingCreated = "rights_offering_created"
+	EventTypeRightsExercised       = "rights_exercised"
+	EventTypeRightsOversubscribed  = "rights_oversubscribed"
+	EventTypeRightsOfferingSettled = "rights_offering_settled"
+
+	AttributeKeyRightsDenom     = "rights_denom"
+	AttributeKeyRightsIssued    = "rights_issued"
+	AttributeKeyRightsExercised = "rights_exercised"
+	AttributeKeySharesRequested = "shares_requested"
+	AttributeKeySharesAllocated = "shares_allocated"
+)
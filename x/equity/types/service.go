@@ -52,6 +52,10 @@ type QueryServer interface {
 	IssuanceHistory(context.Context, *QueryIssuanceHistoryRequest) (*QueryIssuanceHistoryResponse, error)
 	AdjustmentHistory(context.Context, *QueryAdjustmentHistoryRequest) (*QueryAdjustmentHistoryResponse, error)
 	CompanyCapTable(context.Context, *QueryCompanyCapTableRequest) (*QueryCompanyCapTableResponse, error)
+	CapTable(context.Context, *QueryCapTableRequest) (*QueryCapTableResponse, error)
+
+	// Liquidation queries
+	LiquidationWaterfall(context.Context, *QueryLiquidationWaterfallRequest) (*QueryLiquidationWaterfallResponse, error)
 }
 
 // =============================================================================
@@ -239,6 +243,18 @@ type AntiDilutionSummary struct {
 	ActiveProvisions    uint64         `json:"active_provisions"`
 }
 
+// QueryCapTableRequest requests a company's cap table at a block height
+type QueryCapTableRequest struct {
+	CompanyID uint64 `json:"company_id"`
+	Height    int64  `json:"height,omitempty"` // Zero for the current height
+	Format    string `json:"format,omitempty"` // "csv" or "json" to also return an export
+}
+
+type QueryCapTableResponse struct {
+	CapTable CapTable `json:"cap_table"`
+	Export   string   `json:"export,omitempty"`
+}
+
 // =============================================================================
 // Pagination Types
 // =============================================================================
@@ -252,4 +268,18 @@ type PageRequest struct {
 type PageResponse struct {
 	NextKey []byte `json:"next_key,omitempty"`
 	Total   uint64 `json:"total,omitempty"`
-}
\ No newline at end of file
+}
+
+// =============================================================================
+// Liquidation Query Types
+// =============================================================================
+
+type QueryLiquidationWaterfallRequest struct {
+	CompanyID uint64   `json:"company_id"`
+	Proceeds  math.Int `json:"proceeds,omitempty"` // Defaults to the available treasury balance
+}
+
+type QueryLiquidationWaterfallResponse struct {
+	Waterfall LiquidationWaterfall `json:"waterfall"`
+	Holders   []HolderPayout       `json:"holders"`
+}
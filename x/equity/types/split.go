@@ -0,0 +1,200 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Stock split limits
+const (
+	MaxSplitRatioTerm = 1000    // Largest numerator or denominator a split may use
+	SplitCashOutDenom = "uhodl" // Fractional shares are cashed out in this denom
+)
+
+// StockSplitStatus represents the status of a stock split corporate action
+type StockSplitStatus int32
+
+const (
+	StockSplitStatusPending   StockSplitStatus = iota // Proposed, awaiting governance approval
+	StockSplitStatusApproved                          // Approved by governance, ready to execute
+	StockSplitStatusExecuted                          // All records rescaled
+	StockSplitStatusCancelled                         // Cancelled before execution
+)
+
+func (s StockSplitStatus) String() string {
+	switch s {
+	case StockSplitStatusPending:
+		return "pending"
+	case StockSplitStatusApproved:
+		return "approved"
+	case StockSplitStatusExecuted:
+		return "executed"
+	case StockSplitStatusCancelled:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
+// StockSplit is a forward or reverse split of one share class.
+// Numerator:Denominator is new shares per old share, so 2:1 doubles every holding
+// and 1:10 consolidates ten shares into one. Holdings are rounded down to whole
+// shares and the fractional remainder is cashed out of the company treasury at
+// ReferencePrice.
+type StockSplit struct {
+	ID        uint64 `json:"id"`
+	CompanyID uint64 `json:"company_id"`
+	ClassID   string `json:"class_id"`
+
+	// Ratio (new shares per old share)
+	Numerator   uint64 `json:"numerator"`
+	Denominator uint64 `json:"denominator"`
+
+	// Cash-in-lieu price for fractional shares (uhodl per pre-split share)
+	ReferencePrice math.LegacyDec `json:"reference_price"`
+
+	Reason     string           `json:"reason"`
+	ProposedBy string           `json:"proposed_by"`
+	ProposalID uint64           `json:"proposal_id,omitempty"` // Approving governance proposal
+	Status     StockSplitStatus `json:"status"`
+
+	// Execution results
+	HoldersAdjusted   uint64   `json:"holders_adjusted"`
+	SharesBefore      math.Int `json:"shares_before"` // Class issued shares before the split
+	SharesAfter       math.Int `json:"shares_after"`  // Class issued shares after the split
+	FractionalCashOut math.Int `json:"fractional_cash_out"`
+
+	ProposedAt time.Time `json:"proposed_at"`
+	ApprovedAt time.Time `json:"approved_at,omitempty"`
+	ExecutedAt time.Time `json:"executed_at,omitempty"`
+}
+
+// NewStockSplit creates a new pending stock split
+func NewStockSplit(
+	id, companyID uint64,
+	classID string,
+	numerator, denominator uint64,
+	referencePrice math.LegacyDec,
+	reason, proposedBy string,
+	blockTime time.Time,
+) StockSplit {
+	return StockSplit{
+		ID:                id,
+		CompanyID:         companyID,
+		ClassID:           classID,
+		Numerator:         numerator,
+		Denominator:       denominator,
+		ReferencePrice:    referencePrice,
+		Reason:            reason,
+		ProposedBy:        proposedBy,
+		Status:            StockSplitStatusPending,
+		SharesBefore:      math.ZeroInt(),
+		SharesAfter:       math.ZeroInt(),
+		FractionalCashOut: math.ZeroInt(),
+		ProposedAt:        blockTime,
+	}
+}
+
+// Validate validates a stock split
+func (s StockSplit) Validate() error {
+	if s.CompanyID == 0 {
+		return fmt.Errorf("company ID cannot be zero")
+	}
+	if s.ClassID == "" {
+		return fmt.Errorf("class ID cannot be empty")
+	}
+	if s.Numerator == 0 || s.Denominator == 0 {
+		return fmt.Errorf("split ratio terms must be positive")
+	}
+	if s.Numerator == s.Denominator {
+		return fmt.Errorf("split ratio cannot be 1:1")
+	}
+	if s.Numerator > MaxSplitRatioTerm || s.Denominator > MaxSplitRatioTerm {
+		return fmt.Errorf("split ratio terms cannot exceed %d", MaxSplitRatioTerm)
+	}
+	if s.ReferencePrice.IsNil() || s.ReferencePrice.IsNegative() {
+		return fmt.Errorf("reference price cannot be negative")
+	}
+	if s.Reason == "" {
+		return fmt.Errorf("reason cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.ProposedBy); err != nil {
+		return fmt.Errorf("invalid proposer address: %v", err)
+	}
+	return nil
+}
+
+// IsReverse reports whether the split consolidates shares
+func (s StockSplit) IsReverse() bool {
+	return s.Numerator < s.Denominator
+}
+
+// Ratio returns the split ratio as "numerator:denominator"
+func (s StockSplit) Ratio() string {
+	return fmt.Sprintf("%d:%d", s.Numerator, s.Denominator)
+}
+
+// SplitShares returns the whole post-split shares for a pre-split amount and the
+// pre-split remainder that did not make a whole new share
+func (s StockSplit) SplitShares(shares math.Int) (math.Int, math.Int) {
+	if shares.IsNil() || !shares.IsPositive() {
+		return math.ZeroInt(), math.ZeroInt()
+	}
+	scaled := shares.Mul(math.NewIntFromUint64(s.Numerator))
+	den := math.NewIntFromUint64(s.Denominator)
+	return scaled.Quo(den), scaled.Mod(den)
+}
+
+// ScaleShares returns the whole post-split shares for a pre-split amount
+func (s StockSplit) ScaleShares(shares math.Int) math.Int {
+	whole, _ := s.SplitShares(shares)
+	return whole
+}
+
+// ScaleClassTotal returns the post-split value of a share class total that
+// covered heldBefore shares of holdings, which became heldAfter whole shares once
+// fractions were cashed out. The part of the total not held by shareholders
+// (treasury shares) is scaled as one block.
+func (s StockSplit) ScaleClassTotal(total, heldBefore, heldAfter math.Int) math.Int {
+	unheld := total.Sub(heldBefore)
+	if unheld.IsNegative() {
+		return math.MinInt(s.ScaleShares(total), heldAfter)
+	}
+	return heldAfter.Add(s.ScaleShares(unheld))
+}
+
+// ScalePrice returns the post-split equivalent of a per-share price
+func (s StockSplit) ScalePrice(price math.LegacyDec) math.LegacyDec {
+	if price.IsNil() {
+		return price
+	}
+	return price.MulInt64(int64(s.Denominator)).QuoInt64(int64(s.Numerator))
+}
+
+// CashInLieu returns the uhodl owed for a remainder returned by SplitShares.
+// The remainder is in units of 1/Denominator post-split share, which is worth
+// 1/Numerator of a pre-split share at ReferencePrice.
+func (s StockSplit) CashInLieu(remainder math.Int) math.Int {
+	if remainder.IsNil() || !remainder.IsPositive() || s.ReferencePrice.IsNil() {
+		return math.ZeroInt()
+	}
+	return s.ReferencePrice.MulInt(remainder).QuoInt64(int64(s.Numerator)).TruncateInt()
+}
+
+// Stock split event types
+const (
+	EventTypeStockSplitProposed  = "stock_split_proposed"
+	EventTypeStockSplitApproved  = "stock_split_approved"
+	EventTypeStockSplitExecuted  = "stock_split_executed"
+	EventTypeStockSplitCancelled = "stock_split_cancelled"
+	EventTypeFractionalCashOut   = "fractional_share_cash_out"
+
+	AttributeKeySplitID      = "split_id"
+	AttributeKeySplitRatio   = "ratio"
+	AttributeKeySharesBefore = "shares_before"
+	AttributeKeySharesAfter  = "shares_after"
+	AttributeKeyCashOut      = "cash_out"
+)
@@ -429,6 +429,7 @@ type PrimarySaleOffer struct {
 	Status         OfferStatus    `json:"status"`
 	CreatedBy      string         `json:"created_by"`        // Bech32 address
 	CreatedAt      time.Time      `json:"created_at"`
+	Rights         *RightsTerms   `json:"rights,omitempty"`  // Set for rights offerings; nil sells to anyone
 }
 
 // Validate validates a PrimarySaleOffer
@@ -472,6 +473,11 @@ func (o PrimarySaleOffer) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(o.CreatedBy); err != nil {
 		return fmt.Errorf("invalid creator address: %v", err)
 	}
+	if o.Rights != nil {
+		if err := o.Rights.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
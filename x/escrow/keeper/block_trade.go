@@ -0,0 +1,481 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/escrow/types"
+)
+
+// ============ OTC Block Trades ============
+
+// GetNextBlockTradeID returns the next block trade ID and increments the counter
+func (k Keeper) GetNextBlockTradeID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockTradeCounterKey)
+
+	var counter uint64 = 1
+	if bz != nil {
+		counter = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.BlockTradeCounterKey, sdk.Uint64ToBigEndian(counter+1))
+	return counter
+}
+
+// SetNextBlockTradeID sets the block trade counter (used by genesis import)
+func (k Keeper) SetNextBlockTradeID(ctx sdk.Context, nextID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BlockTradeCounterKey, sdk.Uint64ToBigEndian(nextID))
+}
+
+// GetBlockTrade returns a block trade by ID
+func (k Keeper) GetBlockTrade(ctx sdk.Context, blockTradeID uint64) (types.BlockTrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBlockTradeKey(blockTradeID))
+	if bz == nil {
+		return types.BlockTrade{}, false
+	}
+
+	var trade types.BlockTrade
+	if err := json.Unmarshal(bz, &trade); err != nil {
+		return types.BlockTrade{}, false
+	}
+	return trade, true
+}
+
+// SetBlockTrade stores a block trade
+func (k Keeper) SetBlockTrade(ctx sdk.Context, trade types.BlockTrade) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(trade)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal block trade", "error", err)
+		return fmt.Errorf("failed to marshal block trade: %w", err)
+	}
+	store.Set(types.GetBlockTradeKey(trade.ID), bz)
+	return nil
+}
+
+// GetAllBlockTrades returns all block trades
+func (k Keeper) GetAllBlockTrades(ctx sdk.Context) []types.BlockTrade {
+	store := ctx.KVStore(k.storeKey)
+	iterator := prefix.NewStore(store, types.BlockTradePrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var trades []types.BlockTrade
+	for ; iterator.Valid(); iterator.Next() {
+		var trade types.BlockTrade
+		if err := json.Unmarshal(iterator.Value(), &trade); err != nil {
+			continue
+		}
+		trades = append(trades, trade)
+	}
+	return trades
+}
+
+// ProposeBlockTrade proposes a negotiated equity block to a counterparty. Proposing signs
+// the price and quantity and locks the proposer's leg into a mixed-asset escrow; the trade
+// settles when the counterparty signs the same terms via AcceptBlockTrade.
+func (k Keeper) ProposeBlockTrade(
+	ctx sdk.Context,
+	proposer, counterparty string,
+	proposerIsSeller bool,
+	symbol, shareClass string,
+	quantity math.Int,
+	price math.LegacyDec,
+	expiresAt time.Time,
+) (types.BlockTrade, error) {
+	if k.equityKeeper == nil {
+		return types.BlockTrade{}, errors.Wrap(types.ErrInvalidAsset, "equity keeper not configured")
+	}
+	companyID, found := k.equityKeeper.GetCompanyBySymbol(ctx, symbol)
+	if !found {
+		return types.BlockTrade{}, errors.Wrapf(types.ErrInvalidAsset, "unknown equity symbol %s", symbol)
+	}
+
+	seller, buyer := proposer, counterparty
+	if !proposerIsSeller {
+		seller, buyer = counterparty, proposer
+	}
+
+	trade := types.NewBlockTrade(
+		k.GetNextBlockTradeID(ctx),
+		seller, buyer, proposer,
+		companyID, shareClass, symbol,
+		quantity, price,
+		expiresAt,
+		ctx.BlockTime(),
+	)
+	if err := trade.Validate(); err != nil {
+		return types.BlockTrade{}, errors.Wrap(types.ErrInvalidBlockTrade, err.Error())
+	}
+	if err := k.checkBlockTradeAllowed(ctx, trade); err != nil {
+		return types.BlockTrade{}, err
+	}
+
+	// Both legs live in one escrow: buyer is the sender, seller the recipient
+	escrow, err := k.CreateEscrow(ctx, buyer, seller, "", trade.EscrowAssets(), "OTC block trade", trade.Terms(), expiresAt)
+	if err != nil {
+		return types.BlockTrade{}, err
+	}
+	escrow.BlockTradeID = trade.ID
+	if err := k.SetEscrow(ctx, escrow); err != nil {
+		return types.BlockTrade{}, err
+	}
+	trade.EscrowID = escrow.ID
+
+	if err := k.lockBlockTradeLeg(ctx, &trade, proposer); err != nil {
+		return types.BlockTrade{}, err
+	}
+	if err := k.SetBlockTrade(ctx, trade); err != nil {
+		return types.BlockTrade{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBlockTradeProposed,
+			sdk.NewAttribute(types.AttributeKeyBlockTradeID, fmt.Sprintf("%d", trade.ID)),
+			sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", escrow.ID)),
+			sdk.NewAttribute(types.AttributeKeySeller, seller),
+			sdk.NewAttribute(types.AttributeKeyBuyer, buyer),
+			sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+			sdk.NewAttribute(types.AttributeKeyQuantity, quantity.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+		),
+	)
+
+	return trade, nil
+}
+
+// AcceptBlockTrade signs a proposed block trade as the counterparty. The signer must
+// repeat the exact price and quantity; their leg is locked and both legs settle atomically.
+func (k Keeper) AcceptBlockTrade(
+	ctx sdk.Context,
+	blockTradeID uint64,
+	signer string,
+	quantity math.Int,
+	price math.LegacyDec,
+) (types.BlockTrade, error) {
+	trade, found := k.GetBlockTrade(ctx, blockTradeID)
+	if !found {
+		return types.BlockTrade{}, types.ErrBlockTradeNotFound
+	}
+	if trade.Status != types.BlockTradeStatusProposed {
+		return types.BlockTrade{}, types.ErrBlockTradeNotOpen
+	}
+	if ctx.BlockTime().After(trade.ExpiresAt) {
+		return types.BlockTrade{}, errors.Wrap(types.ErrBlockTradeNotOpen, "block trade has expired")
+	}
+	if signer != trade.Seller && signer != trade.Buyer {
+		return types.BlockTrade{}, types.ErrUnauthorized
+	}
+	if trade.HasSigned(signer) {
+		return types.BlockTrade{}, types.ErrBlockTradeAlreadySigned
+	}
+	if !trade.MatchesTerms(quantity, price) {
+		return types.BlockTrade{}, types.ErrBlockTradeTermsMismatch
+	}
+
+	// Restrictions and lockups may have changed since the proposal
+	if err := k.checkBlockTradeAllowed(ctx, trade); err != nil {
+		return types.BlockTrade{}, err
+	}
+
+	if err := k.lockBlockTradeLeg(ctx, &trade, signer); err != nil {
+		return types.BlockTrade{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBlockTradeSigned,
+			sdk.NewAttribute(types.AttributeKeyBlockTradeID, fmt.Sprintf("%d", trade.ID)),
+			sdk.NewAttribute(types.AttributeKeyCounterparty, signer),
+		),
+	)
+
+	if err := k.settleBlockTrade(ctx, &trade); err != nil {
+		return types.BlockTrade{}, err
+	}
+	return trade, nil
+}
+
+// CancelBlockTrade cancels an unsettled block trade and refunds any locked leg.
+// Either party may cancel until the counterparty has signed.
+func (k Keeper) CancelBlockTrade(ctx sdk.Context, blockTradeID uint64, canceller string) error {
+	trade, found := k.GetBlockTrade(ctx, blockTradeID)
+	if !found {
+		return types.ErrBlockTradeNotFound
+	}
+	if canceller != trade.Seller && canceller != trade.Buyer {
+		return types.ErrUnauthorized
+	}
+	if trade.Status != types.BlockTradeStatusProposed {
+		return types.ErrBlockTradeNotOpen
+	}
+
+	if err := k.refundBlockTradeLegs(ctx, trade, types.EscrowStatusCancelled); err != nil {
+		return err
+	}
+
+	trade.Status = types.BlockTradeStatusCancelled
+	if err := k.SetBlockTrade(ctx, trade); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBlockTradeCancelled,
+			sdk.NewAttribute(types.AttributeKeyBlockTradeID, fmt.Sprintf("%d", trade.ID)),
+			sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", trade.EscrowID)),
+		),
+	)
+
+	return nil
+}
+
+// ProcessExpiredBlockTrades refunds locked legs of proposals the counterparty never signed
+func (k Keeper) ProcessExpiredBlockTrades(ctx sdk.Context) {
+	currentTime := ctx.BlockTime()
+
+	for _, trade := range k.GetAllBlockTrades(ctx) {
+		if trade.Status != types.BlockTradeStatusProposed || !currentTime.After(trade.ExpiresAt) {
+			continue
+		}
+
+		if err := k.refundBlockTradeLegs(ctx, trade, types.EscrowStatusExpired); err != nil {
+			k.Logger(ctx).Error("failed to refund expired block trade",
+				"block_trade_id", trade.ID,
+				"error", err,
+			)
+			continue
+		}
+
+		trade.Status = types.BlockTradeStatusExpired
+		k.SetBlockTrade(ctx, trade)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBlockTradeExpired,
+				sdk.NewAttribute(types.AttributeKeyBlockTradeID, fmt.Sprintf("%d", trade.ID)),
+				sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", trade.EscrowID)),
+			),
+		)
+	}
+}
+
+// checkBlockTradeAllowed enforces trading halts and the share class transfer restrictions
+// and lockups on the seller's shares
+func (k Keeper) checkBlockTradeAllowed(ctx sdk.Context, trade types.BlockTrade) error {
+	if k.equityKeeper.IsTradingHalted(ctx, trade.CompanyID) {
+		return errors.Wrap(types.ErrBlockTradeRestricted, "trading is halted for this company")
+	}
+	if err := k.equityKeeper.CheckShareTransferAllowed(
+		ctx, trade.CompanyID, trade.ShareClass, trade.Seller, trade.Buyer, trade.Quantity,
+	); err != nil {
+		return errors.Wrap(types.ErrBlockTradeRestricted, err.Error())
+	}
+	return nil
+}
+
+// lockBlockTradeLeg moves a signing party's leg into the escrow module account
+func (k Keeper) lockBlockTradeLeg(ctx sdk.Context, trade *types.BlockTrade, signer string) error {
+	signerAddr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return err
+	}
+
+	if signer == trade.Seller {
+		coins := sdk.NewCoins(sdk.NewCoin(trade.Symbol, trade.Quantity))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, signerAddr, types.ModuleName, coins); err != nil {
+			return fmt.Errorf("failed to lock %s: %w", trade.Symbol, err)
+		}
+
+		// Seller keeps receiving dividends while the shares sit in escrow
+		if err := k.equityKeeper.RegisterBeneficialOwner(
+			ctx,
+			types.ModuleName,
+			trade.CompanyID,
+			trade.ShareClass,
+			trade.Seller,
+			trade.Quantity,
+			trade.EscrowID,
+			"escrow",
+		); err != nil {
+			k.Logger(ctx).Error("failed to register beneficial owner",
+				"escrow_id", trade.EscrowID,
+				"block_trade_id", trade.ID,
+				"owner", trade.Seller,
+				"error", err,
+			)
+		}
+		trade.SellerSigned = true
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(trade.PaymentDenom, trade.Value()))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, signerAddr, types.ModuleName, coins); err != nil {
+		return fmt.Errorf("failed to lock %s: %w", trade.PaymentDenom, err)
+	}
+	trade.BuyerSigned = true
+	return nil
+}
+
+// settleBlockTrade swaps both locked legs and prints the trade to DEX history as off-book.
+// All transfers run in a cache context so either both legs move or neither does.
+func (k Keeper) settleBlockTrade(ctx sdk.Context, trade *types.BlockTrade) error {
+	escrow, found := k.GetEscrow(ctx, trade.EscrowID)
+	if !found {
+		return types.ErrEscrowNotFound
+	}
+	if !trade.IsFullySigned() {
+		return types.ErrBlockTradeNotOpen
+	}
+
+	sellerAddr, err := sdk.AccAddressFromBech32(trade.Seller)
+	if err != nil {
+		return err
+	}
+	buyerAddr, err := sdk.AccAddressFromBech32(trade.Buyer)
+	if err != nil {
+		return err
+	}
+
+	// Escrow fee comes out of the seller's proceeds and stays in the module account
+	value := trade.Value()
+	fee := escrow.EscrowFee.MulInt(value).TruncateInt()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	shareCoins := sdk.NewCoins(sdk.NewCoin(trade.Symbol, trade.Quantity))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, buyerAddr, shareCoins); err != nil {
+		return fmt.Errorf("failed to deliver %s: %w", trade.Symbol, err)
+	}
+	if proceeds := value.Sub(fee); proceeds.IsPositive() {
+		paymentCoins := sdk.NewCoins(sdk.NewCoin(trade.PaymentDenom, proceeds))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, sellerAddr, paymentCoins); err != nil {
+			return fmt.Errorf("failed to pay %s: %w", trade.PaymentDenom, err)
+		}
+	}
+
+	writeCache()
+
+	if err := k.equityKeeper.UnregisterBeneficialOwner(
+		ctx,
+		types.ModuleName,
+		trade.CompanyID,
+		trade.ShareClass,
+		trade.Seller,
+		trade.EscrowID,
+	); err != nil {
+		k.Logger(ctx).Error("failed to unregister beneficial owner",
+			"escrow_id", trade.EscrowID,
+			"block_trade_id", trade.ID,
+			"owner", trade.Seller,
+			"error", err,
+		)
+	}
+
+	// Print to DEX trade history and market stats without touching the order book
+	if k.dexKeeper != nil {
+		dexTradeID, err := k.dexKeeper.RecordOffBookTrade(
+			ctx,
+			trade.MarketSymbol(),
+			trade.Buyer,
+			trade.Seller,
+			trade.Quantity,
+			trade.Price,
+			math.LegacyZeroDec(),
+			math.LegacyNewDecFromInt(fee),
+			trade.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record off-book trade: %w", err)
+		}
+		trade.DexTradeID = dexTradeID
+	}
+
+	now := ctx.BlockTime()
+	escrow.Status = types.EscrowStatusReleased
+	escrow.FundedAt = now
+	escrow.CompletedAt = now
+	escrow.SenderConfirmed = true
+	escrow.RecipientConfirmed = true
+	if err := k.SetEscrow(ctx, escrow); err != nil {
+		return err
+	}
+
+	trade.Status = types.BlockTradeStatusSettled
+	trade.SettledAt = now
+	if err := k.SetBlockTrade(ctx, *trade); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBlockTradeSettled,
+			sdk.NewAttribute(types.AttributeKeyBlockTradeID, fmt.Sprintf("%d", trade.ID)),
+			sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", trade.EscrowID)),
+			sdk.NewAttribute(types.AttributeKeyDexTradeID, fmt.Sprintf("%d", trade.DexTradeID)),
+			sdk.NewAttribute(types.AttributeKeySeller, trade.Seller),
+			sdk.NewAttribute(types.AttributeKeyBuyer, trade.Buyer),
+			sdk.NewAttribute(types.AttributeKeyQuantity, trade.Quantity.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, trade.Price.String()),
+		),
+	)
+
+	return nil
+}
+
+// refundBlockTradeLegs returns every locked leg to its owner and closes the escrow
+func (k Keeper) refundBlockTradeLegs(ctx sdk.Context, trade types.BlockTrade, status types.EscrowStatus) error {
+	if trade.SellerSigned {
+		sellerAddr, err := sdk.AccAddressFromBech32(trade.Seller)
+		if err != nil {
+			return err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(trade.Symbol, trade.Quantity))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sellerAddr, coins); err != nil {
+			return fmt.Errorf("failed to refund %s: %w", trade.Symbol, err)
+		}
+		if err := k.equityKeeper.UnregisterBeneficialOwner(
+			ctx,
+			types.ModuleName,
+			trade.CompanyID,
+			trade.ShareClass,
+			trade.Seller,
+			trade.EscrowID,
+		); err != nil {
+			k.Logger(ctx).Error("failed to unregister beneficial owner",
+				"escrow_id", trade.EscrowID,
+				"block_trade_id", trade.ID,
+				"owner", trade.Seller,
+				"error", err,
+			)
+		}
+	}
+
+	if trade.BuyerSigned {
+		buyerAddr, err := sdk.AccAddressFromBech32(trade.Buyer)
+		if err != nil {
+			return err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(trade.PaymentDenom, trade.Value()))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyerAddr, coins); err != nil {
+			return fmt.Errorf("failed to refund %s: %w", trade.PaymentDenom, err)
+		}
+	}
+
+	escrow, found := k.GetEscrow(ctx, trade.EscrowID)
+	if !found {
+		return types.ErrEscrowNotFound
+	}
+	escrow.Status = status
+	escrow.CompletedAt = ctx.BlockTime()
+	return k.SetEscrow(ctx, escrow)
+}
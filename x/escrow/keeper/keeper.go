@@ -24,6 +24,7 @@ type Keeper struct {
 	accountKeeper  types.AccountKeeper
 	equityKeeper   types.EquityKeeper
 	stakingKeeper  types.UniversalStakingKeeper // For tier/reputation checks and validator oversight
+	dexKeeper      types.DexKeeper              // For printing OTC block trades to DEX history
 }
 
 // NewKeeper creates a new escrow Keeper instance
@@ -43,6 +44,7 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		equityKeeper:  equityKeeper,
 		stakingKeeper: nil, // Set later via SetStakingKeeper
+		dexKeeper:     nil, // Set later via SetDexKeeper
 	}
 }
 
@@ -51,6 +53,41 @@ func (k *Keeper) SetStakingKeeper(stakingKeeper types.UniversalStakingKeeper) {
 	k.stakingKeeper = stakingKeeper
 }
 
+// SetDexKeeper sets the DEX keeper (for late binding during app initialization)
+func (k *Keeper) SetDexKeeper(dexKeeper types.DexKeeper) {
+	k.dexKeeper = dexKeeper
+}
+
+// GetParams returns the escrow module parameters
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	if err := json.Unmarshal(bz, &params); err != nil {
+		return types.DefaultParams()
+	}
+	return params
+}
+
+// SetParams sets the escrow module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
 // =============================================================================
 // TIER CHECKS - Require Warden+ tier for moderator registration
 // =============================================================================
@@ -276,6 +313,11 @@ func (k Keeper) FundEscrow(ctx sdk.Context, escrowID uint64, funder string) erro
 		return types.ErrUnauthorized
 	}
 
+	// Block trade legs are locked by each party signing the block trade
+	if escrow.IsBlockTrade() {
+		return types.ErrBlockTradeEscrow
+	}
+
 	// Check escrow status
 	if escrow.Status != types.EscrowStatusPending {
 		return types.ErrEscrowAlreadyFunded
@@ -375,16 +417,26 @@ func (k Keeper) ReleaseEscrow(ctx sdk.Context, escrowID uint64, releaser string)
 		return err
 	}
 
+	// COMPLIANCE: Escrowed equity passes from the sender to the recipient, so every
+	// equity asset must clear the share class rules before anything is released
+	for i, asset := range escrow.Assets {
+		if err := k.checkEquityCompliance(ctx, asset, escrow.Sender, escrow.Recipient, escrow.RemainingAmount(i)); err != nil {
+			return err
+		}
+	}
+
 	// Calculate fees
 	feeAmount := escrow.TotalValue.Mul(escrow.EscrowFee).TruncateInt()
 
 	// Transfer assets to recipient (minus fees)
-	for _, asset := range escrow.Assets {
-		transferAmount := asset.Amount
+	// Streaming escrows only release what has not already been withdrawn
+	for i, asset := range escrow.Assets {
+		remaining := escrow.RemainingAmount(i)
+		transferAmount := remaining
 		if asset.AssetType == types.AssetTypeHODL {
 			// Deduct proportional fee from HODL
-			assetFee := feeAmount.Mul(asset.Amount).Quo(escrow.TotalValue.TruncateInt())
-			transferAmount = asset.Amount.Sub(assetFee)
+			assetFee := feeAmount.Mul(remaining).Quo(escrow.TotalValue.TruncateInt())
+			transferAmount = remaining.Sub(assetFee)
 		}
 
 		if transferAmount.IsPositive() {
@@ -479,10 +531,14 @@ func (k Keeper) RefundEscrow(ctx sdk.Context, escrowID uint64, refunder string)
 	}
 
 	// Transfer all assets back to sender
-	for _, asset := range escrow.Assets {
-		coins := sdk.NewCoins(sdk.NewCoin(asset.Denom, asset.Amount))
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, senderAddr, coins); err != nil {
-			return fmt.Errorf("failed to refund %s: %w", asset.Denom, err)
+	// Streaming escrows only refund what has not already been withdrawn
+	for i, asset := range escrow.Assets {
+		remaining := escrow.RemainingAmount(i)
+		if remaining.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(asset.Denom, remaining))
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, senderAddr, coins); err != nil {
+				return fmt.Errorf("failed to refund %s: %w", asset.Denom, err)
+			}
 		}
 
 		// Unregister beneficial owner for equity assets
@@ -545,6 +601,11 @@ func (k Keeper) CancelEscrow(ctx sdk.Context, escrowID uint64, canceller string)
 		return types.ErrUnauthorized
 	}
 
+	// Block trade escrows may hold a locked leg; use CancelBlockTrade to refund it
+	if escrow.IsBlockTrade() {
+		return types.ErrBlockTradeEscrow
+	}
+
 	// Can only cancel pending escrows
 	if escrow.Status != types.EscrowStatusPending {
 		return types.ErrInvalidStatus
@@ -664,9 +725,12 @@ func (k Keeper) OpenDispute(ctx sdk.Context, escrowID uint64, initiator, reason
 	}
 
 	// Create dispute
+	// Template escrows use the template's dispute timeout and appeal limit (default: 7 days, 1 appeal)
 	disputeID := k.GetNextDisputeID(ctx)
-	deadline := ctx.BlockTime().Add(7 * 24 * time.Hour) // 7 days for resolution
+	timeout, maxAppeals := k.disputeTermsForEscrow(ctx, escrow)
+	deadline := ctx.BlockTime().Add(timeout)
 	dispute := types.NewDispute(disputeID, escrowID, initiator, reason, deadline, ctx.BlockTime())
+	dispute.MaxAppeals = maxAppeals
 
 	// Validate
 	if err := dispute.Validate(); err != nil {
@@ -875,9 +939,25 @@ func (k Keeper) splitEscrowFunds(ctx sdk.Context, escrow types.Escrow, dispute *
 		recipientShare = dispute.RecipientAmount.Quo(total)
 	}
 
-	for _, asset := range escrow.Assets {
-		senderAmount := senderShare.MulInt(asset.Amount).TruncateInt()
-		recipientAmount := recipientShare.MulInt(asset.Amount).TruncateInt()
+	for i, asset := range escrow.Assets {
+		remaining := escrow.RemainingAmount(i)
+		senderAmount := senderShare.MulInt(remaining).TruncateInt()
+		recipientAmount := recipientShare.MulInt(remaining).TruncateInt()
+
+		// COMPLIANCE: Equity the recipient may not hold under the share class rules
+		// stays with the sender who deposited it
+		if recipientAmount.IsPositive() {
+			if err := k.checkEquityCompliance(ctx, asset, escrow.Sender, escrow.Recipient, recipientAmount); err != nil {
+				k.Logger(ctx).Info("split equity returned to sender",
+					"escrow_id", escrow.ID,
+					"denom", asset.Denom,
+					"amount", recipientAmount.String(),
+					"reason", err.Error(),
+				)
+				senderAmount = senderAmount.Add(recipientAmount)
+				recipientAmount = math.ZeroInt()
+			}
+		}
 
 		if senderAmount.IsPositive() {
 			senderCoins := sdk.NewCoins(sdk.NewCoin(asset.Denom, senderAmount))
@@ -924,6 +1004,18 @@ func (k Keeper) splitEscrowFunds(ctx sdk.Context, escrow types.Escrow, dispute *
 	return nil
 }
 
+// checkEquityCompliance checks an equity asset can pass from one holder to
+// another under its share class rules; other assets always pass
+func (k Keeper) checkEquityCompliance(ctx sdk.Context, asset types.EscrowAsset, from, to string, amount math.Int) error {
+	if asset.AssetType != types.AssetTypeEquity || k.equityKeeper == nil || !amount.IsPositive() {
+		return nil
+	}
+	if err := k.equityKeeper.CheckTransferCompliance(ctx, asset.CompanyID, asset.ShareClass, from, to, amount); err != nil {
+		return fmt.Errorf("%s cannot pass to %s: %w", asset.Denom, to, err)
+	}
+	return nil
+}
+
 // AppealDispute appeals a dispute resolution
 func (k Keeper) AppealDispute(ctx sdk.Context, disputeID uint64, appellant, reason string) error {
 	dispute, found := k.GetDispute(ctx, disputeID)
@@ -1105,13 +1197,19 @@ func (k Keeper) ProcessExpiredEscrows(ctx sdk.Context) {
 			continue
 		}
 
+		// Block trades refund their locked legs in ProcessExpiredBlockTrades
+		if escrow.IsBlockTrade() {
+			continue
+		}
+
 		// Check if expired and still pending or funded
 		if currentTime.After(escrow.ExpiresAt) {
 			if escrow.Status == types.EscrowStatusPending {
 				escrow.Status = types.EscrowStatusExpired
 				k.SetEscrow(ctx, escrow)
-			} else if escrow.Status == types.EscrowStatusFunded {
+			} else if escrow.Status == types.EscrowStatusFunded && !escrow.IsStream() {
 				// Auto-refund expired funded escrows
+				// (ExpiresAt is only the funding deadline for streams, which run until their end time)
 				k.RefundEscrow(ctx, escrow.ID, escrow.Recipient)
 				escrow.Status = types.EscrowStatusExpired
 				k.SetEscrow(ctx, escrow)
@@ -1194,10 +1292,11 @@ func (k Keeper) EmergencyDisputeResolution(ctx sdk.Context, escrowID uint64, rea
 	}
 
 	// Split each asset 50/50 as emergency fallback
-	for _, asset := range escrow.Assets {
+	for i, asset := range escrow.Assets {
+		remaining := escrow.RemainingAmount(i)
 		if asset.AssetType == types.AssetTypeHODL {
 			// Split HODL tokens
-			halfAmount := asset.Amount.Quo(math.NewInt(2))
+			halfAmount := remaining.Quo(math.NewInt(2))
 			if halfAmount.IsPositive() {
 				senderCoins := sdk.NewCoins(sdk.NewCoin(asset.Denom, halfAmount))
 				if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, senderAddr, senderCoins); err != nil {
@@ -1211,7 +1310,7 @@ func (k Keeper) EmergencyDisputeResolution(ctx sdk.Context, escrowID uint64, rea
 			}
 		} else if asset.AssetType == types.AssetTypeEquity && k.equityKeeper != nil {
 			// Split equity shares
-			halfShares := asset.Amount.Quo(math.NewInt(2))
+			halfShares := remaining.Quo(math.NewInt(2))
 			if halfShares.IsPositive() {
 				// Transfer half to sender
 				if err := k.equityKeeper.TransferShares(ctx, asset.CompanyID, asset.ShareClass,
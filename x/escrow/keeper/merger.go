@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/escrow/types"
+)
+
+// AfterShareExchange moves escrowed target equity to the acquirer after a merger in
+// the equity module, rounding down like the beneficial owner registry. The symbol
+// balance held by the module account has already been exchanged by the equity module
+// and the cash consideration paid to the beneficial owners. Open block trades on the
+// target are cancelled: the seller gets acquirer shares back, the buyer the payment.
+func (k Keeper) AfterShareExchange(
+	ctx sdk.Context,
+	targetID uint64, targetClassID, targetSymbol string,
+	acquirerID uint64, acquirerClassID, acquirerSymbol string,
+	numerator, denominator uint64,
+) error {
+	if denominator == 0 {
+		return types.ErrInvalidEscrow.Wrap("exchange ratio denominator must be positive")
+	}
+	num := math.NewIntFromUint64(numerator)
+	den := math.NewIntFromUint64(denominator)
+	exchange := func(amount math.Int) math.Int {
+		if amount.IsNil() {
+			return amount
+		}
+		return amount.Mul(num).Quo(den)
+	}
+
+	escrowsAdjusted := 0
+	for _, escrow := range k.GetAllEscrows(ctx) {
+		switch escrow.Status {
+		case types.EscrowStatusPending, types.EscrowStatusFunded, types.EscrowStatusDisputed:
+		default:
+			continue
+		}
+
+		changed := false
+		for i, asset := range escrow.Assets {
+			if asset.AssetType != types.AssetTypeEquity || asset.CompanyID != targetID || asset.ShareClass != targetClassID {
+				continue
+			}
+			escrow.Assets[i].CompanyID = acquirerID
+			escrow.Assets[i].ShareClass = acquirerClassID
+			escrow.Assets[i].Denom = acquirerSymbol
+			escrow.Assets[i].Amount = exchange(asset.Amount)
+			if escrow.Stream != nil && i < len(escrow.Stream.Withdrawn) {
+				escrow.Stream.Withdrawn[i] = exchange(escrow.Stream.Withdrawn[i])
+			}
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+
+		if err := k.SetEscrow(ctx, escrow); err != nil {
+			return err
+		}
+		escrowsAdjusted++
+	}
+
+	tradesCancelled := 0
+	for _, trade := range k.GetAllBlockTrades(ctx) {
+		if trade.Status != types.BlockTradeStatusProposed || trade.CompanyID != targetID || trade.ShareClass != targetClassID {
+			continue
+		}
+
+		// The payment leg is refunded at the original price and quantity
+		if trade.BuyerSigned {
+			buyerAddr, err := sdk.AccAddressFromBech32(trade.Buyer)
+			if err != nil {
+				return err
+			}
+			if value := trade.Value(); value.IsPositive() {
+				refund := sdk.NewCoins(sdk.NewCoin(trade.PaymentDenom, value))
+				if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyerAddr, refund); err != nil {
+					return fmt.Errorf("failed to refund %s: %w", trade.PaymentDenom, err)
+				}
+			}
+			trade.BuyerSigned = false
+		}
+
+		trade.CompanyID = acquirerID
+		trade.ShareClass = acquirerClassID
+		trade.Symbol = acquirerSymbol
+		trade.Quantity = exchange(trade.Quantity)
+		if !trade.Quantity.IsPositive() {
+			// Nothing to hand back; drop the registry record directly
+			if trade.SellerSigned {
+				if err := k.equityKeeper.UnregisterBeneficialOwner(ctx, types.ModuleName, acquirerID, acquirerClassID, trade.Seller, trade.EscrowID); err != nil {
+					k.Logger(ctx).Error("failed to unregister beneficial owner",
+						"escrow_id", trade.EscrowID,
+						"block_trade_id", trade.ID,
+						"error", err,
+					)
+				}
+			}
+			trade.SellerSigned = false
+		}
+		if err := k.refundBlockTradeLegs(ctx, trade, types.EscrowStatusCancelled); err != nil {
+			return err
+		}
+		trade.Status = types.BlockTradeStatusCancelled
+		if err := k.SetBlockTrade(ctx, trade); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBlockTradeCancelled,
+				sdk.NewAttribute(types.AttributeKeyBlockTradeID, fmt.Sprintf("%d", trade.ID)),
+				sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", trade.EscrowID)),
+				sdk.NewAttribute("reason", "merger"),
+			),
+		)
+		tradesCancelled++
+	}
+
+	k.Logger(ctx).Info("moved escrowed equity for merger",
+		"target_id", targetID,
+		"target_symbol", targetSymbol,
+		"acquirer_id", acquirerID,
+		"acquirer_symbol", acquirerSymbol,
+		"escrows", escrowsAdjusted,
+		"block_trades_cancelled", tradesCancelled,
+	)
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/escrow/types"
+)
+
+// AfterShareSplit rescales escrowed equity and open block trades after a stock split
+// in the equity module. Share amounts round down, matching the beneficial owner
+// registry. The symbol balance held by the module account is rescaled by the equity
+// module; the HODL legs of block trades are trued up here.
+func (k Keeper) AfterShareSplit(ctx sdk.Context, companyID uint64, classID, symbol string, numerator, denominator uint64) error {
+	if numerator == 0 || denominator == 0 {
+		return types.ErrInvalidEscrow.Wrap("split ratio terms must be positive")
+	}
+	num := math.NewIntFromUint64(numerator)
+	den := math.NewIntFromUint64(denominator)
+	scale := func(amount math.Int) math.Int {
+		if amount.IsNil() {
+			return amount
+		}
+		return amount.Mul(num).Quo(den)
+	}
+
+	escrowsAdjusted := 0
+	for _, escrow := range k.GetAllEscrows(ctx) {
+		switch escrow.Status {
+		case types.EscrowStatusPending, types.EscrowStatusFunded, types.EscrowStatusDisputed:
+		default:
+			continue
+		}
+
+		changed := false
+		for i, asset := range escrow.Assets {
+			if asset.AssetType != types.AssetTypeEquity || asset.CompanyID != companyID || asset.ShareClass != classID {
+				continue
+			}
+			escrow.Assets[i].Amount = scale(asset.Amount)
+			if escrow.Stream != nil && i < len(escrow.Stream.Withdrawn) {
+				escrow.Stream.Withdrawn[i] = scale(escrow.Stream.Withdrawn[i])
+			}
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+
+		if err := k.SetEscrow(ctx, escrow); err != nil {
+			return err
+		}
+		escrowsAdjusted++
+	}
+
+	tradesAdjusted := 0
+	for _, trade := range k.GetAllBlockTrades(ctx) {
+		if trade.Status != types.BlockTradeStatusProposed || trade.CompanyID != companyID || trade.ShareClass != classID {
+			continue
+		}
+		if err := k.rescaleBlockTrade(ctx, trade, scale, num, den); err != nil {
+			return err
+		}
+		tradesAdjusted++
+	}
+
+	k.Logger(ctx).Info("rescaled escrowed equity for stock split",
+		"company_id", companyID,
+		"class_id", classID,
+		"symbol", symbol,
+		"escrows", escrowsAdjusted,
+		"block_trades", tradesAdjusted,
+	)
+
+	return nil
+}
+
+// rescaleBlockTrade applies a split to an open block trade. The price scales by the
+// inverse ratio (rounded down), so the payment leg can only shrink; a buyer who
+// already locked payment is refunded the difference. A trade whose quantity rounds
+// to zero is cancelled and its legs refunded.
+func (k Keeper) rescaleBlockTrade(ctx sdk.Context, trade types.BlockTrade, scale func(math.Int) math.Int, num, den math.Int) error {
+	oldValue := trade.Value()
+	trade.Quantity = scale(trade.Quantity)
+	trade.Price = trade.Price.MulInt(den).QuoTruncate(math.LegacyNewDecFromInt(num))
+	newValue := math.ZeroInt()
+	if trade.Quantity.IsPositive() {
+		newValue = trade.Value()
+	}
+
+	if trade.BuyerSigned && oldValue.GT(newValue) {
+		buyerAddr, err := sdk.AccAddressFromBech32(trade.Buyer)
+		if err != nil {
+			return err
+		}
+		refund := sdk.NewCoins(sdk.NewCoin(trade.PaymentDenom, oldValue.Sub(newValue)))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyerAddr, refund); err != nil {
+			return fmt.Errorf("failed to refund %s: %w", trade.PaymentDenom, err)
+		}
+	}
+
+	if !newValue.IsPositive() {
+		// Nothing left to trade; the payment leg was refunded in full above
+		trade.BuyerSigned = false
+		if err := k.refundBlockTradeLegs(ctx, trade, types.EscrowStatusCancelled); err != nil {
+			return err
+		}
+		trade.Status = types.BlockTradeStatusCancelled
+		if err := k.SetBlockTrade(ctx, trade); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBlockTradeCancelled,
+				sdk.NewAttribute(types.AttributeKeyBlockTradeID, fmt.Sprintf("%d", trade.ID)),
+				sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", trade.EscrowID)),
+				sdk.NewAttribute("reason", "stock_split"),
+			),
+		)
+		return nil
+	}
+
+	escrow, found := k.GetEscrow(ctx, trade.EscrowID)
+	if !found {
+		return types.ErrEscrowNotFound
+	}
+	escrow.Assets = trade.EscrowAssets()
+	escrow.Terms = trade.Terms()
+	if err := k.SetEscrow(ctx, escrow); err != nil {
+		return err
+	}
+
+	return k.SetBlockTrade(ctx, trade)
+}
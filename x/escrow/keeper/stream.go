@@ -0,0 +1,325 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/escrow/types"
+)
+
+// ============ Streaming Escrows ============
+
+// CreateStreamingEscrow creates an escrow whose assets stream from sender to recipient
+// between startTime and endTime. The escrow must still be funded via FundEscrow before
+// fundingDeadline; disputes go through the regular OpenDispute moderator flow.
+func (k Keeper) CreateStreamingEscrow(
+	ctx sdk.Context,
+	sender, recipient string,
+	moderator string,
+	assets []types.EscrowAsset,
+	description, terms string,
+	curve types.StreamCurve,
+	startTime, cliffTime, endTime time.Time,
+	fundingDeadline time.Time,
+) (types.Escrow, error) {
+	schedule := types.NewStreamSchedule(curve, startTime, cliffTime, endTime, len(assets))
+	if err := schedule.Validate(); err != nil {
+		return types.Escrow{}, errors.Wrap(types.ErrInvalidStreamSchedule, err.Error())
+	}
+	if !endTime.After(ctx.BlockTime()) {
+		return types.Escrow{}, errors.Wrap(types.ErrInvalidStreamSchedule, "stream must end in the future")
+	}
+	if fundingDeadline.After(endTime) {
+		return types.Escrow{}, errors.Wrap(types.ErrInvalidStreamSchedule, "funding deadline must not be after stream end")
+	}
+
+	escrow, err := k.CreateEscrow(ctx, sender, recipient, moderator, assets, description, terms, fundingDeadline)
+	if err != nil {
+		return types.Escrow{}, err
+	}
+
+	escrow.Stream = &schedule
+	if err := k.SetEscrow(ctx, escrow); err != nil {
+		return types.Escrow{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStreamCreated,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", escrow.ID)),
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyStreamCurve, curve.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, startTime.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, endTime.String()),
+		),
+	)
+
+	return escrow, nil
+}
+
+// WithdrawFromStream pays the recipient everything that has vested since the last withdrawal
+func (k Keeper) WithdrawFromStream(ctx sdk.Context, escrowID uint64, withdrawer string) ([]types.EscrowAsset, error) {
+	escrow, found := k.GetEscrow(ctx, escrowID)
+	if !found {
+		return nil, types.ErrEscrowNotFound
+	}
+	if !escrow.IsStream() {
+		return nil, types.ErrNotStreamingEscrow
+	}
+
+	// Only the recipient can withdraw accrued funds
+	if escrow.Recipient != withdrawer {
+		return nil, types.ErrUnauthorized
+	}
+
+	// Withdrawals are frozen while a dispute is open
+	if escrow.Status != types.EscrowStatusFunded {
+		if escrow.Status == types.EscrowStatusDisputed {
+			return nil, types.ErrEscrowDisputed
+		}
+		return nil, types.ErrEscrowNotFunded
+	}
+
+	recipientAddr, err := sdk.AccAddressFromBech32(escrow.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	// COMPLIANCE: Streamed equity passes to the recipient as it vests
+	now := ctx.BlockTime()
+	for i, asset := range escrow.Assets {
+		if err := k.checkEquityCompliance(ctx, asset, escrow.Sender, escrow.Recipient, escrow.WithdrawableAmount(i, now)); err != nil {
+			return nil, err
+		}
+	}
+
+	paid := []types.EscrowAsset{}
+	for i, asset := range escrow.Assets {
+		amount := escrow.WithdrawableAmount(i, now)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		transferAmount := amount.Sub(streamFee(escrow, asset, amount))
+		if transferAmount.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(asset.Denom, transferAmount))
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, coins); err != nil {
+				return nil, fmt.Errorf("failed to withdraw %s: %w", asset.Denom, err)
+			}
+		}
+
+		escrow.Stream.Withdrawn[i] = escrow.Stream.WithdrawnAmount(i).Add(amount)
+		k.updateStreamBeneficialOwner(ctx, escrow, i)
+
+		paid = append(paid, types.EscrowAsset{
+			AssetType:  asset.AssetType,
+			Denom:      asset.Denom,
+			Amount:     transferAmount,
+			CompanyID:  asset.CompanyID,
+			ShareClass: asset.ShareClass,
+		})
+	}
+
+	if len(paid) == 0 {
+		return nil, types.ErrNothingToWithdraw
+	}
+
+	escrow.Stream.LastWithdrawalAt = now
+	if escrow.IsFullyWithdrawn() {
+		escrow.Status = types.EscrowStatusReleased
+		escrow.CompletedAt = now
+		k.releaseModeratorCommitment(ctx, escrow)
+	}
+	if err := k.SetEscrow(ctx, escrow); err != nil {
+		return nil, err
+	}
+
+	for _, asset := range paid {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeStreamWithdrawn,
+				sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", escrow.ID)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, escrow.Recipient),
+				sdk.NewAttribute(types.AttributeKeyAsset, asset.Denom),
+				sdk.NewAttribute(types.AttributeKeyAmount, asset.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyVestedFraction, escrow.Stream.VestedFraction(now).String()),
+			),
+		)
+	}
+
+	return paid, nil
+}
+
+// CancelStream stops a stream: the recipient receives everything vested so far and the
+// sender gets the unvested remainder back
+func (k Keeper) CancelStream(ctx sdk.Context, escrowID uint64, canceller string) error {
+	escrow, found := k.GetEscrow(ctx, escrowID)
+	if !found {
+		return types.ErrEscrowNotFound
+	}
+	if !escrow.IsStream() {
+		return types.ErrNotStreamingEscrow
+	}
+
+	// Only the sender can cancel a stream
+	if escrow.Sender != canceller {
+		return types.ErrUnauthorized
+	}
+
+	// Unfunded streams are cancelled like any pending escrow
+	if escrow.Status == types.EscrowStatusPending {
+		return k.CancelEscrow(ctx, escrowID, canceller)
+	}
+	if escrow.Status != types.EscrowStatusFunded {
+		if escrow.Status == types.EscrowStatusDisputed {
+			return types.ErrEscrowDisputed
+		}
+		return types.ErrInvalidStatus
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(escrow.Sender)
+	if err != nil {
+		return err
+	}
+	recipientAddr, err := sdk.AccAddressFromBech32(escrow.Recipient)
+	if err != nil {
+		return err
+	}
+
+	// COMPLIANCE: Vested equity passes to the recipient on cancellation
+	now := ctx.BlockTime()
+	for i, asset := range escrow.Assets {
+		if err := k.checkEquityCompliance(ctx, asset, escrow.Sender, escrow.Recipient, escrow.WithdrawableAmount(i, now)); err != nil {
+			return err
+		}
+	}
+
+	for i, asset := range escrow.Assets {
+		vested := escrow.WithdrawableAmount(i, now)
+		unvested := escrow.RemainingAmount(i).Sub(vested)
+
+		// The vested part is paid out like a withdrawal, net of the escrow fee
+		paid := vested.Sub(streamFee(escrow, asset, vested))
+		if paid.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(asset.Denom, paid))
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, coins); err != nil {
+				return fmt.Errorf("failed to pay vested %s: %w", asset.Denom, err)
+			}
+		}
+		if unvested.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(asset.Denom, unvested))
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, senderAddr, coins); err != nil {
+				return fmt.Errorf("failed to refund unvested %s: %w", asset.Denom, err)
+			}
+		}
+
+		escrow.Stream.Withdrawn[i] = asset.Amount
+		k.updateStreamBeneficialOwner(ctx, escrow, i)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeStreamCancelled,
+				sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", escrow.ID)),
+				sdk.NewAttribute(types.AttributeKeyAsset, asset.Denom),
+				sdk.NewAttribute(types.AttributeKeyAmount, paid.String()),
+				sdk.NewAttribute(types.AttributeKeyRefundAmount, unvested.String()),
+			),
+		)
+	}
+
+	escrow.Stream.CancelledAt = now
+	escrow.Status = types.EscrowStatusCancelled
+	escrow.CompletedAt = now
+	k.releaseModeratorCommitment(ctx, escrow)
+
+	return k.SetEscrow(ctx, escrow)
+}
+
+// GetStreamWithdrawable returns what the recipient of a stream could withdraw right now
+func (k Keeper) GetStreamWithdrawable(ctx sdk.Context, escrowID uint64) ([]types.EscrowAsset, error) {
+	escrow, found := k.GetEscrow(ctx, escrowID)
+	if !found {
+		return nil, types.ErrEscrowNotFound
+	}
+	if !escrow.IsStream() {
+		return nil, types.ErrNotStreamingEscrow
+	}
+
+	withdrawable := make([]types.EscrowAsset, 0, len(escrow.Assets))
+	for i, asset := range escrow.Assets {
+		asset.Amount = escrow.WithdrawableAmount(i, ctx.BlockTime())
+		withdrawable = append(withdrawable, asset)
+	}
+	return withdrawable, nil
+}
+
+// streamFee is the escrow fee retained by the module on a stream payout, the same
+// as a lump-sum release charges on HODL
+func streamFee(escrow types.Escrow, asset types.EscrowAsset, amount math.Int) math.Int {
+	if asset.AssetType != types.AssetTypeHODL {
+		return math.ZeroInt()
+	}
+	return escrow.EscrowFee.MulInt(amount).TruncateInt()
+}
+
+// updateStreamBeneficialOwner keeps the sender's beneficial ownership of streamed equity
+// in line with what is still held in escrow
+func (k Keeper) updateStreamBeneficialOwner(ctx sdk.Context, escrow types.Escrow, i int) {
+	asset := escrow.Assets[i]
+	if asset.AssetType != types.AssetTypeEquity || k.equityKeeper == nil {
+		return
+	}
+
+	remaining := escrow.RemainingAmount(i)
+	var err error
+	if remaining.IsPositive() {
+		err = k.equityKeeper.UpdateBeneficialOwnerShares(
+			ctx,
+			types.ModuleName,
+			asset.CompanyID,
+			asset.ShareClass,
+			escrow.Sender,
+			escrow.ID,
+			remaining,
+		)
+	} else {
+		err = k.equityKeeper.UnregisterBeneficialOwner(
+			ctx,
+			types.ModuleName,
+			asset.CompanyID,
+			asset.ShareClass,
+			escrow.Sender,
+			escrow.ID,
+		)
+	}
+	if err != nil {
+		// Non-fatal: the stream still pays out, but dividends may be misattributed
+		k.Logger(ctx).Error("failed to update beneficial owner for streamed shares",
+			"escrow_id", escrow.ID,
+			"company_id", asset.CompanyID,
+			"class", asset.ShareClass,
+			"owner", escrow.Sender,
+			"remaining", remaining.String(),
+			"error", err,
+		)
+	}
+}
+
+// releaseModeratorCommitment frees the trust ceiling a moderator reserved for this escrow
+func (k Keeper) releaseModeratorCommitment(ctx sdk.Context, escrow types.Escrow) {
+	if escrow.Moderator == "" {
+		return
+	}
+	if err := k.ReleaseModeratorDisputeValue(ctx, escrow.Moderator, escrow.TotalValue); err != nil {
+		k.Logger(ctx).Error("failed to release moderator commitment",
+			"escrow_id", escrow.ID,
+			"moderator", escrow.Moderator,
+			"error", err,
+		)
+	}
+}
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cometbfttypes "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/escrow/keeper"
+	"github.com/sharehodl/sharehodl-blockchain/x/escrow/types"
+)
+
+// mockBankKeeper keeps balances in memory; module accounts are addressed by
+// authtypes.NewModuleAddress like the real bank keeper
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (m *mockBankKeeper) move(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance := m.balances[from.String()]
+	if !balance.IsAllGTE(amt) {
+		return fmt.Errorf("insufficient funds: %s < %s", balance, amt)
+	}
+	m.balances[from.String()] = balance.Sub(amt...)
+	m.balances[to.String()] = m.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, m.balances[addr.String()].AmountOf(denom))
+}
+
+func (m *mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.move(fromAddr, toAddr, amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.move(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.move(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (m *mockBankKeeper) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	m.balances[addr] = m.balances[addr].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	return m.move(authtypes.NewModuleAddress(moduleName), authtypes.NewModuleAddress("burned"), amt)
+}
+
+// mockAccountKeeper resolves module addresses only
+type mockAccountKeeper struct{}
+
+func (mockAccountKeeper) GetAccount(context.Context, sdk.AccAddress) sdk.AccountI { return nil }
+
+func (mockAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+func (mockAccountKeeper) GetModuleAccount(context.Context, string) sdk.ModuleAccountI { return nil }
+
+// setupKeeper returns an escrow keeper on an in-memory store
+func setupKeeper(t *testing.T) (*keeper.Keeper, sdk.Context, *mockBankKeeper) {
+	t.Helper()
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	memKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(memKey, storetypes.StoreTypeMemory, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	bank := &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+	k := keeper.NewKeeper(cdc, storeKey, memKey, bank, mockAccountKeeper{}, nil)
+
+	header := cometbfttypes.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	ctx := sdk.NewContext(stateStore, header, false, log.NewNopLogger())
+
+	return k, ctx, bank
+}
+
+// TestStreamPayoutsChargeEscrowFee tests that cancelling a stream pays the
+// recipient the vested amount net of the same escrow fee a withdrawal charges
+func TestStreamPayoutsChargeEscrowFee(t *testing.T) {
+	k, ctx, bank := setupKeeper(t)
+
+	sender := sdk.AccAddress([]byte("stream_sender_______"))
+	start := ctx.BlockTime()
+	end := start.Add(10 * time.Hour)
+	bank.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin("hodl", math.NewInt(2000)))
+
+	newStream := func(recipient sdk.AccAddress) uint64 {
+		assets := []types.EscrowAsset{{AssetType: types.AssetTypeHODL, Denom: "hodl", Amount: math.NewInt(1000)}}
+		escrow, err := k.CreateStreamingEscrow(ctx, sender.String(), recipient.String(), "", assets,
+			"contractor", "hourly", types.StreamCurveLinear, start, time.Time{}, end, start.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, k.FundEscrow(ctx, escrow.ID, sender.String()))
+		return escrow.ID
+	}
+
+	withdrawer := sdk.AccAddress([]byte("stream_withdrawer___"))
+	cancelled := sdk.AccAddress([]byte("stream_cancelled____"))
+	withdrawID := newStream(withdrawer)
+	cancelID := newStream(cancelled)
+
+	// 400 of 1000 has vested; the recipient is paid 400 less the 1% fee either way
+	ctx = ctx.WithBlockTime(start.Add(4 * time.Hour))
+
+	paid, err := k.WithdrawFromStream(ctx, withdrawID, withdrawer.String())
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(396), paid[0].Amount)
+
+	require.NoError(t, k.CancelStream(ctx, cancelID, sender.String()))
+	require.Equal(t, bank.GetBalance(ctx, withdrawer, "hodl"), bank.GetBalance(ctx, cancelled, "hodl"))
+	require.Equal(t, math.NewInt(396), bank.GetBalance(ctx, cancelled, "hodl").Amount)
+
+	// The unvested 600 goes back to the sender and the fee stays with the module
+	require.Equal(t, math.NewInt(600), bank.GetBalance(ctx, sender, "hodl").Amount)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	require.Equal(t, math.NewInt(600+4+4), bank.GetBalance(ctx, moduleAddr, "hodl").Amount)
+}
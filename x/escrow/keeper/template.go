@@ -0,0 +1,381 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/escrow/types"
+)
+
+// ============ Escrow Templates ============
+
+// GetNextTemplateID returns the next template ID and increments the counter
+func (k Keeper) GetNextTemplateID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EscrowTemplateCounterKey)
+
+	var counter uint64 = 1
+	if bz != nil {
+		counter = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.EscrowTemplateCounterKey, sdk.Uint64ToBigEndian(counter+1))
+	return counter
+}
+
+// SetNextTemplateID sets the template counter (used by genesis import)
+func (k Keeper) SetNextTemplateID(ctx sdk.Context, nextID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EscrowTemplateCounterKey, sdk.Uint64ToBigEndian(nextID))
+}
+
+// GetEscrowTemplate returns the latest version of a template
+func (k Keeper) GetEscrowTemplate(ctx sdk.Context, templateID uint64) (types.EscrowTemplate, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEscrowTemplateKey(templateID))
+	if bz == nil {
+		return types.EscrowTemplate{}, false
+	}
+
+	var template types.EscrowTemplate
+	if err := json.Unmarshal(bz, &template); err != nil {
+		return types.EscrowTemplate{}, false
+	}
+	return template, true
+}
+
+// GetEscrowTemplateVersion returns a specific published version of a template
+func (k Keeper) GetEscrowTemplateVersion(ctx sdk.Context, templateID, version uint64) (types.EscrowTemplate, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEscrowTemplateVersionKey(templateID, version))
+	if bz == nil {
+		return types.EscrowTemplate{}, false
+	}
+
+	var template types.EscrowTemplate
+	if err := json.Unmarshal(bz, &template); err != nil {
+		return types.EscrowTemplate{}, false
+	}
+	return template, true
+}
+
+// SetEscrowTemplate stores a template version and makes it the latest if it is newer
+func (k Keeper) SetEscrowTemplate(ctx sdk.Context, template types.EscrowTemplate) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(template)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal escrow template", "error", err)
+		return fmt.Errorf("failed to marshal escrow template: %w", err)
+	}
+	store.Set(types.GetEscrowTemplateVersionKey(template.ID, template.Version), bz)
+
+	if latest, found := k.GetEscrowTemplate(ctx, template.ID); !found || latest.Version <= template.Version {
+		store.Set(types.GetEscrowTemplateKey(template.ID), bz)
+	}
+	return nil
+}
+
+// PublishEscrowTemplate publishes a new escrow template on behalf of a company or moderator
+func (k Keeper) PublishEscrowTemplate(ctx sdk.Context, template types.EscrowTemplate) (types.EscrowTemplate, error) {
+	if err := k.checkCanPublishTemplate(ctx, template.Publisher, template.PublisherType, template.CompanyID); err != nil {
+		return types.EscrowTemplate{}, err
+	}
+
+	// Fill unset terms with the same defaults as a freeform escrow
+	defaults := types.NewEscrowTemplate(0, "", "", template.PublisherType, 0, "", nil, ctx.BlockTime())
+	if template.EscrowFee.IsNil() {
+		template.EscrowFee = defaults.EscrowFee
+	}
+	if template.ModeratorFee.IsNil() {
+		template.ModeratorFee = defaults.ModeratorFee
+	}
+	if template.DisputeTimeout == 0 {
+		template.DisputeTimeout = defaults.DisputeTimeout
+	}
+	if template.DefaultConditions == nil {
+		template.DefaultConditions = defaults.DefaultConditions
+	}
+
+	template.ID = k.GetNextTemplateID(ctx)
+	template.Version = 1
+	template.TermsHash = types.HashTemplateTerms(template.Terms)
+	template.Active = true
+	template.CreatedAt = ctx.BlockTime()
+	template.UpdatedAt = ctx.BlockTime()
+
+	if err := template.Validate(); err != nil {
+		return types.EscrowTemplate{}, errors.Wrap(types.ErrInvalidTemplate, err.Error())
+	}
+	if err := template.ValidateFees(k.GetParams(ctx)); err != nil {
+		return types.EscrowTemplate{}, errors.Wrap(types.ErrTemplateFeeTooHigh, err.Error())
+	}
+
+	if err := k.SetEscrowTemplate(ctx, template); err != nil {
+		return types.EscrowTemplate{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTemplatePublished,
+			sdk.NewAttribute(types.AttributeKeyTemplateID, fmt.Sprintf("%d", template.ID)),
+			sdk.NewAttribute(types.AttributeKeyTemplateVersion, fmt.Sprintf("%d", template.Version)),
+			sdk.NewAttribute(types.AttributeKeyPublisher, template.Publisher),
+			sdk.NewAttribute(types.AttributeKeyTermsHash, template.TermsHash),
+		),
+	)
+
+	return template, nil
+}
+
+// UpdateEscrowTemplate publishes a new version of an existing template.
+// Escrows created from earlier versions keep their original terms.
+func (k Keeper) UpdateEscrowTemplate(ctx sdk.Context, updater string, update types.EscrowTemplate) (types.EscrowTemplate, error) {
+	current, found := k.GetEscrowTemplate(ctx, update.ID)
+	if !found {
+		return types.EscrowTemplate{}, types.ErrTemplateNotFound
+	}
+	if current.Publisher != updater {
+		return types.EscrowTemplate{}, types.ErrNotTemplatePublisher
+	}
+	if !current.Active {
+		return types.EscrowTemplate{}, types.ErrTemplateInactive
+	}
+
+	// Publisher identity cannot change between versions
+	update.Publisher = current.Publisher
+	update.PublisherType = current.PublisherType
+	update.CompanyID = current.CompanyID
+	update.Version = current.Version + 1
+	update.TermsHash = types.HashTemplateTerms(update.Terms)
+	update.Active = true
+	update.CreatedAt = current.CreatedAt
+	update.UpdatedAt = ctx.BlockTime()
+
+	if err := update.Validate(); err != nil {
+		return types.EscrowTemplate{}, errors.Wrap(types.ErrInvalidTemplate, err.Error())
+	}
+	if err := update.ValidateFees(k.GetParams(ctx)); err != nil {
+		return types.EscrowTemplate{}, errors.Wrap(types.ErrTemplateFeeTooHigh, err.Error())
+	}
+
+	if err := k.SetEscrowTemplate(ctx, update); err != nil {
+		return types.EscrowTemplate{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTemplateUpdated,
+			sdk.NewAttribute(types.AttributeKeyTemplateID, fmt.Sprintf("%d", update.ID)),
+			sdk.NewAttribute(types.AttributeKeyTemplateVersion, fmt.Sprintf("%d", update.Version)),
+			sdk.NewAttribute(types.AttributeKeyTermsHash, update.TermsHash),
+		),
+	)
+
+	return update, nil
+}
+
+// DeactivateEscrowTemplate stops new escrows from being created from a template
+func (k Keeper) DeactivateEscrowTemplate(ctx sdk.Context, templateID uint64, publisher string) error {
+	template, found := k.GetEscrowTemplate(ctx, templateID)
+	if !found {
+		return types.ErrTemplateNotFound
+	}
+	if template.Publisher != publisher {
+		return types.ErrNotTemplatePublisher
+	}
+
+	template.Active = false
+	template.UpdatedAt = ctx.BlockTime()
+	if err := k.SetEscrowTemplate(ctx, template); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTemplateDeactivated,
+			sdk.NewAttribute(types.AttributeKeyTemplateID, fmt.Sprintf("%d", templateID)),
+		),
+	)
+
+	return nil
+}
+
+// CreateEscrowFromTemplate creates an escrow using the latest version of a template.
+// Terms are rendered from the template parameters and the template's fees and default
+// conditions are applied; the escrow records the template ID and version it used.
+func (k Keeper) CreateEscrowFromTemplate(
+	ctx sdk.Context,
+	templateID uint64,
+	sender, recipient string,
+	moderator string,
+	assets []types.EscrowAsset,
+	params []types.TemplateParamValue,
+	expiresAt time.Time,
+) (types.Escrow, error) {
+	template, found := k.GetEscrowTemplate(ctx, templateID)
+	if !found {
+		return types.Escrow{}, types.ErrTemplateNotFound
+	}
+	if !template.Active {
+		return types.Escrow{}, types.ErrTemplateInactive
+	}
+	// Templates published before the fee limits were lowered cannot charge above them
+	if err := template.ValidateFees(k.GetParams(ctx)); err != nil {
+		return types.Escrow{}, errors.Wrap(types.ErrTemplateFeeTooHigh, err.Error())
+	}
+
+	terms, err := template.RenderTerms(params)
+	if err != nil {
+		return types.Escrow{}, errors.Wrap(types.ErrInvalidTemplateParams, err.Error())
+	}
+
+	// Template may require a minimum moderator tier for disputes on its escrows
+	if moderator != "" {
+		mod, found := k.GetModerator(ctx, moderator)
+		if !found {
+			return types.Escrow{}, types.ErrModeratorNotFound
+		}
+		if mod.Tier < template.RequiredModeratorTier {
+			return types.Escrow{}, types.ErrModeratorTierTooLowForTemplate
+		}
+	}
+
+	escrow, err := k.CreateEscrow(ctx, sender, recipient, moderator, assets, template.Name, terms, expiresAt)
+	if err != nil {
+		return types.Escrow{}, err
+	}
+
+	escrow.TemplateID = template.ID
+	escrow.TemplateVersion = template.Version
+	escrow.TemplateParams = params
+	escrow.EscrowFee = template.EscrowFee
+	escrow.ModeratorFee = template.ModeratorFee
+	escrow.Conditions = make([]types.EscrowCondition, len(template.DefaultConditions))
+	copy(escrow.Conditions, template.DefaultConditions)
+
+	if err := k.SetEscrow(ctx, escrow); err != nil {
+		return types.Escrow{}, err
+	}
+	k.IndexEscrowByTemplate(ctx, escrow)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEscrowFromTemplate,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", escrow.ID)),
+			sdk.NewAttribute(types.AttributeKeyTemplateID, fmt.Sprintf("%d", template.ID)),
+			sdk.NewAttribute(types.AttributeKeyTemplateVersion, fmt.Sprintf("%d", template.Version)),
+			sdk.NewAttribute(types.AttributeKeyTermsHash, types.HashTemplateTerms(terms)),
+		),
+	)
+
+	return escrow, nil
+}
+
+// GetEscrowsByTemplate returns all escrows created from a template (any version)
+func (k Keeper) GetEscrowsByTemplate(ctx sdk.Context, templateID uint64) []types.Escrow {
+	store := ctx.KVStore(k.storeKey)
+	iterator := prefix.NewStore(store, types.GetEscrowByTemplatePrefixKey(templateID)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var escrows []types.Escrow
+	for ; iterator.Valid(); iterator.Next() {
+		escrowID := sdk.BigEndianToUint64(iterator.Value())
+		if escrow, found := k.GetEscrow(ctx, escrowID); found {
+			escrows = append(escrows, escrow)
+		}
+	}
+	return escrows
+}
+
+// GetTemplatePrecedents returns resolved disputes on escrows created from a template,
+// so moderators can apply consistent rulings across the same agreement shape
+func (k Keeper) GetTemplatePrecedents(ctx sdk.Context, templateID uint64) []types.Dispute {
+	escrowIDs := make(map[uint64]bool)
+	for _, escrow := range k.GetEscrowsByTemplate(ctx, templateID) {
+		escrowIDs[escrow.ID] = true
+	}
+	if len(escrowIDs) == 0 {
+		return nil
+	}
+
+	var precedents []types.Dispute
+	for _, dispute := range k.GetAllDisputes(ctx) {
+		if !escrowIDs[dispute.EscrowID] {
+			continue
+		}
+		if dispute.Status == types.DisputeStatusResolved || dispute.Status == types.DisputeStatusFinal {
+			precedents = append(precedents, dispute)
+		}
+	}
+	return precedents
+}
+
+// GetAllEscrowTemplates returns every published version of every template
+func (k Keeper) GetAllEscrowTemplates(ctx sdk.Context) []types.EscrowTemplate {
+	store := ctx.KVStore(k.storeKey)
+	iterator := prefix.NewStore(store, types.EscrowTemplateVersionPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var templates []types.EscrowTemplate
+	for ; iterator.Valid(); iterator.Next() {
+		var template types.EscrowTemplate
+		if err := json.Unmarshal(iterator.Value(), &template); err != nil {
+			continue
+		}
+		templates = append(templates, template)
+	}
+	return templates
+}
+
+// disputeTermsForEscrow returns the dispute timeout and appeal limit for an escrow,
+// taken from its template version when it has one
+func (k Keeper) disputeTermsForEscrow(ctx sdk.Context, escrow types.Escrow) (time.Duration, int) {
+	if escrow.TemplateID == 0 {
+		return types.DefaultDisputeTimeout, 1
+	}
+	template, found := k.GetEscrowTemplateVersion(ctx, escrow.TemplateID, escrow.TemplateVersion)
+	if !found {
+		return types.DefaultDisputeTimeout, 1
+	}
+	return template.DisputeTimeout, template.MaxAppeals
+}
+
+// IndexEscrowByTemplate adds an escrow to its template's index
+func (k Keeper) IndexEscrowByTemplate(ctx sdk.Context, escrow types.Escrow) {
+	if escrow.TemplateID == 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEscrowByTemplateKey(escrow.TemplateID, escrow.ID), sdk.Uint64ToBigEndian(escrow.ID))
+}
+
+// checkCanPublishTemplate verifies the publisher may publish templates:
+// company templates need a company owner or authorized proposer, moderator templates
+// need an active, non-blacklisted moderator
+func (k Keeper) checkCanPublishTemplate(ctx sdk.Context, publisher string, publisherType types.TemplatePublisherType, companyID uint64) error {
+	switch publisherType {
+	case types.TemplatePublisherCompany:
+		if k.equityKeeper == nil || !k.equityKeeper.CanProposeForCompany(ctx, companyID, publisher) {
+			return types.ErrNotTemplatePublisher
+		}
+	case types.TemplatePublisherModerator:
+		mod, found := k.GetModerator(ctx, publisher)
+		if !found {
+			return types.ErrModeratorNotFound
+		}
+		if !mod.Active {
+			return types.ErrModeratorNotActive
+		}
+		if mod.Blacklisted {
+			return types.ErrModeratorBlacklisted
+		}
+	default:
+		return errors.Wrapf(types.ErrInvalidTemplate, "invalid publisher type: %d", publisherType)
+	}
+	return nil
+}
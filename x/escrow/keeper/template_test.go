@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/escrow/types"
+)
+
+// TestTemplateFeeLimits tests that template fees are capped by the module params
+// when a template is updated and when an escrow is created from it
+func TestTemplateFeeLimits(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	publisher := sdk.AccAddress([]byte("template_publisher__")).String()
+	sender := sdk.AccAddress([]byte("template_sender_____")).String()
+	recipient := sdk.AccAddress([]byte("template_recipient__")).String()
+	assets := []types.EscrowAsset{{AssetType: types.AssetTypeHODL, Amount: math.NewInt(1000)}}
+	expiresAt := ctx.BlockTime().Add(24 * time.Hour)
+
+	template := types.NewEscrowTemplate(1, "OTC block", publisher, types.TemplatePublisherModerator, 0, "Standard terms", nil, ctx.BlockTime())
+	require.NoError(t, template.ValidateFees(k.GetParams(ctx)))
+
+	// A moderator fee within Validate's 0..1 range but above the protocol maximum
+	greedy := template
+	greedy.EscrowFee = math.LegacyZeroDec()
+	greedy.ModeratorFee = math.LegacyOneDec()
+	require.NoError(t, greedy.Validate())
+	require.NoError(t, k.SetEscrowTemplate(ctx, greedy))
+
+	_, err := k.CreateEscrowFromTemplate(ctx, greedy.ID, sender, recipient, "", assets, nil, expiresAt)
+	require.ErrorIs(t, err, types.ErrTemplateFeeTooHigh)
+
+	_, err = k.UpdateEscrowTemplate(ctx, publisher, greedy)
+	require.ErrorIs(t, err, types.ErrTemplateFeeTooHigh)
+
+	// Templates published under higher limits cannot be used once the limits drop
+	require.NoError(t, k.SetEscrowTemplate(ctx, template))
+	params := k.GetParams(ctx)
+	params.MaxModeratorFee = math.LegacyNewDecWithPrec(1, 3)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err = k.CreateEscrowFromTemplate(ctx, template.ID, sender, recipient, "", assets, nil, expiresAt)
+	require.ErrorIs(t, err, types.ErrTemplateFeeTooHigh)
+}
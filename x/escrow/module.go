@@ -102,6 +102,9 @@ func (am AppModule) EndBlock(ctx sdk.Context) error {
 	// Process expired reporter bans (auto-lift temporary bans)
 	am.keeper.ProcessExpiredReporterBans(ctx)
 
+	// Refund locked legs of OTC block trades the counterparty never signed
+	am.keeper.ProcessExpiredBlockTrades(ctx)
+
 	return nil
 }
 
@@ -120,6 +123,14 @@ type GenesisState struct {
 	ModeratorMetrics      []types.ModeratorMetrics    `json:"moderator_metrics"`
 	EscrowReserve         types.EscrowReserve         `json:"escrow_reserve"`
 	ReporterHistories     []types.ReporterHistory     `json:"reporter_histories"`
+
+	// Escrow templates (every published version)
+	EscrowTemplates       []types.EscrowTemplate      `json:"escrow_templates"`
+
+	// OTC block trades
+	BlockTrades           []types.BlockTrade          `json:"block_trades"`
+
+	Params                types.Params                `json:"params"`
 }
 
 // ProtoMessage implements proto.Message
@@ -147,6 +158,11 @@ func DefaultGenesisState() *GenesisState {
 		ModeratorMetrics:    []types.ModeratorMetrics{},
 		EscrowReserve:       types.NewEscrowReserve(),
 		ReporterHistories:   []types.ReporterHistory{},
+
+		EscrowTemplates:     []types.EscrowTemplate{},
+		BlockTrades:         []types.BlockTrade{},
+
+		Params:              types.DefaultParams(),
 	}
 }
 
@@ -155,8 +171,18 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 	var genesisState GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 
+	// Genesis files written before the fee limits existed carry no escrow params
+	params := genesisState.Params
+	if params.MaxEscrowFee.IsNil() && params.MaxModeratorFee.IsNil() {
+		params = types.DefaultParams()
+	}
+	if err := am.keeper.SetParams(ctx, params); err != nil {
+		panic(err)
+	}
+
 	for _, escrow := range genesisState.Escrows {
 		am.keeper.SetEscrow(ctx, escrow)
+		am.keeper.IndexEscrowByTemplate(ctx, escrow)
 	}
 
 	for _, dispute := range genesisState.Disputes {
@@ -199,6 +225,24 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 	}
 
 	am.keeper.SetEscrowReserve(ctx, genesisState.EscrowReserve)
+
+	var maxTemplateID uint64
+	for _, template := range genesisState.EscrowTemplates {
+		am.keeper.SetEscrowTemplate(ctx, template)
+		if template.ID > maxTemplateID {
+			maxTemplateID = template.ID
+		}
+	}
+	am.keeper.SetNextTemplateID(ctx, maxTemplateID+1)
+
+	var maxBlockTradeID uint64
+	for _, trade := range genesisState.BlockTrades {
+		am.keeper.SetBlockTrade(ctx, trade)
+		if trade.ID > maxBlockTradeID {
+			maxBlockTradeID = trade.ID
+		}
+	}
+	am.keeper.SetNextBlockTradeID(ctx, maxBlockTradeID+1)
 }
 
 // ExportGenesis returns the escrow module's exported genesis state
@@ -217,6 +261,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 		ModeratorMetrics:    am.keeper.GetAllModeratorMetrics(ctx),
 		EscrowReserve:       am.keeper.GetEscrowReserve(ctx),
 		ReporterHistories:   am.keeper.GetAllReporterHistories(ctx),
+
+		EscrowTemplates:     am.keeper.GetAllEscrowTemplates(ctx),
+		BlockTrades:         am.keeper.GetAllBlockTrades(ctx),
+
+		Params:              am.keeper.GetParams(ctx),
 	}
 	return cdc.MustMarshalJSON(&gs)
 }
@@ -0,0 +1,191 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Block trade limits
+const (
+	MaxBlockTradeDuration  = 7 * 24 * time.Hour // Longest a proposal may wait for the counterparty
+	BlockTradePaymentDenom = "uhodl"            // Payment leg denomination
+	BlockTradeQuoteSymbol  = "HODL"             // DEX quote symbol block trades print against
+)
+
+// BlockTradeStatus represents the status of an OTC block trade
+type BlockTradeStatus int32
+
+const (
+	BlockTradeStatusProposed  BlockTradeStatus = iota // Proposer signed and locked their leg
+	BlockTradeStatusSettled                           // Both parties signed, legs swapped atomically
+	BlockTradeStatusCancelled                         // Cancelled by a party before settlement
+	BlockTradeStatusExpired                           // Counterparty did not sign before expiry
+)
+
+func (s BlockTradeStatus) String() string {
+	switch s {
+	case BlockTradeStatusProposed:
+		return "proposed"
+	case BlockTradeStatusSettled:
+		return "settled"
+	case BlockTradeStatusCancelled:
+		return "cancelled"
+	case BlockTradeStatusExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+// BlockTrade is a negotiated equity block crossed outside the DEX order book.
+// Both legs (seller's shares, buyer's HODL) lock into a mixed-asset escrow; the trade
+// settles atomically once both parties have signed the same price and quantity.
+type BlockTrade struct {
+	ID       uint64 `json:"id"`
+	EscrowID uint64 `json:"escrow_id"`
+
+	// Parties
+	Seller   string `json:"seller"`
+	Buyer    string `json:"buyer"`
+	Proposer string `json:"proposer"`
+
+	// Security
+	CompanyID  uint64 `json:"company_id"`
+	ShareClass string `json:"share_class"`
+	Symbol     string `json:"symbol"`
+
+	// Signed terms
+	Quantity     math.Int       `json:"quantity"`
+	Price        math.LegacyDec `json:"price"` // HODL per share
+	PaymentDenom string         `json:"payment_denom"`
+
+	// Signatures (a party's leg is locked when it signs)
+	SellerSigned bool `json:"seller_signed"`
+	BuyerSigned  bool `json:"buyer_signed"`
+
+	Status     BlockTradeStatus `json:"status"`
+	DexTradeID uint64           `json:"dex_trade_id"` // Off-book print in DEX trade history
+
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	SettledAt time.Time `json:"settled_at"`
+}
+
+// NewBlockTrade creates a new block trade proposal
+// Note: Use ctx.BlockTime() for the blockTime parameter in production
+func NewBlockTrade(
+	id uint64,
+	seller, buyer, proposer string,
+	companyID uint64,
+	shareClass, symbol string,
+	quantity math.Int,
+	price math.LegacyDec,
+	expiresAt time.Time,
+	blockTime time.Time,
+) BlockTrade {
+	return BlockTrade{
+		ID:           id,
+		Seller:       seller,
+		Buyer:        buyer,
+		Proposer:     proposer,
+		CompanyID:    companyID,
+		ShareClass:   shareClass,
+		Symbol:       symbol,
+		Quantity:     quantity,
+		Price:        price,
+		PaymentDenom: BlockTradePaymentDenom,
+		Status:       BlockTradeStatusProposed,
+		CreatedAt:    blockTime,
+		ExpiresAt:    expiresAt,
+	}
+}
+
+// Validate validates a block trade
+func (b BlockTrade) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.Seller); err != nil {
+		return fmt.Errorf("invalid seller address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(b.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer address: %v", err)
+	}
+	if b.Seller == b.Buyer {
+		return fmt.Errorf("buyer and seller cannot be the same")
+	}
+	if b.Proposer != b.Seller && b.Proposer != b.Buyer {
+		return fmt.Errorf("proposer must be the buyer or the seller")
+	}
+	if b.Symbol == "" || b.ShareClass == "" {
+		return fmt.Errorf("symbol and share class are required")
+	}
+	if b.Quantity.IsNil() || !b.Quantity.IsPositive() {
+		return fmt.Errorf("quantity must be positive")
+	}
+	if b.Price.IsNil() || !b.Price.IsPositive() {
+		return fmt.Errorf("price must be positive")
+	}
+	if !b.Value().IsPositive() {
+		return fmt.Errorf("trade value rounds to zero")
+	}
+	if b.PaymentDenom == "" {
+		return fmt.Errorf("payment denom cannot be empty")
+	}
+	if !b.ExpiresAt.After(b.CreatedAt) {
+		return fmt.Errorf("expiry must be after creation")
+	}
+	if b.ExpiresAt.Sub(b.CreatedAt) > MaxBlockTradeDuration {
+		return fmt.Errorf("block trade cannot stay open longer than %s", MaxBlockTradeDuration)
+	}
+	return nil
+}
+
+// Value returns the payment leg amount (price * quantity, truncated)
+func (b BlockTrade) Value() math.Int {
+	return b.Price.MulInt(b.Quantity).TruncateInt()
+}
+
+// MarketSymbol returns the DEX market the trade prints to
+func (b BlockTrade) MarketSymbol() string {
+	return b.Symbol + "/" + BlockTradeQuoteSymbol
+}
+
+// MatchesTerms reports whether a signer agreed to exactly the proposed price and quantity
+func (b BlockTrade) MatchesTerms(quantity math.Int, price math.LegacyDec) bool {
+	if quantity.IsNil() || price.IsNil() {
+		return false
+	}
+	return b.Quantity.Equal(quantity) && b.Price.Equal(price)
+}
+
+// HasSigned reports whether an address has signed (and locked its leg)
+func (b BlockTrade) HasSigned(address string) bool {
+	switch address {
+	case b.Seller:
+		return b.SellerSigned
+	case b.Buyer:
+		return b.BuyerSigned
+	default:
+		return false
+	}
+}
+
+// IsFullySigned reports whether both legs are locked
+func (b BlockTrade) IsFullySigned() bool {
+	return b.SellerSigned && b.BuyerSigned
+}
+
+// EscrowAssets returns the escrow legs: the seller's shares then the buyer's payment
+func (b BlockTrade) EscrowAssets() []EscrowAsset {
+	return []EscrowAsset{
+		{AssetType: AssetTypeEquity, Denom: b.Symbol, Amount: b.Quantity, CompanyID: b.CompanyID, ShareClass: b.ShareClass},
+		{AssetType: AssetTypeHODL, Denom: b.PaymentDenom, Amount: b.Value()},
+	}
+}
+
+// Terms returns the human-readable terms both parties sign
+func (b BlockTrade) Terms() string {
+	return fmt.Sprintf("OTC block trade #%d: %s sells %s %s (%s) to %s at %s %s per share for %s%s",
+		b.ID, b.Seller, b.Quantity, b.Symbol, b.ShareClass, b.Buyer, b.Price, BlockTradeQuoteSymbol, b.Value(), b.PaymentDenom)
+}
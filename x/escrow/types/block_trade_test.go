@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBlockTradeValidation tests block trade validation
+func TestBlockTradeValidation(t *testing.T) {
+	seller := sdk.AccAddress([]byte("block_seller________")).String()
+	buyer := sdk.AccAddress([]byte("block_buyer_________")).String()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	valid := NewBlockTrade(1, seller, buyer, seller, 7, "COMMON", "ACME",
+		math.NewInt(250_000), math.LegacyMustNewDecFromStr("12.5"), now.Add(time.Hour), now)
+	require.NoError(t, valid.Validate())
+	require.Equal(t, math.NewInt(3_125_000), valid.Value())
+	require.Equal(t, "ACME/HODL", valid.MarketSymbol())
+
+	invalid := valid
+	invalid.Buyer = seller
+	require.Error(t, invalid.Validate(), "self trade")
+
+	invalid = valid
+	invalid.Proposer = sdk.AccAddress([]byte("someone_else________")).String()
+	require.Error(t, invalid.Validate(), "proposer must be a party")
+
+	invalid = valid
+	invalid.Quantity = math.ZeroInt()
+	require.Error(t, invalid.Validate(), "zero quantity")
+
+	invalid = valid
+	invalid.Price = math.LegacyMustNewDecFromStr("0.000001")
+	invalid.Quantity = math.NewInt(1)
+	require.Error(t, invalid.Validate(), "value rounds to zero")
+
+	invalid = valid
+	invalid.ExpiresAt = now.Add(MaxBlockTradeDuration + time.Hour)
+	require.Error(t, invalid.Validate(), "expiry too far out")
+}
+
+// TestBlockTradeSigning tests term matching and the mixed-asset escrow legs
+func TestBlockTradeSigning(t *testing.T) {
+	seller := sdk.AccAddress([]byte("block_seller________")).String()
+	buyer := sdk.AccAddress([]byte("block_buyer_________")).String()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	trade := NewBlockTrade(1, seller, buyer, buyer, 7, "COMMON", "ACME",
+		math.NewInt(1000), math.LegacyNewDec(50), now.Add(time.Hour), now)
+
+	require.True(t, trade.MatchesTerms(math.NewInt(1000), math.LegacyNewDec(50)))
+	require.False(t, trade.MatchesTerms(math.NewInt(999), math.LegacyNewDec(50)))
+	require.False(t, trade.MatchesTerms(math.NewInt(1000), math.LegacyMustNewDecFromStr("50.01")))
+
+	trade.BuyerSigned = true
+	require.True(t, trade.HasSigned(buyer))
+	require.False(t, trade.HasSigned(seller))
+	require.False(t, trade.IsFullySigned())
+	trade.SellerSigned = true
+	require.True(t, trade.IsFullySigned())
+
+	escrow := NewEscrow(1, buyer, seller, trade.EscrowAssets(), "OTC block trade", trade.Terms(), trade.ExpiresAt, now)
+	escrow.BlockTradeID = trade.ID
+	require.NoError(t, escrow.Validate())
+	require.True(t, escrow.IsBlockTrade())
+	require.Equal(t, AssetTypeMixed, escrow.AssetType())
+	require.Equal(t, math.LegacyNewDec(50_000), escrow.TotalValue)
+}
@@ -137,6 +137,29 @@ var (
 	ErrEvidenceLockedAfterVoting     = errors.Register(ModuleName, 1144, "cannot add evidence after voting has started")
 	ErrRetaliatoryReportNotAllowed   = errors.Register(ModuleName, 1145, "cannot report user who has active report against you")
 	ErrReportCooldownActive          = errors.Register(ModuleName, 1146, "must wait 7 days after being reported before filing user reports")
+
+	// Streaming escrow errors
+	ErrNotStreamingEscrow            = errors.Register(ModuleName, 180, "escrow is not a streaming escrow")
+	ErrInvalidStreamSchedule         = errors.Register(ModuleName, 181, "invalid stream schedule")
+	ErrNothingToWithdraw             = errors.Register(ModuleName, 182, "nothing has vested since the last withdrawal")
+
+	// Escrow template errors
+	ErrTemplateNotFound              = errors.Register(ModuleName, 190, "escrow template not found")
+	ErrInvalidTemplate               = errors.Register(ModuleName, 191, "invalid escrow template")
+	ErrTemplateInactive              = errors.Register(ModuleName, 192, "escrow template is inactive")
+	ErrNotTemplatePublisher          = errors.Register(ModuleName, 193, "not authorized to publish or manage this template")
+	ErrInvalidTemplateParams         = errors.Register(ModuleName, 194, "invalid template parameters")
+	ErrModeratorTierTooLowForTemplate = errors.Register(ModuleName, 195, "moderator tier too low for this template")
+	ErrTemplateFeeTooHigh            = errors.Register(ModuleName, 196, "template fee exceeds the module maximum")
+
+	// OTC block trade errors
+	ErrBlockTradeNotFound            = errors.Register(ModuleName, 200, "block trade not found")
+	ErrInvalidBlockTrade             = errors.Register(ModuleName, 201, "invalid block trade")
+	ErrBlockTradeNotOpen             = errors.Register(ModuleName, 202, "block trade is not open for signing")
+	ErrBlockTradeTermsMismatch       = errors.Register(ModuleName, 203, "signed price or quantity does not match the proposal")
+	ErrBlockTradeAlreadySigned       = errors.Register(ModuleName, 204, "party has already signed this block trade")
+	ErrBlockTradeRestricted          = errors.Register(ModuleName, 205, "share class restrictions prevent this block trade")
+	ErrBlockTradeEscrow              = errors.Register(ModuleName, 206, "block trade escrows can only be managed through the block trade")
 )
 
 // Event types
@@ -197,6 +220,24 @@ const (
 	EventTypeReportDeadlineExtended    = "report_deadline_extended"
 	EventTypeReportStale               = "report_stale"
 	EventTypeReportEscalatedToGovernance = "report_escalated_to_governance"
+
+	// Streaming escrow event types
+	EventTypeStreamCreated             = "stream_created"
+	EventTypeStreamWithdrawn           = "stream_withdrawn"
+	EventTypeStreamCancelled           = "stream_cancelled"
+
+	// Escrow template event types
+	EventTypeTemplatePublished         = "escrow_template_published"
+	EventTypeTemplateUpdated           = "escrow_template_updated"
+	EventTypeTemplateDeactivated       = "escrow_template_deactivated"
+	EventTypeEscrowFromTemplate        = "escrow_created_from_template"
+
+	// OTC block trade event types
+	EventTypeBlockTradeProposed        = "block_trade_proposed"
+	EventTypeBlockTradeSigned          = "block_trade_signed"
+	EventTypeBlockTradeSettled         = "block_trade_settled"
+	EventTypeBlockTradeCancelled       = "block_trade_cancelled"
+	EventTypeBlockTradeExpired         = "block_trade_expired"
 )
 
 // Attribute keys
@@ -260,4 +301,26 @@ const (
 	AttributeKeyReturnAmount         = "return_amount"
 	AttributeKeyGracePeriodDeadline  = "grace_period_deadline"
 	AttributeKeyVoluntaryReturn      = "voluntary_return"
+
+	// Streaming escrow attribute keys
+	AttributeKeyStreamCurve          = "stream_curve"
+	AttributeKeyStartTime            = "start_time"
+	AttributeKeyEndTime              = "end_time"
+	AttributeKeyVestedFraction       = "vested_fraction"
+	AttributeKeyRefundAmount         = "refund_amount"
+
+	// Escrow template attribute keys
+	AttributeKeyTemplateID           = "template_id"
+	AttributeKeyTemplateVersion      = "template_version"
+	AttributeKeyTermsHash            = "terms_hash"
+	AttributeKeyPublisher            = "publisher"
+
+	// OTC block trade attribute keys
+	AttributeKeyBlockTradeID         = "block_trade_id"
+	AttributeKeyBuyer                = "buyer"
+	AttributeKeySeller               = "seller"
+	AttributeKeySymbol               = "symbol"
+	AttributeKeyQuantity             = "quantity"
+	AttributeKeyPrice                = "price"
+	AttributeKeyDexTradeID           = "dex_trade_id"
 )
@@ -31,6 +31,14 @@ type EquityKeeper interface {
 	TransferShares(ctx sdk.Context, companyID uint64, classID string, from, to string, shares math.Int) error
 	IsSymbolTaken(ctx context.Context, symbol string) bool
 	GetCompany(ctx context.Context, companyID uint64) (interface{}, bool)
+	CanProposeForCompany(ctx sdk.Context, companyID uint64, address string) bool
+	GetCompanyBySymbol(ctx sdk.Context, symbol string) (uint64, bool)
+
+	// Share class transfer restrictions and lockups (checked before OTC block trades lock shares)
+	CheckShareTransferAllowed(ctx sdk.Context, companyID uint64, classID string, from, to string, shares math.Int) error
+
+	// Per-class compliance rules (checked before escrowed equity passes to a new holder)
+	CheckTransferCompliance(ctx sdk.Context, companyID uint64, classID string, from, to string, shares math.Int) error
 
 	// Fraud investigation and delisting (Phase 3)
 	InitiateFraudInvestigation(ctx sdk.Context, companyID uint64, reportID uint64, initiator string) error
@@ -46,6 +54,12 @@ type EquityKeeper interface {
 	UpdateBeneficialOwnerShares(ctx sdk.Context, moduleAccount string, companyID uint64, classID string, beneficialOwner string, referenceID uint64, newShares math.Int) error
 }
 
+// DexKeeper defines the expected DEX keeper interface
+// Used to print OTC block trades settled in escrow to DEX trade history and market stats
+type DexKeeper interface {
+	RecordOffBookTrade(ctx sdk.Context, marketSymbol string, buyer, seller string, quantity math.Int, price math.LegacyDec, buyerFee, sellerFee math.LegacyDec, referenceID uint64) (uint64, error)
+}
+
 // UniversalStakingKeeper defines the expected universal staking keeper interface
 // Used to check tier requirements for moderator registration and dispute resolution
 type UniversalStakingKeeper interface {
@@ -116,6 +116,28 @@ var (
 
 	// CompanyInvestigationByStatusPrefix indexes investigations by status
 	CompanyInvestigationByStatusPrefix = []byte{0x1E}
+
+	// Escrow templates
+
+	// EscrowTemplatePrefix stores the latest version of each escrow template
+	EscrowTemplatePrefix = []byte{0x1F}
+
+	// EscrowTemplateCounterKey stores the global template counter
+	EscrowTemplateCounterKey = []byte{0x20}
+
+	// EscrowTemplateVersionPrefix stores every published version of a template
+	EscrowTemplateVersionPrefix = []byte{0x21}
+
+	// EscrowByTemplatePrefix indexes escrows by the template they were created from
+	EscrowByTemplatePrefix = []byte{0x22}
+
+	// OTC block trades
+
+	// BlockTradePrefix stores block trade data
+	BlockTradePrefix = []byte{0x23}
+
+	// BlockTradeCounterKey stores the global block trade counter
+	BlockTradeCounterKey = []byte{0x24}
 )
 
 // GetEscrowKey returns the store key for an escrow
@@ -313,3 +335,33 @@ func GetCompanyInvestigationByStatusPrefixKey(status string) []byte {
 	key := append(CompanyInvestigationByStatusPrefix, []byte(status)...)
 	return append(key, []byte(":")...)
 }
+
+// GetEscrowTemplateKey returns the store key for the latest version of a template
+func GetEscrowTemplateKey(templateID uint64) []byte {
+	return append(EscrowTemplatePrefix, sdk.Uint64ToBigEndian(templateID)...)
+}
+
+// GetEscrowTemplateVersionKey returns the store key for a specific template version
+func GetEscrowTemplateVersionKey(templateID, version uint64) []byte {
+	key := append(EscrowTemplateVersionPrefix, sdk.Uint64ToBigEndian(templateID)...)
+	key = append(key, []byte(":")...)
+	return append(key, sdk.Uint64ToBigEndian(version)...)
+}
+
+// GetEscrowByTemplateKey returns the store key for template's escrow index
+func GetEscrowByTemplateKey(templateID, escrowID uint64) []byte {
+	key := append(EscrowByTemplatePrefix, sdk.Uint64ToBigEndian(templateID)...)
+	key = append(key, []byte(":")...)
+	return append(key, sdk.Uint64ToBigEndian(escrowID)...)
+}
+
+// GetEscrowByTemplatePrefixKey returns the prefix for all escrows created from a template
+func GetEscrowByTemplatePrefixKey(templateID uint64) []byte {
+	key := append(EscrowByTemplatePrefix, sdk.Uint64ToBigEndian(templateID)...)
+	return append(key, []byte(":")...)
+}
+
+// GetBlockTradeKey returns the store key for a block trade
+func GetBlockTradeKey(blockTradeID uint64) []byte {
+	return append(BlockTradePrefix, sdk.Uint64ToBigEndian(blockTradeID)...)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"gopkg.in/yaml.v2"
+)
+
+// Params defines the parameters for the escrow module
+type Params struct {
+	// MaxEscrowFee is the highest escrow fee a template may charge (as a decimal, e.g., 0.05 for 5%)
+	MaxEscrowFee math.LegacyDec `json:"max_escrow_fee" yaml:"max_escrow_fee"`
+
+	// MaxModeratorFee is the highest share of escrowed value a template may pay its moderator
+	MaxModeratorFee math.LegacyDec `json:"max_moderator_fee" yaml:"max_moderator_fee"`
+}
+
+// DefaultParams returns default parameters
+func DefaultParams() Params {
+	return Params{
+		MaxEscrowFee:    math.LegacyNewDecWithPrec(5, 2), // 5%
+		MaxModeratorFee: math.LegacyNewDecWithPrec(2, 2), // 2%
+	}
+}
+
+// String implements the Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate validates the parameters
+func (p Params) Validate() error {
+	if p.MaxEscrowFee.IsNil() || p.MaxEscrowFee.IsNegative() || p.MaxEscrowFee.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max escrow fee must be between 0 and 1: %s", p.MaxEscrowFee)
+	}
+	if p.MaxModeratorFee.IsNil() || p.MaxModeratorFee.IsNegative() || p.MaxModeratorFee.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max moderator fee must be between 0 and 1: %s", p.MaxModeratorFee)
+	}
+	if p.MaxEscrowFee.Add(p.MaxModeratorFee).GT(math.LegacyOneDec()) {
+		return fmt.Errorf("combined max fees cannot exceed 100%%")
+	}
+	return nil
+}
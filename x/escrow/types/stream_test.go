@@ -0,0 +1,111 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStreamVesting tests linear and cliff-plus-linear vesting curves
+func TestStreamVesting(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(100 * time.Hour)
+	cliff := start.Add(25 * time.Hour)
+	amount := math.NewInt(1_000_000)
+
+	linear := NewStreamSchedule(StreamCurveLinear, start, cliff, end, 1)
+	require.NoError(t, linear.Validate())
+	require.True(t, linear.CliffTime.IsZero(), "linear streams ignore the cliff")
+
+	require.True(t, linear.VestedAmount(amount, start.Add(-time.Hour)).IsZero())
+	require.True(t, linear.VestedAmount(amount, start).IsZero())
+	require.Equal(t, math.NewInt(100_000), linear.VestedAmount(amount, start.Add(10*time.Hour)))
+	require.Equal(t, math.NewInt(500_000), linear.VestedAmount(amount, start.Add(50*time.Hour)))
+	require.Equal(t, amount, linear.VestedAmount(amount, end))
+	require.Equal(t, amount, linear.VestedAmount(amount, end.Add(time.Hour)))
+
+	cliffed := NewStreamSchedule(StreamCurveCliffLinear, start, cliff, end, 1)
+	require.NoError(t, cliffed.Validate())
+	require.True(t, cliffed.VestedAmount(amount, start.Add(24*time.Hour)).IsZero())
+	require.Equal(t, math.NewInt(250_000), cliffed.VestedAmount(amount, cliff))
+	require.Equal(t, math.NewInt(750_000), cliffed.VestedAmount(amount, start.Add(75*time.Hour)))
+	require.Equal(t, amount, cliffed.VestedAmount(amount, end))
+}
+
+// TestStreamScheduleValidation tests stream schedule validation
+func TestStreamScheduleValidation(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		schedule StreamSchedule
+		wantErr  bool
+	}{
+		{"valid linear", NewStreamSchedule(StreamCurveLinear, start, time.Time{}, end, 1), false},
+		{"valid cliff", NewStreamSchedule(StreamCurveCliffLinear, start, start.Add(time.Hour), end, 1), false},
+		{"end before start", NewStreamSchedule(StreamCurveLinear, end, time.Time{}, start, 1), true},
+		{"end equals start", NewStreamSchedule(StreamCurveLinear, start, time.Time{}, start, 1), true},
+		{"cliff before start", NewStreamSchedule(StreamCurveCliffLinear, start, start.Add(-time.Hour), end, 1), true},
+		{"cliff after end", NewStreamSchedule(StreamCurveCliffLinear, start, end.Add(time.Hour), end, 1), true},
+		{"unknown curve", NewStreamSchedule(StreamCurve(9), start, time.Time{}, end, 1), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestStreamingEscrowAmounts tests withdrawable and remaining amounts on a streaming escrow
+func TestStreamingEscrowAmounts(t *testing.T) {
+	sender := sdk.AccAddress([]byte("stream_sender_______")).String()
+	recipient := sdk.AccAddress([]byte("stream_recipient____")).String()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(10 * time.Hour)
+
+	assets := []EscrowAsset{
+		{AssetType: AssetTypeHODL, Denom: "uhodl", Amount: math.NewInt(1000)},
+		{AssetType: AssetTypeEquity, Denom: "ACME", Amount: math.NewInt(100), CompanyID: 1, ShareClass: "common"},
+	}
+	escrow := NewEscrow(1, sender, recipient, assets, "contractor", "monthly", start, start)
+	schedule := NewStreamSchedule(StreamCurveLinear, start, time.Time{}, end, len(assets))
+	escrow.Stream = &schedule
+	require.NoError(t, escrow.Validate())
+
+	mid := start.Add(4 * time.Hour)
+	require.Equal(t, math.NewInt(400), escrow.WithdrawableAmount(0, mid))
+	require.Equal(t, math.NewInt(40), escrow.WithdrawableAmount(1, mid))
+
+	// Simulate a withdrawal at mid-stream
+	escrow.Stream.Withdrawn[0] = math.NewInt(400)
+	escrow.Stream.Withdrawn[1] = math.NewInt(40)
+	require.True(t, escrow.WithdrawableAmount(0, mid).IsZero())
+	require.Equal(t, math.NewInt(600), escrow.RemainingAmount(0))
+	require.Equal(t, math.NewInt(60), escrow.RemainingAmount(1))
+	require.False(t, escrow.IsFullyWithdrawn())
+
+	require.Equal(t, math.NewInt(600), escrow.WithdrawableAmount(0, end))
+	escrow.Stream.Withdrawn[0] = math.NewInt(1000)
+	escrow.Stream.Withdrawn[1] = math.NewInt(100)
+	require.True(t, escrow.IsFullyWithdrawn())
+
+	// Lump-sum escrows keep their full amount and never stream
+	escrow.Stream = nil
+	require.Equal(t, math.NewInt(1000), escrow.RemainingAmount(0))
+	require.True(t, escrow.WithdrawableAmount(0, end).IsZero())
+
+	// Stream must track every asset
+	bad := NewStreamSchedule(StreamCurveLinear, start, time.Time{}, end, 1)
+	escrow.Stream = &bad
+	require.Error(t, escrow.Validate())
+}
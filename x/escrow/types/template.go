@@ -0,0 +1,229 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Template limits
+const (
+	MaxTemplateTermsLength  = 20000               // Maximum length of template terms text
+	MaxTemplateParameters   = 32                  // Maximum number of template parameters
+	MinTemplateDisputeTime  = 24 * time.Hour      // Minimum dispute timeout a template may set
+	MaxTemplateDisputeTime  = 60 * 24 * time.Hour // Maximum dispute timeout a template may set
+	DefaultDisputeTimeout   = 7 * 24 * time.Hour  // Dispute timeout for escrows without a template
+	TemplateParamOpenDelim  = "{{"
+	TemplateParamCloseDelim = "}}"
+)
+
+// TemplatePublisherType represents who published an escrow template
+type TemplatePublisherType int32
+
+const (
+	TemplatePublisherCompany   TemplatePublisherType = iota // Published by a listed company
+	TemplatePublisherModerator                              // Published by a registered moderator
+)
+
+func (t TemplatePublisherType) String() string {
+	switch t {
+	case TemplatePublisherCompany:
+		return "company"
+	case TemplatePublisherModerator:
+		return "moderator"
+	default:
+		return "unknown"
+	}
+}
+
+// TemplateParameter is a named placeholder in the template terms, written as {{name}}
+type TemplateParameter struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Required     bool   `json:"required"`
+	DefaultValue string `json:"default_value"`
+}
+
+// TemplateParamValue is a value supplied for a template parameter when creating an escrow
+type TemplateParamValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// EscrowTemplate is a reusable, versioned escrow agreement. Every update publishes a new
+// version; escrows keep referencing the version they were created from.
+type EscrowTemplate struct {
+	ID      uint64 `json:"id"`
+	Version uint64 `json:"version"`
+	Name    string `json:"name"`
+
+	// Publisher
+	Publisher     string                `json:"publisher"`
+	PublisherType TemplatePublisherType `json:"publisher_type"`
+	CompanyID     uint64                `json:"company_id"` // For company-published templates
+
+	// Standard terms, content-hashed so parties can verify the text off-chain
+	Terms      string              `json:"terms"`
+	TermsHash  string              `json:"terms_hash"`
+	Parameters []TemplateParameter `json:"parameters"`
+
+	// Defaults applied to escrows created from this template
+	DefaultConditions     []EscrowCondition `json:"default_conditions"`
+	EscrowFee             math.LegacyDec    `json:"escrow_fee"`
+	ModeratorFee          math.LegacyDec    `json:"moderator_fee"`
+	DisputeTimeout        time.Duration     `json:"dispute_timeout"`
+	MaxAppeals            int               `json:"max_appeals"`
+	RequiredModeratorTier ModeratorTier     `json:"required_moderator_tier"`
+
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// HashTemplateTerms returns the hex-encoded SHA-256 hash of template terms
+func HashTemplateTerms(terms string) string {
+	hash := sha256.Sum256([]byte(terms))
+	return hex.EncodeToString(hash[:])
+}
+
+// Validate validates an escrow template
+func (t EscrowTemplate) Validate() error {
+	if t.Name == "" {
+		return fmt.Errorf("template name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(t.Publisher); err != nil {
+		return fmt.Errorf("invalid publisher address: %v", err)
+	}
+	if t.PublisherType == TemplatePublisherCompany && t.CompanyID == 0 {
+		return fmt.Errorf("company templates must reference a company")
+	}
+	if t.PublisherType != TemplatePublisherCompany && t.PublisherType != TemplatePublisherModerator {
+		return fmt.Errorf("invalid publisher type: %d", t.PublisherType)
+	}
+	if t.Terms == "" {
+		return fmt.Errorf("template terms cannot be empty")
+	}
+	if len(t.Terms) > MaxTemplateTermsLength {
+		return fmt.Errorf("template terms exceed %d characters", MaxTemplateTermsLength)
+	}
+	if t.TermsHash != HashTemplateTerms(t.Terms) {
+		return fmt.Errorf("template terms hash does not match terms")
+	}
+	if len(t.Parameters) > MaxTemplateParameters {
+		return fmt.Errorf("template cannot have more than %d parameters", MaxTemplateParameters)
+	}
+	seen := make(map[string]bool)
+	for _, p := range t.Parameters {
+		if p.Name == "" || strings.ContainsAny(p.Name, "{} ") {
+			return fmt.Errorf("invalid template parameter name: %q", p.Name)
+		}
+		if seen[p.Name] {
+			return fmt.Errorf("duplicate template parameter: %s", p.Name)
+		}
+		seen[p.Name] = true
+	}
+	if t.EscrowFee.IsNil() || t.EscrowFee.IsNegative() || t.EscrowFee.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("escrow fee must be between 0 and 1")
+	}
+	if t.ModeratorFee.IsNil() || t.ModeratorFee.IsNegative() || t.ModeratorFee.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("moderator fee must be between 0 and 1")
+	}
+	if t.EscrowFee.Add(t.ModeratorFee).GT(math.LegacyOneDec()) {
+		return fmt.Errorf("combined fees cannot exceed 100%%")
+	}
+	if t.DisputeTimeout < MinTemplateDisputeTime || t.DisputeTimeout > MaxTemplateDisputeTime {
+		return fmt.Errorf("dispute timeout must be between %s and %s", MinTemplateDisputeTime, MaxTemplateDisputeTime)
+	}
+	if t.MaxAppeals < 0 {
+		return fmt.Errorf("max appeals cannot be negative")
+	}
+	if t.RequiredModeratorTier < ModeratorTierBronze || t.RequiredModeratorTier > ModeratorTierPlatinum {
+		return fmt.Errorf("invalid required moderator tier: %d", t.RequiredModeratorTier)
+	}
+	return nil
+}
+
+// ValidateFees checks the template's fees against the module's fee limits
+func (t EscrowTemplate) ValidateFees(params Params) error {
+	if t.EscrowFee.GT(params.MaxEscrowFee) {
+		return fmt.Errorf("escrow fee %s exceeds the maximum of %s", t.EscrowFee, params.MaxEscrowFee)
+	}
+	if t.ModeratorFee.GT(params.MaxModeratorFee) {
+		return fmt.Errorf("moderator fee %s exceeds the maximum of %s", t.ModeratorFee, params.MaxModeratorFee)
+	}
+	return nil
+}
+
+// RenderTerms substitutes parameter values into the template terms.
+// Missing optional parameters fall back to their default value.
+func (t EscrowTemplate) RenderTerms(values []TemplateParamValue) (string, error) {
+	supplied := make(map[string]string, len(values))
+	for _, v := range values {
+		if _, dup := supplied[v.Name]; dup {
+			return "", fmt.Errorf("parameter %s supplied more than once", v.Name)
+		}
+		supplied[v.Name] = v.Value
+	}
+
+	terms := t.Terms
+	for _, p := range t.Parameters {
+		value, ok := supplied[p.Name]
+		if !ok || value == "" {
+			if p.Required {
+				return "", fmt.Errorf("missing required parameter: %s", p.Name)
+			}
+			value = p.DefaultValue
+		}
+		delete(supplied, p.Name)
+		terms = strings.ReplaceAll(terms, TemplateParamOpenDelim+p.Name+TemplateParamCloseDelim, value)
+	}
+
+	if len(supplied) > 0 {
+		unknown := make([]string, 0, len(supplied))
+		for name := range supplied {
+			unknown = append(unknown, name)
+		}
+		sort.Strings(unknown)
+		return "", fmt.Errorf("unknown template parameters: %s", strings.Join(unknown, ", "))
+	}
+	return terms, nil
+}
+
+// NewEscrowTemplate creates a new version-1 escrow template with the module's default fees
+// Note: Use ctx.BlockTime() for the blockTime parameter in production
+func NewEscrowTemplate(
+	id uint64,
+	name, publisher string,
+	publisherType TemplatePublisherType,
+	companyID uint64,
+	terms string,
+	parameters []TemplateParameter,
+	blockTime time.Time,
+) EscrowTemplate {
+	return EscrowTemplate{
+		ID:                    id,
+		Version:               1,
+		Name:                  name,
+		Publisher:             publisher,
+		PublisherType:         publisherType,
+		CompanyID:             companyID,
+		Terms:                 terms,
+		TermsHash:             HashTemplateTerms(terms),
+		Parameters:            parameters,
+		DefaultConditions:     []EscrowCondition{},
+		EscrowFee:             math.LegacyNewDecWithPrec(1, 2), // 1% default fee
+		ModeratorFee:          math.LegacyNewDecWithPrec(5, 3), // 0.5% moderator fee
+		DisputeTimeout:        DefaultDisputeTimeout,
+		MaxAppeals:            1,
+		RequiredModeratorTier: ModeratorTierBronze,
+		Active:                true,
+		CreatedAt:             blockTime,
+		UpdatedAt:             blockTime,
+	}
+}
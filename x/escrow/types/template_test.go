@@ -0,0 +1,108 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEscrowTemplateRenderTerms tests parameter substitution into template terms
+func TestEscrowTemplateRenderTerms(t *testing.T) {
+	publisher := sdk.AccAddress([]byte("template_publisher__")).String()
+	template := NewEscrowTemplate(
+		1,
+		"OTC block",
+		publisher,
+		TemplatePublisherModerator,
+		0,
+		"Seller delivers {{quantity}} shares of {{symbol}} within {{days}} days.",
+		[]TemplateParameter{
+			{Name: "quantity", Required: true},
+			{Name: "symbol", Required: true},
+			{Name: "days", DefaultValue: "3"},
+		},
+		time.Now(),
+	)
+	require.NoError(t, template.Validate())
+
+	terms, err := template.RenderTerms([]TemplateParamValue{
+		{Name: "quantity", Value: "10000"},
+		{Name: "symbol", Value: "ACME"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Seller delivers 10000 shares of ACME within 3 days.", terms)
+
+	// Missing required parameter
+	_, err = template.RenderTerms([]TemplateParamValue{{Name: "quantity", Value: "1"}})
+	require.Error(t, err)
+
+	// Unknown parameter
+	_, err = template.RenderTerms([]TemplateParamValue{
+		{Name: "quantity", Value: "1"},
+		{Name: "symbol", Value: "ACME"},
+		{Name: "price", Value: "5"},
+	})
+	require.Error(t, err)
+
+	// Duplicate parameter
+	_, err = template.RenderTerms([]TemplateParamValue{
+		{Name: "quantity", Value: "1"},
+		{Name: "quantity", Value: "2"},
+		{Name: "symbol", Value: "ACME"},
+	})
+	require.Error(t, err)
+}
+
+// TestEscrowTemplateValidation tests escrow template validation
+func TestEscrowTemplateValidation(t *testing.T) {
+	publisher := sdk.AccAddress([]byte("template_publisher__")).String()
+	valid := NewEscrowTemplate(1, "Advisor grant", publisher, TemplatePublisherCompany, 7, "Standard advisor terms", nil, time.Now())
+	require.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.CompanyID = 0
+	require.Error(t, invalid.Validate(), "company templates need a company")
+
+	invalid = valid
+	invalid.Terms = "Tampered terms"
+	require.Error(t, invalid.Validate(), "terms hash must match terms")
+
+	invalid = valid
+	invalid.EscrowFee = math.LegacyNewDecWithPrec(6, 1)
+	invalid.ModeratorFee = math.LegacyNewDecWithPrec(5, 1)
+	require.Error(t, invalid.Validate(), "fees cannot exceed 100%")
+
+	invalid = valid
+	invalid.DisputeTimeout = time.Hour
+	require.Error(t, invalid.Validate(), "dispute timeout too short")
+
+	invalid = valid
+	invalid.Parameters = []TemplateParameter{{Name: "a"}, {Name: "a"}}
+	require.Error(t, invalid.Validate(), "duplicate parameters")
+
+	invalid = valid
+	invalid.Parameters = []TemplateParameter{{Name: "{bad}"}}
+	require.Error(t, invalid.Validate(), "parameter names cannot contain delimiters")
+}
+
+// TestEscrowTemplateFeeLimits tests template fees against the module fee limits
+func TestEscrowTemplateFeeLimits(t *testing.T) {
+	publisher := sdk.AccAddress([]byte("template_publisher__")).String()
+	template := NewEscrowTemplate(1, "Advisor grant", publisher, TemplatePublisherCompany, 7, "Standard advisor terms", nil, time.Now())
+	params := DefaultParams()
+	require.NoError(t, params.Validate())
+	require.NoError(t, template.ValidateFees(params))
+
+	template.ModeratorFee = params.MaxModeratorFee.Add(math.LegacyNewDecWithPrec(1, 3))
+	require.Error(t, template.ValidateFees(params), "moderator fee above the maximum")
+
+	template.ModeratorFee = params.MaxModeratorFee
+	template.EscrowFee = params.MaxEscrowFee.Add(math.LegacyNewDecWithPrec(1, 3))
+	require.Error(t, template.ValidateFees(params), "escrow fee above the maximum")
+
+	params.MaxModeratorFee = math.LegacyOneDec()
+	require.Error(t, params.Validate(), "combined limits cannot exceed 100%")
+}
@@ -147,6 +147,17 @@ type Escrow struct {
 	// Signatures
 	SenderConfirmed    bool `json:"sender_confirmed"`
 	RecipientConfirmed bool `json:"recipient_confirmed"`
+
+	// Streaming payment schedule (nil for lump-sum escrows)
+	Stream *StreamSchedule `json:"stream,omitempty"`
+
+	// Template the escrow was created from (zero for freeform escrows)
+	TemplateID      uint64               `json:"template_id,omitempty"`
+	TemplateVersion uint64               `json:"template_version,omitempty"`
+	TemplateParams  []TemplateParamValue `json:"template_params,omitempty"`
+
+	// OTC block trade the escrow settles (zero for regular escrows)
+	BlockTradeID uint64 `json:"block_trade_id,omitempty"`
 }
 
 // EscrowAsset represents an asset held in escrow
@@ -359,9 +370,45 @@ func (e Escrow) Validate() error {
 			return err
 		}
 	}
+	if e.Stream != nil {
+		if err := e.Stream.Validate(); err != nil {
+			return err
+		}
+		if len(e.Stream.Withdrawn) != len(e.Assets) {
+			return fmt.Errorf("stream must track every escrow asset")
+		}
+	}
 	return nil
 }
 
+// AssetType returns the combined asset type of the escrow: AssetTypeMixed when it
+// holds both HODL and equity (e.g. the two legs of a block trade)
+func (e Escrow) AssetType() AssetType {
+	hasHODL, hasEquity := false, false
+	for _, asset := range e.Assets {
+		switch asset.AssetType {
+		case AssetTypeHODL:
+			hasHODL = true
+		case AssetTypeEquity:
+			hasEquity = true
+		case AssetTypeMixed:
+			return AssetTypeMixed
+		}
+	}
+	if hasHODL && hasEquity {
+		return AssetTypeMixed
+	}
+	if hasEquity {
+		return AssetTypeEquity
+	}
+	return AssetTypeHODL
+}
+
+// IsBlockTrade returns true if the escrow settles an OTC block trade
+func (e Escrow) IsBlockTrade() bool {
+	return e.BlockTradeID != 0
+}
+
 func (a EscrowAsset) Validate() error {
 	if a.Denom == "" {
 		return fmt.Errorf("asset denom cannot be empty")
@@ -493,6 +493,33 @@ func (idx *Indexer) createNotificationFromEvent(event sdk.Event, blockInfo types
 			Timestamp: blockInfo.Timestamp,
 			Severity:  "info",
 		}
+	case "meeting_concluded":
+		return &types.NotificationEvent{
+			ID:        "meeting_" + strconv.FormatInt(blockInfo.Height, 10),
+			Type:      "meeting",
+			Title:     "Shareholder Meeting Results",
+			Message:   "A shareholder meeting has closed and published its results",
+			Timestamp: blockInfo.Timestamp,
+			Severity:  "info",
+		}
+	case "proposal_queued":
+		return &types.NotificationEvent{
+			ID:        "timelock_" + strconv.FormatInt(blockInfo.Height, 10),
+			Type:      "governance",
+			Title:     "Proposal Queued for Execution",
+			Message:   "A passed proposal is waiting out its timelock and can still be vetoed",
+			Timestamp: blockInfo.Timestamp,
+			Severity:  "warning",
+		}
+	case "execution_vetoed":
+		return &types.NotificationEvent{
+			ID:        "veto_" + strconv.FormatInt(blockInfo.Height, 10),
+			Type:      "governance",
+			Title:     "Queued Execution Vetoed",
+			Message:   "A passed proposal was vetoed before it could execute",
+			Timestamp: blockInfo.Timestamp,
+			Severity:  "error",
+		}
 	case "large_trade":
 		return &types.NotificationEvent{
 			ID:        "trade_" + strconv.FormatInt(blockInfo.Height, 10),
@@ -62,6 +62,16 @@ type UniversalStakingKeeper interface {
 type GovernanceKeeper interface {
 	GetProposal(ctx sdk.Context, proposalID uint64) (governancetypes.Proposal, bool)
 	GetAllProposals(ctx sdk.Context) []governancetypes.Proposal
+	GetShareholderMeeting(ctx sdk.Context, meetingID uint64) (governancetypes.ShareholderMeeting, bool)
+	GetCompanyMeetings(ctx sdk.Context, companyID uint64) []governancetypes.ShareholderMeeting
+	GetPendingExecutions(ctx sdk.Context) []governancetypes.QueuedExecution
+	GetGuardianCouncil(ctx sdk.Context) (governancetypes.GuardianCouncil, bool)
+	GetDelegateProfile(ctx sdk.Context, delegate string) (governancetypes.DelegateProfile, bool)
+	GetAllDelegateProfiles(ctx sdk.Context) []governancetypes.DelegateProfile
+	GetDelegateVotingRecord(ctx sdk.Context, delegate string) []governancetypes.DelegateVoteRecord
+	GetLiquidDelegationsToDelegate(ctx sdk.Context, delegate string) []governancetypes.LiquidDelegation
+	GetOptimisticProposals(ctx sdk.Context) []governancetypes.OptimisticProposal
+	GetExtendedParams(ctx sdk.Context) governancetypes.ExtendedParams
 }
 
 type BankKeeper interface {
@@ -287,9 +297,204 @@ func (k Keeper) GetGovernanceProposalInfo(ctx sdk.Context, proposalID uint64) (t
 		TotalDeposit:      proposal.TotalDeposit,
 		QuorumRequired:    proposal.Quorum,
 		ThresholdRequired: proposal.Threshold,
+		VotingMode:        proposal.VotingMode.String(),
 	}, true
 }
 
+// GetShareholderMeetingInfo retrieves a shareholder meeting with its published results
+func (k Keeper) GetShareholderMeetingInfo(ctx sdk.Context, meetingID uint64) (types.ShareholderMeetingInfo, bool) {
+	meeting, found := k.governanceKeeper.GetShareholderMeeting(ctx, meetingID)
+	if !found {
+		return types.ShareholderMeetingInfo{}, false
+	}
+	return buildShareholderMeetingInfo(meeting), true
+}
+
+// GetCompanyMeetingsInfo retrieves every shareholder meeting of a company
+func (k Keeper) GetCompanyMeetingsInfo(ctx sdk.Context, companyID uint64) []types.ShareholderMeetingInfo {
+	meetings := k.governanceKeeper.GetCompanyMeetings(ctx, companyID)
+	infos := make([]types.ShareholderMeetingInfo, 0, len(meetings))
+	for _, meeting := range meetings {
+		infos = append(infos, buildShareholderMeetingInfo(meeting))
+	}
+	return infos
+}
+
+// GetPendingExecutionsInfo returns the passed proposals waiting out their execution timelock
+func (k Keeper) GetPendingExecutionsInfo(ctx sdk.Context) []types.PendingExecutionInfo {
+	var vetoesRequired uint32
+	if council, found := k.governanceKeeper.GetGuardianCouncil(ctx); found {
+		vetoesRequired = council.VetoThreshold
+	}
+
+	pending := k.governanceKeeper.GetPendingExecutions(ctx)
+	infos := make([]types.PendingExecutionInfo, 0, len(pending))
+	for _, queued := range pending {
+		infos = append(infos, types.PendingExecutionInfo{
+			ProposalID:     queued.ProposalID,
+			Type:           queued.ProposalType.String(),
+			Title:          queued.Title,
+			QueuedAt:       queued.QueuedAt,
+			ExecuteAt:      queued.ExecuteAt,
+			GuardianVetoes: uint32(len(queued.GuardianVetoes)),
+			VetoesRequired: vetoesRequired,
+			VetoProposalID: queued.VetoProposalID,
+		})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ExecuteAt.Before(infos[j].ExecuteAt)
+	})
+	return infos
+}
+
+// GetDelegatesInfo returns every registered delegate, most followed first
+func (k Keeper) GetDelegatesInfo(ctx sdk.Context) []types.DelegateInfo {
+	profiles := k.governanceKeeper.GetAllDelegateProfiles(ctx)
+	infos := make([]types.DelegateInfo, 0, len(profiles))
+	for _, profile := range profiles {
+		infos = append(infos, k.buildDelegateInfo(ctx, profile))
+	}
+	sort.SliceStable(infos, func(i, j int) bool {
+		return infos[i].Followers > infos[j].Followers
+	})
+	return infos
+}
+
+// GetDelegateVotingRecordInfo returns a registered delegate and their voting record, newest vote first
+func (k Keeper) GetDelegateVotingRecordInfo(ctx sdk.Context, delegate string) (types.DelegateInfo, []types.DelegateVoteInfo, bool) {
+	profile, found := k.governanceKeeper.GetDelegateProfile(ctx, delegate)
+	if !found {
+		return types.DelegateInfo{}, nil, false
+	}
+
+	records := k.governanceKeeper.GetDelegateVotingRecord(ctx, delegate)
+	votes := make([]types.DelegateVoteInfo, 0, len(records))
+	for i := len(records) - 1; i >= 0; i-- {
+		r := records[i]
+		options := make([]types.WeightedOption, 0, len(r.Options))
+		for _, opt := range r.Options {
+			options = append(options, types.WeightedOption{
+				Option: opt.Option.String(),
+				Weight: opt.Weight,
+			})
+		}
+		votes = append(votes, types.DelegateVoteInfo{
+			ProposalID:     r.ProposalID,
+			Type:           r.ProposalType.String(),
+			CompanyID:      r.CompanyID,
+			Title:          r.Title,
+			Options:        options,
+			OwnPower:       r.OwnPower,
+			DelegatedPower: r.DelegatedPower,
+			Delegators:     r.Delegators,
+			VotedAt:        r.VotedAt,
+		})
+	}
+
+	return k.buildDelegateInfo(ctx, profile), votes, true
+}
+
+// GetOptimisticProposalsInfo returns optimistic proposals in their challenge window, closing soonest first
+func (k Keeper) GetOptimisticProposalsInfo(ctx sdk.Context) []types.OptimisticProposalInfo {
+	vetoThreshold := k.governanceKeeper.GetExtendedParams(ctx).GetOptimisticVetoThreshold()
+
+	optimistic := k.governanceKeeper.GetOptimisticProposals(ctx)
+	infos := make([]types.OptimisticProposalInfo, 0, len(optimistic))
+	for _, o := range optimistic {
+		info := types.OptimisticProposalInfo{
+			ProposalID:       o.ProposalID,
+			Title:            o.Title,
+			SubmittedAt:      o.SubmittedAt,
+			ChallengeEndTime: o.ChallengeEndTime,
+			Objections:       uint32(len(o.Objections)),
+			ObjectedPower:    o.ObjectedPower,
+			VetoThreshold:    vetoThreshold,
+		}
+		if proposal, found := k.governanceKeeper.GetProposal(ctx, o.ProposalID); found {
+			info.Proposer = proposal.Proposer
+			for _, msg := range proposal.Messages {
+				info.MessageTypes = append(info.MessageTypes, msg.TypeURL)
+			}
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ChallengeEndTime.Before(infos[j].ChallengeEndTime)
+	})
+	return infos
+}
+
+// buildDelegateInfo converts a delegate profile to its explorer view
+func (k Keeper) buildDelegateInfo(ctx sdk.Context, profile governancetypes.DelegateProfile) types.DelegateInfo {
+	var followers uint32
+	for _, delegation := range k.governanceKeeper.GetLiquidDelegationsToDelegate(ctx, profile.Address) {
+		if delegation.IsActive(ctx.BlockHeight()) {
+			followers++
+		}
+	}
+
+	topics := make([]string, 0, len(profile.Topics))
+	for _, t := range profile.Topics {
+		topics = append(topics, t.String())
+	}
+
+	return types.DelegateInfo{
+		Address:      profile.Address,
+		Name:         profile.Name,
+		Statement:    profile.Statement,
+		Topics:       topics,
+		CompanyIDs:   profile.CompanyIDs,
+		Followers:    followers,
+		VotesCast:    uint32(len(k.governanceKeeper.GetDelegateVotingRecord(ctx, profile.Address))),
+		RegisteredAt: profile.RegisteredAt,
+	}
+}
+
+// buildShareholderMeetingInfo converts a governance meeting to its explorer view
+func buildShareholderMeetingInfo(meeting governancetypes.ShareholderMeeting) types.ShareholderMeetingInfo {
+	attendance := math.LegacyZeroDec()
+	if !meeting.RecordPower.IsNil() && meeting.RecordPower.IsPositive() {
+		attendance = meeting.PresentPower.Quo(meeting.RecordPower)
+	}
+
+	results := make([]types.MeetingResultInfo, 0, len(meeting.Results))
+	for _, r := range meeting.Results {
+		results = append(results, types.MeetingResultInfo{
+			ProposalID:   r.ProposalID,
+			Title:        r.Title,
+			Type:         r.Type,
+			Outcome:      r.Status,
+			YesVotes:     r.YesVotes,
+			NoVotes:      r.NoVotes,
+			AbstainVotes: r.AbstainVotes,
+			VetoVotes:    r.NoWithVetoVotes,
+			Elected:      r.Elected,
+		})
+	}
+
+	return types.ShareholderMeetingInfo{
+		ID:               meeting.ID,
+		CompanyID:        meeting.CompanyID,
+		Title:            meeting.Title,
+		Convener:         meeting.Convener,
+		Status:           meeting.Status.String(),
+		RecordDate:       meeting.RecordDate,
+		RecordHeight:     meeting.RecordHeight,
+		OpensAt:          meeting.OpensAt,
+		ClosesAt:         meeting.ClosesAt,
+		QuorumRequired:   meeting.Quorum,
+		RecordPower:      meeting.RecordPower,
+		PresentPower:     meeting.PresentPower,
+		Attendance:       attendance,
+		QuorumMet:        meeting.HasQuorum(),
+		BallotsCast:      meeting.BallotsCast,
+		ProxiesAppointed: meeting.ProxiesAppointed,
+		Results:          results,
+		MinutesHash:      meeting.MinutesHash,
+		ClosedAt:         meeting.ClosedAt,
+	}
+}
+
 // Analytics Methods
 
 // GetNetworkStats retrieves comprehensive network statistics
@@ -174,6 +174,95 @@ func (q QueryServer) Proposal(c context.Context, req *types.QueryProposalRequest
 	}, nil
 }
 
+// ShareholderMeeting returns a shareholder meeting and its published results
+func (q QueryServer) ShareholderMeeting(c context.Context, req *types.QueryShareholderMeetingRequest) (*types.QueryShareholderMeetingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	meetingInfo, found := q.Keeper.GetShareholderMeetingInfo(ctx, req.MeetingId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "shareholder meeting not found")
+	}
+
+	return &types.QueryShareholderMeetingResponse{
+		Meeting: &meetingInfo,
+	}, nil
+}
+
+// MeetingsByCompany returns the shareholder meetings of a company
+func (q QueryServer) MeetingsByCompany(c context.Context, req *types.QueryMeetingsByCompanyRequest) (*types.QueryMeetingsByCompanyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryMeetingsByCompanyResponse{
+		Meetings: q.Keeper.GetCompanyMeetingsInfo(ctx, req.CompanyId),
+	}, nil
+}
+
+// PendingExecutions returns the passed proposals waiting out their execution timelock
+func (q QueryServer) PendingExecutions(c context.Context, req *types.QueryPendingExecutionsRequest) (*types.QueryPendingExecutionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPendingExecutionsResponse{
+		Executions: q.Keeper.GetPendingExecutionsInfo(ctx),
+	}, nil
+}
+
+// Delegates returns every registered delegate
+func (q QueryServer) Delegates(c context.Context, req *types.QueryDelegatesRequest) (*types.QueryDelegatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDelegatesResponse{
+		Delegates: q.Keeper.GetDelegatesInfo(ctx),
+	}, nil
+}
+
+// DelegateVotingRecord returns a registered delegate's profile and voting record
+func (q QueryServer) DelegateVotingRecord(c context.Context, req *types.QueryDelegateVotingRecordRequest) (*types.QueryDelegateVotingRecordResponse, error) {
+	if req == nil || req.Delegate == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegate, votes, found := q.Keeper.GetDelegateVotingRecordInfo(ctx, req.Delegate)
+	if !found {
+		return nil, status.Error(codes.NotFound, "delegate not found")
+	}
+
+	return &types.QueryDelegateVotingRecordResponse{
+		Delegate: &delegate,
+		Votes:    votes,
+	}, nil
+}
+
+// OptimisticProposals returns optimistic proposals in their challenge window
+func (q QueryServer) OptimisticProposals(c context.Context, req *types.QueryOptimisticProposalsRequest) (*types.QueryOptimisticProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryOptimisticProposalsResponse{
+		Proposals: q.Keeper.GetOptimisticProposalsInfo(ctx),
+	}, nil
+}
+
 // Analytics queries
 
 // NetworkStats returns comprehensive network statistics
@@ -169,10 +169,97 @@ type GovernanceProposalInfo struct {
 	TallyResult      TallyInfo           `json:"tally_result"`
 	QuorumRequired   math.LegacyDec      `json:"quorum_required"`
 	ThresholdRequired math.LegacyDec     `json:"threshold_required"`
+	VotingMode       string              `json:"voting_mode"`
 	Outcome          string              `json:"outcome"`
 	ExecutionResult  string              `json:"execution_result"`
 }
 
+// ShareholderMeetingInfo represents a company's shareholder meeting and its published results
+type ShareholderMeetingInfo struct {
+	ID               uint64              `json:"id"`
+	CompanyID        uint64              `json:"company_id"`
+	Title            string              `json:"title"`
+	Convener         string              `json:"convener"`
+	Status           string              `json:"status"`
+	RecordDate       time.Time           `json:"record_date"`
+	RecordHeight     int64               `json:"record_height"`
+	OpensAt          time.Time           `json:"opens_at"`
+	ClosesAt         time.Time           `json:"closes_at"`
+	QuorumRequired   math.LegacyDec      `json:"quorum_required"`
+	RecordPower      math.LegacyDec      `json:"record_power"`
+	PresentPower     math.LegacyDec      `json:"present_power"`
+	Attendance       math.LegacyDec      `json:"attendance"` // Share of record-date power present
+	QuorumMet        bool                `json:"quorum_met"`
+	BallotsCast      uint64              `json:"ballots_cast"`
+	ProxiesAppointed uint64              `json:"proxies_appointed"`
+	Results          []MeetingResultInfo `json:"results"`
+	MinutesHash      string              `json:"minutes_hash,omitempty"`
+	ClosedAt         time.Time           `json:"closed_at,omitempty"`
+}
+
+// MeetingResultInfo represents the outcome of one agenda item at a shareholder meeting
+type MeetingResultInfo struct {
+	ProposalID   uint64   `json:"proposal_id"`
+	Title        string   `json:"title"`
+	Type         string   `json:"type"`
+	Outcome      string   `json:"outcome"`
+	YesVotes     math.Int `json:"yes_votes"`
+	NoVotes      math.Int `json:"no_votes"`
+	AbstainVotes math.Int `json:"abstain_votes"`
+	VetoVotes    math.Int `json:"veto_votes"`
+	Elected      []string `json:"elected,omitempty"`
+}
+
+// PendingExecutionInfo represents a passed proposal waiting out its execution timelock
+type PendingExecutionInfo struct {
+	ProposalID      uint64    `json:"proposal_id"`
+	Type            string    `json:"type"`
+	Title           string    `json:"title"`
+	QueuedAt        time.Time `json:"queued_at"`
+	ExecuteAt       time.Time `json:"execute_at"`
+	GuardianVetoes  uint32    `json:"guardian_vetoes"`            // Guardian signatures on a veto so far
+	VetoesRequired  uint32    `json:"vetoes_required"`            // Signatures the sitting council needs to veto
+	VetoProposalID  uint64    `json:"veto_proposal_id,omitempty"` // Emergency veto vote raised against it
+}
+
+// DelegateInfo represents a registered delegate holders can follow
+type DelegateInfo struct {
+	Address      string    `json:"address"`
+	Name         string    `json:"name"`
+	Statement    string    `json:"statement"`
+	Topics       []string  `json:"topics,omitempty"`
+	CompanyIDs   []uint64  `json:"company_ids,omitempty"`
+	Followers    uint32    `json:"followers"`  // Active delegations to the delegate, across all scopes
+	VotesCast    uint32    `json:"votes_cast"`
+	RegisteredAt time.Time `json:"registered_at"`
+}
+
+// DelegateVoteInfo represents one vote in a delegate's voting record
+type DelegateVoteInfo struct {
+	ProposalID     uint64           `json:"proposal_id"`
+	Type           string           `json:"type"`
+	CompanyID      uint64           `json:"company_id,omitempty"`
+	Title          string           `json:"title"`
+	Options        []WeightedOption `json:"options"`
+	OwnPower       math.Int         `json:"own_power"`
+	DelegatedPower math.Int         `json:"delegated_power"` // Net of power followers took back by voting directly
+	Delegators     uint32           `json:"delegators"`
+	VotedAt        time.Time        `json:"voted_at"`
+}
+
+// OptimisticProposalInfo represents an optimistic proposal in its challenge window
+type OptimisticProposalInfo struct {
+	ProposalID       uint64           `json:"proposal_id"`
+	Title            string           `json:"title"`
+	Proposer         string           `json:"proposer"`
+	MessageTypes     []string         `json:"message_types"`
+	SubmittedAt      time.Time        `json:"submitted_at"`
+	ChallengeEndTime time.Time        `json:"challenge_end_time"`
+	Objections       uint32           `json:"objections"`
+	ObjectedPower    math.Int         `json:"objected_power"`
+	VetoThreshold    math.LegacyDec   `json:"veto_threshold"` // Share of eligible power whose objection escalates the proposal to a vote
+}
+
 // VoteInfo represents individual vote information
 type VoteInfo struct {
 	Voter           string              `json:"voter"`
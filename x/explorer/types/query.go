@@ -31,6 +31,12 @@ type QueryServer interface {
 	// Governance queries
 	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
 	VotesByProposal(context.Context, *QueryVotesByProposalRequest) (*QueryVotesByProposalResponse, error)
+	ShareholderMeeting(context.Context, *QueryShareholderMeetingRequest) (*QueryShareholderMeetingResponse, error)
+	MeetingsByCompany(context.Context, *QueryMeetingsByCompanyRequest) (*QueryMeetingsByCompanyResponse, error)
+	PendingExecutions(context.Context, *QueryPendingExecutionsRequest) (*QueryPendingExecutionsResponse, error)
+	Delegates(context.Context, *QueryDelegatesRequest) (*QueryDelegatesResponse, error)
+	DelegateVotingRecord(context.Context, *QueryDelegateVotingRecordRequest) (*QueryDelegateVotingRecordResponse, error)
+	OptimisticProposals(context.Context, *QueryOptimisticProposalsRequest) (*QueryOptimisticProposalsResponse, error)
 	
 	// Analytics queries
 	NetworkStats(context.Context, *QueryNetworkStatsRequest) (*QueryNetworkStatsResponse, error)
@@ -163,6 +169,49 @@ type QueryVotesByProposalResponse struct {
 	Pagination *query.PageResponse `json:"pagination,omitempty"`
 }
 
+type QueryShareholderMeetingRequest struct {
+	MeetingId uint64 `json:"meeting_id"`
+}
+
+type QueryShareholderMeetingResponse struct {
+	Meeting *ShareholderMeetingInfo `json:"meeting"`
+}
+
+type QueryMeetingsByCompanyRequest struct {
+	CompanyId uint64 `json:"company_id"`
+}
+
+type QueryMeetingsByCompanyResponse struct {
+	Meetings []ShareholderMeetingInfo `json:"meetings"`
+}
+
+type QueryPendingExecutionsRequest struct{}
+
+type QueryPendingExecutionsResponse struct {
+	Executions []PendingExecutionInfo `json:"executions"`
+}
+
+type QueryDelegatesRequest struct{}
+
+type QueryDelegatesResponse struct {
+	Delegates []DelegateInfo `json:"delegates"`
+}
+
+type QueryDelegateVotingRecordRequest struct {
+	Delegate string `json:"delegate"`
+}
+
+type QueryDelegateVotingRecordResponse struct {
+	Delegate *DelegateInfo     `json:"delegate"`
+	Votes    []DelegateVoteInfo `json:"votes"`
+}
+
+type QueryOptimisticProposalsRequest struct{}
+
+type QueryOptimisticProposalsResponse struct {
+	Proposals []OptimisticProposalInfo `json:"proposals"`
+}
+
 // Analytics query messages
 
 type QueryNetworkStatsRequest struct{}
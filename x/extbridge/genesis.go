@@ -44,9 +44,57 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetTSSSession(ctx, session)
 	}
 
+	// Set TSS keys
+	for _, key := range genState.TSSKeys {
+		k.SetTSSKey(ctx, key)
+	}
+
+	// Set TSS key generations
+	for _, keyGen := range genState.TSSKeyGenerations {
+		k.SetTSSKeyGeneration(ctx, keyGen)
+	}
+
+	// Set TSS key migrations
+	for _, migration := range genState.TSSKeyMigrations {
+		k.SetTSSKeyMigration(ctx, migration)
+	}
+
 	// Set circuit breaker
 	k.SetCircuitBreaker(ctx, genState.CircuitBreaker)
 
+	// Set scoped circuit breakers
+	for _, cb := range genState.ScopedCircuitBreakers {
+		k.SetScopedCircuitBreaker(ctx, cb)
+	}
+
+	// Set anomalies
+	for _, anomaly := range genState.Anomalies {
+		k.SetAnomaly(ctx, anomaly)
+	}
+
+	// Set bridged supplies
+	for _, supply := range genState.BridgedSupplies {
+		k.SetBridgedSupply(ctx, supply)
+	}
+
+	// Set reserve rounds
+	for _, round := range genState.ReserveRounds {
+		k.SetReserveRound(ctx, round)
+	}
+
+	// Set reserve snapshots
+	for _, snapshot := range genState.ReserveSnapshots {
+		k.SetReserveSnapshot(ctx, snapshot)
+	}
+
+	// Set outbound nonces and custody outputs
+	for _, nonces := range genState.ExternalNonces {
+		k.SetExternalNonceState(ctx, nonces)
+	}
+	for _, output := range genState.CustodyOutputs {
+		k.SetCustodyOutput(ctx, output)
+	}
+
 	// Initialize ID counters (handled by keeper via GetNext methods)
 }
 
@@ -60,7 +108,17 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Attestations:     []types.DepositAttestation{}, // TODO: Export attestations
 		Withdrawals:      k.GetAllWithdrawals(ctx),
 		TSSSessions:      k.GetAllTSSSessions(ctx),
+		TSSKeys:          k.GetAllTSSKeys(ctx),
+		TSSKeyGenerations: k.GetAllTSSKeyGenerations(ctx),
+		TSSKeyMigrations:  k.GetAllTSSKeyMigrations(ctx),
 		CircuitBreaker:   k.GetCircuitBreaker(ctx),
+		ScopedCircuitBreakers: k.GetAllScopedCircuitBreakers(ctx),
+		Anomalies:        k.GetAllAnomalies(ctx),
+		BridgedSupplies:  k.GetAllBridgedSupplies(ctx),
+		ReserveRounds:    k.GetAllReserveRounds(ctx),
+		ReserveSnapshots: k.GetAllReserveSnapshots(ctx),
+		ExternalNonces:   k.GetAllExternalNonceStates(ctx),
+		CustodyOutputs:   k.GetAllCustodyOutputs(ctx),
 		NextDepositID:    k.GetNextDepositID(ctx),
 		NextWithdrawalID: k.GetNextWithdrawalID(ctx),
 		NextTSSSessionID: k.GetNextTSSSessionID(ctx),
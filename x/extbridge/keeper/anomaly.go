@@ -0,0 +1,477 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/types"
+)
+
+const (
+	// anomalyDetector is recorded as the trigger of circuit breakers tripped automatically
+	anomalyDetector = "anomaly_detection"
+
+	// minDepositHistory is the fewest earlier deposits of an asset needed before
+	// a deposit is compared against their percentile
+	minDepositHistory = 10
+
+	// defaultDepositHistory is the number of earlier deposits compared against when unset
+	defaultDepositHistory = 100
+)
+
+// GetAnomaly retrieves an anomaly detection record by ID
+func (k Keeper) GetAnomaly(ctx sdk.Context, anomalyID uint64) (types.AnomalyDetection, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AnomalyKey(anomalyID))
+	if bz == nil {
+		return types.AnomalyDetection{}, false
+	}
+
+	var anomaly types.AnomalyDetection
+	if err := json.Unmarshal(bz, &anomaly); err != nil {
+		return types.AnomalyDetection{}, false
+	}
+	return anomaly, true
+}
+
+// SetAnomaly stores an anomaly detection record
+func (k Keeper) SetAnomaly(ctx sdk.Context, anomaly types.AnomalyDetection) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(anomaly)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal anomaly", "error", err)
+		return
+	}
+	store.Set(types.AnomalyKey(anomaly.ID), bz)
+}
+
+// GetAllAnomalies returns all anomaly detection records
+func (k Keeper) GetAllAnomalies(ctx sdk.Context) []types.AnomalyDetection {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.AnomalyPrefix)
+	defer iterator.Close()
+
+	anomalies := []types.AnomalyDetection{}
+	for ; iterator.Valid(); iterator.Next() {
+		var anomaly types.AnomalyDetection
+		if err := json.Unmarshal(iterator.Value(), &anomaly); err != nil {
+			continue
+		}
+		anomalies = append(anomalies, anomaly)
+	}
+	return anomalies
+}
+
+// RecordAnomaly stores a finding and, when automatic pausing is enabled,
+// trips a circuit breaker for a high or critical one
+func (k Keeper) RecordAnomaly(ctx sdk.Context, anomaly types.AnomalyDetection) uint64 {
+	anomaly.ID = k.GetNextAnomalyID(ctx)
+
+	params := k.GetParams(ctx)
+	if anomaly.TripsCircuitBreaker() && params.EmergencyPauseEnabled {
+		anomaly.ActionTaken = k.tripCircuitBreaker(ctx, anomaly)
+	}
+
+	k.SetAnomaly(ctx, anomaly)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_anomaly_detected",
+			sdk.NewAttribute("anomaly_id", fmt.Sprintf("%d", anomaly.ID)),
+			sdk.NewAttribute("chain_id", anomaly.ChainID),
+			sdk.NewAttribute("asset", anomaly.AssetSymbol),
+			sdk.NewAttribute("detection_type", anomaly.DetectionType),
+			sdk.NewAttribute("severity", anomaly.Severity),
+			sdk.NewAttribute("description", anomaly.Description),
+			sdk.NewAttribute("action_taken", anomaly.ActionTaken),
+		),
+	)
+
+	k.Logger(ctx).Warn("bridge anomaly detected",
+		"anomaly_id", anomaly.ID,
+		"chain", anomaly.ChainID,
+		"asset", anomaly.AssetSymbol,
+		"type", anomaly.DetectionType,
+		"severity", anomaly.Severity,
+	)
+
+	return anomaly.ID
+}
+
+// DetectAnomalies runs the anomaly detectors over recent bridge activity and
+// records their findings. Window detectors report an asset at most once per
+// anomaly window (runs in EndBlock)
+func (k Keeper) DetectAnomalies(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	recent := k.recentAnomalies(ctx, ctx.BlockTime().Add(-params.AnomalyWindowDuration()))
+
+	findings := k.detectWithdrawalAnomalies(ctx, params)
+	findings = append(findings, k.detectLargeDeposits(ctx, params)...)
+	findings = append(findings, k.detectSupplyMismatches(ctx, params)...)
+
+	for _, finding := range findings {
+		if finding.DetectionType != types.AnomalyTypeLargeDeposit && hasAnomaly(recent, finding) {
+			continue
+		}
+		k.RecordAnomaly(ctx, finding)
+		recent = append(recent, finding)
+	}
+}
+
+// detectWithdrawalAnomalies flags assets with a burst of withdrawal requests
+// within the anomaly window, and senders withdrawing to many recipients no
+// earlier withdrawal on that chain went to
+func (k Keeper) detectWithdrawalAnomalies(ctx sdk.Context, params types.Params) []types.AnomalyDetection {
+	if params.AnomalyBurstCount == 0 && params.AnomalyFreshAddressCount == 0 {
+		return nil
+	}
+
+	now := ctx.BlockTime()
+	window := params.AnomalyWindowDuration()
+	windowStart := now.Add(-window)
+
+	// Withdrawals are keyed by ID, so walking back from the newest stops at the window's start
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.WithdrawalPrefix)
+	groups := make(map[string][]types.Withdrawal)
+	for ; iterator.Valid(); iterator.Next() {
+		var withdrawal types.Withdrawal
+		if err := json.Unmarshal(iterator.Value(), &withdrawal); err != nil {
+			continue
+		}
+		if !withdrawal.RequestedAt.After(windowStart) {
+			break
+		}
+		key := withdrawal.ChainID + "/" + withdrawal.AssetSymbol
+		groups[key] = append([]types.Withdrawal{withdrawal}, groups[key]...)
+	}
+	iterator.Close()
+
+	findings := []types.AnomalyDetection{}
+	for _, key := range sortedKeys(groups) {
+		withdrawals := groups[key]
+		chainID, assetSymbol := withdrawals[0].ChainID, withdrawals[0].AssetSymbol
+
+		count := uint64(len(withdrawals))
+		if params.AnomalyBurstCount > 0 && count >= params.AnomalyBurstCount {
+			severity := types.AnomalySeverityHigh
+			if count >= 2*params.AnomalyBurstCount {
+				severity = types.AnomalySeverityCritical
+			}
+			findings = append(findings, types.NewAnomalyDetection(
+				chainID,
+				assetSymbol,
+				types.AnomalyTypeRapidWithdrawal,
+				severity,
+				fmt.Sprintf("%d withdrawals requested within %s (threshold %d)", count, window, params.AnomalyBurstCount),
+				withdrawalIDs(withdrawals),
+				now,
+			))
+		}
+
+		if params.AnomalyFreshAddressCount == 0 {
+			continue
+		}
+
+		// Group each sender's withdrawals to recipients not seen before the window
+		bySender := make(map[string][]types.Withdrawal)
+		for _, withdrawal := range withdrawals {
+			bySender[withdrawal.Sender] = append(bySender[withdrawal.Sender], withdrawal)
+		}
+		for _, sender := range sortedKeys(bySender) {
+			if uint64(len(bySender[sender])) < params.AnomalyFreshAddressCount {
+				continue
+			}
+			fresh := []types.Withdrawal{}
+			recipients := make(map[string]bool)
+			for _, withdrawal := range bySender[sender] {
+				if k.recipientSeenBefore(ctx, withdrawal.ChainID, withdrawal.Recipient, windowStart) {
+					continue
+				}
+				fresh = append(fresh, withdrawal)
+				recipients[withdrawal.Recipient] = true
+			}
+			if uint64(len(recipients)) < params.AnomalyFreshAddressCount {
+				continue
+			}
+
+			findings = append(findings, types.NewAnomalyDetection(
+				chainID,
+				assetSymbol,
+				types.AnomalyTypeFreshAddressWithdrawals,
+				types.AnomalySeverityHigh,
+				fmt.Sprintf("%s withdrew to %d previously unseen recipients within %s (threshold %d)", sender, len(recipients), window, params.AnomalyFreshAddressCount),
+				withdrawalIDs(fresh),
+				now,
+			))
+		}
+	}
+
+	return findings
+}
+
+// recipientSeenBefore returns whether a withdrawal to the recipient on the
+// chain was requested up to a time
+func (k Keeper) recipientSeenBefore(ctx sdk.Context, chainID, recipient string, before time.Time) bool {
+	first, err := sdk.ParseTimeBytes(ctx.KVStore(k.storeKey).Get(types.WithdrawalRecipientKey(chainID, recipient)))
+	if err != nil {
+		return false
+	}
+	return !first.After(before)
+}
+
+// detectLargeDeposits flags deposits observed in this block that exceed the
+// configured percentile of the asset's recent deposits. One more than twice
+// the largest recent deposit is high severity
+func (k Keeper) detectLargeDeposits(ctx sdk.Context, params types.Params) []types.AnomalyDetection {
+	if params.AnomalyDepositPercentile.IsNil() {
+		return nil
+	}
+
+	historySize := int(params.AnomalyDepositHistory)
+	if historySize == 0 {
+		historySize = defaultDepositHistory
+	}
+
+	now := ctx.BlockTime()
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.DepositPrefix)
+	defer iterator.Close()
+
+	// Deposits are keyed by ID, so this block's come first, then each asset's history
+	observed := []types.Deposit{}
+	history := make(map[string][]math.Int)
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		if err := json.Unmarshal(iterator.Value(), &deposit); err != nil {
+			continue
+		}
+
+		key := deposit.ChainID + "/" + deposit.AssetSymbol
+		if deposit.ObservedAt.Equal(now) {
+			observed = append([]types.Deposit{deposit}, observed...)
+			history[key] = history[key][:0]
+			continue
+		}
+		if len(observed) == 0 {
+			break
+		}
+
+		if samples, needed := history[key]; needed && len(samples) < historySize && !deposit.IsRejected() {
+			history[key] = append(samples, deposit.Amount)
+		}
+		if historyFilled(history, historySize) {
+			break
+		}
+	}
+
+	findings := []types.AnomalyDetection{}
+	for _, deposit := range observed {
+		samples := history[deposit.ChainID+"/"+deposit.AssetSymbol]
+		if len(samples) < minDepositHistory {
+			continue
+		}
+
+		sorted := make([]math.Int, len(samples))
+		copy(sorted, samples)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i].LT(sorted[j]) })
+
+		// Nearest-rank percentile
+		rank := params.AnomalyDepositPercentile.MulInt64(int64(len(sorted))).Ceil().TruncateInt64()
+		if rank < 1 {
+			rank = 1
+		}
+		threshold := sorted[rank-1]
+		if !deposit.Amount.GT(threshold) {
+			continue
+		}
+
+		severity := types.AnomalySeverityMedium
+		largest := sorted[len(sorted)-1]
+		if deposit.Amount.GT(largest.MulRaw(2)) {
+			severity = types.AnomalySeverityHigh
+		}
+
+		findings = append(findings, types.NewAnomalyDetection(
+			deposit.ChainID,
+			deposit.AssetSymbol,
+			types.AnomalyTypeLargeDeposit,
+			severity,
+			fmt.Sprintf("deposit %d of %s exceeds the %s percentile %s of the last %d deposits (largest %s)",
+				deposit.ID, deposit.Amount, params.AnomalyDepositPercentile, threshold, len(sorted), largest),
+			[]string{fmt.Sprintf("%d", deposit.ID)},
+			now,
+		))
+	}
+
+	return findings
+}
+
+// detectSupplyMismatches flags assets whose latest attested reserves fall
+// short of the bridged HODL outstanding when they were attested by more than
+// the tolerance. Both sides are taken as of the snapshot, valued at the
+// conversion rate of the time, so flows since and later repricing do not
+// register; assets without a snapshot are skipped.
+func (k Keeper) detectSupplyMismatches(ctx sdk.Context, params types.Params) []types.AnomalyDetection {
+	if params.SupplyMismatchTolerance.IsNil() {
+		return nil
+	}
+
+	findings := []types.AnomalyDetection{}
+	for _, asset := range k.GetAllExternalAssets(ctx) {
+		snapshot, found := k.GetLatestReserveSnapshot(ctx, asset.ChainID, asset.AssetSymbol)
+		if !found || !snapshot.Supply.IsPositive() {
+			continue
+		}
+
+		limit := math.LegacyNewDecFromInt(snapshot.CustodyValue).Mul(math.LegacyOneDec().Add(params.SupplyMismatchTolerance))
+		if math.LegacyNewDecFromInt(snapshot.Supply).LTE(limit) {
+			continue
+		}
+
+		findings = append(findings, types.NewAnomalyDetection(
+			asset.ChainID,
+			asset.AssetSymbol,
+			types.AnomalyTypeSupplyMismatch,
+			types.AnomalySeverityCritical,
+			fmt.Sprintf("%s HODL outstanding against reserves of %s worth %s HODL attested at height %d",
+				snapshot.Supply, snapshot.Balance, snapshot.CustodyValue, snapshot.ExternalHeight),
+			[]string{},
+			ctx.BlockTime(),
+		))
+	}
+
+	return findings
+}
+
+// tripCircuitBreaker pauses the operations an anomaly puts at risk: the asset
+// for a high finding, the whole chain for a critical one. It returns the
+// action taken
+func (k Keeper) tripCircuitBreaker(ctx sdk.Context, anomaly types.AnomalyDetection) string {
+	scope := anomaly.AssetSymbol
+	if anomaly.Severity == types.AnomalySeverityCritical {
+		scope = ""
+	}
+
+	canDeposit, canWithdraw, canAttest := true, true, true
+	switch anomaly.DetectionType {
+	case types.AnomalyTypeRapidWithdrawal, types.AnomalyTypeFreshAddressWithdrawals:
+		canWithdraw = false
+	case types.AnomalyTypeLargeDeposit:
+		canDeposit, canAttest = false, false
+	default:
+		canDeposit, canWithdraw, canAttest = false, false, false
+	}
+
+	// An active breaker stays at least as strict
+	if cb, found := k.GetScopedCircuitBreaker(ctx, anomaly.ChainID, scope); found && cb.Enabled && !cb.IsExpired(ctx.BlockTime()) {
+		canDeposit = canDeposit && cb.CanDeposit
+		canWithdraw = canWithdraw && cb.CanWithdraw
+		canAttest = canAttest && cb.CanAttest
+	}
+
+	cb := types.CircuitBreaker{
+		ChainID:     anomaly.ChainID,
+		AssetSymbol: scope,
+		Enabled:     true,
+		Reason:      fmt.Sprintf("anomaly %d: %s", anomaly.ID, anomaly.DetectionType),
+		TriggeredBy: anomalyDetector,
+		TriggeredAt: ctx.BlockTime(),
+		CanDeposit:  canDeposit,
+		CanWithdraw: canWithdraw,
+		CanAttest:   canAttest,
+	}
+	k.SetScopedCircuitBreaker(ctx, cb)
+
+	paused := []string{}
+	for operation, allowed := range map[string]bool{"deposit": canDeposit, "withdraw": canWithdraw, "attest": canAttest} {
+		if !allowed {
+			paused = append(paused, operation)
+		}
+	}
+	sort.Strings(paused)
+	target := anomaly.ChainID
+	if scope != "" {
+		target = anomaly.ChainID + "/" + scope
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_circuit_breaker_tripped",
+			sdk.NewAttribute("anomaly_id", fmt.Sprintf("%d", anomaly.ID)),
+			sdk.NewAttribute("chain_id", anomaly.ChainID),
+			sdk.NewAttribute("asset", scope),
+			sdk.NewAttribute("paused", strings.Join(paused, ",")),
+		),
+	)
+
+	return fmt.Sprintf("paused %s on %s", strings.Join(paused, ", "), target)
+}
+
+// recentAnomalies returns the anomalies detected since a time, newest first
+func (k Keeper) recentAnomalies(ctx sdk.Context, since time.Time) []types.AnomalyDetection {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.AnomalyPrefix)
+	defer iterator.Close()
+
+	anomalies := []types.AnomalyDetection{}
+	for ; iterator.Valid(); iterator.Next() {
+		var anomaly types.AnomalyDetection
+		if err := json.Unmarshal(iterator.Value(), &anomaly); err != nil {
+			continue
+		}
+		if !anomaly.DetectedAt.After(since) {
+			break
+		}
+		anomalies = append(anomalies, anomaly)
+	}
+	return anomalies
+}
+
+// hasAnomaly returns whether a finding of the same type was already recorded for its asset
+func hasAnomaly(anomalies []types.AnomalyDetection, finding types.AnomalyDetection) bool {
+	for _, anomaly := range anomalies {
+		if anomaly.DetectionType == finding.DetectionType &&
+			anomaly.ChainID == finding.ChainID &&
+			anomaly.AssetSymbol == finding.AssetSymbol {
+			return true
+		}
+	}
+	return false
+}
+
+// historyFilled returns whether every asset needing deposit history has enough
+func historyFilled(history map[string][]math.Int, size int) bool {
+	for _, samples := range history {
+		if len(samples) < size {
+			return false
+		}
+	}
+	return true
+}
+
+// withdrawalIDs returns the IDs of withdrawals for an anomaly's related transactions
+func withdrawalIDs(withdrawals []types.Withdrawal) []string {
+	ids := make([]string, len(withdrawals))
+	for i, withdrawal := range withdrawals {
+		ids[i] = fmt.Sprintf("%d", withdrawal.ID)
+	}
+	return ids
+}
+
+// sortedKeys returns a map's keys in order, for deterministic iteration
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,295 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/keeper"
+	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/types"
+	hodltypes "github.com/sharehodl/sharehodl-blockchain/x/hodl/types"
+)
+
+// setAnomalyParams applies anomaly detector settings on top of the defaults
+func (suite *KeeperTestSuite) setAnomalyParams(update func(params *types.Params)) {
+	params := suite.keeper.GetParams(suite.ctx)
+	update(&params)
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+}
+
+// fundedSender returns a sender holding plenty of HODL
+func (suite *KeeperTestSuite) fundedSender(name string) sdk.AccAddress {
+	sender := sdk.AccAddress(name)
+	suite.bankKeeper.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin(hodltypes.HODLDenom, math.NewInt(1_000_000_000)))
+	return sender
+}
+
+// nextBlock moves the block time forward
+func (suite *KeeperTestSuite) nextBlock(d time.Duration) {
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(d))
+}
+
+// TestDetectRapidWithdrawals tests that a burst of withdrawals pauses the asset's withdrawals
+func (suite *KeeperTestSuite) TestDetectRapidWithdrawals() {
+	suite.addUnkeyedChain("ethereum-1", "evm", "0x1234567890abcdef")
+	suite.setAnomalyParams(func(params *types.Params) {
+		params.AnomalyBurstCount = 3
+		params.AnomalyFreshAddressCount = 0
+	})
+	sender := suite.fundedSender("sender1")
+
+	for i := 0; i < 2; i++ {
+		_, err := suite.keeper.RequestWithdrawal(suite.ctx, sender, "ethereum-1", "USDT", testRecipient, math.NewInt(1_000_000))
+		suite.Require().NoError(err)
+	}
+	suite.keeper.DetectAnomalies(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetAllAnomalies(suite.ctx))
+
+	suite.nextBlock(time.Minute)
+	_, err := suite.keeper.RequestWithdrawal(suite.ctx, sender, "ethereum-1", "USDT", testRecipient, math.NewInt(1_000_000))
+	suite.Require().NoError(err)
+	suite.keeper.DetectAnomalies(suite.ctx)
+
+	anomalies := suite.keeper.GetAllAnomalies(suite.ctx)
+	suite.Require().Len(anomalies, 1)
+	anomaly := anomalies[0]
+	suite.Require().Equal(types.AnomalyTypeRapidWithdrawal, anomaly.DetectionType)
+	suite.Require().Equal(types.AnomalySeverityHigh, anomaly.Severity)
+	suite.Require().Equal([]string{"1", "2", "3"}, anomaly.RelatedTxs)
+	suite.Require().Equal(suite.ctx.BlockTime(), anomaly.DetectedAt)
+	suite.Require().NotEmpty(anomaly.ActionTaken)
+
+	// The asset's withdrawals are paused; its deposits and other assets are not
+	cb, found := suite.keeper.GetScopedCircuitBreaker(suite.ctx, "ethereum-1", "USDT")
+	suite.Require().True(found)
+	suite.Require().True(cb.Enabled)
+	suite.Require().False(cb.CanWithdraw)
+	suite.Require().True(cb.CanDeposit)
+
+	_, err = suite.keeper.RequestWithdrawal(suite.ctx, sender, "ethereum-1", "USDT", testRecipient, math.NewInt(1_000_000))
+	suite.Require().ErrorIs(err, types.ErrCircuitBreakerActive)
+	suite.Require().NoError(suite.keeper.IsOperationAllowedFor(suite.ctx, "deposit", "ethereum-1", "USDT"))
+	suite.Require().NoError(suite.keeper.IsOperationAllowedFor(suite.ctx, "withdraw", "ethereum-1", "USDC"))
+	suite.Require().NoError(suite.keeper.IsOperationAllowed(suite.ctx, "withdraw"))
+
+	// The same burst is reported once per window
+	suite.nextBlock(time.Minute)
+	suite.keeper.DetectAnomalies(suite.ctx)
+	suite.Require().Len(suite.keeper.GetAllAnomalies(suite.ctx), 1)
+
+	// Timelocked withdrawals are held back from signing too
+	suite.nextBlock(2 * time.Hour)
+	suite.keeper.ProcessWithdrawals(suite.ctx)
+	_, err = suite.keeper.CreateTSSSession(suite.ctx, 1)
+	suite.Require().ErrorIs(err, types.ErrCircuitBreakerActive)
+
+	// Governance lifts the asset's breaker
+	msgServer := keeper.NewMsgServerImpl(*suite.keeper)
+	_, err = msgServer.UpdateCircuitBreaker(suite.ctx, &types.MsgUpdateCircuitBreaker{
+		Authority:   "cosmos1authority",
+		ChainID:     "ethereum-1",
+		AssetSymbol: "USDT",
+	})
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetScopedCircuitBreaker(suite.ctx, "ethereum-1", "USDT")
+	suite.Require().False(found)
+	suite.Require().NoError(suite.keeper.IsOperationAllowedFor(suite.ctx, "withdraw", "ethereum-1", "USDT"))
+}
+
+// TestDetectCriticalWithdrawalBurst tests that a burst of twice the threshold pauses the whole chain
+func (suite *KeeperTestSuite) TestDetectCriticalWithdrawalBurst() {
+	suite.addUnkeyedChain("ethereum-1", "evm", "0x1234567890abcdef")
+	suite.setAnomalyParams(func(params *types.Params) {
+		params.AnomalyBurstCount = 2
+		params.AnomalyFreshAddressCount = 0
+	})
+	sender := suite.fundedSender("sender1")
+
+	for i := 0; i < 4; i++ {
+		_, err := suite.keeper.RequestWithdrawal(suite.ctx, sender, "ethereum-1", "USDT", testRecipient, math.NewInt(1_000_000))
+		suite.Require().NoError(err)
+	}
+	suite.keeper.DetectAnomalies(suite.ctx)
+
+	anomalies := suite.keeper.GetAllAnomalies(suite.ctx)
+	suite.Require().Len(anomalies, 1)
+	suite.Require().Equal(types.AnomalySeverityCritical, anomalies[0].Severity)
+
+	cb, found := suite.keeper.GetScopedCircuitBreaker(suite.ctx, "ethereum-1", "")
+	suite.Require().True(found)
+	suite.Require().False(cb.CanWithdraw)
+	suite.Require().ErrorIs(suite.keeper.IsOperationAllowedFor(suite.ctx, "withdraw", "ethereum-1", "USDC"), types.ErrCircuitBreakerActive)
+}
+
+// TestDetectAnomalyWithoutEmergencyPause tests that findings are only recorded when automatic pausing is off
+func (suite *KeeperTestSuite) TestDetectAnomalyWithoutEmergencyPause() {
+	suite.addUnkeyedChain("ethereum-1", "evm", "0x1234567890abcdef")
+	suite.setAnomalyParams(func(params *types.Params) {
+		params.AnomalyBurstCount = 2
+		params.EmergencyPauseEnabled = false
+	})
+	sender := suite.fundedSender("sender1")
+
+	for i := 0; i < 2; i++ {
+		_, err := suite.keeper.RequestWithdrawal(suite.ctx, sender, "ethereum-1", "USDT", testRecipient, math.NewInt(1_000_000))
+		suite.Require().NoError(err)
+	}
+	suite.keeper.DetectAnomalies(suite.ctx)
+
+	anomalies := suite.keeper.GetAllAnomalies(suite.ctx)
+	suite.Require().Len(anomalies, 1)
+	suite.Require().Empty(anomalies[0].ActionTaken)
+	suite.Require().Empty(suite.keeper.GetAllScopedCircuitBreakers(suite.ctx))
+}
+
+// TestDetectFreshAddressWithdrawals tests flagging a sender withdrawing to many unseen recipients
+func (suite *KeeperTestSuite) TestDetectFreshAddressWithdrawals() {
+	suite.addUnkeyedChain("ethereum-1", "evm", "0x1234567890abcdef")
+	suite.setAnomalyParams(func(params *types.Params) {
+		params.AnomalyBurstCount = 0
+		params.AnomalyFreshAddressCount = 3
+	})
+	sender := suite.fundedSender("sender1")
+	other := suite.fundedSender("sender2")
+
+	_, err := suite.keeper.RequestWithdrawal(suite.ctx, other, "ethereum-1", "USDT", evmAddress("known"), math.NewInt(1_000_000))
+	suite.Require().NoError(err)
+
+	// A recipient seen before the window does not count
+	suite.nextBlock(time.Hour)
+	for _, recipient := range []string{evmAddress("known"), evmAddress("new1"), evmAddress("new2"), evmAddress("new2")} {
+		_, err := suite.keeper.RequestWithdrawal(suite.ctx, sender, "ethereum-1", "USDT", recipient, math.NewInt(1_000_000))
+		suite.Require().NoError(err)
+	}
+	suite.keeper.DetectAnomalies(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetAllAnomalies(suite.ctx))
+
+	// Another sender's unseen recipients do not add up with this one's
+	_, err = suite.keeper.RequestWithdrawal(suite.ctx, other, "ethereum-1", "USDT", evmAddress("new3"), math.NewInt(1_000_000))
+	suite.Require().NoError(err)
+	suite.keeper.DetectAnomalies(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetAllAnomalies(suite.ctx))
+
+	suite.nextBlock(time.Minute)
+	withdrawalID, err := suite.keeper.RequestWithdrawal(suite.ctx, sender, "ethereum-1", "USDT", evmAddress("new4"), math.NewInt(1_000_000))
+	suite.Require().NoError(err)
+	suite.keeper.DetectAnomalies(suite.ctx)
+
+	anomalies := suite.keeper.GetAllAnomalies(suite.ctx)
+	suite.Require().Len(anomalies, 1)
+	suite.Require().Equal(types.AnomalyTypeFreshAddressWithdrawals, anomalies[0].DetectionType)
+	suite.Require().Equal(types.AnomalySeverityHigh, anomalies[0].Severity)
+	suite.Require().Len(anomalies[0].RelatedTxs, 4)
+	suite.Require().Contains(anomalies[0].RelatedTxs, fmt.Sprintf("%d", withdrawalID))
+	suite.Require().Contains(anomalies[0].Description, sender.String())
+
+	_, err = suite.keeper.RequestWithdrawal(suite.ctx, other, "ethereum-1", "USDT", evmAddress("known"), math.NewInt(1_000_000))
+	suite.Require().ErrorIs(err, types.ErrCircuitBreakerActive)
+}
+
+// TestDetectLargeDeposits tests flagging deposits above a percentile of recent history
+func (suite *KeeperTestSuite) TestDetectLargeDeposits() {
+	suite.addUnkeyedChain("ethereum-1", "evm", "0x1234567890abcdef")
+	validator := sdk.AccAddress("validator1")
+	suite.addEligibleValidators([]sdk.AccAddress{validator})
+
+	observe := func(txHash string, amount int64) uint64 {
+		depositID, err := suite.keeper.ObserveDeposit(suite.ctx, validator, "ethereum-1", "USDT", txHash, 100, "0xsender", "cosmos1recipient", math.NewInt(amount))
+		suite.Require().NoError(err)
+		return depositID
+	}
+
+	// New highs are not flagged until there is enough history to judge by
+	for i := 0; i < 12; i++ {
+		suite.nextBlock(time.Minute)
+		observe(fmt.Sprintf("0xhistory%d", i), 1_000_000+int64(i%3)*10_000)
+		suite.keeper.DetectAnomalies(suite.ctx)
+	}
+	suite.Require().Empty(suite.keeper.GetAllAnomalies(suite.ctx))
+
+	// Above the percentile but in line with the largest recent deposit
+	suite.nextBlock(time.Minute)
+	mediumID := observe("0xmedium", 1_500_000)
+	suite.keeper.DetectAnomalies(suite.ctx)
+
+	anomalies := suite.keeper.GetAllAnomalies(suite.ctx)
+	suite.Require().Len(anomalies, 1)
+	suite.Require().Equal(types.AnomalyTypeLargeDeposit, anomalies[0].DetectionType)
+	suite.Require().Equal(types.AnomalySeverityMedium, anomalies[0].Severity)
+	suite.Require().Equal([]string{fmt.Sprintf("%d", mediumID)}, anomalies[0].RelatedTxs)
+	suite.Require().Empty(suite.keeper.GetAllScopedCircuitBreakers(suite.ctx))
+
+	// More than twice the largest recent deposit pauses the asset's deposits and attestations
+	suite.nextBlock(time.Minute)
+	observe("0xhuge", 5_000_000)
+	suite.keeper.DetectAnomalies(suite.ctx)
+
+	anomalies = suite.keeper.GetAllAnomalies(suite.ctx)
+	suite.Require().Len(anomalies, 2)
+	suite.Require().Equal(types.AnomalySeverityHigh, anomalies[1].Severity)
+
+	_, err := suite.keeper.ObserveDeposit(suite.ctx, validator, "ethereum-1", "USDT", "0xafter", 100, "0xsender", "cosmos1recipient", math.NewInt(1_000_000))
+	suite.Require().ErrorIs(err, types.ErrCircuitBreakerActive)
+	_, _, err = suite.keeper.AttestDeposit(suite.ctx, validator, mediumID, true, "0xmedium", math.NewInt(1_500_000))
+	suite.Require().ErrorIs(err, types.ErrCircuitBreakerActive)
+	suite.Require().NoError(suite.keeper.IsOperationAllowedFor(suite.ctx, "withdraw", "ethereum-1", "USDT"))
+}
+
+// TestDetectSupplyMismatch tests flagging bridged HODL not backed by attested reserves
+func (suite *KeeperTestSuite) TestDetectSupplyMismatch() {
+	suite.addUnkeyedChain("ethereum-1", "evm", "0x1234567890abcdef")
+	validator := sdk.AccAddress("validator1")
+	suite.addEligibleValidators([]sdk.AccAddress{validator})
+
+	recipient := sdk.AccAddress("recipient1")
+	depositID, err := suite.keeper.ObserveDeposit(suite.ctx, validator, "ethereum-1", "USDT", "0xdeposit", 100, "0xsender", recipient.String(), math.NewInt(5_000_000))
+	suite.Require().NoError(err)
+	_, completed, err := suite.keeper.AttestDeposit(suite.ctx, validator, depositID, true, "0xdeposit", math.NewInt(5_000_000))
+	suite.Require().NoError(err)
+	suite.Require().True(completed)
+
+	supply := suite.keeper.GetBridgedSupply(suite.ctx, "ethereum-1", "USDT")
+	suite.Require().Equal(math.NewInt(5_000_000), supply.Minted)
+	suite.Require().Equal(math.NewInt(5_000_000), supply.Deposited)
+	suite.Require().True(supply.Burned.IsZero())
+
+	// Without an attested snapshot there is nothing to compare
+	suite.keeper.DetectAnomalies(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetAllAnomalies(suite.ctx))
+
+	// Full reserves are backed, and repricing the asset afterwards does not
+	// change what was attested
+	suite.attestReserves([]sdk.AccAddress{validator}, "ethereum-1", "USDT", 200, 5_000_000)
+	asset, _ := suite.keeper.GetExternalAsset(suite.ctx, "ethereum-1", "USDT")
+	asset.ConversionRate = math.LegacyNewDecWithPrec(5, 1)
+	suite.keeper.SetExternalAsset(suite.ctx, asset)
+
+	suite.nextBlock(time.Minute)
+	suite.keeper.DetectAnomalies(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetAllAnomalies(suite.ctx))
+
+	// Attested reserves short of the outstanding HODL are critical
+	asset.ConversionRate = math.LegacyOneDec()
+	suite.keeper.SetExternalAsset(suite.ctx, asset)
+	suite.nextBlock(suite.keeper.GetParams(suite.ctx).ReserveAttestationIntervalDuration())
+	suite.attestReserves([]sdk.AccAddress{validator}, "ethereum-1", "USDT", 300, 4_000_000)
+	suite.keeper.DetectAnomalies(suite.ctx)
+
+	anomalies := suite.keeper.GetAllAnomalies(suite.ctx)
+	suite.Require().Len(anomalies, 1)
+	suite.Require().Equal(types.AnomalyTypeSupplyMismatch, anomalies[0].DetectionType)
+	suite.Require().Equal(types.AnomalySeverityCritical, anomalies[0].Severity)
+
+	// A critical finding pauses everything on the chain
+	cb, found := suite.keeper.GetScopedCircuitBreaker(suite.ctx, "ethereum-1", "")
+	suite.Require().True(found)
+	suite.Require().False(cb.CanDeposit)
+	suite.Require().False(cb.CanWithdraw)
+	suite.Require().False(cb.CanAttest)
+	suite.Require().Equal("anomaly_detection", cb.TriggeredBy)
+	for _, operation := range []string{"deposit", "withdraw", "attest"} {
+		suite.Require().ErrorIs(suite.keeper.IsOperationAllowedFor(suite.ctx, operation, "ethereum-1", "USDC"), types.ErrCircuitBreakerActive)
+	}
+}
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"encoding/json"
+	"sort"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/types"
+)
+
+// =========================================================================
+// EVM account nonces
+// =========================================================================
+
+// GetExternalNonceState retrieves the account nonces a chain's TSS address has handed out
+func (k Keeper) GetExternalNonceState(ctx sdk.Context, chainID string) types.ExternalNonceState {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ExternalNonceKey(chainID))
+	if bz == nil {
+		return types.ExternalNonceState{ChainID: chainID}
+	}
+
+	var state types.ExternalNonceState
+	if err := json.Unmarshal(bz, &state); err != nil {
+		return types.ExternalNonceState{ChainID: chainID}
+	}
+	return state
+}
+
+// SetExternalNonceState stores a chain's outbound account nonces
+func (k Keeper) SetExternalNonceState(ctx sdk.Context, state types.ExternalNonceState) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(state)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal external nonce state", "error", err)
+		return
+	}
+	store.Set(types.ExternalNonceKey(state.ChainID), bz)
+}
+
+// GetAllExternalNonceStates returns every chain's outbound account nonces
+func (k Keeper) GetAllExternalNonceStates(ctx sdk.Context) []types.ExternalNonceState {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ExternalNoncePrefix)
+	defer iterator.Close()
+
+	states := []types.ExternalNonceState{}
+	for ; iterator.Valid(); iterator.Next() {
+		var state types.ExternalNonceState
+		if err := json.Unmarshal(iterator.Value(), &state); err != nil {
+			continue
+		}
+		states = append(states, state)
+	}
+	return states
+}
+
+// allocateExternalNonce hands out the lowest account nonce no signed
+// transaction uses
+func (k Keeper) allocateExternalNonce(ctx sdk.Context, chainID string) uint64 {
+	state := k.GetExternalNonceState(ctx, chainID)
+	var nonce uint64
+	if len(state.Released) > 0 {
+		nonce, state.Released = state.Released[0], state.Released[1:]
+	} else {
+		nonce = state.Next
+		state.Next++
+	}
+	k.SetExternalNonceState(ctx, state)
+	return nonce
+}
+
+// releaseExternalNonce returns the nonce of a transaction that will never be
+// signed, so the next transfer reuses it
+func (k Keeper) releaseExternalNonce(ctx sdk.Context, chainID string, nonce uint64) {
+	state := k.GetExternalNonceState(ctx, chainID)
+	state.Released = append(state.Released, nonce)
+	sort.Slice(state.Released, func(i, j int) bool { return state.Released[i] < state.Released[j] })
+	k.SetExternalNonceState(ctx, state)
+}
+
+// =========================================================================
+// UTXO custody outputs
+// =========================================================================
+
+// SetCustodyOutput records an unspent output held at a UTXO TSS address
+func (k Keeper) SetCustodyOutput(ctx sdk.Context, output types.CustodyOutput) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(output)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal custody output", "error", err)
+		return
+	}
+	store.Set(types.CustodyOutputKey(output.ChainID, output.Outpoint()), bz)
+}
+
+// DeleteCustodyOutput removes a spent custody output
+func (k Keeper) DeleteCustodyOutput(ctx sdk.Context, output types.CustodyOutput) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CustodyOutputKey(output.ChainID, output.Outpoint()))
+}
+
+// GetCustodyOutputs returns a chain's unspent custody outputs
+func (k Keeper) GetCustodyOutputs(ctx sdk.Context, chainID string) []types.CustodyOutput {
+	return k.custodyOutputs(ctx, types.CustodyChainPrefix(chainID))
+}
+
+// GetAllCustodyOutputs returns every chain's unspent custody outputs
+func (k Keeper) GetAllCustodyOutputs(ctx sdk.Context) []types.CustodyOutput {
+	return k.custodyOutputs(ctx, types.CustodyOutputPrefix)
+}
+
+// custodyOutputs returns the custody outputs stored under a prefix
+func (k Keeper) custodyOutputs(ctx sdk.Context, prefix []byte) []types.CustodyOutput {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	outputs := []types.CustodyOutput{}
+	for ; iterator.Valid(); iterator.Next() {
+		var output types.CustodyOutput
+		if err := json.Unmarshal(iterator.Value(), &output); err != nil {
+			continue
+		}
+		outputs = append(outputs, output)
+	}
+	return outputs
+}
+
+// recordDepositOutput adds a completed deposit's output to a UTXO chain's
+// custody set
+func (k Keeper) recordDepositOutput(ctx sdk.Context, deposit types.Deposit) {
+	chain, found := k.GetExternalChain(ctx, deposit.ChainID)
+	if !found || chain.ChainType != "utxo" {
+		return
+	}
+	txID, vout, err := types.ParseOutpoint(deposit.ExternalTxHash)
+	if err != nil {
+		k.Logger(ctx).Error("UTXO deposit has no outpoint", "deposit_id", deposit.ID, "error", err)
+		return
+	}
+	k.SetCustodyOutput(ctx, types.CustodyOutput{
+		ChainID:     deposit.ChainID,
+		AssetSymbol: deposit.AssetSymbol,
+		TxID:        txID,
+		Vout:        vout,
+		Amount:      deposit.Amount,
+		Address:     chain.TSSAddress,
+	})
+}
+
+// selectCustodyOutput picks the smallest output of an asset at the TSS
+// address that covers an amount on its own
+func (k Keeper) selectCustodyOutput(ctx sdk.Context, chain types.ExternalChain, assetSymbol string, amount math.Int) (types.CustodyOutput, bool) {
+	var selected types.CustodyOutput
+	found := false
+	for _, output := range k.GetCustodyOutputs(ctx, chain.ChainID) {
+		if output.Address != chain.TSSAddress || output.AssetSymbol != assetSymbol || output.Amount.LT(amount) {
+			continue
+		}
+		if !found || output.Amount.LT(selected.Amount) {
+			selected, found = output, true
+		}
+	}
+	return selected, found
+}
+
+// =========================================================================
+// Withdrawal transactions
+// =========================================================================
+
+// buildWithdrawalTx builds the unsigned transfer paying a withdrawal out of
+// custody, reserving the account nonce or custody output it spends
+func (k Keeper) buildWithdrawalTx(ctx sdk.Context, withdrawal types.Withdrawal) (types.ExternalTx, error) {
+	chain, found := k.GetExternalChain(ctx, withdrawal.ChainID)
+	if !found {
+		return types.ExternalTx{}, types.ErrChainNotSupported
+	}
+	asset, found := k.GetExternalAsset(ctx, withdrawal.ChainID, withdrawal.AssetSymbol)
+	if !found {
+		return types.ExternalTx{}, types.ErrAssetNotSupported
+	}
+
+	switch chain.ChainType {
+	case "evm":
+		nonce := k.allocateExternalNonce(ctx, chain.ChainID)
+		tx, err := types.BuildEVMTransfer(chain, asset, nonce, withdrawal.Recipient, withdrawal.ExternalAmount)
+		if err != nil {
+			k.releaseExternalNonce(ctx, chain.ChainID, nonce)
+			return types.ExternalTx{}, types.ErrExternalTxBuild.Wrap(err.Error())
+		}
+		return tx, nil
+	case "utxo":
+		input, found := k.selectCustodyOutput(ctx, chain, withdrawal.AssetSymbol, withdrawal.ExternalAmount)
+		if !found {
+			return types.ExternalTx{}, types.ErrInsufficientCustody.Wrapf("no single custody output covers %s", withdrawal.ExternalAmount)
+		}
+		tx, err := types.BuildUTXOTransfer(chain, input, withdrawal.Recipient, withdrawal.ExternalAmount)
+		if err != nil {
+			return types.ExternalTx{}, types.ErrExternalTxBuild.Wrap(err.Error())
+		}
+		k.DeleteCustodyOutput(ctx, input)
+		return tx, nil
+	default:
+		return types.ExternalTx{}, types.ErrChainNotSupported.Wrapf("no transaction builder for %s chains", chain.ChainType)
+	}
+}
+
+// releaseExternalTx returns what an unsigned transaction reserved, once it
+// will never be signed
+func (k Keeper) releaseExternalTx(ctx sdk.Context, chainID string, tx types.ExternalTx) {
+	if tx.Nonce != nil {
+		k.releaseExternalNonce(ctx, chainID, *tx.Nonce)
+	}
+	for _, input := range tx.Inputs {
+		k.SetCustodyOutput(ctx, input)
+	}
+}
+
+// settleExternalTx records the custody change of a transaction once it is signed
+func (k Keeper) settleExternalTx(ctx sdk.Context, tx types.ExternalTx) {
+	if tx.Change != nil {
+		k.SetCustodyOutput(ctx, *tx.Change)
+	}
+}
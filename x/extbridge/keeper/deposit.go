@@ -91,8 +91,8 @@ func (k Keeper) ObserveDeposit(
 	recipient string,
 	amount math.Int,
 ) (uint64, error) {
-	// Check circuit breaker
-	if err := k.IsOperationAllowed(ctx, "deposit"); err != nil {
+	// Check circuit breakers
+	if err := k.IsOperationAllowedFor(ctx, "deposit", chainID, assetSymbol); err != nil {
 		return 0, err
 	}
 
@@ -130,6 +130,13 @@ func (k Keeper) ObserveDeposit(
 		return 0, types.ErrDuplicateDeposit
 	}
 
+	// A UTXO deposit is the output it created, so it can be spent later
+	if chain.ChainType == "utxo" {
+		if _, _, err := types.ParseOutpoint(externalTxHash); err != nil {
+			return 0, types.ErrInvalidTxHash.Wrap(err.Error())
+		}
+	}
+
 	// Verify amount is within limits
 	if amount.LT(chain.MinDeposit) {
 		return 0, types.ErrAmountTooSmall
@@ -239,6 +246,11 @@ func (k Keeper) AttestDeposit(
 		return 0, false, fmt.Errorf("deposit cannot be attested")
 	}
 
+	// Check the deposit's chain and asset circuit breakers
+	if err := k.IsOperationAllowedFor(ctx, "attest", deposit.ChainID, deposit.AssetSymbol); err != nil {
+		return 0, false, err
+	}
+
 	// Check if validator already attested
 	if k.HasAttestation(ctx, depositID, validator) {
 		return 0, false, types.ErrAlreadyAttested
@@ -282,7 +294,18 @@ func (k Keeper) AttestDeposit(
 
 	// Check if we have enough attestations
 	completed := false
-	if deposit.HasEnoughAttestations() {
+	if deposit.HasEnoughAttestations() && k.IsMintingHalted(ctx, deposit.ChainID, deposit.AssetSymbol) {
+		// Hold the deposit until attested reserves cover the bridged supply again
+		k.Logger(ctx).Info("deposit held while minting is halted", "deposit_id", depositID)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"extbridge_deposit_held",
+				sdk.NewAttribute("deposit_id", fmt.Sprintf("%d", depositID)),
+				sdk.NewAttribute("chain_id", deposit.ChainID),
+				sdk.NewAttribute("asset", deposit.AssetSymbol),
+			),
+		)
+	} else if deposit.HasEnoughAttestations() {
 		if err := k.CompleteDeposit(ctx, depositID); err != nil {
 			k.Logger(ctx).Error("failed to complete deposit", "deposit_id", depositID, "error", err)
 			return deposit.Attestations, false, err
@@ -317,6 +340,11 @@ func (k Keeper) CompleteDeposit(ctx sdk.Context, depositID uint64) error {
 		return types.ErrDepositCompleted
 	}
 
+	// Never mint against reserves that do not cover the bridged supply
+	if k.IsMintingHalted(ctx, deposit.ChainID, deposit.AssetSymbol) {
+		return types.ErrMintingHalted.Wrapf("%s/%s", deposit.ChainID, deposit.AssetSymbol)
+	}
+
 	// Check if recipient is banned - funds should not be minted to banned addresses
 	if banned, reason := k.IsAddressBanned(ctx, deposit.Recipient); banned {
 		k.Logger(ctx).Warn("attempted deposit to banned address",
@@ -329,6 +357,7 @@ func (k Keeper) CompleteDeposit(ctx sdk.Context, depositID uint64) error {
 		if err := k.SetDeposit(ctx, deposit); err != nil {
 			return err
 		}
+		k.recordDepositOutput(ctx, deposit)
 		return types.ErrAddressBanned.Wrapf("recipient is banned: %s", reason)
 	}
 
@@ -361,6 +390,9 @@ func (k Keeper) CompleteDeposit(ctx sdk.Context, depositID uint64) error {
 		return err
 	}
 
+	k.recordBridgedMint(ctx, deposit.ChainID, deposit.AssetSymbol, deposit.HODLAmount, deposit.Amount)
+	k.recordDepositOutput(ctx, deposit)
+
 	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -264,6 +264,48 @@ func (k Keeper) GetNextTSSSessionID(ctx sdk.Context) uint64 {
 	return id
 }
 
+// GetNextTSSKeyGenerationID returns and increments the next key generation ceremony ID
+func (k Keeper) GetNextTSSKeyGenerationID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextTSSKeyGenerationIDKey)
+	if bz == nil {
+		// First time: return 1 and store 2 for next call
+		nextBz := make([]byte, 8)
+		binary.BigEndian.PutUint64(nextBz, 2)
+		store.Set(types.NextTSSKeyGenerationIDKey, nextBz)
+		return 1
+	}
+
+	id := binary.BigEndian.Uint64(bz)
+	nextID := id + 1
+	nextBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(nextBz, nextID)
+	store.Set(types.NextTSSKeyGenerationIDKey, nextBz)
+
+	return id
+}
+
+// GetNextAnomalyID returns and increments the next anomaly ID
+func (k Keeper) GetNextAnomalyID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextAnomalyIDKey)
+	if bz == nil {
+		// First time: return 1 and store 2 for next call
+		nextBz := make([]byte, 8)
+		binary.BigEndian.PutUint64(nextBz, 2)
+		store.Set(types.NextAnomalyIDKey, nextBz)
+		return 1
+	}
+
+	id := binary.BigEndian.Uint64(bz)
+	nextID := id + 1
+	nextBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(nextBz, nextID)
+	store.Set(types.NextAnomalyIDKey, nextBz)
+
+	return id
+}
+
 // =========================================================================
 // Validator Tier Checks
 // =========================================================================
@@ -371,6 +413,82 @@ func (k Keeper) IsOperationAllowed(ctx sdk.Context, operation string) error {
 	return nil
 }
 
+// IsOperationAllowedFor checks an operation on an asset against the
+// module-wide circuit breaker and the breakers of its chain and the asset
+func (k Keeper) IsOperationAllowedFor(ctx sdk.Context, operation string, chainID string, assetSymbol string) error {
+	if err := k.IsOperationAllowed(ctx, operation); err != nil {
+		return err
+	}
+
+	for _, scope := range []string{"", assetSymbol} {
+		cb, found := k.GetScopedCircuitBreaker(ctx, chainID, scope)
+		if !found {
+			continue
+		}
+
+		if cb.IsExpired(ctx.BlockTime()) {
+			k.DeleteScopedCircuitBreaker(ctx, chainID, scope)
+			continue
+		}
+
+		if !cb.IsOperationAllowed(operation) {
+			return types.ErrCircuitBreakerActive.Wrapf("%s paused for %s/%s: %s", operation, chainID, scope, cb.Reason)
+		}
+	}
+
+	return nil
+}
+
+// GetScopedCircuitBreaker retrieves a chain's circuit breaker, or an asset's
+// when assetSymbol is set
+func (k Keeper) GetScopedCircuitBreaker(ctx sdk.Context, chainID string, assetSymbol string) (types.CircuitBreaker, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ScopedCircuitBreakerKey(chainID, assetSymbol))
+	if bz == nil {
+		return types.CircuitBreaker{}, false
+	}
+
+	var cb types.CircuitBreaker
+	if err := json.Unmarshal(bz, &cb); err != nil {
+		return types.CircuitBreaker{}, false
+	}
+	return cb, true
+}
+
+// SetScopedCircuitBreaker stores a chain or asset circuit breaker
+func (k Keeper) SetScopedCircuitBreaker(ctx sdk.Context, cb types.CircuitBreaker) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(cb)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal circuit breaker", "error", err)
+		return
+	}
+	store.Set(types.ScopedCircuitBreakerKey(cb.ChainID, cb.AssetSymbol), bz)
+}
+
+// DeleteScopedCircuitBreaker lifts a chain or asset circuit breaker
+func (k Keeper) DeleteScopedCircuitBreaker(ctx sdk.Context, chainID string, assetSymbol string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ScopedCircuitBreakerKey(chainID, assetSymbol))
+}
+
+// GetAllScopedCircuitBreakers returns every chain and asset circuit breaker
+func (k Keeper) GetAllScopedCircuitBreakers(ctx sdk.Context) []types.CircuitBreaker {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ScopedCircuitBreakerPrefix)
+	defer iterator.Close()
+
+	breakers := []types.CircuitBreaker{}
+	for ; iterator.Valid(); iterator.Next() {
+		var cb types.CircuitBreaker
+		if err := json.Unmarshal(iterator.Value(), &cb); err != nil {
+			continue
+		}
+		breakers = append(breakers, cb)
+	}
+	return breakers
+}
+
 // =========================================================================
 // Asset Conversion
 // =========================================================================
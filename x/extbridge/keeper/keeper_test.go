@@ -106,6 +106,7 @@ type MockStakingKeeper struct {
 	validators     map[string]bool
 	validatorTiers map[string]int32
 	totalVals      uint64
+	slashed        map[string]math.LegacyDec
 }
 
 func NewMockStakingKeeper() *MockStakingKeeper {
@@ -113,6 +114,7 @@ func NewMockStakingKeeper() *MockStakingKeeper {
 		validators:     make(map[string]bool),
 		validatorTiers: make(map[string]int32),
 		totalVals:      0,
+		slashed:        make(map[string]math.LegacyDec),
 	}
 }
 
@@ -148,6 +150,11 @@ func (m *MockStakingKeeper) GetValidatorsByTier(ctx sdk.Context, minTier int32)
 	return validators, nil
 }
 
+func (m *MockStakingKeeper) Slash(ctx sdk.Context, staker sdk.AccAddress, reason string, slashFraction math.LegacyDec) error {
+	m.slashed[staker.String()] = slashFraction
+	return nil
+}
+
 // MockEscrowKeeper is a mock implementation of EscrowKeeper for ban checking
 type MockEscrowKeeper struct {
 	bannedAddresses map[string]string // address -> ban reason
@@ -677,7 +684,7 @@ func (suite *KeeperTestSuite) TestBannedAddressCannotWithdraw() {
 		sender,
 		"ethereum-1",
 		"USDT",
-		"0xRecipient...",
+		testRecipient,
 		math.NewInt(1_000_000),
 	)
 	suite.Require().Error(err)
@@ -695,7 +702,7 @@ func (suite *KeeperTestSuite) TestBannedAddressCannotWithdraw() {
 		sender,
 		"ethereum-1",
 		"USDT",
-		"0xRecipient...",
+		testRecipient,
 		math.NewInt(1_000_000),
 	)
 	// If it errors, it should NOT be because of a ban
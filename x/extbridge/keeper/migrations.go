@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator runs the extbridge module's store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 indexes open TSS sessions by deadline and withdrawal recipients
+// by when they were first paid, which the end blocker reads instead of
+// scanning every session and withdrawal
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	for _, session := range m.keeper.GetAllTSSSessions(ctx) {
+		if err := m.keeper.SetTSSSession(ctx, session); err != nil {
+			return err
+		}
+	}
+	for _, withdrawal := range m.keeper.GetAllWithdrawals(ctx) {
+		if err := m.keeper.SetWithdrawal(ctx, withdrawal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -123,6 +123,141 @@ func (ms msgServer) SubmitTSSSignature(goCtx context.Context, msg *types.MsgSubm
 	}, nil
 }
 
+// SubmitTSSCommitment handles TSS nonce commitments from validators
+func (ms msgServer) SubmitTSSCommitment(goCtx context.Context, msg *types.MsgSubmitTSSCommitment) (*types.MsgSubmitTSSCommitmentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	ready, err := ms.Keeper.SubmitTSSCommitment(
+		ctx,
+		validator,
+		msg.SessionID,
+		msg.Commitment,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitTSSCommitmentResponse{
+		SessionID:       msg.SessionID,
+		SigningSetReady: ready,
+	}, nil
+}
+
+// RegisterTSSKey handles registering a chain's TSS key (governance only)
+func (ms msgServer) RegisterTSSKey(goCtx context.Context, msg *types.MsgRegisterTSSKey) (*types.MsgRegisterTSSKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Verify authority
+	if msg.Authority != ms.Keeper.GetAuthority() {
+		return nil, types.ErrUnauthorized
+	}
+
+	key := types.TSSKey{
+		ChainID:   msg.ChainID,
+		Scheme:    msg.Scheme,
+		PublicKey: msg.PublicKey,
+		Threshold: msg.Threshold,
+		Shares:    msg.Shares,
+	}
+	if err := ms.Keeper.RegisterTSSKey(ctx, key); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterTSSKeyResponse{}, nil
+}
+
+// StartTSSKeyGeneration handles starting a chain's key generation ceremony (governance only)
+func (ms msgServer) StartTSSKeyGeneration(goCtx context.Context, msg *types.MsgStartTSSKeyGeneration) (*types.MsgStartTSSKeyGenerationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Verify authority
+	if msg.Authority != ms.Keeper.GetAuthority() {
+		return nil, types.ErrUnauthorized
+	}
+
+	keyGenID, err := ms.Keeper.StartTSSKeyGeneration(ctx, msg.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgStartTSSKeyGenerationResponse{KeyGenID: keyGenID}, nil
+}
+
+// SubmitTSSDealing handles key generation dealings from validators
+func (ms msgServer) SubmitTSSDealing(goCtx context.Context, msg *types.MsgSubmitTSSDealing) (*types.MsgSubmitTSSDealingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	dealings, err := ms.Keeper.SubmitTSSDealing(ctx, validator, msg.KeyGenID, msg.Commitments, msg.Proof)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitTSSDealingResponse{Dealings: dealings}, nil
+}
+
+// FileTSSComplaint handles key generation complaints from validators
+func (ms msgServer) FileTSSComplaint(goCtx context.Context, msg *types.MsgFileTSSComplaint) (*types.MsgFileTSSComplaintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.FileTSSComplaint(ctx, validator, msg.KeyGenID, msg.Dealer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFileTSSComplaintResponse{}, nil
+}
+
+// SubmitTSSJustification handles accused dealers revealing disputed shares
+func (ms msgServer) SubmitTSSJustification(goCtx context.Context, msg *types.MsgSubmitTSSJustification) (*types.MsgSubmitTSSJustificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	accepted, err := ms.Keeper.SubmitTSSJustification(ctx, validator, msg.KeyGenID, msg.Accuser, msg.Share)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitTSSJustificationResponse{Accepted: accepted}, nil
+}
+
+// AttestReserves handles proof-of-reserves balance attestations from validators
+func (ms msgServer) AttestReserves(goCtx context.Context, msg *types.MsgAttestReserves) (*types.MsgAttestReservesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	attestations, finalized, err := ms.Keeper.AttestReserves(ctx, validator, msg.ChainID, msg.AssetSymbol, msg.ExternalHeight, msg.Balance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAttestReservesResponse{
+		Attestations: attestations,
+		Finalized:    finalized,
+	}, nil
+}
+
 // UpdateCircuitBreaker handles circuit breaker updates from governance
 func (ms msgServer) UpdateCircuitBreaker(goCtx context.Context, msg *types.MsgUpdateCircuitBreaker) (*types.MsgUpdateCircuitBreakerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -142,19 +277,64 @@ func (ms msgServer) UpdateCircuitBreaker(goCtx context.Context, msg *types.MsgUp
 		nil,
 	)
 
-	ms.Keeper.SetCircuitBreaker(ctx, cb)
+	if msg.ChainID == "" {
+		ms.Keeper.SetCircuitBreaker(ctx, cb)
+	} else {
+		if _, found := ms.Keeper.GetExternalChain(ctx, msg.ChainID); !found {
+			return nil, types.ErrChainNotSupported
+		}
+
+		// Disabling a chain or asset breaker, including one tripped by anomaly detection, lifts it
+		if msg.Enabled {
+			cb.ChainID = msg.ChainID
+			cb.AssetSymbol = msg.AssetSymbol
+			cb.TriggeredAt = ctx.BlockTime()
+			ms.Keeper.SetScopedCircuitBreaker(ctx, cb)
+		} else {
+			ms.Keeper.DeleteScopedCircuitBreaker(ctx, msg.ChainID, msg.AssetSymbol)
+		}
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"extbridge_circuit_breaker_updated",
 			sdk.NewAttribute("enabled", fmt.Sprintf("%t", msg.Enabled)),
 			sdk.NewAttribute("reason", msg.Reason),
+			sdk.NewAttribute("chain_id", msg.ChainID),
+			sdk.NewAttribute("asset", msg.AssetSymbol),
 		),
 	)
 
 	return &types.MsgUpdateCircuitBreakerResponse{}, nil
 }
 
+// SetRateLimit handles withdrawal rate limit updates from governance
+func (ms msgServer) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Verify authority
+	if msg.Authority != ms.Keeper.GetAuthority() {
+		return nil, types.ErrUnauthorized
+	}
+
+	params := ms.Keeper.GetParams(ctx)
+	params.RateLimitWindow = msg.RateLimitWindow
+	params.MaxWithdrawalPerWindow = msg.MaxWithdrawalPerWindow
+	if err := ms.Keeper.SetParams(ctx, params); err != nil {
+		return nil, types.ErrInvalidRateLimit.Wrap(err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_rate_limit_updated",
+			sdk.NewAttribute("rate_limit_window", fmt.Sprintf("%d", msg.RateLimitWindow)),
+			sdk.NewAttribute("max_withdrawal_per_window", msg.MaxWithdrawalPerWindow.String()),
+		),
+	)
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
 // AddExternalChain handles adding new external chains (governance only)
 func (ms msgServer) AddExternalChain(goCtx context.Context, msg *types.MsgAddExternalChain) (*types.MsgAddExternalChainResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -175,6 +355,9 @@ func (ms msgServer) AddExternalChain(goCtx context.Context, msg *types.MsgAddExt
 		msg.MinDeposit,
 		msg.MaxDeposit,
 	)
+	chain.NetworkID = msg.NetworkID
+	chain.FeeRate = msg.FeeRate
+	chain.GasLimit = msg.GasLimit
 
 	if err := chain.Validate(); err != nil {
 		return nil, err
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the QueryServer interface
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return &queryServer{Keeper: keeper}
+}
+
+var _ types.QueryServer = queryServer{}
+
+// Reserves queries an asset's bridged supply and attested reserve history
+func (qs queryServer) Reserves(goCtx context.Context, req *types.QueryReservesRequest) (*types.QueryReservesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := qs.Keeper.GetExternalAsset(ctx, req.ChainID, req.AssetSymbol); !found {
+		return nil, types.ErrAssetNotSupported
+	}
+
+	return &types.QueryReservesResponse{
+		Supply:        qs.Keeper.GetBridgedSupply(ctx, req.ChainID, req.AssetSymbol),
+		History:       qs.Keeper.GetReserveSnapshots(ctx, req.ChainID, req.AssetSymbol, req.Limit),
+		MintingHalted: qs.Keeper.IsMintingHalted(ctx, req.ChainID, req.AssetSymbol),
+	}, nil
+}
@@ -0,0 +1,322 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/types"
+)
+
+// GetReserveRound retrieves an open reserve attestation round
+func (k Keeper) GetReserveRound(ctx sdk.Context, chainID string, assetSymbol string, externalHeight uint64) (types.ReserveRound, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ReserveRoundKey(chainID, assetSymbol, externalHeight))
+	if bz == nil {
+		return types.ReserveRound{}, false
+	}
+
+	var round types.ReserveRound
+	if err := json.Unmarshal(bz, &round); err != nil {
+		return types.ReserveRound{}, false
+	}
+	return round, true
+}
+
+// SetReserveRound stores a reserve attestation round
+func (k Keeper) SetReserveRound(ctx sdk.Context, round types.ReserveRound) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(round)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal reserve round", "error", err)
+		return
+	}
+	store.Set(types.ReserveRoundKey(round.ChainID, round.AssetSymbol, round.ExternalHeight), bz)
+}
+
+// DeleteReserveRound removes a reserve attestation round
+func (k Keeper) DeleteReserveRound(ctx sdk.Context, round types.ReserveRound) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ReserveRoundKey(round.ChainID, round.AssetSymbol, round.ExternalHeight))
+}
+
+// GetAllReserveRounds returns all open reserve attestation rounds
+func (k Keeper) GetAllReserveRounds(ctx sdk.Context) []types.ReserveRound {
+	return k.reserveRounds(ctx, types.ReserveRoundPrefix)
+}
+
+// reserveRounds returns the reserve rounds stored under a prefix
+func (k Keeper) reserveRounds(ctx sdk.Context, prefix []byte) []types.ReserveRound {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	rounds := []types.ReserveRound{}
+	for ; iterator.Valid(); iterator.Next() {
+		var round types.ReserveRound
+		if err := json.Unmarshal(iterator.Value(), &round); err != nil {
+			continue
+		}
+		rounds = append(rounds, round)
+	}
+	return rounds
+}
+
+// SetReserveSnapshot stores an attested reserve snapshot
+func (k Keeper) SetReserveSnapshot(ctx sdk.Context, snapshot types.ReserveSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal reserve snapshot", "error", err)
+		return
+	}
+	store.Set(types.ReserveSnapshotKey(snapshot.ChainID, snapshot.AssetSymbol, snapshot.ExternalHeight), bz)
+}
+
+// GetReserveSnapshots returns an asset's reserve history, newest first,
+// limited to the most recent snapshots when limit is set
+func (k Keeper) GetReserveSnapshots(ctx sdk.Context, chainID string, assetSymbol string, limit uint64) []types.ReserveSnapshot {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.ReserveAssetPrefix(types.ReserveSnapshotPrefix, chainID, assetSymbol))
+	defer iterator.Close()
+
+	snapshots := []types.ReserveSnapshot{}
+	for ; iterator.Valid(); iterator.Next() {
+		if limit > 0 && uint64(len(snapshots)) >= limit {
+			break
+		}
+		var snapshot types.ReserveSnapshot
+		if err := json.Unmarshal(iterator.Value(), &snapshot); err != nil {
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// GetLatestReserveSnapshot returns an asset's most recent reserve snapshot
+func (k Keeper) GetLatestReserveSnapshot(ctx sdk.Context, chainID string, assetSymbol string) (types.ReserveSnapshot, bool) {
+	snapshots := k.GetReserveSnapshots(ctx, chainID, assetSymbol, 1)
+	if len(snapshots) == 0 {
+		return types.ReserveSnapshot{}, false
+	}
+	return snapshots[0], true
+}
+
+// GetAllReserveSnapshots returns every asset's reserve history
+func (k Keeper) GetAllReserveSnapshots(ctx sdk.Context) []types.ReserveSnapshot {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ReserveSnapshotPrefix)
+	defer iterator.Close()
+
+	snapshots := []types.ReserveSnapshot{}
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.ReserveSnapshot
+		if err := json.Unmarshal(iterator.Value(), &snapshot); err != nil {
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// IsMintingHalted returns whether an asset's latest attested reserves fall
+// short of its bridged supply
+func (k Keeper) IsMintingHalted(ctx sdk.Context, chainID string, assetSymbol string) bool {
+	snapshot, found := k.GetLatestReserveSnapshot(ctx, chainID, assetSymbol)
+	return found && !snapshot.Backed
+}
+
+// AttestReserves records a validator's report of an asset's TSS address
+// balance at an external block height. Once a quorum of eligible validators
+// reports the same balance, it is reconciled against the bridged supply
+func (k Keeper) AttestReserves(
+	ctx sdk.Context,
+	validator sdk.AccAddress,
+	chainID string,
+	assetSymbol string,
+	externalHeight uint64,
+	balance math.Int,
+) (uint64, bool, error) {
+	// Verify validator eligibility
+	if _, err := k.IsValidatorEligible(ctx, validator); err != nil {
+		return 0, false, err
+	}
+
+	chain, found := k.GetExternalChain(ctx, chainID)
+	if !found {
+		return 0, false, types.ErrChainNotSupported
+	}
+	if _, found := k.GetExternalAsset(ctx, chainID, assetSymbol); !found {
+		return 0, false, types.ErrAssetNotSupported
+	}
+
+	round, found := k.GetReserveRound(ctx, chainID, assetSymbol, externalHeight)
+	if !found {
+		if latest, ok := k.GetLatestReserveSnapshot(ctx, chainID, assetSymbol); ok {
+			if externalHeight <= latest.ExternalHeight {
+				return 0, false, types.ErrInvalidReserveAttestation.Wrapf(
+					"height %d is not after the latest snapshot at %d", externalHeight, latest.ExternalHeight)
+			}
+			nextDue := latest.AttestedAt.Add(k.GetParams(ctx).ReserveAttestationIntervalDuration())
+			if ctx.BlockTime().Before(nextDue) {
+				return 0, false, types.ErrReserveAttestationTooSoon.Wrapf("next snapshot due at %s", nextDue)
+			}
+		}
+		round = types.ReserveRound{
+			ChainID:        chainID,
+			AssetSymbol:    assetSymbol,
+			ExternalHeight: externalHeight,
+			TSSAddress:     k.reserveAddress(ctx, chain),
+			Attestations:   []types.ReserveAttestation{},
+			OpenedAt:       ctx.BlockTime(),
+		}
+	}
+
+	if round.TSSAddress != k.reserveAddress(ctx, chain) {
+		return 0, false, types.ErrInvalidReserveAttestation.Wrap("custody moved to a new TSS address during the round")
+	}
+	if round.HasAttested(validator.String()) {
+		return 0, false, types.ErrInvalidReserveAttestation.Wrap("validator already attested in this round")
+	}
+
+	round.Attestations = append(round.Attestations, types.ReserveAttestation{
+		Validator:   validator.String(),
+		Balance:     balance,
+		SubmittedAt: ctx.BlockTime(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_reserves_attested",
+			sdk.NewAttribute("chain_id", chainID),
+			sdk.NewAttribute("asset", assetSymbol),
+			sdk.NewAttribute("external_height", fmt.Sprintf("%d", externalHeight)),
+			sdk.NewAttribute("validator", validator.String()),
+			sdk.NewAttribute("balance", balance.String()),
+			sdk.NewAttribute("attestations", fmt.Sprintf("%d", len(round.Attestations))),
+		),
+	)
+
+	required, err := k.CalculateRequiredAttestations(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	attesters := round.Attesters(balance)
+	if uint64(len(attesters)) < required {
+		k.SetReserveRound(ctx, round)
+		return uint64(len(round.Attestations)), false, nil
+	}
+
+	k.finalizeReserveRound(ctx, round, balance, attesters)
+	return uint64(len(round.Attestations)), true, nil
+}
+
+// finalizeReserveRound records the quorum's balance as the asset's latest
+// snapshot, halting minting while it does not cover the bridged supply and
+// releasing held deposits once it does again
+func (k Keeper) finalizeReserveRound(ctx sdk.Context, round types.ReserveRound, balance math.Int, attesters []string) {
+	wasHalted := k.IsMintingHalted(ctx, round.ChainID, round.AssetSymbol)
+
+	asset, _ := k.GetExternalAsset(ctx, round.ChainID, round.AssetSymbol)
+	supply := k.GetBridgedSupply(ctx, round.ChainID, round.AssetSymbol).Outstanding()
+	snapshot := types.NewReserveSnapshot(round, balance, asset.ConversionRate, supply, attesters, ctx.BlockTime())
+	k.SetReserveSnapshot(ctx, snapshot)
+
+	// Competing rounds for the asset are superseded by the snapshot
+	for _, open := range k.reserveRounds(ctx, types.ReserveAssetPrefix(types.ReserveRoundPrefix, round.ChainID, round.AssetSymbol)) {
+		k.DeleteReserveRound(ctx, open)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_reserves_finalized",
+			sdk.NewAttribute("chain_id", snapshot.ChainID),
+			sdk.NewAttribute("asset", snapshot.AssetSymbol),
+			sdk.NewAttribute("external_height", fmt.Sprintf("%d", snapshot.ExternalHeight)),
+			sdk.NewAttribute("balance", snapshot.Balance.String()),
+			sdk.NewAttribute("custody_value", snapshot.CustodyValue.String()),
+			sdk.NewAttribute("supply", snapshot.Supply.String()),
+			sdk.NewAttribute("backing_ratio", snapshot.BackingRatio.String()),
+			sdk.NewAttribute("backed", fmt.Sprintf("%t", snapshot.Backed)),
+		),
+	)
+
+	switch {
+	case !snapshot.Backed && !wasHalted:
+		k.Logger(ctx).Error("attested reserves below bridged supply, halting minting",
+			"chain_id", snapshot.ChainID,
+			"asset", snapshot.AssetSymbol,
+			"custody_value", snapshot.CustodyValue.String(),
+			"supply", snapshot.Supply.String(),
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"extbridge_minting_halted",
+				sdk.NewAttribute("chain_id", snapshot.ChainID),
+				sdk.NewAttribute("asset", snapshot.AssetSymbol),
+				sdk.NewAttribute("backing_ratio", snapshot.BackingRatio.String()),
+			),
+		)
+	case snapshot.Backed && wasHalted:
+		k.Logger(ctx).Info("attested reserves cover bridged supply, resuming minting",
+			"chain_id", snapshot.ChainID,
+			"asset", snapshot.AssetSymbol,
+		)
+		released := k.releaseHeldDeposits(ctx, snapshot.ChainID, snapshot.AssetSymbol)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"extbridge_minting_resumed",
+				sdk.NewAttribute("chain_id", snapshot.ChainID),
+				sdk.NewAttribute("asset", snapshot.AssetSymbol),
+				sdk.NewAttribute("released_deposits", fmt.Sprintf("%d", released)),
+			),
+		)
+	}
+}
+
+// releaseHeldDeposits completes an asset's deposits that reached their
+// attestation threshold while minting was halted
+func (k Keeper) releaseHeldDeposits(ctx sdk.Context, chainID string, assetSymbol string) int {
+	released := 0
+	for _, deposit := range k.GetAllDeposits(ctx) {
+		if deposit.ChainID != chainID || deposit.AssetSymbol != assetSymbol {
+			continue
+		}
+		if !deposit.CanAttest() || !deposit.HasEnoughAttestations() {
+			continue
+		}
+		if err := k.CompleteDeposit(ctx, deposit.ID); err != nil {
+			k.Logger(ctx).Error("failed to complete held deposit", "deposit_id", deposit.ID, "error", err)
+			continue
+		}
+		released++
+	}
+	return released
+}
+
+// ExpireReserveRounds drops reserve rounds that failed to reach a quorum
+// within the attestation interval, so validators can attest a fresh height
+func (k Keeper) ExpireReserveRounds(ctx sdk.Context) {
+	timeout := k.GetParams(ctx).ReserveAttestationIntervalDuration()
+	for _, round := range k.GetAllReserveRounds(ctx) {
+		if ctx.BlockTime().Before(round.OpenedAt.Add(timeout)) {
+			continue
+		}
+		k.DeleteReserveRound(ctx, round)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"extbridge_reserve_round_expired",
+				sdk.NewAttribute("chain_id", round.ChainID),
+				sdk.NewAttribute("asset", round.AssetSymbol),
+				sdk.NewAttribute("external_height", fmt.Sprintf("%d", round.ExternalHeight)),
+				sdk.NewAttribute("attestations", fmt.Sprintf("%d", len(round.Attestations))),
+			),
+		)
+	}
+}
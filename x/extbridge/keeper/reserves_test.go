@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/keeper"
+	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/types"
+)
+
+// bridgeDeposit observes a deposit and has the validators attest it
+func (suite *KeeperTestSuite) bridgeDeposit(validators []sdk.AccAddress, txHash string, amount int64) (uint64, bool) {
+	recipient := sdk.AccAddress("recipient1").String()
+	depositID, err := suite.keeper.ObserveDeposit(suite.ctx, validators[0], "ethereum-1", "USDT", txHash, 100, "0xsender", recipient, math.NewInt(amount))
+	suite.Require().NoError(err)
+
+	completed := false
+	for _, val := range validators {
+		_, completed, err = suite.keeper.AttestDeposit(suite.ctx, val, depositID, true, txHash, math.NewInt(amount))
+		suite.Require().NoError(err)
+	}
+	return depositID, completed
+}
+
+// TestAttestReserves tests that a quorum agreeing on a balance records a reserve snapshot
+func (suite *KeeperTestSuite) TestAttestReserves() {
+	suite.addUnkeyedChain("ethereum-1", "evm", "0x1234567890abcdef")
+	validators := testValidators(4)
+	suite.addEligibleValidators(validators)
+
+	_, completed := suite.bridgeDeposit(validators[:3], "0xdeposit", 5_000_000)
+	suite.Require().True(completed)
+
+	attest := func(val sdk.AccAddress, height uint64, balance int64) (uint64, bool, error) {
+		return suite.keeper.AttestReserves(suite.ctx, val, "ethereum-1", "USDT", height, math.NewInt(balance))
+	}
+
+	// Three of four validators must agree on the same balance
+	_, finalized, err := attest(validators[0], 200, 5_000_000)
+	suite.Require().NoError(err)
+	suite.Require().False(finalized)
+	_, finalized, err = attest(validators[1], 200, 4_000_000)
+	suite.Require().NoError(err)
+	suite.Require().False(finalized)
+	_, _, err = attest(validators[0], 200, 5_000_000)
+	suite.Require().ErrorIs(err, types.ErrInvalidReserveAttestation)
+	_, finalized, err = attest(validators[2], 200, 5_000_000)
+	suite.Require().NoError(err)
+	suite.Require().False(finalized)
+
+	attestations, finalized, err := attest(validators[3], 200, 5_000_000)
+	suite.Require().NoError(err)
+	suite.Require().True(finalized)
+	suite.Require().Equal(uint64(4), attestations)
+
+	snapshot, found := suite.keeper.GetLatestReserveSnapshot(suite.ctx, "ethereum-1", "USDT")
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(200), snapshot.ExternalHeight)
+	suite.Require().Equal("0x1234567890abcdef", snapshot.TSSAddress)
+	suite.Require().Equal(math.NewInt(5_000_000), snapshot.Balance)
+	suite.Require().Equal(math.NewInt(5_000_000), snapshot.Supply)
+	suite.Require().Equal(math.LegacyOneDec(), snapshot.BackingRatio)
+	suite.Require().True(snapshot.Backed)
+	suite.Require().ElementsMatch([]string{validators[0].String(), validators[2].String(), validators[3].String()}, snapshot.Attesters)
+	suite.Require().Empty(suite.keeper.GetAllReserveRounds(suite.ctx))
+	suite.Require().False(suite.keeper.IsMintingHalted(suite.ctx, "ethereum-1", "USDT"))
+
+	// Later snapshots must move forward in height and wait out the interval
+	suite.nextBlock(time.Minute)
+	_, _, err = attest(validators[0], 150, 5_000_000)
+	suite.Require().ErrorIs(err, types.ErrInvalidReserveAttestation)
+	_, _, err = attest(validators[0], 300, 5_000_000)
+	suite.Require().ErrorIs(err, types.ErrReserveAttestationTooSoon)
+
+	suite.nextBlock(time.Hour)
+	_, _, err = attest(validators[0], 300, 5_000_000)
+	suite.Require().NoError(err)
+
+	// Non-validators cannot attest
+	_, _, err = attest(sdk.AccAddress("outsider"), 300, 5_000_000)
+	suite.Require().ErrorIs(err, types.ErrNotValidator)
+}
+
+// TestReserveShortfallHaltsMinting tests that deposits are held while attested
+// reserves fall short of the bridged supply and released once they recover
+func (suite *KeeperTestSuite) TestReserveShortfallHaltsMinting() {
+	suite.addUnkeyedChain("ethereum-1", "evm", "0x1234567890abcdef")
+	validator := sdk.AccAddress("validator1")
+	validators := []sdk.AccAddress{validator}
+	suite.addEligibleValidators(validators)
+
+	_, completed := suite.bridgeDeposit(validators, "0xdeposit", 5_000_000)
+	suite.Require().True(completed)
+
+	_, finalized, err := suite.keeper.AttestReserves(suite.ctx, validator, "ethereum-1", "USDT", 200, math.NewInt(4_000_000))
+	suite.Require().NoError(err)
+	suite.Require().True(finalized)
+
+	snapshot, _ := suite.keeper.GetLatestReserveSnapshot(suite.ctx, "ethereum-1", "USDT")
+	suite.Require().False(snapshot.Backed)
+	suite.Require().Equal(math.LegacyNewDecWithPrec(8, 1), snapshot.BackingRatio)
+	suite.Require().True(suite.keeper.IsMintingHalted(suite.ctx, "ethereum-1", "USDT"))
+
+	// A fully attested deposit is held rather than minted
+	heldID, completed := suite.bridgeDeposit(validators, "0xheld", 1_000_000)
+	suite.Require().False(completed)
+	deposit, _ := suite.keeper.GetDeposit(suite.ctx, heldID)
+	suite.Require().Equal(types.DepositStatusAttesting, deposit.Status)
+	suite.Require().ErrorIs(suite.keeper.CompleteDeposit(suite.ctx, heldID), types.ErrMintingHalted)
+	suite.Require().Equal(math.NewInt(5_000_000), suite.keeper.GetBridgedSupply(suite.ctx, "ethereum-1", "USDT").Minted)
+
+	// Other assets keep minting
+	suite.Require().False(suite.keeper.IsMintingHalted(suite.ctx, "ethereum-1", "USDC"))
+
+	// Reserves covering the supply release the held deposit
+	suite.nextBlock(time.Hour)
+	_, finalized, err = suite.keeper.AttestReserves(suite.ctx, validator, "ethereum-1", "USDT", 300, math.NewInt(6_000_000))
+	suite.Require().NoError(err)
+	suite.Require().True(finalized)
+	suite.Require().False(suite.keeper.IsMintingHalted(suite.ctx, "ethereum-1", "USDT"))
+
+	deposit, _ = suite.keeper.GetDeposit(suite.ctx, heldID)
+	suite.Require().True(deposit.IsCompleted())
+	suite.Require().Equal(math.NewInt(6_000_000), suite.keeper.GetBridgedSupply(suite.ctx, "ethereum-1", "USDT").Minted)
+
+	// The reserves query reports the ratio history newest first
+	queryServer := keeper.NewQueryServerImpl(*suite.keeper)
+	res, err := queryServer.Reserves(suite.ctx, &types.QueryReservesRequest{ChainID: "ethereum-1", AssetSymbol: "USDT"})
+	suite.Require().NoError(err)
+	suite.Require().False(res.MintingHalted)
+	suite.Require().Equal(math.NewInt(6_000_000), res.Supply.Outstanding())
+	suite.Require().Len(res.History, 2)
+	suite.Require().Equal(uint64(300), res.History[0].ExternalHeight)
+	suite.Require().Equal(math.LegacyNewDecWithPrec(12, 1), res.History[0].BackingRatio)
+	suite.Require().Equal(uint64(200), res.History[1].ExternalHeight)
+
+	res, err = queryServer.Reserves(suite.ctx, &types.QueryReservesRequest{ChainID: "ethereum-1", AssetSymbol: "USDT", Limit: 1})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.History, 1)
+
+	_, err = queryServer.Reserves(suite.ctx, &types.QueryReservesRequest{ChainID: "ethereum-1", AssetSymbol: "DAI"})
+	suite.Require().ErrorIs(err, types.ErrAssetNotSupported)
+}
+
+// TestExpireReserveRounds tests that rounds without a quorum are dropped after the interval
+func (suite *KeeperTestSuite) TestExpireReserveRounds() {
+	suite.addUnkeyedChain("ethereum-1", "evm", "0x1234567890abcdef")
+	validators := testValidators(4)
+	suite.addEligibleValidators(validators)
+
+	_, finalized, err := suite.keeper.AttestReserves(suite.ctx, validators[0], "ethereum-1", "USDT", 200, math.ZeroInt())
+	suite.Require().NoError(err)
+	suite.Require().False(finalized)
+
+	suite.nextBlock(30 * time.Minute)
+	suite.keeper.ExpireReserveRounds(suite.ctx)
+	suite.Require().Len(suite.keeper.GetAllReserveRounds(suite.ctx), 1)
+
+	suite.nextBlock(30 * time.Minute)
+	suite.keeper.ExpireReserveRounds(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetAllReserveRounds(suite.ctx))
+	_, found := suite.keeper.GetLatestReserveSnapshot(suite.ctx, "ethereum-1", "USDT")
+	suite.Require().False(found)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/types"
@@ -157,3 +158,62 @@ func (k Keeper) UpdateDepositRateLimit(ctx sdk.Context, chainID string, assetSym
 
 	k.SetRateLimit(ctx, rateLimit)
 }
+
+// GetBridgedSupply retrieves an asset's bridged supply, empty if nothing has been bridged
+func (k Keeper) GetBridgedSupply(ctx sdk.Context, chainID string, assetSymbol string) types.BridgedSupply {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalBridgedKey(chainID, assetSymbol))
+	if bz == nil {
+		return types.NewBridgedSupply(chainID, assetSymbol)
+	}
+
+	var supply types.BridgedSupply
+	if err := json.Unmarshal(bz, &supply); err != nil {
+		return types.NewBridgedSupply(chainID, assetSymbol)
+	}
+	return supply
+}
+
+// SetBridgedSupply stores an asset's bridged supply
+func (k Keeper) SetBridgedSupply(ctx sdk.Context, supply types.BridgedSupply) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(supply)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal bridged supply", "error", err)
+		return
+	}
+	store.Set(types.TotalBridgedKey(supply.ChainID, supply.AssetSymbol), bz)
+}
+
+// GetAllBridgedSupplies returns the bridged supply of every asset
+func (k Keeper) GetAllBridgedSupplies(ctx sdk.Context) []types.BridgedSupply {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.TotalBridgedPrefix)
+	defer iterator.Close()
+
+	supplies := []types.BridgedSupply{}
+	for ; iterator.Valid(); iterator.Next() {
+		var supply types.BridgedSupply
+		if err := json.Unmarshal(iterator.Value(), &supply); err != nil {
+			continue
+		}
+		supplies = append(supplies, supply)
+	}
+	return supplies
+}
+
+// recordBridgedMint adds a completed deposit to its asset's bridged supply
+func (k Keeper) recordBridgedMint(ctx sdk.Context, chainID string, assetSymbol string, hodlAmount math.Int, externalAmount math.Int) {
+	supply := k.GetBridgedSupply(ctx, chainID, assetSymbol)
+	supply.Minted = supply.Minted.Add(hodlAmount)
+	supply.Deposited = supply.Deposited.Add(externalAmount)
+	k.SetBridgedSupply(ctx, supply)
+}
+
+// recordBridgedBurn adds a signed withdrawal to its asset's bridged supply
+func (k Keeper) recordBridgedBurn(ctx sdk.Context, chainID string, assetSymbol string, hodlAmount math.Int, externalAmount math.Int) {
+	supply := k.GetBridgedSupply(ctx, chainID, assetSymbol)
+	supply.Burned = supply.Burned.Add(hodlAmount)
+	supply.Withdrawn = supply.Withdrawn.Add(externalAmount)
+	k.SetBridgedSupply(ctx, supply)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -35,6 +36,13 @@ func (k Keeper) SetTSSSession(ctx sdk.Context, session types.TSSSession) error {
 		k.Logger(ctx).Error("failed to marshal TSS session", "error", err)
 		return fmt.Errorf("failed to marshal TSS session: %w", err)
 	}
+	// Keep the deadline index to the sessions still collecting signatures
+	if previous, found := k.GetTSSSession(ctx, session.ID); found && previous.CanAddSignature() {
+		store.Delete(types.OpenTSSSessionKey(previous.TimeoutAt, previous.ID))
+	}
+	if session.CanAddSignature() {
+		store.Set(types.OpenTSSSessionKey(session.TimeoutAt, session.ID), sdk.Uint64ToBigEndian(session.ID))
+	}
 	store.Set(types.TSSSessionKey(session.ID), bz)
 	return nil
 }
@@ -56,7 +64,34 @@ func (k Keeper) GetAllTSSSessions(ctx sdk.Context) []types.TSSSession {
 	return sessions
 }
 
-// CreateTSSSession creates a new TSS signing session for a withdrawal
+// getOpenTSSSessions returns the sessions still collecting signatures whose
+// deadline passed before now when expired is set, or those still running otherwise
+func (k Keeper) getOpenTSSSessions(ctx sdk.Context, now time.Time, expired bool) []types.TSSSession {
+	start, end := types.OpenTSSSessionPrefix, types.OpenTSSSessionKey(now, 0)
+	if !expired {
+		start, end = end, storetypes.PrefixEndBytes(types.OpenTSSSessionPrefix)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(start, end)
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	sessions := []types.TSSSession{}
+	for _, id := range ids {
+		if session, found := k.GetTSSSession(ctx, id); found {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions
+}
+
+// CreateTSSSession creates a new TSS signing session for a withdrawal. The
+// holders of the chain's TSS key take part; the first to commit up to the
+// key's threshold form the signing set.
 func (k Keeper) CreateTSSSession(ctx sdk.Context, withdrawalID uint64) (uint64, error) {
 	withdrawal, found := k.GetWithdrawal(ctx, withdrawalID)
 	if !found {
@@ -67,44 +102,46 @@ func (k Keeper) CreateTSSSession(ctx sdk.Context, withdrawalID uint64) (uint64,
 		return 0, types.ErrWithdrawalNotReady
 	}
 
-	// Get eligible validators
-	participants, err := k.GetEligibleValidators(ctx)
-	if err != nil {
+	// Paused withdrawals are held back from signing as well as from new requests
+	if err := k.IsOperationAllowedFor(ctx, "withdraw", withdrawal.ChainID, withdrawal.AssetSymbol); err != nil {
 		return 0, err
 	}
 
-	if len(participants) == 0 {
-		return 0, fmt.Errorf("no eligible validators for TSS")
+	key, found := k.GetTSSKey(ctx, withdrawal.ChainID)
+	if !found {
+		return 0, types.ErrTSSKeyNotFound
 	}
 
-	// Calculate required signatures
-	params := k.GetParams(ctx)
-	requiredSigs := uint64(len(participants)) * params.TSSThreshold.MulInt64(100).TruncateInt().Uint64() / 100
-	if requiredSigs == 0 {
-		requiredSigs = 1
+	// Withdrawals wait for a pending migration so the sweep is the old key's last spend
+	if migration, found := k.GetTSSKeyMigration(ctx, withdrawal.ChainID); found && migration.IsPending() {
+		return 0, types.ErrTSSMigrationPending
 	}
 
-	// Create message to sign (simplified - in production this would be the actual tx)
-	message := []byte(fmt.Sprintf("withdrawal:%d:%s:%s:%s",
-		withdrawalID,
-		withdrawal.ChainID,
-		withdrawal.Recipient,
-		withdrawal.ExternalAmount.String(),
-	))
+	participants, err := k.eligibleTSSSigners(ctx, key)
+	if err != nil {
+		return 0, err
+	}
 
-	// Create session
-	sessionID := k.GetNextTSSSessionID(ctx)
-	participantAddrs := make([]string, len(participants))
-	for i, p := range participants {
-		participantAddrs[i] = p.String()
+	// The transfer is built once; a session retrying after a failed one signs
+	// the same transaction, with the same nonce or custody output
+	if withdrawal.Tx == nil {
+		tx, err := k.buildWithdrawalTx(ctx, withdrawal)
+		if err != nil {
+			return 0, err
+		}
+		withdrawal.Tx = &tx
 	}
+	message := withdrawal.Tx.Digest
 
+	// Create session
+	sessionID := k.GetNextTSSSessionID(ctx)
 	session := types.NewTSSSession(
 		sessionID,
 		withdrawalID,
 		withdrawal.ChainID,
-		participantAddrs,
-		requiredSigs,
+		key.Scheme,
+		participants,
+		key.Threshold,
 		1*time.Hour, // 1 hour timeout
 		message,
 		ctx.BlockTime(), // Use block time for determinism
@@ -127,85 +164,193 @@ func (k Keeper) CreateTSSSession(ctx sdk.Context, withdrawalID uint64) (uint64,
 			"extbridge_tss_session_created",
 			sdk.NewAttribute("session_id", fmt.Sprintf("%d", sessionID)),
 			sdk.NewAttribute("withdrawal_id", fmt.Sprintf("%d", withdrawalID)),
+			sdk.NewAttribute("scheme", key.Scheme),
 			sdk.NewAttribute("participants", fmt.Sprintf("%d", len(participants))),
-			sdk.NewAttribute("required_sigs", fmt.Sprintf("%d", requiredSigs)),
+			sdk.NewAttribute("required_sigs", fmt.Sprintf("%d", key.Threshold)),
 		),
 	)
 
 	return sessionID, nil
 }
 
-// SubmitTSSSignature submits a TSS signature share
-func (k Keeper) SubmitTSSSignature(
+// SubmitTSSCommitment submits a validator's nonce commitment for a session.
+// Returns true once the signing set is formed and signature shares can be
+// submitted.
+func (k Keeper) SubmitTSSCommitment(
 	ctx sdk.Context,
 	validator sdk.AccAddress,
 	sessionID uint64,
-	signatureData []byte,
+	commitmentData []byte,
 ) (bool, error) {
 	// Verify validator eligibility
 	if _, err := k.IsValidatorEligible(ctx, validator); err != nil {
 		return false, err
 	}
 
-	// Get session
 	session, found := k.GetTSSSession(ctx, sessionID)
 	if !found {
 		return false, types.ErrTSSSessionNotFound
 	}
+	if err := k.checkTSSSessionOpen(ctx, &session); err != nil {
+		return false, err
+	}
 
-	// Check if session can accept signatures
-	if !session.CanAddSignature() {
-		if session.IsCompleted() {
-			return false, types.ErrTSSSessionCompleted
-		}
-		if session.IsFailed() {
-			return false, types.ErrTSSSessionFailed
-		}
-		return false, fmt.Errorf("session cannot accept signatures")
+	if !session.IsParticipant(validator.String()) {
+		return false, fmt.Errorf("validator not a participant in this session")
+	}
+	if session.HasSigningSet() {
+		return false, types.ErrInvalidTSSSession.Wrap("signing set already formed")
+	}
+	if _, found := session.GetCommitment(validator.String()); found || session.IsFaulty(validator.String()) {
+		return false, fmt.Errorf("validator already submitted commitment")
 	}
 
-	// Check timeout
-	if session.IsTimeout(ctx.BlockTime()) {
-		session.Status = types.TSSSessionStatusTimeout
-		if err := k.SetTSSSession(ctx, session); err != nil {
-			k.Logger(ctx).Error("failed to update TSS session on timeout", "error", err)
-		}
-		return false, types.ErrTSSTimeout
+	key, found := k.GetTSSKey(ctx, session.ChainID)
+	if !found {
+		return false, types.ErrTSSKeyNotFound
+	}
+	share, found := key.GetShare(validator.String())
+	if !found {
+		return false, fmt.Errorf("validator not a participant in this session")
+	}
+	if err := types.ValidateTSSCommitment(session.Scheme, commitmentData); err != nil {
+		return false, types.ErrInvalidTSSCommitment.Wrap(err.Error())
 	}
 
-	// Check if validator is a participant
-	isParticipant := false
-	for _, p := range session.Participants {
-		if p == validator.String() {
-			isParticipant = true
-			break
+	// A presignature that is not bound to the signer's key share is evidence
+	// against it; the signer is slashed and left out of the signing set
+	if err := types.VerifyTSSCommitmentProof(key, share.Index, commitmentData); err != nil {
+		session.FaultyParticipants = append(session.FaultyParticipants, validator.String())
+		k.slashTSSCommitment(ctx, validator, session.ID, err)
+		return false, k.SetTSSSession(ctx, session)
+	}
+
+	session.Commitments = append(session.Commitments, types.TSSCommitment{
+		Validator:   validator.String(),
+		Index:       share.Index,
+		Data:        commitmentData,
+		SubmittedAt: ctx.BlockTime(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_commitment_submitted",
+			sdk.NewAttribute("session_id", fmt.Sprintf("%d", sessionID)),
+			sdk.NewAttribute("validator", validator.String()),
+			sdk.NewAttribute("commitments", fmt.Sprintf("%d", len(session.Commitments))),
+			sdk.NewAttribute("required_sigs", fmt.Sprintf("%d", session.RequiredSigs)),
+		),
+	)
+
+	if !session.HasSigningSet() {
+		return false, k.SetTSSSession(ctx, session)
+	}
+
+	// The signing set is formed; derive the nonce everyone signs with. Signers
+	// an inconsistency is attributable to are slashed; either way the session
+	// fails and the withdrawal is signed afresh.
+	nonce, err := types.ComputeTSSNonce(key, session.Message, session.Commitments)
+	if err != nil {
+		var fault *types.TSSCommitmentFault
+		if errors.As(err, &fault) {
+			for _, index := range fault.Indices {
+				faulty, found := key.GetShareByIndex(index)
+				if !found {
+					continue
+				}
+				signer, addrErr := sdk.AccAddressFromBech32(faulty.Validator)
+				if addrErr != nil {
+					continue
+				}
+				session.FaultyParticipants = append(session.FaultyParticipants, faulty.Validator)
+				k.slashTSSCommitment(ctx, signer, session.ID, err)
+			}
 		}
+		k.failTSSSession(ctx, &session, fmt.Sprintf("inconsistent commitments: %s", err))
+		return false, k.SetTSSSession(ctx, session)
+	}
+
+	session.Nonce = nonce
+	session.Status = types.TSSSessionStatusActive
+	if err := k.SetTSSSession(ctx, session); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// SubmitTSSSignature submits a TSS signature share. Each share is verified
+// before it counts; a share that fails is evidence against its signer, who is
+// slashed, and the session fails since its signing set can no longer finish.
+func (k Keeper) SubmitTSSSignature(
+	ctx sdk.Context,
+	validator sdk.AccAddress,
+	sessionID uint64,
+	signatureData []byte,
+) (bool, error) {
+	// Verify validator eligibility
+	if _, err := k.IsValidatorEligible(ctx, validator); err != nil {
+		return false, err
 	}
-	if !isParticipant {
+
+	// Get session
+	session, found := k.GetTSSSession(ctx, sessionID)
+	if !found {
+		return false, types.ErrTSSSessionNotFound
+	}
+	if err := k.checkTSSSessionOpen(ctx, &session); err != nil {
+		return false, err
+	}
+
+	// Check if validator is a participant
+	if !session.IsParticipant(validator.String()) {
 		return false, fmt.Errorf("validator not a participant in this session")
 	}
+	if session.Status != types.TSSSessionStatusActive {
+		return false, types.ErrInvalidTSSSession.Wrap("signing set not formed yet")
+	}
+	commitment, found := session.GetCommitment(validator.String())
+	if !found {
+		return false, fmt.Errorf("validator not in the signing set")
+	}
 
 	// Check for duplicate signature
-	for _, share := range session.SignatureShares {
-		if share.Validator == validator.String() {
-			return false, fmt.Errorf("validator already submitted signature")
-		}
+	if session.HasSubmittedShare(validator.String()) {
+		return false, fmt.Errorf("validator already submitted signature")
+	}
+
+	key, found := k.GetTSSKey(ctx, session.ChainID)
+	if !found {
+		return false, types.ErrTSSKeyNotFound
+	}
+
+	if err := types.VerifyTSSShare(key, session.Message, session.Commitments, commitment.Index, signatureData); err != nil {
+		session.FaultyParticipants = append(session.FaultyParticipants, validator.String())
+		k.slashTSSSigner(ctx, validator, session.ID, err)
+		k.failTSSSession(ctx, &session, fmt.Sprintf("invalid share from %s", validator))
+		return false, k.SetTSSSession(ctx, session)
 	}
 
 	// Add signature share
-	share := types.NewTSSSignatureShare(sessionID, validator.String(), signatureData)
+	share := types.NewTSSSignatureShare(sessionID, validator.String(), signatureData, ctx.BlockTime())
 	session.SignatureShares = append(session.SignatureShares, share)
 	session.ReceivedSigs++
 
-	if session.Status == types.TSSSessionStatusPending {
-		session.Status = types.TSSSessionStatusActive
-	}
-
 	// Check if we have enough signatures
 	completed := false
 	if session.HasEnoughSignatures() {
-		// In production, this would combine the signature shares into a final signature
-		session.CombinedSignature = []byte("combined_signature_placeholder")
+		shares := make([][]byte, len(session.SignatureShares))
+		for i, s := range session.SignatureShares {
+			shares[i] = s.SignatureData
+		}
+
+		combined, err := types.CombineTSSShares(key, session.Message, session.Commitments, shares)
+		if err != nil {
+			k.failTSSSession(ctx, &session, fmt.Sprintf("combining shares: %s", err))
+			return false, k.SetTSSSession(ctx, session)
+		}
+
+		session.CombinedSignature = combined
 		session.Status = types.TSSSessionStatusCompleted
 		now := ctx.BlockTime()
 		session.CompletedAt = &now
@@ -213,16 +358,23 @@ func (k Keeper) SubmitTSSSignature(
 
 		// Update withdrawal status and burn escrowed HODL
 		withdrawal, found := k.GetWithdrawal(ctx, session.WithdrawalID)
-		if found {
+		if session.Kind == types.TSSSessionKindMigration {
+			k.completeTSSMigrationSweep(ctx, session)
+		} else if found {
 			withdrawal.Status = types.WithdrawalStatusSigned
 			if err := k.SetWithdrawal(ctx, withdrawal); err != nil {
 				k.Logger(ctx).Error("failed to update withdrawal status after TSS", "error", err)
 			}
+			if withdrawal.Tx != nil {
+				k.settleExternalTx(ctx, *withdrawal.Tx)
+			}
 
 			// Now burn the escrowed HODL since TSS signing succeeded
 			hodlCoin := sdk.NewCoin(hodltypes.HODLDenom, withdrawal.HODLAmount)
 			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(hodlCoin)); err != nil {
 				k.Logger(ctx).Error("failed to burn escrowed HODL after TSS completion", "error", err)
+			} else {
+				k.recordBridgedBurn(ctx, withdrawal.ChainID, withdrawal.AssetSymbol, withdrawal.HODLAmount, withdrawal.ExternalAmount)
 			}
 		}
 	}
@@ -245,3 +397,155 @@ func (k Keeper) SubmitTSSSignature(
 
 	return completed, nil
 }
+
+// eligibleTSSSigners returns the holders of a key who are still eligible
+// validators, failing if too few remain to sign
+func (k Keeper) eligibleTSSSigners(ctx sdk.Context, key types.TSSKey) ([]string, error) {
+	participants := []string{}
+	for _, share := range key.Shares {
+		holder, err := sdk.AccAddressFromBech32(share.Validator)
+		if err != nil {
+			continue
+		}
+		if eligible, _ := k.IsValidatorEligible(ctx, holder); eligible {
+			participants = append(participants, share.Validator)
+		}
+	}
+
+	if uint64(len(participants)) < key.Threshold {
+		return nil, fmt.Errorf("only %d of the %d key holders needed to sign are eligible validators", len(participants), key.Threshold)
+	}
+	return participants, nil
+}
+
+// checkTSSSessionOpen returns an error if a session no longer accepts
+// commitments or shares. A session past its deadline is marked timed out by
+// ExpireTSSSessions, since a status written here would be reverted with the
+// rejected message.
+func (k Keeper) checkTSSSessionOpen(ctx sdk.Context, session *types.TSSSession) error {
+	if !session.CanAddSignature() {
+		if session.IsCompleted() {
+			return types.ErrTSSSessionCompleted
+		}
+		if session.IsFailed() {
+			return types.ErrTSSSessionFailed
+		}
+		return fmt.Errorf("session cannot accept signatures")
+	}
+
+	if session.IsTimeout(ctx.BlockTime()) {
+		return types.ErrTSSTimeout
+	}
+
+	return nil
+}
+
+// ExpireTSSSessions times out sessions past their deadline and returns what
+// they were signing to be signed by a new session (runs in EndBlock)
+func (k Keeper) ExpireTSSSessions(ctx sdk.Context) {
+	for _, session := range k.getOpenTSSSessions(ctx, ctx.BlockTime(), true) {
+		if !session.CanAddSignature() || !session.IsTimeout(ctx.BlockTime()) {
+			continue
+		}
+
+		session.Status = types.TSSSessionStatusTimeout
+		if err := k.SetTSSSession(ctx, session); err != nil {
+			k.Logger(ctx).Error("failed to update TSS session on timeout", "error", err)
+			continue
+		}
+		k.releaseTSSSession(ctx, session)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"extbridge_tss_session_timeout",
+				sdk.NewAttribute("session_id", fmt.Sprintf("%d", session.ID)),
+				sdk.NewAttribute("withdrawal_id", fmt.Sprintf("%d", session.WithdrawalID)),
+			),
+		)
+	}
+}
+
+// failTSSSession fails a session and returns its withdrawal to the ready state
+// so a new session can sign it
+func (k Keeper) failTSSSession(ctx sdk.Context, session *types.TSSSession, reason string) {
+	session.Status = types.TSSSessionStatusFailed
+	k.releaseTSSSession(ctx, *session)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_session_failed",
+			sdk.NewAttribute("session_id", fmt.Sprintf("%d", session.ID)),
+			sdk.NewAttribute("withdrawal_id", fmt.Sprintf("%d", session.WithdrawalID)),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+}
+
+// releaseTSSSession returns the withdrawal or migration a session that ended
+// unsigned was signing to be picked up by a new session
+func (k Keeper) releaseTSSSession(ctx sdk.Context, session types.TSSSession) {
+	withdrawal, found := k.GetWithdrawal(ctx, session.WithdrawalID)
+	if session.Kind == types.TSSSessionKindMigration {
+		k.resetTSSMigration(ctx, session)
+	} else if found && withdrawal.Status == types.WithdrawalStatusSigning &&
+		withdrawal.TSSSessionID != nil && *withdrawal.TSSSessionID == session.ID {
+		withdrawal.Status = types.WithdrawalStatusReady
+		withdrawal.TSSSessionID = nil
+		if err := k.SetWithdrawal(ctx, withdrawal); err != nil {
+			k.Logger(ctx).Error("failed to reset withdrawal after TSS failure", "error", err)
+		}
+	}
+}
+
+// slashTSSSigner slashes a validator whose signature share failed verification
+func (k Keeper) slashTSSSigner(ctx sdk.Context, validator sdk.AccAddress, sessionID uint64, fault error) {
+	k.slashTSSFault(ctx, validator, "tss_invalid_signature_share")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_share_rejected",
+			sdk.NewAttribute("session_id", fmt.Sprintf("%d", sessionID)),
+			sdk.NewAttribute("validator", validator.String()),
+			sdk.NewAttribute("reason", fault.Error()),
+		),
+	)
+
+	k.Logger(ctx).Error("invalid TSS signature share",
+		"session_id", sessionID,
+		"validator", validator.String(),
+		"error", fault,
+	)
+}
+
+// slashTSSCommitment slashes a validator whose commitment made its session's
+// signing set inconsistent
+func (k Keeper) slashTSSCommitment(ctx sdk.Context, validator sdk.AccAddress, sessionID uint64, fault error) {
+	k.slashTSSFault(ctx, validator, "tss_invalid_commitment")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_commitment_rejected",
+			sdk.NewAttribute("session_id", fmt.Sprintf("%d", sessionID)),
+			sdk.NewAttribute("validator", validator.String()),
+			sdk.NewAttribute("reason", fault.Error()),
+		),
+	)
+
+	k.Logger(ctx).Error("invalid TSS commitment",
+		"session_id", sessionID,
+		"validator", validator.String(),
+		"error", fault,
+	)
+}
+
+// slashTSSFault slashes a validator by TSSFaultSlashFraction for a provable
+// TSS protocol fault
+func (k Keeper) slashTSSFault(ctx sdk.Context, validator sdk.AccAddress, reason string) {
+	fraction := k.GetParams(ctx).TSSFaultSlashFraction
+	if fraction.IsNil() || !fraction.IsPositive() {
+		return
+	}
+	if err := k.stakingKeeper.Slash(ctx, validator, reason, fraction); err != nil {
+		k.Logger(ctx).Error("failed to slash TSS participant", "validator", validator.String(), "reason", reason, "error", err)
+	}
+}
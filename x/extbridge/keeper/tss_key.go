@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/types"
+)
+
+// GetTSSKey retrieves the TSS key registered for a chain
+func (k Keeper) GetTSSKey(ctx sdk.Context, chainID string) (types.TSSKey, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TSSKeyKey(chainID))
+	if bz == nil {
+		return types.TSSKey{}, false
+	}
+
+	var key types.TSSKey
+	if err := json.Unmarshal(bz, &key); err != nil {
+		return types.TSSKey{}, false
+	}
+	return key, true
+}
+
+// SetTSSKey stores a chain's TSS key
+func (k Keeper) SetTSSKey(ctx sdk.Context, key types.TSSKey) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(key)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal TSS key", "error", err)
+		return
+	}
+	store.Set(types.TSSKeyKey(key.ChainID), bz)
+}
+
+// GetAllTSSKeys returns every registered TSS key
+func (k Keeper) GetAllTSSKeys(ctx sdk.Context) []types.TSSKey {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.TSSKeyPrefix)
+	defer iterator.Close()
+
+	keys := []types.TSSKey{}
+	for ; iterator.Valid(); iterator.Next() {
+		var key types.TSSKey
+		if err := json.Unmarshal(iterator.Value(), &key); err != nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// RegisterTSSKey registers the threshold key behind a chain's TSS address.
+// The key's public shares must be consistent with its group key, the group
+// key must derive the chain's TSS address, and every holder must be an
+// eligible validator.
+func (k Keeper) RegisterTSSKey(ctx sdk.Context, key types.TSSKey) error {
+	chain, found := k.GetExternalChain(ctx, key.ChainID)
+	if !found {
+		return types.ErrChainNotSupported
+	}
+	if _, found := k.GetTSSKey(ctx, key.ChainID); found {
+		return types.ErrTSSKeyExists
+	}
+
+	if err := key.Validate(); err != nil {
+		return types.ErrInvalidTSSKey.Wrap(err.Error())
+	}
+	if err := types.VerifyTSSAddress(chain.ChainType, key.Scheme, key.PublicKey, chain.TSSAddress); err != nil {
+		return types.ErrInvalidTSSKey.Wrap(err.Error())
+	}
+
+	if minThreshold := k.minTSSThreshold(ctx, uint64(len(key.Shares))); key.Threshold < minThreshold {
+		return types.ErrInvalidTSSKey.Wrapf("threshold %d is below the minimum of %d for %d holders", key.Threshold, minThreshold, len(key.Shares))
+	}
+
+	for _, share := range key.Shares {
+		holder, err := sdk.AccAddressFromBech32(share.Validator)
+		if err != nil {
+			return types.ErrInvalidTSSKey.Wrap(err.Error())
+		}
+		if _, err := k.IsValidatorEligible(ctx, holder); err != nil {
+			return types.ErrInvalidTSSKey.Wrapf("key share holder %s: %s", share.Validator, err)
+		}
+	}
+
+	key.RegisteredAt = ctx.BlockTime()
+	k.SetTSSKey(ctx, key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_key_registered",
+			sdk.NewAttribute("chain_id", key.ChainID),
+			sdk.NewAttribute("scheme", key.Scheme),
+			sdk.NewAttribute("threshold", fmt.Sprintf("%d", key.Threshold)),
+			sdk.NewAttribute("holders", fmt.Sprintf("%d", len(key.Shares))),
+		),
+	)
+
+	return nil
+}
+
+// minTSSThreshold returns the fewest signers a key with the given number of
+// holders may require, from the TSSThreshold param
+func (k Keeper) minTSSThreshold(ctx sdk.Context, holders uint64) uint64 {
+	params := k.GetParams(ctx)
+	required := holders * params.TSSThreshold.MulInt64(100).TruncateInt().Uint64() / 100
+	if required == 0 {
+		required = 1
+	}
+	return required
+}
@@ -0,0 +1,578 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/types"
+)
+
+// GetTSSKeyGeneration retrieves a key generation ceremony by ID
+func (k Keeper) GetTSSKeyGeneration(ctx sdk.Context, keyGenID uint64) (types.TSSKeyGeneration, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TSSKeyGenerationKey(keyGenID))
+	if bz == nil {
+		return types.TSSKeyGeneration{}, false
+	}
+
+	var keyGen types.TSSKeyGeneration
+	if err := json.Unmarshal(bz, &keyGen); err != nil {
+		return types.TSSKeyGeneration{}, false
+	}
+	return keyGen, true
+}
+
+// SetTSSKeyGeneration stores a key generation ceremony
+func (k Keeper) SetTSSKeyGeneration(ctx sdk.Context, keyGen types.TSSKeyGeneration) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(keyGen)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal TSS key generation", "error", err)
+		return fmt.Errorf("failed to marshal TSS key generation: %w", err)
+	}
+	store.Set(types.TSSKeyGenerationKey(keyGen.ID), bz)
+	return nil
+}
+
+// GetAllTSSKeyGenerations returns all key generation ceremonies
+func (k Keeper) GetAllTSSKeyGenerations(ctx sdk.Context) []types.TSSKeyGeneration {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.TSSKeyGenerationPrefix)
+	defer iterator.Close()
+
+	keyGens := []types.TSSKeyGeneration{}
+	for ; iterator.Valid(); iterator.Next() {
+		var keyGen types.TSSKeyGeneration
+		if err := json.Unmarshal(iterator.Value(), &keyGen); err != nil {
+			continue
+		}
+		keyGens = append(keyGens, keyGen)
+	}
+	return keyGens
+}
+
+// GetActiveTSSKeyGeneration returns the ceremony in progress for a chain, if any
+func (k Keeper) GetActiveTSSKeyGeneration(ctx sdk.Context, chainID string) (types.TSSKeyGeneration, bool) {
+	for _, keyGen := range k.GetAllTSSKeyGenerations(ctx) {
+		if keyGen.ChainID == chainID && keyGen.IsInProgress() {
+			return keyGen, true
+		}
+	}
+	return types.TSSKeyGeneration{}, false
+}
+
+// StartTSSKeyGeneration starts a key generation ceremony among the eligible
+// validators for a chain. If the chain already has a key the ceremony rotates
+// it, and its funds migrate to the new key's address once it completes.
+func (k Keeper) StartTSSKeyGeneration(ctx sdk.Context, chainID string) (uint64, error) {
+	chain, found := k.GetExternalChain(ctx, chainID)
+	if !found {
+		return 0, types.ErrChainNotSupported
+	}
+	if _, active := k.GetActiveTSSKeyGeneration(ctx, chainID); active {
+		return 0, types.ErrTSSKeyGenInProgress
+	}
+	if migration, found := k.GetTSSKeyMigration(ctx, chainID); found && migration.IsPending() {
+		return 0, types.ErrTSSMigrationPending
+	}
+	_, rotation := k.GetTSSKey(ctx, chainID)
+	if rotation && !types.HasTxBuilder(chain.ChainType) {
+		return 0, types.ErrChainNotSupported.Wrapf("custody on %s chains cannot be swept to a rotated key", chain.ChainType)
+	}
+
+	eligible, err := k.GetEligibleValidators(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(eligible) == 0 {
+		return 0, fmt.Errorf("no eligible validators available")
+	}
+
+	// Share indices follow address order so every node assigns them alike
+	participants := make([]string, len(eligible))
+	for i, val := range eligible {
+		participants[i] = val.String()
+	}
+	sort.Strings(participants)
+
+	params := k.GetParams(ctx)
+
+	keyGenID := k.GetNextTSSKeyGenerationID(ctx)
+	keyGen := types.TSSKeyGeneration{
+		ID:            keyGenID,
+		ChainID:       chainID,
+		Status:        types.TSSSessionStatusActive,
+		Scheme:        types.TSSSchemeForChainType(chain.ChainType),
+		Rotation:      rotation,
+		Participants:  participants,
+		Threshold:     k.minTSSThreshold(ctx, uint64(len(participants))),
+		Phase:         types.TSSKeyGenPhaseDealing,
+		PhaseDeadline: ctx.BlockTime().Add(params.TSSKeyGenRoundTimeout()),
+		Dealings:      []types.TSSDealing{},
+		Complaints:    []types.TSSComplaint{},
+		CreatedAt:     ctx.BlockTime(),
+	}
+	if err := keyGen.Validate(); err != nil {
+		return 0, err
+	}
+	if err := k.SetTSSKeyGeneration(ctx, keyGen); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_keygen_started",
+			sdk.NewAttribute("key_gen_id", fmt.Sprintf("%d", keyGenID)),
+			sdk.NewAttribute("chain_id", chainID),
+			sdk.NewAttribute("scheme", keyGen.Scheme),
+			sdk.NewAttribute("rotation", fmt.Sprintf("%t", rotation)),
+			sdk.NewAttribute("participants", fmt.Sprintf("%d", len(participants))),
+			sdk.NewAttribute("threshold", fmt.Sprintf("%d", keyGen.Threshold)),
+		),
+	)
+
+	return keyGenID, nil
+}
+
+// SubmitTSSDealing records a participant's polynomial commitments. Returns
+// the number of dealings received; once every participant has dealt the
+// ceremony moves on to complaints without waiting for the round to close.
+func (k Keeper) SubmitTSSDealing(
+	ctx sdk.Context,
+	validator sdk.AccAddress,
+	keyGenID uint64,
+	commitments [][]byte,
+	proof []byte,
+) (uint64, error) {
+	if _, err := k.IsValidatorEligible(ctx, validator); err != nil {
+		return 0, err
+	}
+
+	keyGen, err := k.openTSSKeyGeneration(ctx, keyGenID, types.TSSKeyGenPhaseDealing)
+	if err != nil {
+		return 0, err
+	}
+
+	index := keyGen.ParticipantIndex(validator.String())
+	if index == 0 {
+		return 0, fmt.Errorf("validator not a participant in this key generation")
+	}
+	if _, found := keyGen.GetDealing(validator.String()); found {
+		return 0, fmt.Errorf("validator already submitted dealing")
+	}
+	if err := types.ValidateTSSDealing(keyGen.Scheme, keyGen.ID, index, keyGen.Threshold, commitments, proof); err != nil {
+		return 0, types.ErrInvalidTSSDealing.Wrap(err.Error())
+	}
+
+	keyGen.Dealings = append(keyGen.Dealings, types.TSSDealing{
+		Validator:   validator.String(),
+		Index:       index,
+		Commitments: commitments,
+		Proof:       proof,
+		SubmittedAt: ctx.BlockTime(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_dealing_submitted",
+			sdk.NewAttribute("key_gen_id", fmt.Sprintf("%d", keyGenID)),
+			sdk.NewAttribute("validator", validator.String()),
+			sdk.NewAttribute("dealings", fmt.Sprintf("%d", len(keyGen.Dealings))),
+			sdk.NewAttribute("participants", fmt.Sprintf("%d", len(keyGen.Participants))),
+		),
+	)
+
+	if len(keyGen.Dealings) == len(keyGen.Participants) {
+		k.advanceTSSKeyGeneration(ctx, &keyGen)
+	}
+	if err := k.SetTSSKeyGeneration(ctx, keyGen); err != nil {
+		return 0, err
+	}
+
+	return uint64(len(keyGen.Dealings)), nil
+}
+
+// FileTSSComplaint records an accusation that a dealer's private share to
+// the accuser was missing or did not match the dealer's commitments
+func (k Keeper) FileTSSComplaint(ctx sdk.Context, accuser sdk.AccAddress, keyGenID uint64, dealer string) error {
+	if _, err := k.IsValidatorEligible(ctx, accuser); err != nil {
+		return err
+	}
+
+	keyGen, err := k.openTSSKeyGeneration(ctx, keyGenID, types.TSSKeyGenPhaseComplaint)
+	if err != nil {
+		return err
+	}
+
+	// Only dealers hold a share of the resulting key, so only they can be owed one
+	if _, found := keyGen.GetDealing(accuser.String()); !found {
+		return types.ErrInvalidTSSComplaint.Wrap("accuser did not deal in this key generation")
+	}
+	if _, found := keyGen.GetDealing(dealer); !found || dealer == accuser.String() {
+		return types.ErrInvalidTSSComplaint.Wrapf("%s is not a dealer that owes the accuser a share", dealer)
+	}
+	if _, found := keyGen.GetComplaint(accuser.String(), dealer); found {
+		return types.ErrInvalidTSSComplaint.Wrap("complaint already filed")
+	}
+
+	keyGen.Complaints = append(keyGen.Complaints, types.TSSComplaint{
+		Accuser: accuser.String(),
+		Dealer:  dealer,
+		FiledAt: ctx.BlockTime(),
+	})
+	if err := k.SetTSSKeyGeneration(ctx, keyGen); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_complaint_filed",
+			sdk.NewAttribute("key_gen_id", fmt.Sprintf("%d", keyGenID)),
+			sdk.NewAttribute("accuser", accuser.String()),
+			sdk.NewAttribute("dealer", dealer),
+		),
+	)
+
+	return nil
+}
+
+// SubmitTSSJustification answers a complaint by revealing the disputed share.
+// A share that does not match the dealer's commitments proves the dealer
+// cheated: it is excluded from the key and slashed. Returns whether the share
+// was accepted.
+func (k Keeper) SubmitTSSJustification(
+	ctx sdk.Context,
+	dealer sdk.AccAddress,
+	keyGenID uint64,
+	accuser string,
+	share []byte,
+) (bool, error) {
+	if _, err := k.IsValidatorEligible(ctx, dealer); err != nil {
+		return false, err
+	}
+
+	keyGen, err := k.openTSSKeyGeneration(ctx, keyGenID, types.TSSKeyGenPhaseJustification)
+	if err != nil {
+		return false, err
+	}
+
+	position, found := keyGen.GetComplaint(accuser, dealer.String())
+	if !found {
+		return false, types.ErrInvalidTSSComplaint.Wrap("no complaint from accuser against this dealer")
+	}
+	complaint := keyGen.Complaints[position]
+	if complaint.AnsweredAt != nil || keyGen.IsBlamed(dealer.String()) {
+		return false, types.ErrInvalidTSSComplaint.Wrap("complaint already resolved")
+	}
+
+	dealing, _ := keyGen.GetDealing(dealer.String())
+	if err := types.VerifyTSSDealtShare(keyGen.Scheme, dealing.Commitments, keyGen.ParticipantIndex(accuser), share); err != nil {
+		k.blameTSSParticipant(ctx, &keyGen, dealer.String(), fmt.Sprintf("invalid share revealed to %s: %s", accuser, err), true)
+		return false, k.SetTSSKeyGeneration(ctx, keyGen)
+	}
+
+	now := ctx.BlockTime()
+	complaint.Share = share
+	complaint.AnsweredAt = &now
+	keyGen.Complaints[position] = complaint
+	if err := k.SetTSSKeyGeneration(ctx, keyGen); err != nil {
+		return false, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_complaint_answered",
+			sdk.NewAttribute("key_gen_id", fmt.Sprintf("%d", keyGenID)),
+			sdk.NewAttribute("accuser", accuser),
+			sdk.NewAttribute("dealer", dealer.String()),
+		),
+	)
+
+	return true, nil
+}
+
+// ProcessTSSKeyGenerations closes the rounds of ceremonies whose deadline has
+// passed (runs in EndBlock)
+func (k Keeper) ProcessTSSKeyGenerations(ctx sdk.Context) {
+	for _, keyGen := range k.GetAllTSSKeyGenerations(ctx) {
+		if !keyGen.IsInProgress() || !ctx.BlockTime().After(keyGen.PhaseDeadline) {
+			continue
+		}
+
+		k.advanceTSSKeyGeneration(ctx, &keyGen)
+		if err := k.SetTSSKeyGeneration(ctx, keyGen); err != nil {
+			k.Logger(ctx).Error("failed to update TSS key generation", "key_gen_id", keyGen.ID, "error", err)
+		}
+	}
+}
+
+// CheckTSSKeyRotations starts a rotation for each chain whose key holders
+// have drifted from the eligible validator set by at least the rotation
+// threshold, or no longer include enough eligible validators to sign (runs
+// in EndBlock)
+func (k Keeper) CheckTSSKeyRotations(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.TSSRotationThreshold.IsNil() {
+		return
+	}
+
+	validators, err := k.GetEligibleValidators(ctx)
+	if err != nil || len(validators) == 0 {
+		return
+	}
+	eligible := make(map[string]bool, len(validators))
+	for _, val := range validators {
+		eligible[val.String()] = true
+	}
+
+	for _, key := range k.GetAllTSSKeys(ctx) {
+		if _, active := k.GetActiveTSSKeyGeneration(ctx, key.ChainID); active {
+			continue
+		}
+		if migration, found := k.GetTSSKeyMigration(ctx, key.ChainID); found && migration.IsPending() {
+			continue
+		}
+		// Custody can only move to a rotated key where a sweep can be built
+		if chain, found := k.GetExternalChain(ctx, key.ChainID); !found || !types.HasTxBuilder(chain.ChainType) {
+			continue
+		}
+
+		holders := make(map[string]bool, len(key.Shares))
+		departed := 0
+		for _, share := range key.Shares {
+			holders[share.Validator] = true
+			if !eligible[share.Validator] {
+				departed++
+			}
+		}
+		joined := 0
+		for val := range eligible {
+			if !holders[val] {
+				joined++
+			}
+		}
+
+		changed := departed + joined
+		drift := params.TSSRotationThreshold.MulInt64(int64(len(key.Shares)))
+		canSign := uint64(len(key.Shares)-departed) >= key.Threshold
+		if changed == 0 || (canSign && drift.GT(math.LegacyNewDec(int64(changed)))) {
+			continue
+		}
+
+		keyGenID, err := k.StartTSSKeyGeneration(ctx, key.ChainID)
+		if err != nil {
+			k.Logger(ctx).Error("failed to start TSS key rotation", "chain_id", key.ChainID, "error", err)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"extbridge_tss_key_rotation_triggered",
+				sdk.NewAttribute("chain_id", key.ChainID),
+				sdk.NewAttribute("key_gen_id", fmt.Sprintf("%d", keyGenID)),
+				sdk.NewAttribute("departed", fmt.Sprintf("%d", departed)),
+				sdk.NewAttribute("joined", fmt.Sprintf("%d", joined)),
+			),
+		)
+	}
+}
+
+// openTSSKeyGeneration returns a ceremony that is accepting messages for the
+// given round
+func (k Keeper) openTSSKeyGeneration(ctx sdk.Context, keyGenID uint64, phase types.TSSKeyGenPhase) (types.TSSKeyGeneration, error) {
+	keyGen, found := k.GetTSSKeyGeneration(ctx, keyGenID)
+	if !found {
+		return keyGen, types.ErrTSSKeyGenNotFound
+	}
+	if keyGen.Phase != phase {
+		return keyGen, types.ErrInvalidTSSKeyGenPhase.Wrapf("key generation is in the %s round", keyGen.Phase)
+	}
+	if ctx.BlockTime().After(keyGen.PhaseDeadline) {
+		return keyGen, types.ErrInvalidTSSKeyGenPhase.Wrapf("the %s round has closed", phase)
+	}
+	return keyGen, nil
+}
+
+// advanceTSSKeyGeneration closes the ceremony's current round, blaming the
+// participants that failed it, and opens the next or finishes the ceremony
+func (k Keeper) advanceTSSKeyGeneration(ctx sdk.Context, keyGen *types.TSSKeyGeneration) {
+	params := k.GetParams(ctx)
+
+	switch keyGen.Phase {
+	case types.TSSKeyGenPhaseDealing:
+		for _, participant := range keyGen.Participants {
+			if _, found := keyGen.GetDealing(participant); !found {
+				k.blameTSSParticipant(ctx, keyGen, participant, "no dealing", false)
+			}
+		}
+		if uint64(len(keyGen.Dealings)) < keyGen.Threshold {
+			k.failTSSKeyGeneration(ctx, keyGen, fmt.Sprintf("only %d of the %d dealings needed were submitted", len(keyGen.Dealings), keyGen.Threshold))
+			return
+		}
+		keyGen.Phase = types.TSSKeyGenPhaseComplaint
+	case types.TSSKeyGenPhaseComplaint:
+		if len(keyGen.Complaints) == 0 {
+			k.finalizeTSSKeyGeneration(ctx, keyGen)
+			return
+		}
+		keyGen.Phase = types.TSSKeyGenPhaseJustification
+	case types.TSSKeyGenPhaseJustification:
+		for _, complaint := range keyGen.Complaints {
+			if complaint.AnsweredAt == nil && !keyGen.IsBlamed(complaint.Dealer) {
+				k.blameTSSParticipant(ctx, keyGen, complaint.Dealer, fmt.Sprintf("unanswered complaint from %s", complaint.Accuser), true)
+			}
+		}
+		k.finalizeTSSKeyGeneration(ctx, keyGen)
+		return
+	default:
+		return
+	}
+
+	keyGen.PhaseDeadline = ctx.BlockTime().Add(params.TSSKeyGenRoundTimeout())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_keygen_round",
+			sdk.NewAttribute("key_gen_id", fmt.Sprintf("%d", keyGen.ID)),
+			sdk.NewAttribute("phase", keyGen.Phase.String()),
+			sdk.NewAttribute("deadline", keyGen.PhaseDeadline.String()),
+		),
+	)
+}
+
+// finalizeTSSKeyGeneration derives the key from the qualified dealings. A
+// chain's first key is installed directly; a rotated key waits for the old
+// key to sign the custody migration.
+func (k Keeper) finalizeTSSKeyGeneration(ctx sdk.Context, keyGen *types.TSSKeyGeneration) {
+	qualified := keyGen.QualifiedDealings()
+	if uint64(len(qualified)) < keyGen.Threshold {
+		k.failTSSKeyGeneration(ctx, keyGen, fmt.Sprintf("only %d of the %d qualified dealers needed remain", len(qualified), keyGen.Threshold))
+		return
+	}
+
+	indices := make([]uint32, len(qualified))
+	for i, dealing := range qualified {
+		indices[i] = dealing.Index
+	}
+	groupKey, publicShares, err := types.CombineTSSDealings(keyGen.Scheme, qualified, indices)
+	if err != nil {
+		k.failTSSKeyGeneration(ctx, keyGen, err.Error())
+		return
+	}
+
+	key := types.TSSKey{
+		ChainID:      keyGen.ChainID,
+		Scheme:       keyGen.Scheme,
+		PublicKey:    groupKey,
+		Threshold:    keyGen.Threshold,
+		RegisteredAt: ctx.BlockTime(),
+	}
+	for i, dealing := range qualified {
+		key.Shares = append(key.Shares, types.TSSKeyShare{
+			Validator:   dealing.Validator,
+			Index:       dealing.Index,
+			PublicShare: publicShares[i],
+		})
+	}
+	if err := key.Validate(); err != nil {
+		k.failTSSKeyGeneration(ctx, keyGen, err.Error())
+		return
+	}
+
+	chain, found := k.GetExternalChain(ctx, keyGen.ChainID)
+	if !found {
+		k.failTSSKeyGeneration(ctx, keyGen, "chain no longer exists")
+		return
+	}
+	address, err := types.TSSAddressForChain(chain, key.Scheme, key.PublicKey)
+	if err != nil {
+		k.failTSSKeyGeneration(ctx, keyGen, err.Error())
+		return
+	}
+
+	now := ctx.BlockTime()
+	keyGen.PublicKey = groupKey
+	keyGen.Address = address
+	keyGen.Status = types.TSSSessionStatusCompleted
+	keyGen.Phase = types.TSSKeyGenPhaseFinished
+	keyGen.CompletedAt = &now
+
+	_, rotation := k.GetTSSKey(ctx, keyGen.ChainID)
+	if rotation {
+		// The old key stays live until its custody is swept to the new address
+		k.SetTSSKeyMigration(ctx, types.TSSKeyMigration{
+			ChainID:         keyGen.ChainID,
+			KeyGenerationID: keyGen.ID,
+			Status:          types.TSSMigrationStatusPending,
+			OldAddress:      chain.TSSAddress,
+			NewAddress:      address,
+			NewKey:          key,
+			CreatedAt:       now,
+		})
+	} else {
+		k.SetTSSKey(ctx, key)
+		chain.TSSAddress = address
+		k.SetExternalChain(ctx, chain)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_keygen_completed",
+			sdk.NewAttribute("key_gen_id", fmt.Sprintf("%d", keyGen.ID)),
+			sdk.NewAttribute("chain_id", keyGen.ChainID),
+			sdk.NewAttribute("address", address),
+			sdk.NewAttribute("holders", fmt.Sprintf("%d", len(key.Shares))),
+			sdk.NewAttribute("threshold", fmt.Sprintf("%d", key.Threshold)),
+			sdk.NewAttribute("migration", fmt.Sprintf("%t", rotation)),
+		),
+	)
+}
+
+// failTSSKeyGeneration ends a ceremony without a key
+func (k Keeper) failTSSKeyGeneration(ctx sdk.Context, keyGen *types.TSSKeyGeneration, reason string) {
+	now := ctx.BlockTime()
+	keyGen.Status = types.TSSSessionStatusFailed
+	keyGen.Phase = types.TSSKeyGenPhaseFinished
+	keyGen.FailureReason = reason
+	keyGen.CompletedAt = &now
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_keygen_failed",
+			sdk.NewAttribute("key_gen_id", fmt.Sprintf("%d", keyGen.ID)),
+			sdk.NewAttribute("chain_id", keyGen.ChainID),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+}
+
+// blameTSSParticipant excludes a participant from a ceremony's key, slashing
+// it when the fault is provable misbehaviour rather than absence
+func (k Keeper) blameTSSParticipant(ctx sdk.Context, keyGen *types.TSSKeyGeneration, validator, reason string, slash bool) {
+	keyGen.Blamed = append(keyGen.Blamed, types.TSSBlame{
+		Validator: validator,
+		Reason:    reason,
+		Slashed:   slash,
+	})
+
+	if slash {
+		if addr, err := sdk.AccAddressFromBech32(validator); err == nil {
+			k.slashTSSFault(ctx, addr, "tss_keygen_fault")
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_participant_blamed",
+			sdk.NewAttribute("key_gen_id", fmt.Sprintf("%d", keyGen.ID)),
+			sdk.NewAttribute("validator", validator),
+			sdk.NewAttribute("reason", reason),
+			sdk.NewAttribute("slashed", fmt.Sprintf("%t", slash)),
+		),
+	)
+}
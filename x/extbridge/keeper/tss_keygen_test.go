@@ -0,0 +1,500 @@
+package keeper_test
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/types"
+)
+
+// addEligibleValidators makes validators eligible to take part in the bridge
+func (suite *KeeperTestSuite) addEligibleValidators(validators []sdk.AccAddress) {
+	for _, val := range validators {
+		suite.stakingKeeper.validators[val.String()] = true
+		suite.stakingKeeper.validatorTiers[val.String()] = 4
+	}
+	suite.stakingKeeper.totalVals = uint64(len(suite.stakingKeeper.validators))
+}
+
+// attestReserves has validators attest an asset's balance at an external
+// height until a quorum agrees, returning the snapshot
+func (suite *KeeperTestSuite) attestReserves(validators []sdk.AccAddress, chainID, assetSymbol string, height uint64, balance int64) types.ReserveSnapshot {
+	for _, val := range validators {
+		_, finalized, err := suite.keeper.AttestReserves(suite.ctx, val, chainID, assetSymbol, height, math.NewInt(balance))
+		suite.Require().NoError(err)
+		if finalized {
+			snapshot, found := suite.keeper.GetLatestReserveSnapshot(suite.ctx, chainID, assetSymbol)
+			suite.Require().True(found)
+			return snapshot
+		}
+	}
+	suite.FailNow("reserve attestation did not reach a quorum")
+	return types.ReserveSnapshot{}
+}
+
+// addUnkeyedChain adds an enabled chain with a USDT asset and a hand-entered
+// TSS address no registered key controls
+func (suite *KeeperTestSuite) addUnkeyedChain(chainID, chainType, address string) {
+	chain := types.NewExternalChain(
+		chainID,
+		"Test Chain",
+		chainType,
+		true,
+		12,
+		12,
+		address,
+		math.NewInt(100_000),
+		math.NewInt(10_000_000_000),
+	)
+	chain.NetworkID = 1
+	chain.FeeRate = math.NewInt(20_000_000_000)
+	chain.GasLimit = 100_000
+	suite.keeper.SetExternalChain(suite.ctx, chain)
+	suite.keeper.SetExternalAsset(suite.ctx, types.NewExternalAsset(
+		chainID,
+		"USDT",
+		"Tether USD",
+		"0xdAC17F958D2ee523a2206206994597C13D831ec7",
+		6,
+		true,
+		math.LegacyOneDec(),
+		math.NewInt(1_000_000_000_000),
+		math.NewInt(100_000_000_000),
+	))
+}
+
+// dealAll has each validator deal in a ceremony, returning their polynomials
+func (suite *KeeperTestSuite) dealAll(keyGenID uint64, validators []sdk.AccAddress) map[string]*localDealer {
+	keyGen, found := suite.keeper.GetTSSKeyGeneration(suite.ctx, keyGenID)
+	suite.Require().True(found)
+
+	dealers := make(map[string]*localDealer)
+	for _, val := range validators {
+		dealer := newLocalDealer(keyGen.Scheme, keyGen.Threshold)
+		commitments, proof := dealer.dealing(keyGenID, keyGen.ParticipantIndex(val.String()))
+		_, err := suite.keeper.SubmitTSSDealing(suite.ctx, val, keyGenID, commitments, proof)
+		suite.Require().NoError(err)
+		dealers[val.String()] = dealer
+	}
+	return dealers
+}
+
+// closeKeyGenRound moves past a ceremony's round deadline and runs EndBlock processing
+func (suite *KeeperTestSuite) closeKeyGenRound(keyGenID uint64) types.TSSKeyGeneration {
+	keyGen, _ := suite.keeper.GetTSSKeyGeneration(suite.ctx, keyGenID)
+	suite.ctx = suite.ctx.WithBlockTime(keyGen.PhaseDeadline.Add(1 * time.Second))
+	suite.keeper.ProcessTSSKeyGenerations(suite.ctx)
+
+	keyGen, _ = suite.keeper.GetTSSKeyGeneration(suite.ctx, keyGenID)
+	return keyGen
+}
+
+// qualifiedDealers returns the polynomials of the dealers whose shares make up a key
+func qualifiedDealers(key types.TSSKey, dealers map[string]*localDealer) []*localDealer {
+	qualified := []*localDealer{}
+	for _, share := range key.Shares {
+		qualified = append(qualified, dealers[share.Validator])
+	}
+	return qualified
+}
+
+// TestTSSKeyGeneration tests a chain's first key generation ceremony
+func (suite *KeeperTestSuite) TestTSSKeyGeneration() {
+	validators := testValidators(4)
+	suite.addEligibleValidators(validators)
+	suite.addUnkeyedChain("solana-1", "solana", "hand-entered")
+
+	keyGenID, err := suite.keeper.StartTSSKeyGeneration(suite.ctx, "solana-1")
+	suite.Require().NoError(err)
+
+	keyGen, found := suite.keeper.GetTSSKeyGeneration(suite.ctx, keyGenID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.TSSSchemeEdDSA, keyGen.Scheme)
+	suite.Require().False(keyGen.Rotation)
+	suite.Require().Len(keyGen.Participants, 4)
+	suite.Require().Equal(uint64(2), keyGen.Threshold)
+	suite.Require().Equal(types.TSSKeyGenPhaseDealing, keyGen.Phase)
+
+	// Only one ceremony per chain at a time
+	_, err = suite.keeper.StartTSSKeyGeneration(suite.ctx, "solana-1")
+	suite.Require().ErrorIs(err, types.ErrTSSKeyGenInProgress)
+
+	// Every participant dealing moves the ceremony on to complaints
+	dealers := suite.dealAll(keyGenID, validators)
+	keyGen, _ = suite.keeper.GetTSSKeyGeneration(suite.ctx, keyGenID)
+	suite.Require().Equal(types.TSSKeyGenPhaseComplaint, keyGen.Phase)
+
+	// With no complaints the key is installed when the round closes
+	keyGen = suite.closeKeyGenRound(keyGenID)
+	suite.Require().Equal(types.TSSKeyGenPhaseFinished, keyGen.Phase)
+	suite.Require().Equal(types.TSSSessionStatusCompleted, keyGen.Status)
+	suite.Require().Empty(keyGen.Blamed)
+
+	key, found := suite.keeper.GetTSSKey(suite.ctx, "solana-1")
+	suite.Require().True(found)
+	suite.Require().Equal(keyGen.PublicKey, key.PublicKey)
+	suite.Require().Len(key.Shares, 4)
+	suite.Require().NoError(key.Validate())
+
+	chain, _ := suite.keeper.GetExternalChain(suite.ctx, "solana-1")
+	suite.Require().Equal(keyGen.Address, chain.TSSAddress)
+	suite.Require().NoError(types.VerifyTSSAddress(chain.ChainType, key.Scheme, key.PublicKey, chain.TSSAddress))
+
+	_, found = suite.keeper.GetTSSKeyMigration(suite.ctx, "solana-1")
+	suite.Require().False(found)
+
+	// The generated key signs
+	signers := signerSetFromDealers(key, validators, qualifiedDealers(key, dealers))
+	sessionID := suite.openSigningSession("solana-1", []byte("solana transaction message"))
+
+	signing := validators[1:3]
+	suite.commitSigners(signers, sessionID, signing)
+	suite.Require().True(suite.signShares(signers, sessionID, signing))
+
+	session, _ := suite.keeper.GetTSSSession(suite.ctx, sessionID)
+	suite.Require().True(ed25519.Verify(ed25519.PublicKey(key.PublicKey), session.Message, session.CombinedSignature))
+}
+
+// TestTSSKeyGenerationDealingValidation tests rejected dealings
+func (suite *KeeperTestSuite) TestTSSKeyGenerationDealingValidation() {
+	validators := testValidators(3)
+	suite.addEligibleValidators(validators)
+	suite.addUnkeyedChain("ethereum-1", "evm", "0xhand-entered")
+
+	keyGenID, err := suite.keeper.StartTSSKeyGeneration(suite.ctx, "ethereum-1")
+	suite.Require().NoError(err)
+	keyGen, _ := suite.keeper.GetTSSKeyGeneration(suite.ctx, keyGenID)
+
+	dealer := newLocalDealer(keyGen.Scheme, keyGen.Threshold)
+	index := keyGen.ParticipantIndex(validators[0].String())
+
+	// A proof made for another participant's index does not verify
+	commitments, proof := dealer.dealing(keyGenID, index+1)
+	_, err = suite.keeper.SubmitTSSDealing(suite.ctx, validators[0], keyGenID, commitments, proof)
+	suite.Require().ErrorIs(err, types.ErrInvalidTSSDealing)
+
+	// Too few coefficients
+	commitments, proof = dealer.dealing(keyGenID, index)
+	_, err = suite.keeper.SubmitTSSDealing(suite.ctx, validators[0], keyGenID, commitments[:1], proof)
+	suite.Require().ErrorIs(err, types.ErrInvalidTSSDealing)
+
+	dealings, err := suite.keeper.SubmitTSSDealing(suite.ctx, validators[0], keyGenID, commitments, proof)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), dealings)
+
+	_, err = suite.keeper.SubmitTSSDealing(suite.ctx, validators[0], keyGenID, commitments, proof)
+	suite.Require().Error(err)
+
+	// Non-participants cannot deal
+	outsider := sdk.AccAddress("outsider")
+	suite.addEligibleValidators([]sdk.AccAddress{outsider})
+	_, err = suite.keeper.SubmitTSSDealing(suite.ctx, outsider, keyGenID, commitments, proof)
+	suite.Require().Error(err)
+
+	// Complaints are only taken in their round
+	err = suite.keeper.FileTSSComplaint(suite.ctx, validators[0], keyGenID, validators[1].String())
+	suite.Require().ErrorIs(err, types.ErrInvalidTSSKeyGenPhase)
+
+	// Too few dealings by the deadline fails the ceremony, blaming the absent
+	keyGen = suite.closeKeyGenRound(keyGenID)
+	suite.Require().Equal(types.TSSSessionStatusFailed, keyGen.Status)
+	suite.Require().Equal(types.TSSKeyGenPhaseFinished, keyGen.Phase)
+	suite.Require().Len(keyGen.Blamed, 2)
+	suite.Require().Empty(suite.stakingKeeper.slashed)
+
+	_, found := suite.keeper.GetTSSKey(suite.ctx, "ethereum-1")
+	suite.Require().False(found)
+
+	// A new ceremony can then be started
+	_, err = suite.keeper.StartTSSKeyGeneration(suite.ctx, "ethereum-1")
+	suite.Require().NoError(err)
+}
+
+// TestTSSKeyGenerationComplaints tests complaints, justifications and blame
+func (suite *KeeperTestSuite) TestTSSKeyGenerationComplaints() {
+	validators := testValidators(7)
+	suite.addEligibleValidators(validators)
+	suite.addUnkeyedChain("ethereum-1", "evm", "0xhand-entered")
+
+	keyGenID, err := suite.keeper.StartTSSKeyGeneration(suite.ctx, "ethereum-1")
+	suite.Require().NoError(err)
+	keyGen, _ := suite.keeper.GetTSSKeyGeneration(suite.ctx, keyGenID)
+	suite.Require().Equal(uint64(4), keyGen.Threshold)
+
+	// The last validator never deals
+	absent := validators[6]
+	dealers := suite.dealAll(keyGenID, validators[:6])
+	keyGen = suite.closeKeyGenRound(keyGenID)
+	suite.Require().Equal(types.TSSKeyGenPhaseComplaint, keyGen.Phase)
+	suite.Require().True(keyGen.IsBlamed(absent.String()))
+
+	accuser := validators[0]
+	honest, cheat, silent := validators[1], validators[2], validators[4]
+
+	// Only dealers can complain, and only about other dealers
+	err = suite.keeper.FileTSSComplaint(suite.ctx, absent, keyGenID, honest.String())
+	suite.Require().ErrorIs(err, types.ErrInvalidTSSComplaint)
+	err = suite.keeper.FileTSSComplaint(suite.ctx, accuser, keyGenID, absent.String())
+	suite.Require().ErrorIs(err, types.ErrInvalidTSSComplaint)
+
+	for _, dealer := range []sdk.AccAddress{honest, cheat, silent} {
+		suite.Require().NoError(suite.keeper.FileTSSComplaint(suite.ctx, accuser, keyGenID, dealer.String()))
+	}
+	err = suite.keeper.FileTSSComplaint(suite.ctx, accuser, keyGenID, honest.String())
+	suite.Require().ErrorIs(err, types.ErrInvalidTSSComplaint)
+
+	keyGen = suite.closeKeyGenRound(keyGenID)
+	suite.Require().Equal(types.TSSKeyGenPhaseJustification, keyGen.Phase)
+	accuserIndex := keyGen.ParticipantIndex(accuser.String())
+
+	// The honest dealer's revealed share matches its commitments
+	accepted, err := suite.keeper.SubmitTSSJustification(suite.ctx, honest, keyGenID, accuser.String(), dealers[honest.String()].share(accuserIndex))
+	suite.Require().NoError(err)
+	suite.Require().True(accepted)
+
+	// A share that does not match proves the dealer cheated
+	accepted, err = suite.keeper.SubmitTSSJustification(suite.ctx, cheat, keyGenID, accuser.String(), dealers[cheat.String()].share(accuserIndex+1))
+	suite.Require().NoError(err)
+	suite.Require().False(accepted)
+	suite.Require().Contains(suite.stakingKeeper.slashed, cheat.String())
+
+	_, err = suite.keeper.SubmitTSSJustification(suite.ctx, cheat, keyGenID, accuser.String(), dealers[cheat.String()].share(accuserIndex))
+	suite.Require().ErrorIs(err, types.ErrInvalidTSSComplaint)
+
+	// The silent dealer is blamed when the round closes
+	keyGen = suite.closeKeyGenRound(keyGenID)
+	suite.Require().Equal(types.TSSSessionStatusCompleted, keyGen.Status)
+	suite.Require().Contains(suite.stakingKeeper.slashed, silent.String())
+	suite.Require().NotContains(suite.stakingKeeper.slashed, absent.String())
+	suite.Require().NotContains(suite.stakingKeeper.slashed, honest.String())
+	suite.Require().Len(keyGen.Blamed, 3)
+
+	key, found := suite.keeper.GetTSSKey(suite.ctx, "ethereum-1")
+	suite.Require().True(found)
+	suite.Require().Len(key.Shares, 4)
+	for _, excluded := range []sdk.AccAddress{absent, cheat, silent} {
+		_, holds := key.GetShare(excluded.String())
+		suite.Require().False(holds)
+	}
+
+	chain, _ := suite.keeper.GetExternalChain(suite.ctx, "ethereum-1")
+	suite.Require().Equal(keyGen.Address, chain.TSSAddress)
+
+	// The qualified dealers' shares sign for the key
+	signers := signerSetFromDealers(key, validators, qualifiedDealers(key, dealers))
+	withdrawalID := suite.readyWithdrawal("ethereum-1")
+	sessionID, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
+	suite.Require().NoError(err)
+
+	signing := []sdk.AccAddress{validators[0], validators[1], validators[3], validators[5]}
+	suite.commitSigners(signers, sessionID, signing)
+	suite.Require().True(suite.signShares(signers, sessionID, signing))
+}
+
+// TestTSSKeyRotation tests rotating a key when the validator set changes and
+// migrating custody to the new address
+func (suite *KeeperTestSuite) TestTSSKeyRotation() {
+	validators := testValidators(5)
+	oldSigners := suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators[:3], 2)
+	oldKey, _ := suite.keeper.GetTSSKey(suite.ctx, "ethereum-1")
+	oldChain, _ := suite.keeper.GetExternalChain(suite.ctx, "ethereum-1")
+
+	// An unchanged validator set keeps the key
+	suite.keeper.CheckTSSKeyRotations(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetAllTSSKeyGenerations(suite.ctx))
+
+	// A withdrawal is being signed under the old key when the set changes
+	withdrawalID := suite.readyWithdrawal("ethereum-1")
+	withdrawalSession, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
+	suite.Require().NoError(err)
+
+	suite.addEligibleValidators(validators[3:])
+	suite.keeper.CheckTSSKeyRotations(suite.ctx)
+
+	keyGens := suite.keeper.GetAllTSSKeyGenerations(suite.ctx)
+	suite.Require().Len(keyGens, 1)
+	keyGenID := keyGens[0].ID
+	suite.Require().True(keyGens[0].Rotation)
+	suite.Require().Len(keyGens[0].Participants, 5)
+
+	// A running ceremony is not restarted
+	suite.keeper.CheckTSSKeyRotations(suite.ctx)
+	suite.Require().Len(suite.keeper.GetAllTSSKeyGenerations(suite.ctx), 1)
+
+	dealers := suite.dealAll(keyGenID, validators)
+	keyGen := suite.closeKeyGenRound(keyGenID)
+	suite.Require().Equal(types.TSSSessionStatusCompleted, keyGen.Status)
+
+	// The old key stays active until the migration is signed
+	key, _ := suite.keeper.GetTSSKey(suite.ctx, "ethereum-1")
+	suite.Require().Equal(oldKey.PublicKey, key.PublicKey)
+
+	migration, found := suite.keeper.GetTSSKeyMigration(suite.ctx, "ethereum-1")
+	suite.Require().True(found)
+	suite.Require().Equal(types.TSSMigrationStatusPending, migration.Status)
+	suite.Require().Equal(oldChain.TSSAddress, migration.OldAddress)
+	suite.Require().Equal(keyGen.Address, migration.NewAddress)
+	suite.Require().NotEqual(migration.OldAddress, migration.NewAddress)
+
+	// No new withdrawal sessions while the migration is pending
+	_, err = suite.keeper.StartTSSKeyGeneration(suite.ctx, "ethereum-1")
+	suite.Require().ErrorIs(err, types.ErrTSSMigrationPending)
+	pendingWithdrawal := suite.readyWithdrawal("ethereum-1")
+	_, err = suite.keeper.CreateTSSSession(suite.ctx, pendingWithdrawal)
+	suite.Require().ErrorIs(err, types.ErrTSSMigrationPending)
+
+	// The sweep waits for the in-flight withdrawal under the old key
+	withdrawalCtx := suite.ctx
+	suite.ctx = withdrawalCtx.WithBlockTime(keyGen.CompletedAt.Add(1 * time.Second))
+	suite.keeper.ProcessTSSKeyMigrations(suite.ctx)
+	migration, _ = suite.keeper.GetTSSKeyMigration(suite.ctx, "ethereum-1")
+	suite.Require().Equal(types.TSSMigrationStatusPending, migration.Status)
+	suite.Require().Nil(migration.SettledAt)
+
+	suite.commitSigners(oldSigners, withdrawalSession, validators[:2])
+	suite.Require().True(suite.signShares(oldSigners, withdrawalSession, validators[:2]))
+
+	// The sweep is built from the old address's balance attested after that
+	suite.keeper.ProcessTSSKeyMigrations(suite.ctx)
+	migration, _ = suite.keeper.GetTSSKeyMigration(suite.ctx, "ethereum-1")
+	suite.Require().Equal(types.TSSMigrationStatusPending, migration.Status)
+	suite.Require().NotNil(migration.SettledAt)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(1 * time.Second))
+	snapshot := suite.attestReserves(validators, "ethereum-1", "USDT", 100, 7_000_000)
+	suite.Require().Equal(oldChain.TSSAddress, snapshot.TSSAddress)
+
+	suite.keeper.ProcessTSSKeyMigrations(suite.ctx)
+	migration, _ = suite.keeper.GetTSSKeyMigration(suite.ctx, "ethereum-1")
+	suite.Require().Equal(types.TSSMigrationStatusSigning, migration.Status)
+	suite.Require().Len(migration.Sweeps, 1)
+	sweep := migration.Sweeps[0]
+	suite.Require().Equal(math.NewInt(7_000_000), sweep.Amount)
+	suite.Require().Contains(hex.EncodeToString(sweep.Tx.Raw), strings.ToLower(migration.NewAddress[2:]))
+	suite.Require().Equal([]types.TSSMigrationTarget{{AssetSymbol: "USDT", Balance: math.NewInt(7_000_000)}}, migration.Targets)
+	suite.Require().NotNil(sweep.TSSSessionID)
+	firstSession := *sweep.TSSSessionID
+	session, _ := suite.keeper.GetTSSSession(suite.ctx, firstSession)
+	suite.Require().Equal(sweep.Tx.Digest, session.Message)
+
+	// A timed-out sweep session is replaced
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(2 * time.Hour))
+	suite.keeper.ProcessTSSKeyMigrations(suite.ctx)
+	migration, _ = suite.keeper.GetTSSKeyMigration(suite.ctx, "ethereum-1")
+	suite.Require().NotNil(migration.Sweeps[0].TSSSessionID)
+	suite.Require().NotEqual(firstSession, *migration.Sweeps[0].TSSSessionID)
+	timedOut, _ := suite.keeper.GetTSSSession(suite.ctx, firstSession)
+	suite.Require().Equal(types.TSSSessionStatusTimeout, timedOut.Status)
+
+	// The old key holders sign the sweep transaction
+	sweepSession := *migration.Sweeps[0].TSSSessionID
+	suite.commitSigners(oldSigners, sweepSession, validators[1:3])
+	suite.Require().True(suite.signShares(oldSigners, sweepSession, validators[1:3]))
+
+	migration, _ = suite.keeper.GetTSSKeyMigration(suite.ctx, "ethereum-1")
+	sweep = migration.Sweeps[0]
+	suite.Require().True(sweep.IsSigned())
+	suite.Require().NoError(types.VerifyTSSSignature(oldKey.Scheme, oldKey.PublicKey, sweep.Tx.Digest, sweep.Signature))
+
+	// The old key stays live until reserves at the new address are attested
+	suite.keeper.ProcessTSSKeyMigrations(suite.ctx)
+	migration, _ = suite.keeper.GetTSSKeyMigration(suite.ctx, "ethereum-1")
+	suite.Require().Equal(types.TSSMigrationStatusAttesting, migration.Status)
+
+	interval := suite.keeper.GetParams(suite.ctx).ReserveAttestationIntervalDuration()
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(interval))
+	snapshot = suite.attestReserves(validators, "ethereum-1", "USDT", 200, 0)
+	suite.Require().Equal(migration.NewAddress, snapshot.TSSAddress)
+	suite.keeper.ProcessTSSKeyMigrations(suite.ctx)
+	migration, _ = suite.keeper.GetTSSKeyMigration(suite.ctx, "ethereum-1")
+	suite.Require().Equal(types.TSSMigrationStatusAttesting, migration.Status)
+	key, _ = suite.keeper.GetTSSKey(suite.ctx, "ethereum-1")
+	suite.Require().Equal(oldKey.PublicKey, key.PublicKey)
+	chain, _ := suite.keeper.GetExternalChain(suite.ctx, "ethereum-1")
+	suite.Require().Equal(oldChain.TSSAddress, chain.TSSAddress)
+
+	// Once the sweep has landed the new key takes over
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(interval))
+	suite.attestReserves(validators, "ethereum-1", "USDT", 300, 7_000_000)
+	suite.keeper.ProcessTSSKeyMigrations(suite.ctx)
+	migration, _ = suite.keeper.GetTSSKeyMigration(suite.ctx, "ethereum-1")
+	suite.Require().Equal(types.TSSMigrationStatusCompleted, migration.Status)
+
+	key, _ = suite.keeper.GetTSSKey(suite.ctx, "ethereum-1")
+	suite.Require().Equal(keyGen.PublicKey, key.PublicKey)
+	suite.Require().Len(key.Shares, 5)
+	chain, _ = suite.keeper.GetExternalChain(suite.ctx, "ethereum-1")
+	suite.Require().Equal(migration.NewAddress, chain.TSSAddress)
+
+	// Held-back withdrawals are signed by the new key holders, old and new
+	newSigners := signerSetFromDealers(key, validators, qualifiedDealers(key, dealers))
+	sessionID, err := suite.keeper.CreateTSSSession(suite.ctx, pendingWithdrawal)
+	suite.Require().NoError(err)
+	signing := []sdk.AccAddress{validators[0], validators[3], validators[4]}
+	suite.commitSigners(newSigners, sessionID, signing)
+	suite.Require().True(suite.signShares(newSigners, sessionID, signing))
+}
+
+// TestTSSKeyRotationUTXO tests that a UTXO chain's custody outputs are each
+// swept to the rotated key's address, and that chains no sweep can be built
+// for are not rotated
+func (suite *KeeperTestSuite) TestTSSKeyRotationUTXO() {
+	validators := testValidators(4)
+	oldSigners := suite.setupTSSChain("bitcoin-1", "utxo", types.TSSSchemeECDSA, validators[:3], 2)
+	oldKey, _ := suite.keeper.GetTSSKey(suite.ctx, "bitcoin-1")
+	chain, _ := suite.keeper.GetExternalChain(suite.ctx, "bitcoin-1")
+	chain.FeeRate = math.NewInt(10)
+	suite.keeper.SetExternalChain(suite.ctx, chain)
+
+	funded := types.CustodyOutput{ChainID: "bitcoin-1", AssetSymbol: "USDT", TxID: strings.Repeat("ab", 32), Vout: 0, Amount: math.NewInt(100_000), Address: chain.TSSAddress}
+	dust := types.CustodyOutput{ChainID: "bitcoin-1", AssetSymbol: "USDT", TxID: strings.Repeat("cd", 32), Vout: 1, Amount: math.NewInt(600), Address: chain.TSSAddress}
+	suite.keeper.SetCustodyOutput(suite.ctx, funded)
+	suite.keeper.SetCustodyOutput(suite.ctx, dust)
+
+	suite.addEligibleValidators(validators[3:])
+	keyGenID, err := suite.keeper.StartTSSKeyGeneration(suite.ctx, "bitcoin-1")
+	suite.Require().NoError(err)
+	suite.dealAll(keyGenID, validators)
+	keyGen := suite.closeKeyGenRound(keyGenID)
+	suite.Require().Equal(types.TSSSessionStatusCompleted, keyGen.Status)
+
+	// Custody is known output by output, so the sweeps are built at once; an
+	// output that cannot cover its own fee is left behind
+	suite.keeper.ProcessTSSKeyMigrations(suite.ctx)
+	migration, _ := suite.keeper.GetTSSKeyMigration(suite.ctx, "bitcoin-1")
+	suite.Require().Equal(types.TSSMigrationStatusSigning, migration.Status)
+	suite.Require().Len(migration.Sweeps, 1)
+	sweep := migration.Sweeps[0]
+	suite.Require().Equal(funded, sweep.Tx.Inputs[0])
+	suite.Require().Nil(sweep.Tx.Change)
+	suite.Require().Equal(funded.Amount.Sub(sweep.Tx.NetworkFee), sweep.Amount)
+	suite.Require().Equal([]types.CustodyOutput{dust}, suite.keeper.GetCustodyOutputs(suite.ctx, "bitcoin-1"))
+
+	sessionID := *sweep.TSSSessionID
+	suite.commitSigners(oldSigners, sessionID, validators[:2])
+	suite.Require().True(suite.signShares(oldSigners, sessionID, validators[:2]))
+
+	// The swept output is custodied at the new address
+	swept := types.CustodyOutput{ChainID: "bitcoin-1", AssetSymbol: "USDT", TxID: types.UTXOTxID(sweep.Tx.Raw), Vout: 0, Amount: sweep.Amount, Address: migration.NewAddress}
+	suite.Require().ElementsMatch([]types.CustodyOutput{dust, swept}, suite.keeper.GetCustodyOutputs(suite.ctx, "bitcoin-1"))
+
+	suite.keeper.ProcessTSSKeyMigrations(suite.ctx)
+	suite.attestReserves(validators, "bitcoin-1", "USDT", 100, sweep.Amount.Int64())
+	suite.keeper.ProcessTSSKeyMigrations(suite.ctx)
+	migration, _ = suite.keeper.GetTSSKeyMigration(suite.ctx, "bitcoin-1")
+	suite.Require().Equal(types.TSSMigrationStatusCompleted, migration.Status)
+	chain, _ = suite.keeper.GetExternalChain(suite.ctx, "bitcoin-1")
+	suite.Require().Equal(migration.NewAddress, chain.TSSAddress)
+	key, _ := suite.keeper.GetTSSKey(suite.ctx, "bitcoin-1")
+	suite.Require().NotEqual(oldKey.PublicKey, key.PublicKey)
+
+	// Ed25519 custody cannot be swept yet, so those keys are not rotated
+	suite.setupTSSChain("solana-1", "solana", types.TSSSchemeEdDSA, validators[:3], 2)
+	_, err = suite.keeper.StartTSSKeyGeneration(suite.ctx, "solana-1")
+	suite.Require().ErrorIs(err, types.ErrChainNotSupported)
+}
@@ -0,0 +1,425 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/types"
+)
+
+// GetTSSKeyMigration retrieves a chain's latest custody migration
+func (k Keeper) GetTSSKeyMigration(ctx sdk.Context, chainID string) (types.TSSKeyMigration, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TSSKeyMigrationKey(chainID))
+	if bz == nil {
+		return types.TSSKeyMigration{}, false
+	}
+
+	var migration types.TSSKeyMigration
+	if err := json.Unmarshal(bz, &migration); err != nil {
+		return types.TSSKeyMigration{}, false
+	}
+	return migration, true
+}
+
+// SetTSSKeyMigration stores a chain's custody migration
+func (k Keeper) SetTSSKeyMigration(ctx sdk.Context, migration types.TSSKeyMigration) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(migration)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal TSS key migration", "error", err)
+		return
+	}
+	store.Set(types.TSSKeyMigrationKey(migration.ChainID), bz)
+}
+
+// GetAllTSSKeyMigrations returns every chain's latest custody migration
+func (k Keeper) GetAllTSSKeyMigrations(ctx sdk.Context) []types.TSSKeyMigration {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.TSSKeyMigrationPrefix)
+	defer iterator.Close()
+
+	migrations := []types.TSSKeyMigration{}
+	for ; iterator.Valid(); iterator.Next() {
+		var migration types.TSSKeyMigration
+		if err := json.Unmarshal(iterator.Value(), &migration); err != nil {
+			continue
+		}
+		migrations = append(migrations, migration)
+	}
+	return migrations
+}
+
+// ProcessTSSKeyMigrations drives pending custody migrations (runs in EndBlock).
+// Once no withdrawal is being signed under the old key, a sweep of each asset
+// to the new address is built from the old address's custody; the old key's
+// holders sign the sweeps one session at a time, and the new key takes over
+// once a reserve snapshot of the new address attests that they landed.
+func (k Keeper) ProcessTSSKeyMigrations(ctx sdk.Context) {
+	for _, migration := range k.GetAllTSSKeyMigrations(ctx) {
+		if !migration.IsPending() {
+			continue
+		}
+
+		if migration.Status == types.TSSMigrationStatusPending {
+			k.prepareTSSMigration(ctx, &migration)
+		}
+		if migration.Status == types.TSSMigrationStatusSigning {
+			k.signTSSMigration(ctx, &migration)
+		}
+		if migration.Status == types.TSSMigrationStatusAttesting {
+			k.attestTSSMigration(ctx, &migration)
+		}
+		k.SetTSSKeyMigration(ctx, migration)
+	}
+}
+
+// prepareTSSMigration builds a migration's sweeps once the old address's
+// custody is known: EVM balances must be attested after the last withdrawal
+// under the old key was signed, while UTXO custody is tracked output by output
+func (k Keeper) prepareTSSMigration(ctx sdk.Context, migration *types.TSSKeyMigration) {
+	if k.hasOpenWithdrawalSession(ctx, migration.ChainID) {
+		migration.SettledAt = nil
+		return
+	}
+	if migration.SettledAt == nil {
+		now := ctx.BlockTime()
+		migration.SettledAt = &now
+	}
+
+	chain, found := k.GetExternalChain(ctx, migration.ChainID)
+	if !found {
+		return
+	}
+	sweeps, ready, err := k.buildMigrationSweeps(ctx, chain, *migration)
+	if err != nil {
+		k.Logger(ctx).Error("failed to build TSS migration sweeps", "chain_id", migration.ChainID, "error", err)
+		return
+	}
+	if !ready {
+		return
+	}
+
+	// The new address must end up holding what it already held plus the sweeps
+	targets := []types.TSSMigrationTarget{}
+	for _, sweep := range sweeps {
+		i := len(targets) - 1
+		if i < 0 || targets[i].AssetSymbol != sweep.AssetSymbol {
+			held := math.ZeroInt()
+			if snapshot, found := k.GetLatestReserveSnapshot(ctx, chain.ChainID, sweep.AssetSymbol); found && snapshot.TSSAddress == migration.NewAddress {
+				held = snapshot.Balance
+			}
+			targets = append(targets, types.TSSMigrationTarget{AssetSymbol: sweep.AssetSymbol, Balance: held})
+			i++
+		}
+		targets[i].Balance = targets[i].Balance.Add(sweep.Amount)
+	}
+
+	migration.Sweeps = sweeps
+	migration.Targets = targets
+	migration.Status = types.TSSMigrationStatusSigning
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_migration_sweeps_built",
+			sdk.NewAttribute("chain_id", migration.ChainID),
+			sdk.NewAttribute("old_address", migration.OldAddress),
+			sdk.NewAttribute("new_address", migration.NewAddress),
+			sdk.NewAttribute("sweeps", fmt.Sprintf("%d", len(sweeps))),
+		),
+	)
+}
+
+// buildMigrationSweeps builds the transactions moving a chain's custody from
+// the old address to the new one, grouped by asset. It reports not ready while
+// an EVM asset's balance at the old address has not been attested since the
+// migration settled.
+func (k Keeper) buildMigrationSweeps(ctx sdk.Context, chain types.ExternalChain, migration types.TSSKeyMigration) ([]types.TSSMigrationSweep, bool, error) {
+	switch chain.ChainType {
+	case "evm":
+		var tokens []types.ExternalAsset
+		var native *types.ExternalAsset
+		balances := make(map[string]math.Int)
+		for _, asset := range k.GetAllExternalAssets(ctx) {
+			if asset.ChainID != chain.ChainID {
+				continue
+			}
+			snapshot, found := k.GetLatestReserveSnapshot(ctx, chain.ChainID, asset.AssetSymbol)
+			if !found || snapshot.TSSAddress != migration.OldAddress || !snapshot.AttestedAt.After(*migration.SettledAt) {
+				return nil, false, nil
+			}
+			if !snapshot.Balance.IsPositive() {
+				continue
+			}
+			balances[asset.AssetSymbol] = snapshot.Balance
+			if asset.ContractAddress == "" {
+				nativeAsset := asset
+				native = &nativeAsset
+			} else {
+				tokens = append(tokens, asset)
+			}
+		}
+
+		// Tokens go first; the native sweep is what is left after every
+		// sweep's gas
+		type transfer struct {
+			asset  types.ExternalAsset
+			amount math.Int
+		}
+		var transfers []transfer
+		for _, asset := range tokens {
+			transfers = append(transfers, transfer{asset, balances[asset.AssetSymbol]})
+		}
+		if native != nil {
+			gas := chain.FeeRate.MulRaw(int64(chain.GasLimit)).MulRaw(int64(len(transfers) + 1))
+			if value := balances[native.AssetSymbol].Sub(gas); value.IsPositive() {
+				transfers = append(transfers, transfer{*native, value})
+			}
+		}
+
+		sweeps := make([]types.TSSMigrationSweep, 0, len(transfers))
+		for _, t := range transfers {
+			nonce := k.allocateExternalNonce(ctx, chain.ChainID)
+			tx, err := types.BuildEVMTransfer(chain, t.asset, nonce, migration.NewAddress, t.amount)
+			if err != nil {
+				k.releaseExternalNonce(ctx, chain.ChainID, nonce)
+				for _, sweep := range sweeps {
+					k.releaseExternalTx(ctx, chain.ChainID, sweep.Tx)
+				}
+				return nil, false, types.ErrExternalTxBuild.Wrap(err.Error())
+			}
+			sweeps = append(sweeps, types.TSSMigrationSweep{AssetSymbol: t.asset.AssetSymbol, Amount: t.amount, Tx: tx})
+		}
+		return sweeps, true, nil
+	case "utxo":
+		outputs := k.GetCustodyOutputs(ctx, chain.ChainID)
+		sort.SliceStable(outputs, func(i, j int) bool { return outputs[i].AssetSymbol < outputs[j].AssetSymbol })
+
+		sweeps := []types.TSSMigrationSweep{}
+		for _, output := range outputs {
+			if output.Address != migration.OldAddress {
+				continue
+			}
+			// Outputs that cannot cover their own fee are left behind
+			tx, err := types.BuildUTXOTransfer(chain, output, migration.NewAddress, output.Amount)
+			if err != nil {
+				k.Logger(ctx).Info("custody output not swept", "chain_id", chain.ChainID, "outpoint", output.Outpoint(), "error", err)
+				continue
+			}
+			k.DeleteCustodyOutput(ctx, output)
+			sweeps = append(sweeps, types.TSSMigrationSweep{
+				AssetSymbol: output.AssetSymbol,
+				Amount:      output.Amount.Sub(tx.NetworkFee),
+				Tx:          tx,
+			})
+		}
+		return sweeps, true, nil
+	default:
+		return nil, false, types.ErrChainNotSupported.Wrapf("no transaction builder for %s chains", chain.ChainType)
+	}
+}
+
+// signTSSMigration opens a session for the next unsigned sweep, replacing a
+// session that timed out, and moves the migration on to attestation once
+// every sweep is signed
+func (k Keeper) signTSSMigration(ctx sdk.Context, migration *types.TSSKeyMigration) {
+	i, found := migration.NextSweep()
+	if !found {
+		now := ctx.BlockTime()
+		migration.Status = types.TSSMigrationStatusAttesting
+		migration.SignedAt = &now
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"extbridge_tss_migration_signed",
+				sdk.NewAttribute("chain_id", migration.ChainID),
+				sdk.NewAttribute("new_address", migration.NewAddress),
+				sdk.NewAttribute("sweeps", fmt.Sprintf("%d", len(migration.Sweeps))),
+			),
+		)
+		return
+	}
+
+	sweep := &migration.Sweeps[i]
+	if sweep.TSSSessionID != nil {
+		session, found := k.GetTSSSession(ctx, *sweep.TSSSessionID)
+		if found && session.CanAddSignature() && !session.IsTimeout(ctx.BlockTime()) {
+			return
+		}
+		if found && session.CanAddSignature() {
+			session.Status = types.TSSSessionStatusTimeout
+			if err := k.SetTSSSession(ctx, session); err != nil {
+				k.Logger(ctx).Error("failed to update TSS session on timeout", "error", err)
+			}
+		}
+		sweep.TSSSessionID = nil
+	}
+
+	if err := k.createTSSMigrationSession(ctx, migration, i); err != nil {
+		k.Logger(ctx).Error("failed to create TSS migration session", "chain_id", migration.ChainID, "error", err)
+	}
+}
+
+// createTSSMigrationSession opens a session for the current key's holders to
+// sign one of a migration's sweeps
+func (k Keeper) createTSSMigrationSession(ctx sdk.Context, migration *types.TSSKeyMigration, sweepIndex int) error {
+	key, found := k.GetTSSKey(ctx, migration.ChainID)
+	if !found {
+		return types.ErrTSSKeyNotFound
+	}
+
+	participants, err := k.eligibleTSSSigners(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	sweep := &migration.Sweeps[sweepIndex]
+	sessionID := k.GetNextTSSSessionID(ctx)
+	session := types.NewTSSSession(
+		sessionID,
+		0,
+		migration.ChainID,
+		key.Scheme,
+		participants,
+		key.Threshold,
+		1*time.Hour, // 1 hour timeout
+		sweep.Tx.Digest,
+		ctx.BlockTime(),
+	)
+	session.Kind = types.TSSSessionKindMigration
+
+	if err := k.SetTSSSession(ctx, session); err != nil {
+		return err
+	}
+	sweep.TSSSessionID = &sessionID
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_migration_session_created",
+			sdk.NewAttribute("session_id", fmt.Sprintf("%d", sessionID)),
+			sdk.NewAttribute("chain_id", migration.ChainID),
+			sdk.NewAttribute("asset", sweep.AssetSymbol),
+			sdk.NewAttribute("amount", sweep.Amount.String()),
+			sdk.NewAttribute("old_address", migration.OldAddress),
+			sdk.NewAttribute("new_address", migration.NewAddress),
+		),
+	)
+
+	return nil
+}
+
+// completeTSSMigrationSweep records the old key's signature on a sweep. UTXO
+// sweeps add their output to the custody set at the new address, where it is
+// spent once the new key takes over.
+func (k Keeper) completeTSSMigrationSweep(ctx sdk.Context, session types.TSSSession) {
+	migration, found := k.GetTSSKeyMigration(ctx, session.ChainID)
+	if !found {
+		k.Logger(ctx).Error("completed TSS migration session has no pending migration", "session_id", session.ID)
+		return
+	}
+	i, found := migration.SweepForSession(session.ID)
+	if !found {
+		k.Logger(ctx).Error("completed TSS migration session has no pending sweep", "session_id", session.ID)
+		return
+	}
+
+	sweep := &migration.Sweeps[i]
+	sweep.Signature = session.CombinedSignature
+	if len(sweep.Tx.Inputs) > 0 {
+		k.SetCustodyOutput(ctx, types.CustodyOutput{
+			ChainID:     migration.ChainID,
+			AssetSymbol: sweep.AssetSymbol,
+			TxID:        types.UTXOTxID(sweep.Tx.Raw),
+			Vout:        0,
+			Amount:      sweep.Amount,
+			Address:     migration.NewAddress,
+		})
+	}
+	k.SetTSSKeyMigration(ctx, migration)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_migration_sweep_signed",
+			sdk.NewAttribute("chain_id", migration.ChainID),
+			sdk.NewAttribute("session_id", fmt.Sprintf("%d", session.ID)),
+			sdk.NewAttribute("asset", sweep.AssetSymbol),
+			sdk.NewAttribute("amount", sweep.Amount.String()),
+		),
+	)
+}
+
+// resetTSSMigration frees a sweep whose session failed so a new session can
+// sign it
+func (k Keeper) resetTSSMigration(ctx sdk.Context, session types.TSSSession) {
+	migration, found := k.GetTSSKeyMigration(ctx, session.ChainID)
+	if !found {
+		return
+	}
+	i, found := migration.SweepForSession(session.ID)
+	if !found {
+		return
+	}
+	migration.Sweeps[i].TSSSessionID = nil
+	k.SetTSSKeyMigration(ctx, migration)
+}
+
+// attestTSSMigration installs the rotated key once the latest reserve
+// snapshot of every swept asset attests the new address holds its target.
+// Until then the old key stays live and the signed sweeps can be rebroadcast.
+func (k Keeper) attestTSSMigration(ctx sdk.Context, migration *types.TSSKeyMigration) {
+	for _, target := range migration.Targets {
+		snapshot, found := k.GetLatestReserveSnapshot(ctx, migration.ChainID, target.AssetSymbol)
+		if !found || snapshot.TSSAddress != migration.NewAddress || snapshot.Balance.LT(target.Balance) {
+			return
+		}
+	}
+
+	now := ctx.BlockTime()
+	migration.Status = types.TSSMigrationStatusCompleted
+	migration.CompletedAt = &now
+
+	key := migration.NewKey
+	key.RegisteredAt = now
+	k.SetTSSKey(ctx, key)
+
+	if chain, found := k.GetExternalChain(ctx, migration.ChainID); found {
+		chain.TSSAddress = migration.NewAddress
+		k.SetExternalChain(ctx, chain)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"extbridge_tss_migration_completed",
+			sdk.NewAttribute("chain_id", migration.ChainID),
+			sdk.NewAttribute("old_address", migration.OldAddress),
+			sdk.NewAttribute("new_address", migration.NewAddress),
+		),
+	)
+}
+
+// reserveAddress returns the address whose reserves validators attest: the
+// new address of a migration whose sweeps are signed, else the TSS address
+func (k Keeper) reserveAddress(ctx sdk.Context, chain types.ExternalChain) string {
+	if migration, found := k.GetTSSKeyMigration(ctx, chain.ChainID); found && migration.Status == types.TSSMigrationStatusAttesting {
+		return migration.NewAddress
+	}
+	return chain.TSSAddress
+}
+
+// hasOpenWithdrawalSession returns whether a withdrawal on a chain is still
+// being signed
+func (k Keeper) hasOpenWithdrawalSession(ctx sdk.Context, chainID string) bool {
+	for _, session := range k.getOpenTSSSessions(ctx, ctx.BlockTime(), false) {
+		if session.ChainID == chainID && session.Kind == types.TSSSessionKindWithdrawal &&
+			session.CanAddSignature() && !session.IsTimeout(ctx.BlockTime()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,395 @@
+package keeper_test
+
+import (
+	"crypto/rand"
+
+	"filippo.io/edwards25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/types"
+)
+
+// localSignerSet is an in-process set of TSS key holders for tests. A trusted
+// dealer splits the key and, for ECDSA, deals each session's presignature in
+// place of the off-chain presigning protocol; the chain only sees what real
+// signers would publish.
+type localSignerSet struct {
+	key types.TSSKey
+
+	ecdsaKey    secp256k1.ModNScalar
+	ecdsaShares map[string]secp256k1.ModNScalar
+	presigns    map[uint64]map[string][2]secp256k1.ModNScalar // session -> signer -> k_i, χ_i
+
+	eddsaShares map[string]*edwards25519.Scalar
+	nonces      map[uint64]map[string][2]*edwards25519.Scalar // session -> signer -> d_i, e_i
+}
+
+func newLocalSignerSet(chainID, scheme string, holders []sdk.AccAddress, threshold uint64) *localSignerSet {
+	set := &localSignerSet{
+		key: types.TSSKey{
+			ChainID:   chainID,
+			Scheme:    scheme,
+			Threshold: threshold,
+		},
+		ecdsaShares: make(map[string]secp256k1.ModNScalar),
+		presigns:    make(map[uint64]map[string][2]secp256k1.ModNScalar),
+		eddsaShares: make(map[string]*edwards25519.Scalar),
+		nonces:      make(map[uint64]map[string][2]*edwards25519.Scalar),
+	}
+
+	switch scheme {
+	case types.TSSSchemeECDSA:
+		coefficients := make([]secp256k1.ModNScalar, threshold)
+		for i := range coefficients {
+			coefficients[i] = randomSecpScalar()
+		}
+		set.ecdsaKey = coefficients[0]
+		set.key.PublicKey = secpBaseMult(&set.ecdsaKey)
+
+		for i, holder := range holders {
+			index := uint32(i + 1)
+			var x, share secp256k1.ModNScalar
+			x.SetInt(index)
+			for j := len(coefficients) - 1; j >= 0; j-- {
+				share.Mul(&x).Add(&coefficients[j])
+			}
+			set.ecdsaShares[holder.String()] = share
+			set.key.Shares = append(set.key.Shares, types.TSSKeyShare{
+				Validator:   holder.String(),
+				Index:       index,
+				PublicShare: secpBaseMult(&share),
+			})
+		}
+	case types.TSSSchemeEdDSA:
+		coefficients := make([]*edwards25519.Scalar, threshold)
+		for i := range coefficients {
+			coefficients[i] = randomEdScalar()
+		}
+		set.key.PublicKey = new(edwards25519.Point).ScalarBaseMult(coefficients[0]).Bytes()
+
+		for i, holder := range holders {
+			index := uint32(i + 1)
+			x := edScalar(index)
+			share := edwards25519.NewScalar()
+			for j := len(coefficients) - 1; j >= 0; j-- {
+				share.MultiplyAdd(share, x, coefficients[j])
+			}
+			set.eddsaShares[holder.String()] = share
+			set.key.Shares = append(set.key.Shares, types.TSSKeyShare{
+				Validator:   holder.String(),
+				Index:       index,
+				PublicShare: new(edwards25519.Point).ScalarBaseMult(share).Bytes(),
+			})
+		}
+	}
+
+	return set
+}
+
+// address returns the chain address the set's group key controls
+func (s *localSignerSet) address(chainType string) string {
+	address, err := types.TSSAddressForKey(chainType, s.key.Scheme, s.key.PublicKey, "bc")
+	if err != nil {
+		panic(err)
+	}
+	return address
+}
+
+// commitments returns each signer's commitment-round message for a session
+func (s *localSignerSet) commitments(sessionID uint64, signers []sdk.AccAddress) map[string][]byte {
+	out := make(map[string][]byte, len(signers))
+
+	switch s.key.Scheme {
+	case types.TSSSchemeECDSA:
+		// Deal R = k⁻¹·G with k split additively among the signers, and k·x
+		// as χ_i = λ_i·x_i·k_i + δ_i with the cross terms split into the δ_i
+		k := randomSecpScalar()
+		var kInv, kx secp256k1.ModNScalar
+		kInv.Set(&k).InverseNonConst()
+		kx.Mul2(&k, &s.ecdsaKey)
+		nonce := secpBaseMultPoint(&kInv)
+
+		kShares := splitSecpScalar(k, len(signers))
+		indices := make([]uint32, len(signers))
+		for i, signer := range signers {
+			share, _ := s.key.GetShare(signer.String())
+			indices[i] = share.Index
+		}
+		diagonal := make([]secp256k1.ModNScalar, len(signers))
+		crossTerms := kx
+		for i, signer := range signers {
+			x := s.ecdsaShares[signer.String()]
+			lambda := secpLagrange(indices[i], indices)
+			diagonal[i].Mul2(&lambda, &x).Mul(&kShares[i])
+			var negated secp256k1.ModNScalar
+			negated.NegateVal(&diagonal[i])
+			crossTerms.Add(&negated)
+		}
+		deltaShares := splitSecpScalar(crossTerms, len(signers))
+
+		s.presigns[sessionID] = make(map[string][2]secp256k1.ModNScalar)
+		for i, signer := range signers {
+			var chi secp256k1.ModNScalar
+			chi.Add2(&diagonal[i], &deltaShares[i])
+			s.presigns[sessionID][signer.String()] = [2]secp256k1.ModNScalar{kShares[i], chi}
+
+			// P_i = x_i·K_i with a proof that x_i is the registered share's
+			x := s.ecdsaShares[signer.String()]
+			var nonceShare secp256k1.JacobianPoint
+			secp256k1.ScalarMultNonConst(&kShares[i], nonce, &nonceShare)
+			proofNonce := randomSecpScalar()
+
+			data := secpPointBytes(nonce)
+			data = append(data, secpPointBytes(&nonceShare)...)
+			data = append(data, secpMultBytes(&x, &nonceShare)...)
+			data = append(data, secpMultBytes(&deltaShares[i], nonce)...)
+			data = append(data, secpBaseMult(&proofNonce)...)
+			data = append(data, secpMultBytes(&proofNonce, &nonceShare)...)
+
+			share, _ := s.key.GetShare(signer.String())
+			var c, z secp256k1.ModNScalar
+			c.SetByteSlice(types.ECDSAPresignChallenge(share.Index, share.PublicShare, append(data, make([]byte, types.TSSShareSize)...)))
+			z.Mul2(&c, &x).Add(&proofNonce)
+			zBz := z.Bytes()
+			out[signer.String()] = append(data, zBz[:]...)
+		}
+	case types.TSSSchemeEdDSA:
+		s.nonces[sessionID] = make(map[string][2]*edwards25519.Scalar)
+		for _, signer := range signers {
+			hiding, binding := randomEdScalar(), randomEdScalar()
+			s.nonces[sessionID][signer.String()] = [2]*edwards25519.Scalar{hiding, binding}
+
+			data := new(edwards25519.Point).ScalarBaseMult(hiding).Bytes()
+			data = append(data, new(edwards25519.Point).ScalarBaseMult(binding).Bytes()...)
+			out[signer.String()] = data
+		}
+	}
+
+	return out
+}
+
+// signatureShare returns a signer's share for a session whose signing set is formed
+func (s *localSignerSet) signatureShare(session types.TSSSession, signer sdk.AccAddress) []byte {
+	switch s.key.Scheme {
+	case types.TSSSchemeECDSA:
+		m, r, err := types.ECDSASigningFactors(session.Message, session.Nonce)
+		if err != nil {
+			panic(err)
+		}
+		presign := s.presigns[session.ID][signer.String()]
+		var share, term secp256k1.ModNScalar
+		share.Mul2(&m, &presign[0])
+		term.Mul2(&r, &presign[1])
+		share.Add(&term)
+		bz := share.Bytes()
+		return bz[:]
+	default:
+		commitment, _ := session.GetCommitment(signer.String())
+		rho, lambda, challenge, err := types.EdDSASignerFactors(s.key.PublicKey, session.Message, session.Commitments, commitment.Index)
+		if err != nil {
+			panic(err)
+		}
+		nonce := s.nonces[session.ID][signer.String()]
+		keyTerm := edwards25519.NewScalar().Multiply(lambda, s.eddsaShares[signer.String()])
+		share := edwards25519.NewScalar().MultiplyAdd(nonce[1], rho, nonce[0])
+		share.MultiplyAdd(keyTerm, challenge, share)
+		return share.Bytes()
+	}
+}
+
+// localDealer is a key generation participant's secret polynomial
+type localDealer struct {
+	scheme string
+	secp   []secp256k1.ModNScalar
+	ed     []*edwards25519.Scalar
+}
+
+func newLocalDealer(scheme string, threshold uint64) *localDealer {
+	dealer := &localDealer{scheme: scheme}
+	for i := uint64(0); i < threshold; i++ {
+		if scheme == types.TSSSchemeECDSA {
+			dealer.secp = append(dealer.secp, randomSecpScalar())
+		} else {
+			dealer.ed = append(dealer.ed, randomEdScalar())
+		}
+	}
+	return dealer
+}
+
+// dealing returns the dealer's coefficient commitments and proof of
+// knowledge for the participant at index in a ceremony
+func (d *localDealer) dealing(keyGenID uint64, index uint32) ([][]byte, []byte) {
+	commitments := [][]byte{}
+	if d.scheme == types.TSSSchemeECDSA {
+		for i := range d.secp {
+			commitments = append(commitments, secpBaseMult(&d.secp[i]))
+		}
+		nonce := randomSecpScalar()
+		nonceBz := secpBaseMult(&nonce)
+		var c, z secp256k1.ModNScalar
+		c.SetByteSlice(types.TSSDealingChallenge(d.scheme, keyGenID, index, commitments[0], nonceBz))
+		z.Mul2(&c, &d.secp[0]).Add(&nonce)
+		zBz := z.Bytes()
+		return commitments, append(nonceBz, zBz[:]...)
+	}
+
+	for _, coefficient := range d.ed {
+		commitments = append(commitments, new(edwards25519.Point).ScalarBaseMult(coefficient).Bytes())
+	}
+	nonce := randomEdScalar()
+	nonceBz := new(edwards25519.Point).ScalarBaseMult(nonce).Bytes()
+	c, _ := edwards25519.NewScalar().SetCanonicalBytes(types.TSSDealingChallenge(d.scheme, keyGenID, index, commitments[0], nonceBz))
+	z := edwards25519.NewScalar().MultiplyAdd(c, d.ed[0], nonce)
+	return commitments, append(nonceBz, z.Bytes()...)
+}
+
+// share returns the dealer's private share for the participant at index
+func (d *localDealer) share(index uint32) []byte {
+	if d.scheme == types.TSSSchemeECDSA {
+		share := evalSecpPoly(d.secp, index)
+		bz := share.Bytes()
+		return bz[:]
+	}
+	return evalEdPoly(d.ed, index).Bytes()
+}
+
+// signerSetFromDealers returns the signers of a key generated by the given
+// dealers, each holder's share being the sum of the dealers' shares to it
+func signerSetFromDealers(key types.TSSKey, holders []sdk.AccAddress, dealers []*localDealer) *localSignerSet {
+	set := &localSignerSet{
+		key:         key,
+		ecdsaShares: make(map[string]secp256k1.ModNScalar),
+		presigns:    make(map[uint64]map[string][2]secp256k1.ModNScalar),
+		eddsaShares: make(map[string]*edwards25519.Scalar),
+		nonces:      make(map[uint64]map[string][2]*edwards25519.Scalar),
+	}
+
+	for _, dealer := range dealers {
+		if key.Scheme == types.TSSSchemeECDSA {
+			set.ecdsaKey.Add(&dealer.secp[0])
+		}
+	}
+	for _, holder := range holders {
+		share, found := key.GetShare(holder.String())
+		if !found {
+			continue
+		}
+		if key.Scheme == types.TSSSchemeECDSA {
+			var sum secp256k1.ModNScalar
+			for _, dealer := range dealers {
+				term := evalSecpPoly(dealer.secp, share.Index)
+				sum.Add(&term)
+			}
+			set.ecdsaShares[holder.String()] = sum
+		} else {
+			sum := edwards25519.NewScalar()
+			for _, dealer := range dealers {
+				sum.Add(sum, evalEdPoly(dealer.ed, share.Index))
+			}
+			set.eddsaShares[holder.String()] = sum
+		}
+	}
+	return set
+}
+
+func evalSecpPoly(coefficients []secp256k1.ModNScalar, index uint32) secp256k1.ModNScalar {
+	var x, result secp256k1.ModNScalar
+	x.SetInt(index)
+	for j := len(coefficients) - 1; j >= 0; j-- {
+		result.Mul(&x).Add(&coefficients[j])
+	}
+	return result
+}
+
+func evalEdPoly(coefficients []*edwards25519.Scalar, index uint32) *edwards25519.Scalar {
+	x := edScalar(index)
+	result := edwards25519.NewScalar()
+	for j := len(coefficients) - 1; j >= 0; j-- {
+		result.MultiplyAdd(result, x, coefficients[j])
+	}
+	return result
+}
+
+// secpLagrange returns the Lagrange coefficient of index at zero over indices
+func secpLagrange(index uint32, indices []uint32) secp256k1.ModNScalar {
+	var xi, num, den secp256k1.ModNScalar
+	xi.SetInt(index)
+	num.SetInt(1)
+	den.SetInt(1)
+	for _, j := range indices {
+		if j == index {
+			continue
+		}
+		var xj, diff secp256k1.ModNScalar
+		xj.SetInt(j)
+		num.Mul(&xj)
+		diff.NegateVal(&xi).Add(&xj) // x_j - x_i
+		den.Mul(&diff)
+	}
+	return *num.Mul(den.InverseNonConst())
+}
+
+func randomSecpScalar() secp256k1.ModNScalar {
+	var scalar secp256k1.ModNScalar
+	for scalar.IsZero() {
+		var buf [32]byte
+		if _, err := rand.Read(buf[:]); err != nil {
+			panic(err)
+		}
+		scalar.SetByteSlice(buf[:])
+	}
+	return scalar
+}
+
+// splitSecpScalar returns n random scalars that sum to total
+func splitSecpScalar(total secp256k1.ModNScalar, n int) []secp256k1.ModNScalar {
+	parts := make([]secp256k1.ModNScalar, n)
+	last := total
+	for i := 0; i < n-1; i++ {
+		parts[i] = randomSecpScalar()
+		var negated secp256k1.ModNScalar
+		negated.NegateVal(&parts[i])
+		last.Add(&negated)
+	}
+	parts[n-1] = last
+	return parts
+}
+
+func secpBaseMultPoint(k *secp256k1.ModNScalar) *secp256k1.JacobianPoint {
+	var point secp256k1.JacobianPoint
+	secp256k1.ScalarBaseMultNonConst(k, &point)
+	return &point
+}
+
+func secpBaseMult(k *secp256k1.ModNScalar) []byte {
+	return secpPointBytes(secpBaseMultPoint(k))
+}
+
+func secpMultBytes(k *secp256k1.ModNScalar, point *secp256k1.JacobianPoint) []byte {
+	var result secp256k1.JacobianPoint
+	secp256k1.ScalarMultNonConst(k, point, &result)
+	return secpPointBytes(&result)
+}
+
+func secpPointBytes(point *secp256k1.JacobianPoint) []byte {
+	affine := *point
+	affine.ToAffine()
+	return secp256k1.NewPublicKey(&affine.X, &affine.Y).SerializeCompressed()
+}
+
+func randomEdScalar() *edwards25519.Scalar {
+	var buf [64]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		panic(err)
+	}
+	scalar, _ := edwards25519.NewScalar().SetUniformBytes(buf[:])
+	return scalar
+}
+
+func edScalar(x uint32) *edwards25519.Scalar {
+	var buf [32]byte
+	buf[0], buf[1], buf[2], buf[3] = byte(x), byte(x>>8), byte(x>>16), byte(x>>24)
+	scalar, _ := edwards25519.NewScalar().SetCanonicalBytes(buf[:])
+	return scalar
+}
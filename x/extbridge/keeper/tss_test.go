@@ -1,33 +1,48 @@
 package keeper_test
 
 import (
+	"crypto/ed25519"
+	"encoding/hex"
 	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/types"
 	hodltypes "github.com/sharehodl/sharehodl-blockchain/x/hodl/types"
 )
 
-// TestCreateTSSSession tests TSS session creation
-func (suite *KeeperTestSuite) TestCreateTSSSession() {
-	// Set up chain and asset
+// setupTSSChain adds an enabled chain with a USDT asset whose TSS address is
+// held by a local signer set of eligible validators, and registers its key
+func (suite *KeeperTestSuite) setupTSSChain(chainID, chainType, scheme string, validators []sdk.AccAddress, threshold uint64) *localSignerSet {
+	suite.stakingKeeper.totalVals = uint64(len(validators))
+	for _, val := range validators {
+		suite.stakingKeeper.validators[val.String()] = true
+		suite.stakingKeeper.validatorTiers[val.String()] = 4
+	}
+
+	signers := newLocalSignerSet(chainID, scheme, validators, threshold)
+
 	chain := types.NewExternalChain(
-		"ethereum-1",
-		"Ethereum",
-		"evm",
+		chainID,
+		"Test Chain",
+		chainType,
 		true,
 		12,
 		12,
-		"0x1234567890abcdef",
+		signers.address(chainType),
 		math.NewInt(100_000),
 		math.NewInt(10_000_000_000),
 	)
+	chain.NetworkID = 1
+	chain.FeeRate = math.NewInt(20_000_000_000)
+	chain.GasLimit = 100_000
 	suite.keeper.SetExternalChain(suite.ctx, chain)
 
 	asset := types.NewExternalAsset(
-		"ethereum-1",
+		chainID,
 		"USDT",
 		"Tether USD",
 		"0xdAC17F958D2ee523a2206206994597C13D831ec7",
@@ -39,40 +54,123 @@ func (suite *KeeperTestSuite) TestCreateTSSSession() {
 	)
 	suite.keeper.SetExternalAsset(suite.ctx, asset)
 
-	// Set up validators
-	validators := []sdk.AccAddress{
-		sdk.AccAddress("validator1"),
-		sdk.AccAddress("validator2"),
-		sdk.AccAddress("validator3"),
-	}
-	suite.stakingKeeper.totalVals = uint64(len(validators))
-	for _, val := range validators {
-		suite.stakingKeeper.validators[val.String()] = true
-		suite.stakingKeeper.validatorTiers[val.String()] = 4
-	}
+	suite.Require().NoError(suite.keeper.RegisterTSSKey(suite.ctx, signers.key))
+	return signers
+}
 
-	// Create withdrawal
+// readyWithdrawal requests a withdrawal on a chain and moves it past its timelock
+func (suite *KeeperTestSuite) readyWithdrawal(chainID string) uint64 {
 	sender := sdk.AccAddress("sender1")
-	suite.bankKeeper.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin(hodltypes.HODLDenom, math.NewInt(10_000_000)))
+	suite.bankKeeper.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin(hodltypes.HODLDenom, math.NewInt(100_000_000)))
 
 	withdrawalID, err := suite.keeper.RequestWithdrawal(
 		suite.ctx,
 		sender,
-		"ethereum-1",
+		chainID,
 		"USDT",
-		"0xrecipient",
+		testRecipient,
 		math.NewInt(5_000_000),
 	)
 	suite.Require().NoError(err)
 
-	// Update withdrawal to ready status and move past timelock
 	params := suite.keeper.GetParams(suite.ctx)
 	futureTime := suite.ctx.BlockTime().Add(params.WithdrawalTimelockDuration()).Add(1 * time.Second)
 	suite.ctx = suite.ctx.WithBlockTime(futureTime)
 
 	withdrawal, _ := suite.keeper.GetWithdrawal(suite.ctx, withdrawalID)
 	withdrawal.Status = types.WithdrawalStatusReady
-	suite.keeper.SetWithdrawal(suite.ctx, withdrawal)
+	suite.Require().NoError(suite.keeper.SetWithdrawal(suite.ctx, withdrawal))
+
+	return withdrawalID
+}
+
+// TestWithdrawalTxNonces tests that each withdrawal transaction takes the
+// next account nonce and that a refunded withdrawal's nonce is reused
+func (suite *KeeperTestSuite) TestWithdrawalTxNonces() {
+	validators := testValidators(3)
+	suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators, 2)
+
+	nonces := make([]uint64, 2)
+	withdrawalIDs := make([]uint64, 2)
+	for i := range nonces {
+		withdrawalIDs[i] = suite.readyWithdrawal("ethereum-1")
+		_, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalIDs[i])
+		suite.Require().NoError(err)
+		withdrawal, _ := suite.keeper.GetWithdrawal(suite.ctx, withdrawalIDs[i])
+		nonces[i] = *withdrawal.Tx.Nonce
+	}
+	suite.Require().Equal([]uint64{0, 1}, nonces)
+
+	// The first transaction will never be signed; its nonce is handed out again
+	failed, _ := suite.keeper.GetWithdrawal(suite.ctx, withdrawalIDs[0])
+	failed.Status = types.WithdrawalStatusFailed
+	suite.Require().NoError(suite.keeper.SetWithdrawal(suite.ctx, failed))
+	suite.Require().NoError(suite.keeper.RefundWithdrawal(suite.ctx, withdrawalIDs[0]))
+	withdrawalID := suite.readyWithdrawal("ethereum-1")
+	_, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
+	suite.Require().NoError(err)
+	withdrawal, _ := suite.keeper.GetWithdrawal(suite.ctx, withdrawalID)
+	suite.Require().Equal(uint64(0), *withdrawal.Tx.Nonce)
+}
+
+// openSigningSession stores a session for a chain's key holders to sign a
+// message, for schemes no withdrawal transaction can be built for
+func (suite *KeeperTestSuite) openSigningSession(chainID string, message []byte) uint64 {
+	key, found := suite.keeper.GetTSSKey(suite.ctx, chainID)
+	suite.Require().True(found)
+	participants := make([]string, len(key.Shares))
+	for i, share := range key.Shares {
+		participants[i] = share.Validator
+	}
+
+	sessionID := suite.keeper.GetNextTSSSessionID(suite.ctx)
+	session := types.NewTSSSession(sessionID, 0, chainID, key.Scheme, participants, key.Threshold, time.Hour, message, suite.ctx.BlockTime())
+	suite.Require().NoError(suite.keeper.SetTSSSession(suite.ctx, session))
+	return sessionID
+}
+
+// commitSigners submits the signers' commitments, forming the signing set
+func (suite *KeeperTestSuite) commitSigners(signers *localSignerSet, sessionID uint64, validators []sdk.AccAddress) {
+	commitments := signers.commitments(sessionID, validators)
+	for _, val := range validators {
+		_, err := suite.keeper.SubmitTSSCommitment(suite.ctx, val, sessionID, commitments[val.String()])
+		suite.Require().NoError(err)
+	}
+}
+
+// signShares submits the signers' shares, returning whether the session completed
+func (suite *KeeperTestSuite) signShares(signers *localSignerSet, sessionID uint64, validators []sdk.AccAddress) bool {
+	completed := false
+	for _, val := range validators {
+		session, _ := suite.keeper.GetTSSSession(suite.ctx, sessionID)
+		var err error
+		completed, err = suite.keeper.SubmitTSSSignature(suite.ctx, val, sessionID, signers.signatureShare(session, val))
+		suite.Require().NoError(err)
+	}
+	return completed
+}
+
+// testRecipient is an EVM address withdrawals in tests pay out to
+const testRecipient = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+// evmAddress returns a distinct EVM address for a label
+func evmAddress(label string) string {
+	return "0x" + hex.EncodeToString(append([]byte(label), make([]byte, 20-len(label))...))
+}
+
+func testValidators(n int) []sdk.AccAddress {
+	validators := make([]sdk.AccAddress, n)
+	for i := range validators {
+		validators[i] = sdk.AccAddress("validator" + string(rune('1'+i)))
+	}
+	return validators
+}
+
+// TestCreateTSSSession tests TSS session creation
+func (suite *KeeperTestSuite) TestCreateTSSSession() {
+	validators := testValidators(3)
+	suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators, 2)
+	withdrawalID := suite.readyWithdrawal("ethereum-1")
 
 	// Create TSS session
 	sessionID, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
@@ -84,18 +182,36 @@ func (suite *KeeperTestSuite) TestCreateTSSSession() {
 	suite.Require().True(found)
 	suite.Require().Equal(withdrawalID, session.WithdrawalID)
 	suite.Require().Equal("ethereum-1", session.ChainID)
+	suite.Require().Equal(types.TSSSchemeECDSA, session.Scheme)
 	suite.Require().Equal(types.TSSSessionStatusPending, session.Status)
 	suite.Require().Len(session.Participants, 3)
-	suite.Require().Equal(uint64(2), session.RequiredSigs) // 67% of 3 = 2
+	suite.Require().Equal(uint64(2), session.RequiredSigs) // key threshold
 	suite.Require().Equal(uint64(0), session.ReceivedSigs)
 
 	// Verify withdrawal status updated
-	withdrawal, _ = suite.keeper.GetWithdrawal(suite.ctx, withdrawalID)
+	withdrawal, _ := suite.keeper.GetWithdrawal(suite.ctx, withdrawalID)
 	suite.Require().Equal(types.WithdrawalStatusSigning, withdrawal.Status)
 	suite.Require().NotNil(withdrawal.TSSSessionID)
 	suite.Require().Equal(sessionID, *withdrawal.TSSSessionID)
 }
 
+// TestCreateTSSSessionNoKey tests that chains without a registered key cannot sign
+func (suite *KeeperTestSuite) TestCreateTSSSessionNoKey() {
+	validators := testValidators(3)
+	suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators, 2)
+
+	chain, _ := suite.keeper.GetExternalChain(suite.ctx, "ethereum-1")
+	chain.ChainID = "ethereum-2"
+	suite.keeper.SetExternalChain(suite.ctx, chain)
+	asset, _ := suite.keeper.GetExternalAsset(suite.ctx, "ethereum-1", "USDT")
+	asset.ChainID = "ethereum-2"
+	suite.keeper.SetExternalAsset(suite.ctx, asset)
+
+	withdrawalID := suite.readyWithdrawal("ethereum-2")
+	_, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
+	suite.Require().ErrorIs(err, types.ErrTSSKeyNotFound)
+}
+
 // TestCreateTSSSessionWithdrawalNotReady tests TSS creation with unready withdrawal
 func (suite *KeeperTestSuite) TestCreateTSSSessionWithdrawalNotReady() {
 	// Set up chain and asset
@@ -134,7 +250,7 @@ func (suite *KeeperTestSuite) TestCreateTSSSessionWithdrawalNotReady() {
 		sender,
 		"ethereum-1",
 		"USDT",
-		"0xrecipient",
+		testRecipient,
 		math.NewInt(5_000_000),
 	)
 	suite.Require().NoError(err)
@@ -153,83 +269,72 @@ func (suite *KeeperTestSuite) TestCreateTSSSessionNonExistentWithdrawal() {
 	suite.Require().Equal(types.ErrWithdrawalNotFound, err)
 }
 
-// TestSubmitTSSSignature tests signature submission
-func (suite *KeeperTestSuite) TestSubmitTSSSignature() {
-	// Set up chain, asset, and validators
-	chain := types.NewExternalChain(
-		"ethereum-1",
-		"Ethereum",
-		"evm",
-		true,
-		12,
-		12,
-		"0x1234567890abcdef",
-		math.NewInt(100_000),
-		math.NewInt(10_000_000_000),
-	)
-	suite.keeper.SetExternalChain(suite.ctx, chain)
+// TestSubmitTSSCommitment tests forming the signing set
+func (suite *KeeperTestSuite) TestSubmitTSSCommitment() {
+	validators := testValidators(3)
+	signers := suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators, 2)
+	withdrawalID := suite.readyWithdrawal("ethereum-1")
 
-	asset := types.NewExternalAsset(
-		"ethereum-1",
-		"USDT",
-		"Tether USD",
-		"0xdAC17F958D2ee523a2206206994597C13D831ec7",
-		6,
-		true,
-		math.LegacyOneDec(),
-		math.NewInt(1_000_000_000_000),
-		math.NewInt(100_000_000_000),
-	)
-	suite.keeper.SetExternalAsset(suite.ctx, asset)
+	sessionID, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
+	suite.Require().NoError(err)
 
-	validators := []sdk.AccAddress{
-		sdk.AccAddress("validator1"),
-		sdk.AccAddress("validator2"),
-		sdk.AccAddress("validator3"),
-	}
-	suite.stakingKeeper.totalVals = uint64(len(validators))
-	for _, val := range validators {
-		suite.stakingKeeper.validators[val.String()] = true
-		suite.stakingKeeper.validatorTiers[val.String()] = 4
-	}
+	commitments := signers.commitments(sessionID, validators[:2])
 
-	// Create withdrawal and TSS session
-	sender := sdk.AccAddress("sender1")
-	suite.bankKeeper.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin(hodltypes.HODLDenom, math.NewInt(10_000_000)))
+	// Malformed commitments are rejected
+	_, err = suite.keeper.SubmitTSSCommitment(suite.ctx, validators[0], sessionID, []byte("commitment"))
+	suite.Require().ErrorIs(err, types.ErrInvalidTSSCommitment)
 
-	withdrawalID, err := suite.keeper.RequestWithdrawal(
-		suite.ctx,
-		sender,
-		"ethereum-1",
-		"USDT",
-		"0xrecipient",
-		math.NewInt(5_000_000),
-	)
+	ready, err := suite.keeper.SubmitTSSCommitment(suite.ctx, validators[0], sessionID, commitments[validators[0].String()])
 	suite.Require().NoError(err)
+	suite.Require().False(ready)
 
-	params := suite.keeper.GetParams(suite.ctx)
-	futureTime := suite.ctx.BlockTime().Add(params.WithdrawalTimelockDuration()).Add(1 * time.Second)
-	suite.ctx = suite.ctx.WithBlockTime(futureTime)
+	_, err = suite.keeper.SubmitTSSCommitment(suite.ctx, validators[0], sessionID, commitments[validators[0].String()])
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "already submitted")
 
-	withdrawal, _ := suite.keeper.GetWithdrawal(suite.ctx, withdrawalID)
-	withdrawal.Status = types.WithdrawalStatusReady
-	suite.keeper.SetWithdrawal(suite.ctx, withdrawal)
+	ready, err = suite.keeper.SubmitTSSCommitment(suite.ctx, validators[1], sessionID, commitments[validators[1].String()])
+	suite.Require().NoError(err)
+	suite.Require().True(ready)
+
+	session, _ := suite.keeper.GetTSSSession(suite.ctx, sessionID)
+	suite.Require().Equal(types.TSSSessionStatusActive, session.Status)
+	suite.Require().Len(session.Commitments, 2)
+	suite.Require().NotEmpty(session.Nonce)
+
+	// The signing set is closed once formed
+	late := signers.commitments(sessionID+1, validators[2:])
+	_, err = suite.keeper.SubmitTSSCommitment(suite.ctx, validators[2], sessionID, late[validators[2].String()])
+	suite.Require().ErrorIs(err, types.ErrInvalidTSSSession)
+}
+
+// TestSubmitTSSSignature tests signature submission
+func (suite *KeeperTestSuite) TestSubmitTSSSignature() {
+	validators := testValidators(3)
+	signers := suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators, 2)
+	withdrawalID := suite.readyWithdrawal("ethereum-1")
 
 	sessionID, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
 	suite.Require().NoError(err)
 
+	// Shares are not accepted before the signing set is formed
+	_, err = suite.keeper.SubmitTSSSignature(suite.ctx, validators[0], sessionID, make([]byte, types.TSSShareSize))
+	suite.Require().ErrorIs(err, types.ErrInvalidTSSSession)
+
+	suite.commitSigners(signers, sessionID, validators[:2])
+
 	// Submit first signature
+	session, _ := suite.keeper.GetTSSSession(suite.ctx, sessionID)
 	completed, err := suite.keeper.SubmitTSSSignature(
 		suite.ctx,
 		validators[0],
 		sessionID,
-		[]byte("signature_share_1"),
+		signers.signatureShare(session, validators[0]),
 	)
 	suite.Require().NoError(err)
 	suite.Require().False(completed)
 
 	// Verify session updated
-	session, _ := suite.keeper.GetTSSSession(suite.ctx, sessionID)
+	session, _ = suite.keeper.GetTSSSession(suite.ctx, sessionID)
 	suite.Require().Equal(types.TSSSessionStatusActive, session.Status)
 	suite.Require().Equal(uint64(1), session.ReceivedSigs)
 	suite.Require().Len(session.SignatureShares, 1)
@@ -239,7 +344,7 @@ func (suite *KeeperTestSuite) TestSubmitTSSSignature() {
 		suite.ctx,
 		validators[1],
 		sessionID,
-		[]byte("signature_share_2"),
+		signers.signatureShare(session, validators[1]),
 	)
 	suite.Require().NoError(err)
 	suite.Require().True(completed)
@@ -248,162 +353,229 @@ func (suite *KeeperTestSuite) TestSubmitTSSSignature() {
 	session, _ = suite.keeper.GetTSSSession(suite.ctx, sessionID)
 	suite.Require().Equal(types.TSSSessionStatusCompleted, session.Status)
 	suite.Require().Equal(uint64(2), session.ReceivedSigs)
-	suite.Require().NotEmpty(session.CombinedSignature)
 	suite.Require().NotNil(session.CompletedAt)
 
-	// Verify withdrawal status updated
-	withdrawal, _ = suite.keeper.GetWithdrawal(suite.ctx, withdrawalID)
+	// The combined signature verifies under the group key, and recovers to
+	// the chain's TSS address the way an EVM chain checks it
+	suite.Require().Len(session.CombinedSignature, types.ECDSASignatureSize)
+	suite.Require().NoError(types.VerifyTSSSignature(types.TSSSchemeECDSA, signers.key.PublicKey, session.Message, session.CombinedSignature))
+
+	compact := append([]byte{27 + session.CombinedSignature[64]}, session.CombinedSignature[:64]...)
+	recovered, _, err := ecdsa.RecoverCompact(compact, session.Message)
+	suite.Require().NoError(err)
+	address, err := types.TSSAddressForKey("evm", types.TSSSchemeECDSA, recovered.SerializeCompressed(), "")
+	suite.Require().NoError(err)
+	chain, _ := suite.keeper.GetExternalChain(suite.ctx, "ethereum-1")
+	suite.Require().Equal(chain.TSSAddress, address)
+
+	// The signed digest is the Keccak-256 hash of the withdrawal's EIP-155
+	// transaction, an ERC-20 transfer to the recipient from nonce 0
+	withdrawal, _ := suite.keeper.GetWithdrawal(suite.ctx, withdrawalID)
 	suite.Require().Equal(types.WithdrawalStatusSigned, withdrawal.Status)
+	suite.Require().NotNil(withdrawal.Tx)
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(withdrawal.Tx.Raw)
+	suite.Require().Equal(hasher.Sum(nil), session.Message)
+	suite.Require().Equal(uint64(0), *withdrawal.Tx.Nonce)
+	suite.Require().Contains(hex.EncodeToString(withdrawal.Tx.Raw), "a9059cbb"+"0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	suite.Require().Contains(hex.EncodeToString(withdrawal.Tx.Raw), "dac17f958d2ee523a2206206994597c13d831ec7")
 }
 
-// TestSubmitTSSSignatureDuplicate tests duplicate signature prevention
-func (suite *KeeperTestSuite) TestSubmitTSSSignatureDuplicate() {
-	// Set up chain, asset, and validators
-	chain := types.NewExternalChain(
-		"ethereum-1",
-		"Ethereum",
-		"evm",
-		true,
-		12,
-		12,
-		"0x1234567890abcdef",
-		math.NewInt(100_000),
-		math.NewInt(10_000_000_000),
-	)
-	suite.keeper.SetExternalChain(suite.ctx, chain)
+// TestSubmitTSSSignatureEdDSA tests FROST signing for an Ed25519 chain
+func (suite *KeeperTestSuite) TestSubmitTSSSignatureEdDSA() {
+	validators := testValidators(5)
+	signers := suite.setupTSSChain("solana-1", "solana", types.TSSSchemeEdDSA, validators, 3)
+	sessionID := suite.openSigningSession("solana-1", []byte("solana transaction message"))
 
-	asset := types.NewExternalAsset(
-		"ethereum-1",
-		"USDT",
-		"Tether USD",
-		"0xdAC17F958D2ee523a2206206994597C13D831ec7",
-		6,
-		true,
-		math.LegacyOneDec(),
-		math.NewInt(1_000_000_000_000),
-		math.NewInt(100_000_000_000),
-	)
-	suite.keeper.SetExternalAsset(suite.ctx, asset)
+	// Any threshold of holders can sign
+	signingSet := []sdk.AccAddress{validators[4], validators[1], validators[2]}
+	suite.commitSigners(signers, sessionID, signingSet)
+	suite.Require().True(suite.signShares(signers, sessionID, signingSet))
 
-	// Set up 3 validators so session needs 2 signatures (3*67/100 = 2)
-	validator := sdk.AccAddress("validator1")
-	validator2 := sdk.AccAddress("validator2")
-	validator3 := sdk.AccAddress("validator3")
-	suite.stakingKeeper.validators[validator.String()] = true
-	suite.stakingKeeper.validatorTiers[validator.String()] = 4
-	suite.stakingKeeper.validators[validator2.String()] = true
-	suite.stakingKeeper.validatorTiers[validator2.String()] = 4
-	suite.stakingKeeper.validators[validator3.String()] = true
-	suite.stakingKeeper.validatorTiers[validator3.String()] = 4
-	suite.stakingKeeper.totalVals = 3
+	session, _ := suite.keeper.GetTSSSession(suite.ctx, sessionID)
+	suite.Require().Equal(types.TSSSessionStatusCompleted, session.Status)
+	suite.Require().True(ed25519.Verify(ed25519.PublicKey(signers.key.PublicKey), session.Message, session.CombinedSignature))
+}
 
-	// Create withdrawal and TSS session
-	sender := sdk.AccAddress("sender1")
-	suite.bankKeeper.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin(hodltypes.HODLDenom, math.NewInt(10_000_000)))
+// TestSubmitTSSSignatureInvalidShare tests that bad shares are rejected and slashed
+func (suite *KeeperTestSuite) TestSubmitTSSSignatureInvalidShare() {
+	tests := []struct {
+		name      string
+		chainID   string
+		chainType string
+		scheme    string
+	}{
+		{"ecdsa", "ethereum-1", "evm", types.TSSSchemeECDSA},
+		{"eddsa", "solana-1", "solana", types.TSSSchemeEdDSA},
+	}
 
-	withdrawalID, err := suite.keeper.RequestWithdrawal(
-		suite.ctx,
-		sender,
-		"ethereum-1",
-		"USDT",
-		"0xrecipient",
-		math.NewInt(5_000_000),
-	)
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			validators := testValidators(3)
+			signers := suite.setupTSSChain(tt.chainID, tt.chainType, tt.scheme, validators, 2)
+
+			// Ed25519 chains have no withdrawal transaction builder yet
+			var withdrawalID, sessionID uint64
+			if tt.scheme == types.TSSSchemeECDSA {
+				withdrawalID = suite.readyWithdrawal(tt.chainID)
+				var err error
+				sessionID, err = suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
+				suite.Require().NoError(err)
+			} else {
+				sessionID = suite.openSigningSession(tt.chainID, []byte("solana transaction message"))
+			}
+			suite.commitSigners(signers, sessionID, validators[:2])
+
+			// validator1 submits validator2's share as its own
+			session, _ := suite.keeper.GetTSSSession(suite.ctx, sessionID)
+			completed, err := suite.keeper.SubmitTSSSignature(suite.ctx, validators[0], sessionID, signers.signatureShare(session, validators[1]))
+			suite.Require().NoError(err)
+			suite.Require().False(completed)
+
+			// The signer is slashed and the session fails
+			suite.Require().Equal(types.DefaultParams().TSSFaultSlashFraction, suite.stakingKeeper.slashed[validators[0].String()])
+			suite.Require().NotContains(suite.stakingKeeper.slashed, validators[1].String())
+
+			session, _ = suite.keeper.GetTSSSession(suite.ctx, sessionID)
+			suite.Require().Equal(types.TSSSessionStatusFailed, session.Status)
+			suite.Require().Equal([]string{validators[0].String()}, session.FaultyParticipants)
+			suite.Require().Empty(session.CombinedSignature)
+			if withdrawalID == 0 {
+				return
+			}
+
+			// The withdrawal can be signed by a new session
+			withdrawal, _ := suite.keeper.GetWithdrawal(suite.ctx, withdrawalID)
+			suite.Require().Equal(types.WithdrawalStatusReady, withdrawal.Status)
+			suite.Require().Nil(withdrawal.TSSSessionID)
+
+			retryID, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
+			suite.Require().NoError(err)
+			suite.commitSigners(signers, retryID, validators[1:])
+			suite.Require().True(suite.signShares(signers, retryID, validators[1:]))
+		})
+	}
+}
+
+// TestTSSInconsistentPresignatures tests ECDSA commitments that do not add up
+func (suite *KeeperTestSuite) TestTSSInconsistentPresignatures() {
+	validators := testValidators(3)
+	signers := suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators, 2)
+	withdrawalID := suite.readyWithdrawal("ethereum-1")
+
+	sessionID, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
 	suite.Require().NoError(err)
 
-	params := suite.keeper.GetParams(suite.ctx)
-	futureTime := suite.ctx.BlockTime().Add(params.WithdrawalTimelockDuration()).Add(1 * time.Second)
-	suite.ctx = suite.ctx.WithBlockTime(futureTime)
+	// Commitments from two different presignatures
+	first := signers.commitments(sessionID, validators[:2])
+	second := signers.commitments(sessionID+100, validators[:2])
+
+	_, err = suite.keeper.SubmitTSSCommitment(suite.ctx, validators[0], sessionID, first[validators[0].String()])
+	suite.Require().NoError(err)
+	ready, err := suite.keeper.SubmitTSSCommitment(suite.ctx, validators[1], sessionID, second[validators[1].String()])
+	suite.Require().NoError(err)
+	suite.Require().False(ready)
+
+	session, _ := suite.keeper.GetTSSSession(suite.ctx, sessionID)
+	suite.Require().Equal(types.TSSSessionStatusFailed, session.Status)
+	suite.Require().Empty(suite.stakingKeeper.slashed)
 
 	withdrawal, _ := suite.keeper.GetWithdrawal(suite.ctx, withdrawalID)
-	withdrawal.Status = types.WithdrawalStatusReady
-	err = suite.keeper.SetWithdrawal(suite.ctx, withdrawal)
+	suite.Require().Equal(types.WithdrawalStatusReady, withdrawal.Status)
+}
+
+// TestTSSDeviatingNonce tests that a signer committing to a nonce other than
+// the signing set majority's is slashed
+func (suite *KeeperTestSuite) TestTSSDeviatingNonce() {
+	validators := testValidators(4)
+	signers := suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators, 3)
+	withdrawalID := suite.readyWithdrawal("ethereum-1")
+
+	sessionID, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
 	suite.Require().NoError(err)
 
+	honest := signers.commitments(sessionID, validators[:3])
+	other := signers.commitments(sessionID+100, validators[:3])
+	for _, val := range validators[:2] {
+		_, err = suite.keeper.SubmitTSSCommitment(suite.ctx, val, sessionID, honest[val.String()])
+		suite.Require().NoError(err)
+	}
+	ready, err := suite.keeper.SubmitTSSCommitment(suite.ctx, validators[2], sessionID, other[validators[2].String()])
+	suite.Require().NoError(err)
+	suite.Require().False(ready)
+
+	session, _ := suite.keeper.GetTSSSession(suite.ctx, sessionID)
+	suite.Require().Equal(types.TSSSessionStatusFailed, session.Status)
+	suite.Require().Equal([]string{validators[2].String()}, session.FaultyParticipants)
+	suite.Require().Equal(types.DefaultParams().TSSFaultSlashFraction, suite.stakingKeeper.slashed[validators[2].String()])
+	suite.Require().Len(suite.stakingKeeper.slashed, 1)
+}
+
+// TestTSSCommitmentUnboundKeyShare tests that a presignature commitment not
+// made with the signer's registered key share is slashed and left out
+func (suite *KeeperTestSuite) TestTSSCommitmentUnboundKeyShare() {
+	validators := testValidators(3)
+	signers := suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators, 2)
+	withdrawalID := suite.readyWithdrawal("ethereum-1")
+
 	sessionID, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
 	suite.Require().NoError(err)
 
+	// validator1 submits validator2's commitment as its own
+	commitments := signers.commitments(sessionID, validators[:2])
+	ready, err := suite.keeper.SubmitTSSCommitment(suite.ctx, validators[0], sessionID, commitments[validators[1].String()])
+	suite.Require().NoError(err)
+	suite.Require().False(ready)
+
+	suite.Require().Equal(types.DefaultParams().TSSFaultSlashFraction, suite.stakingKeeper.slashed[validators[0].String()])
+	session, _ := suite.keeper.GetTSSSession(suite.ctx, sessionID)
+	suite.Require().Equal(types.TSSSessionStatusPending, session.Status)
+	suite.Require().Empty(session.Commitments)
+	suite.Require().Equal([]string{validators[0].String()}, session.FaultyParticipants)
+
+	// The signer cannot commit again; the others still form the signing set
+	_, err = suite.keeper.SubmitTSSCommitment(suite.ctx, validators[0], sessionID, commitments[validators[0].String()])
+	suite.Require().Error(err)
+
+	suite.commitSigners(signers, sessionID, validators[1:])
+	suite.Require().True(suite.signShares(signers, sessionID, validators[1:]))
+}
+
+// TestSubmitTSSSignatureDuplicate tests duplicate signature prevention
+func (suite *KeeperTestSuite) TestSubmitTSSSignatureDuplicate() {
+	// Set up 3 validators with a 2-of-3 key
+	validators := testValidators(3)
+	signers := suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators, 2)
+	withdrawalID := suite.readyWithdrawal("ethereum-1")
+
+	sessionID, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
+	suite.Require().NoError(err)
+	suite.commitSigners(signers, sessionID, validators[:2])
+
 	// Submit first signature (1/2 required, should not complete)
-	completed, err := suite.keeper.SubmitTSSSignature(
-		suite.ctx,
-		validator,
-		sessionID,
-		[]byte("signature_share_1"),
-	)
+	session, _ := suite.keeper.GetTSSSession(suite.ctx, sessionID)
+	share := signers.signatureShare(session, validators[0])
+	completed, err := suite.keeper.SubmitTSSSignature(suite.ctx, validators[0], sessionID, share)
 	suite.Require().NoError(err)
 	suite.Require().False(completed) // Session not yet complete
 
 	// Try to submit again from same validator - should fail
-	_, err = suite.keeper.SubmitTSSSignature(
-		suite.ctx,
-		validator,
-		sessionID,
-		[]byte("signature_share_2"),
-	)
+	_, err = suite.keeper.SubmitTSSSignature(suite.ctx, validators[0], sessionID, share)
 	suite.Require().Error(err)
 	suite.Require().Contains(err.Error(), "already submitted")
 }
 
 // TestSubmitTSSSignatureInvalidValidator tests signature from non-participant
 func (suite *KeeperTestSuite) TestSubmitTSSSignatureInvalidValidator() {
-	// Set up chain, asset, and validators
-	chain := types.NewExternalChain(
-		"ethereum-1",
-		"Ethereum",
-		"evm",
-		true,
-		12,
-		12,
-		"0x1234567890abcdef",
-		math.NewInt(100_000),
-		math.NewInt(10_000_000_000),
-	)
-	suite.keeper.SetExternalChain(suite.ctx, chain)
-
-	asset := types.NewExternalAsset(
-		"ethereum-1",
-		"USDT",
-		"Tether USD",
-		"0xdAC17F958D2ee523a2206206994597C13D831ec7",
-		6,
-		true,
-		math.LegacyOneDec(),
-		math.NewInt(1_000_000_000_000),
-		math.NewInt(100_000_000_000),
-	)
-	suite.keeper.SetExternalAsset(suite.ctx, asset)
-
-	validator := sdk.AccAddress("validator1")
-	suite.stakingKeeper.validators[validator.String()] = true
-	suite.stakingKeeper.validatorTiers[validator.String()] = 4
-	suite.stakingKeeper.totalVals = 10
-
-	// Create withdrawal and TSS session
-	sender := sdk.AccAddress("sender1")
-	suite.bankKeeper.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin(hodltypes.HODLDenom, math.NewInt(10_000_000)))
-
-	withdrawalID, err := suite.keeper.RequestWithdrawal(
-		suite.ctx,
-		sender,
-		"ethereum-1",
-		"USDT",
-		"0xrecipient",
-		math.NewInt(5_000_000),
-	)
-	suite.Require().NoError(err)
-
-	params := suite.keeper.GetParams(suite.ctx)
-	futureTime := suite.ctx.BlockTime().Add(params.WithdrawalTimelockDuration()).Add(1 * time.Second)
-	suite.ctx = suite.ctx.WithBlockTime(futureTime)
-
-	withdrawal, _ := suite.keeper.GetWithdrawal(suite.ctx, withdrawalID)
-	withdrawal.Status = types.WithdrawalStatusReady
-	suite.keeper.SetWithdrawal(suite.ctx, withdrawal)
+	validators := testValidators(3)
+	suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators, 2)
+	withdrawalID := suite.readyWithdrawal("ethereum-1")
 
 	sessionID, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
 	suite.Require().NoError(err)
 
-	// Try to submit signature from non-participant
+	// Try to submit signature from a validator holding no key share
 	nonParticipant := sdk.AccAddress("nonparticipant")
 	suite.stakingKeeper.validators[nonParticipant.String()] = true
 	suite.stakingKeeper.validatorTiers[nonParticipant.String()] = 4
@@ -437,59 +609,9 @@ func (suite *KeeperTestSuite) TestSubmitTSSSignatureNonExistentSession() {
 
 // TestTSSSessionTimeout tests timeout handling
 func (suite *KeeperTestSuite) TestTSSSessionTimeout() {
-	// Set up chain, asset, and validators
-	chain := types.NewExternalChain(
-		"ethereum-1",
-		"Ethereum",
-		"evm",
-		true,
-		12,
-		12,
-		"0x1234567890abcdef",
-		math.NewInt(100_000),
-		math.NewInt(10_000_000_000),
-	)
-	suite.keeper.SetExternalChain(suite.ctx, chain)
-
-	asset := types.NewExternalAsset(
-		"ethereum-1",
-		"USDT",
-		"Tether USD",
-		"0xdAC17F958D2ee523a2206206994597C13D831ec7",
-		6,
-		true,
-		math.LegacyOneDec(),
-		math.NewInt(1_000_000_000_000),
-		math.NewInt(100_000_000_000),
-	)
-	suite.keeper.SetExternalAsset(suite.ctx, asset)
-
-	validator := sdk.AccAddress("validator1")
-	suite.stakingKeeper.validators[validator.String()] = true
-	suite.stakingKeeper.validatorTiers[validator.String()] = 4
-	suite.stakingKeeper.totalVals = 10
-
-	// Create withdrawal and TSS session
-	sender := sdk.AccAddress("sender1")
-	suite.bankKeeper.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin(hodltypes.HODLDenom, math.NewInt(10_000_000)))
-
-	withdrawalID, err := suite.keeper.RequestWithdrawal(
-		suite.ctx,
-		sender,
-		"ethereum-1",
-		"USDT",
-		"0xrecipient",
-		math.NewInt(5_000_000),
-	)
-	suite.Require().NoError(err)
-
-	params := suite.keeper.GetParams(suite.ctx)
-	futureTime := suite.ctx.BlockTime().Add(params.WithdrawalTimelockDuration()).Add(1 * time.Second)
-	suite.ctx = suite.ctx.WithBlockTime(futureTime)
-
-	withdrawal, _ := suite.keeper.GetWithdrawal(suite.ctx, withdrawalID)
-	withdrawal.Status = types.WithdrawalStatusReady
-	suite.keeper.SetWithdrawal(suite.ctx, withdrawal)
+	validators := testValidators(1)
+	suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators, 1)
+	withdrawalID := suite.readyWithdrawal("ethereum-1")
 
 	sessionID, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
 	suite.Require().NoError(err)
@@ -501,88 +623,73 @@ func (suite *KeeperTestSuite) TestTSSSessionTimeout() {
 	// Try to submit signature after timeout
 	_, err = suite.keeper.SubmitTSSSignature(
 		suite.ctx,
-		validator,
+		validators[0],
 		sessionID,
 		[]byte("signature_share"),
 	)
 	suite.Require().Error(err)
 	suite.Require().Equal(types.ErrTSSTimeout, err)
 
-	// Verify session marked as timeout
+	// EndBlock marks the session timed out and frees the withdrawal to re-sign
+	suite.keeper.ExpireTSSSessions(suite.ctx)
 	session, _ := suite.keeper.GetTSSSession(suite.ctx, sessionID)
 	suite.Require().Equal(types.TSSSessionStatusTimeout, session.Status)
-}
 
-// TestTSSSessionCompleted tests signature submission to completed session
-func (suite *KeeperTestSuite) TestTSSSessionCompleted() {
-	// Set up chain, asset, and validators
-	chain := types.NewExternalChain(
-		"ethereum-1",
-		"Ethereum",
-		"evm",
-		true,
-		12,
-		12,
-		"0x1234567890abcdef",
-		math.NewInt(100_000),
-		math.NewInt(10_000_000_000),
-	)
-	suite.keeper.SetExternalChain(suite.ctx, chain)
+	withdrawal, _ := suite.keeper.GetWithdrawal(suite.ctx, withdrawalID)
+	suite.Require().Equal(types.WithdrawalStatusReady, withdrawal.Status)
+	suite.Require().Nil(withdrawal.TSSSessionID)
+}
 
-	asset := types.NewExternalAsset(
-		"ethereum-1",
-		"USDT",
-		"Tether USD",
-		"0xdAC17F958D2ee523a2206206994597C13D831ec7",
-		6,
-		true,
-		math.LegacyOneDec(),
-		math.NewInt(1_000_000_000_000),
-		math.NewInt(100_000_000_000),
-	)
-	suite.keeper.SetExternalAsset(suite.ctx, asset)
+// TestExpireTSSSessionsByDeadline tests that only open sessions past their
+// deadline time out, and that a session's deadline moves with it
+func (suite *KeeperTestSuite) TestExpireTSSSessionsByDeadline() {
+	validators := testValidators(1)
+	suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators, 1)
 
-	validators := []sdk.AccAddress{
-		sdk.AccAddress("validator1"),
-		sdk.AccAddress("validator2"),
-		sdk.AccAddress("validator3"),
-	}
-	suite.stakingKeeper.totalVals = uint64(len(validators))
-	for _, val := range validators {
-		suite.stakingKeeper.validators[val.String()] = true
-		suite.stakingKeeper.validatorTiers[val.String()] = 4
+	var sessionIDs []uint64
+	for i := 0; i < 3; i++ {
+		sessionID, err := suite.keeper.CreateTSSSession(suite.ctx, suite.readyWithdrawal("ethereum-1"))
+		suite.Require().NoError(err)
+		sessionIDs = append(sessionIDs, sessionID)
 	}
+	now := suite.ctx.BlockTime()
 
-	// Create withdrawal and TSS session
-	sender := sdk.AccAddress("sender1")
-	suite.bankKeeper.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin(hodltypes.HODLDenom, math.NewInt(10_000_000)))
+	// The first runs out first, the second is extended, the third already failed
+	extended, _ := suite.keeper.GetTSSSession(suite.ctx, sessionIDs[1])
+	extended.TimeoutAt = now.Add(24 * time.Hour)
+	suite.Require().NoError(suite.keeper.SetTSSSession(suite.ctx, extended))
+	failed, _ := suite.keeper.GetTSSSession(suite.ctx, sessionIDs[2])
+	failed.Status = types.TSSSessionStatusFailed
+	suite.Require().NoError(suite.keeper.SetTSSSession(suite.ctx, failed))
 
-	withdrawalID, err := suite.keeper.RequestWithdrawal(
-		suite.ctx,
-		sender,
-		"ethereum-1",
-		"USDT",
-		"0xrecipient",
-		math.NewInt(5_000_000),
-	)
-	suite.Require().NoError(err)
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(2 * time.Hour))
+	suite.keeper.ExpireTSSSessions(suite.ctx)
 
-	params := suite.keeper.GetParams(suite.ctx)
-	futureTime := suite.ctx.BlockTime().Add(params.WithdrawalTimelockDuration()).Add(1 * time.Second)
-	suite.ctx = suite.ctx.WithBlockTime(futureTime)
+	session, _ := suite.keeper.GetTSSSession(suite.ctx, sessionIDs[0])
+	suite.Require().Equal(types.TSSSessionStatusTimeout, session.Status)
+	session, _ = suite.keeper.GetTSSSession(suite.ctx, sessionIDs[1])
+	suite.Require().True(session.CanAddSignature())
+	session, _ = suite.keeper.GetTSSSession(suite.ctx, sessionIDs[2])
+	suite.Require().Equal(types.TSSSessionStatusFailed, session.Status)
+
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(25 * time.Hour))
+	suite.keeper.ExpireTSSSessions(suite.ctx)
+	session, _ = suite.keeper.GetTSSSession(suite.ctx, sessionIDs[1])
+	suite.Require().Equal(types.TSSSessionStatusTimeout, session.Status)
+}
 
-	withdrawal, _ := suite.keeper.GetWithdrawal(suite.ctx, withdrawalID)
-	withdrawal.Status = types.WithdrawalStatusReady
-	suite.keeper.SetWithdrawal(suite.ctx, withdrawal)
+// TestTSSSessionCompleted tests signature submission to completed session
+func (suite *KeeperTestSuite) TestTSSSessionCompleted() {
+	validators := testValidators(3)
+	signers := suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators, 2)
+	withdrawalID := suite.readyWithdrawal("ethereum-1")
 
 	sessionID, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
 	suite.Require().NoError(err)
 
 	// Complete session with 2 signatures
-	_, err = suite.keeper.SubmitTSSSignature(suite.ctx, validators[0], sessionID, []byte("sig1"))
-	suite.Require().NoError(err)
-	_, err = suite.keeper.SubmitTSSSignature(suite.ctx, validators[1], sessionID, []byte("sig2"))
-	suite.Require().NoError(err)
+	suite.commitSigners(signers, sessionID, validators[:2])
+	suite.Require().True(suite.signShares(signers, sessionID, validators[:2]))
 
 	// Try to submit signature to completed session
 	_, err = suite.keeper.SubmitTSSSignature(suite.ctx, validators[2], sessionID, []byte("sig3"))
@@ -592,62 +699,13 @@ func (suite *KeeperTestSuite) TestTSSSessionCompleted() {
 
 // TestGetAllTSSSessions tests retrieving all TSS sessions
 func (suite *KeeperTestSuite) TestGetAllTSSSessions() {
-	// Set up chain, asset, and validators
-	chain := types.NewExternalChain(
-		"ethereum-1",
-		"Ethereum",
-		"evm",
-		true,
-		12,
-		12,
-		"0x1234567890abcdef",
-		math.NewInt(100_000),
-		math.NewInt(10_000_000_000),
-	)
-	suite.keeper.SetExternalChain(suite.ctx, chain)
-
-	asset := types.NewExternalAsset(
-		"ethereum-1",
-		"USDT",
-		"Tether USD",
-		"0xdAC17F958D2ee523a2206206994597C13D831ec7",
-		6,
-		true,
-		math.LegacyOneDec(),
-		math.NewInt(1_000_000_000_000),
-		math.NewInt(100_000_000_000),
-	)
-	suite.keeper.SetExternalAsset(suite.ctx, asset)
-
-	validator := sdk.AccAddress("validator1")
-	suite.stakingKeeper.validators[validator.String()] = true
-	suite.stakingKeeper.validatorTiers[validator.String()] = 4
-	suite.stakingKeeper.totalVals = 10
-
-	sender := sdk.AccAddress("sender1")
-	suite.bankKeeper.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin(hodltypes.HODLDenom, math.NewInt(100_000_000)))
+	validators := testValidators(1)
+	suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, validators, 1)
 
 	// Create multiple withdrawals and TSS sessions
-	params := suite.keeper.GetParams(suite.ctx)
-	futureTime := suite.ctx.BlockTime().Add(params.WithdrawalTimelockDuration()).Add(1 * time.Second)
-	suite.ctx = suite.ctx.WithBlockTime(futureTime)
-
 	for i := 0; i < 3; i++ {
-		withdrawalID, err := suite.keeper.RequestWithdrawal(
-			suite.ctx,
-			sender,
-			"ethereum-1",
-			"USDT",
-			"0xrecipient",
-			math.NewInt(5_000_000),
-		)
-		suite.Require().NoError(err)
-
-		withdrawal, _ := suite.keeper.GetWithdrawal(suite.ctx, withdrawalID)
-		withdrawal.Status = types.WithdrawalStatusReady
-		suite.keeper.SetWithdrawal(suite.ctx, withdrawal)
-
-		_, err = suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
+		withdrawalID := suite.readyWithdrawal("ethereum-1")
+		_, err := suite.keeper.CreateTSSSession(suite.ctx, withdrawalID)
 		suite.Require().NoError(err)
 	}
 
@@ -660,3 +718,69 @@ func (suite *KeeperTestSuite) TestGetAllTSSSessions() {
 	suite.Require().Equal(uint64(2), sessions[1].ID)
 	suite.Require().Equal(uint64(3), sessions[2].ID)
 }
+
+// TestRegisterTSSKey tests TSS key registration checks
+func (suite *KeeperTestSuite) TestRegisterTSSKey() {
+	validators := testValidators(3)
+	for _, val := range validators {
+		suite.stakingKeeper.validators[val.String()] = true
+		suite.stakingKeeper.validatorTiers[val.String()] = 4
+	}
+
+	addChain := func(chainID, chainType, address string) {
+		suite.keeper.SetExternalChain(suite.ctx, types.NewExternalChain(
+			chainID, "Test Chain", chainType, true, 6, 600, address,
+			math.NewInt(100_000), math.NewInt(10_000_000_000),
+		))
+	}
+
+	// A Bitcoin key controls the chain's P2WPKH address
+	bitcoin := newLocalSignerSet("bitcoin-1", types.TSSSchemeECDSA, validators, 2)
+	addChain("bitcoin-1", "utxo", bitcoin.address("utxo"))
+	suite.Require().NoError(suite.keeper.RegisterTSSKey(suite.ctx, bitcoin.key))
+
+	key, found := suite.keeper.GetTSSKey(suite.ctx, "bitcoin-1")
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), key.Threshold)
+	suite.Require().Len(suite.keeper.GetAllTSSKeys(suite.ctx), 1)
+
+	// Keys cannot be replaced
+	suite.Require().ErrorIs(suite.keeper.RegisterTSSKey(suite.ctx, bitcoin.key), types.ErrTSSKeyExists)
+
+	other := newLocalSignerSet("ethereum-1", types.TSSSchemeECDSA, validators, 2)
+	addChain("ethereum-1", "evm", other.address("evm"))
+
+	tests := []struct {
+		name   string
+		modify func(key *types.TSSKey)
+	}{
+		{"key does not control the address", func(key *types.TSSKey) {
+			key.PublicKey = bitcoin.key.PublicKey
+			key.Shares = bitcoin.key.Shares
+		}},
+		{"public share off the key polynomial", func(key *types.TSSKey) {
+			key.Shares[2].PublicShare = bitcoin.key.Shares[2].PublicShare
+		}},
+		{"threshold below minimum", func(key *types.TSSKey) {
+			key.Threshold = 1
+		}},
+		{"wrong scheme for chain type", func(key *types.TSSKey) {
+			key.Scheme = types.TSSSchemeEdDSA
+		}},
+		{"holder is not an eligible validator", func(key *types.TSSKey) {
+			key.Shares[1].Validator = sdk.AccAddress("stranger").String()
+		}},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			key := other.key
+			key.Shares = append([]types.TSSKeyShare(nil), other.key.Shares...)
+			tt.modify(&key)
+			suite.Require().ErrorIs(suite.keeper.RegisterTSSKey(suite.ctx, key), types.ErrInvalidTSSKey)
+		})
+	}
+
+	// Unchanged, the key registers
+	suite.Require().NoError(suite.keeper.RegisterTSSKey(suite.ctx, other.key))
+}
@@ -37,6 +37,12 @@ func (k Keeper) SetWithdrawal(ctx sdk.Context, withdrawal types.Withdrawal) erro
 		return fmt.Errorf("failed to marshal withdrawal: %w", err)
 	}
 	store.Set(types.WithdrawalKey(withdrawal.ID), bz)
+
+	// Remember when each recipient was first sent a withdrawal
+	recipientKey := types.WithdrawalRecipientKey(withdrawal.ChainID, withdrawal.Recipient)
+	if first, err := sdk.ParseTimeBytes(store.Get(recipientKey)); err != nil || withdrawal.RequestedAt.Before(first) {
+		store.Set(recipientKey, sdk.FormatTimeBytes(withdrawal.RequestedAt))
+	}
 	return nil
 }
 
@@ -75,8 +81,8 @@ func (k Keeper) RequestWithdrawal(
 		return 0, types.ErrAddressBanned.Wrapf("sender is banned: %s", reason)
 	}
 
-	// Check circuit breaker
-	if err := k.IsOperationAllowed(ctx, "withdraw"); err != nil {
+	// Check circuit breakers
+	if err := k.IsOperationAllowedFor(ctx, "withdraw", chainID, assetSymbol); err != nil {
 		return 0, err
 	}
 
@@ -104,6 +110,14 @@ func (k Keeper) RequestWithdrawal(
 		return 0, types.ErrAssetNotSupported
 	}
 
+	// Only chains the module can build transfers for can be paid out to
+	if !types.HasTxBuilder(chain.ChainType) {
+		return 0, types.ErrChainNotSupported.Wrapf("no transaction builder for %s chains", chain.ChainType)
+	}
+	if err := types.ValidateExternalRecipient(chain, recipient); err != nil {
+		return 0, types.ErrInvalidExternalAddress.Wrap(err.Error())
+	}
+
 	// Check sender has enough HODL
 	balance := k.bankKeeper.GetBalance(ctx, sender, hodltypes.HODLDenom)
 	if balance.Amount.LT(hodlAmount) {
@@ -144,6 +158,7 @@ func (k Keeper) RequestWithdrawal(
 		externalAmount,
 		feeAmount,
 		params.WithdrawalTimelockDuration(),
+		ctx.BlockTime(),
 	)
 
 	if err := k.SetWithdrawal(ctx, withdrawal); err != nil {
@@ -203,6 +218,11 @@ func (k Keeper) RefundWithdrawal(ctx sdk.Context, withdrawalID uint64) error {
 		return err
 	}
 
+	// The unsigned transfer will never be signed; free its nonce or custody output
+	if withdrawal.Tx != nil {
+		k.releaseExternalTx(ctx, withdrawal.ChainID, *withdrawal.Tx)
+	}
+
 	// Update withdrawal status
 	withdrawal.Status = types.WithdrawalStatusRefunded
 	if err := k.SetWithdrawal(ctx, withdrawal); err != nil {
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/keeper"
 	"github.com/sharehodl/sharehodl-blockchain/x/extbridge/types"
 	hodltypes "github.com/sharehodl/sharehodl-blockchain/x/hodl/types"
 )
@@ -50,7 +51,7 @@ func (suite *KeeperTestSuite) TestRequestWithdrawal() {
 		sender,
 		"ethereum-1",
 		"USDT",
-		"0xrecipient",
+		testRecipient,
 		hodlAmount,
 	)
 
@@ -63,7 +64,7 @@ func (suite *KeeperTestSuite) TestRequestWithdrawal() {
 	suite.Require().Equal("ethereum-1", withdrawal.ChainID)
 	suite.Require().Equal("USDT", withdrawal.AssetSymbol)
 	suite.Require().Equal(sender.String(), withdrawal.Sender)
-	suite.Require().Equal("0xrecipient", withdrawal.Recipient)
+	suite.Require().Equal(testRecipient, withdrawal.Recipient)
 	suite.Require().Equal(types.WithdrawalStatusPending, withdrawal.Status)
 
 	// Verify fee was calculated
@@ -119,7 +120,7 @@ func (suite *KeeperTestSuite) TestRequestWithdrawalInsufficientBalance() {
 		sender,
 		"ethereum-1",
 		"USDT",
-		"0xrecipient",
+		testRecipient,
 		math.NewInt(10_000_000),
 	)
 
@@ -146,6 +147,34 @@ func (suite *KeeperTestSuite) TestRequestWithdrawalUnsupportedChain() {
 	suite.Require().Equal(types.ErrChainNotSupported, err)
 }
 
+// TestRequestWithdrawalNoTxBuilder tests that withdrawals are refused on chains
+// no transfer transaction can be built for
+func (suite *KeeperTestSuite) TestRequestWithdrawalNoTxBuilder() {
+	suite.setupTSSChain("solana-1", "solana", types.TSSSchemeEdDSA, testValidators(3), 2)
+	sender := sdk.AccAddress("sender1")
+	suite.bankKeeper.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin(hodltypes.HODLDenom, math.NewInt(10_000_000)))
+
+	_, err := suite.keeper.RequestWithdrawal(suite.ctx, sender, "solana-1", "USDT", "9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM", math.NewInt(5_000_000))
+	suite.Require().ErrorIs(err, types.ErrChainNotSupported)
+}
+
+// TestRequestWithdrawalInvalidRecipient tests that recipients must be valid
+// addresses on the external chain
+func (suite *KeeperTestSuite) TestRequestWithdrawalInvalidRecipient() {
+	suite.setupTSSChain("ethereum-1", "evm", types.TSSSchemeECDSA, testValidators(3), 2)
+	sender := sdk.AccAddress("sender1")
+	suite.bankKeeper.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin(hodltypes.HODLDenom, math.NewInt(10_000_000)))
+
+	for _, recipient := range []string{
+		"0xrecipient",
+		"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0x0000000000000000000000000000000000000000",
+	} {
+		_, err := suite.keeper.RequestWithdrawal(suite.ctx, sender, "ethereum-1", "USDT", recipient, math.NewInt(5_000_000))
+		suite.Require().ErrorIs(err, types.ErrInvalidExternalAddress, recipient)
+	}
+}
+
 // TestRequestWithdrawalDisabledChain tests withdrawal to disabled chain
 func (suite *KeeperTestSuite) TestRequestWithdrawalDisabledChain() {
 	// Set up disabled chain
@@ -184,7 +213,7 @@ func (suite *KeeperTestSuite) TestRequestWithdrawalDisabledChain() {
 		sender,
 		"ethereum-1",
 		"USDT",
-		"0xrecipient",
+		testRecipient,
 		math.NewInt(10_000_000),
 	)
 
@@ -237,7 +266,7 @@ func (suite *KeeperTestSuite) TestRequestWithdrawalRateLimit() {
 		sender,
 		"ethereum-1",
 		"USDT",
-		"0xrecipient",
+		testRecipient,
 		math.NewInt(8_000_000),
 	)
 	suite.Require().NoError(err)
@@ -248,7 +277,7 @@ func (suite *KeeperTestSuite) TestRequestWithdrawalRateLimit() {
 		sender,
 		"ethereum-1",
 		"USDT",
-		"0xrecipient",
+		testRecipient,
 		math.NewInt(8_000_000),
 	)
 	suite.Require().Error(err)
@@ -260,12 +289,48 @@ func (suite *KeeperTestSuite) TestRequestWithdrawalRateLimit() {
 		sender,
 		"ethereum-1",
 		"USDT",
-		"0xrecipient",
+		testRecipient,
 		math.NewInt(2_000_000),
 	)
 	suite.Require().NoError(err)
 }
 
+// TestSetRateLimit tests that governance sets the withdrawal rate limit
+// without touching any other parameter
+func (suite *KeeperTestSuite) TestSetRateLimit() {
+	msgServer := keeper.NewMsgServerImpl(*suite.keeper)
+	before := suite.keeper.GetParams(suite.ctx)
+
+	_, err := msgServer.SetRateLimit(suite.ctx, &types.MsgSetRateLimit{
+		Authority:              "cosmos1notauthority",
+		RateLimitWindow:        3600,
+		MaxWithdrawalPerWindow: math.NewInt(5_000_000),
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = msgServer.SetRateLimit(suite.ctx, &types.MsgSetRateLimit{
+		Authority:              "cosmos1authority",
+		RateLimitWindow:        0,
+		MaxWithdrawalPerWindow: math.NewInt(5_000_000),
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidRateLimit)
+
+	_, err = msgServer.SetRateLimit(suite.ctx, &types.MsgSetRateLimit{
+		Authority:              "cosmos1authority",
+		RateLimitWindow:        3600,
+		MaxWithdrawalPerWindow: math.NewInt(5_000_000),
+	})
+	suite.Require().NoError(err)
+
+	after := suite.keeper.GetParams(suite.ctx)
+	suite.Require().Equal(uint64(3600), after.RateLimitWindow)
+	suite.Require().Equal(math.NewInt(5_000_000), after.MaxWithdrawalPerWindow)
+
+	after.RateLimitWindow = before.RateLimitWindow
+	after.MaxWithdrawalPerWindow = before.MaxWithdrawalPerWindow
+	suite.Require().Equal(before, after)
+}
+
 // TestWithdrawalTimelock tests timelock enforcement
 func (suite *KeeperTestSuite) TestWithdrawalTimelock() {
 	// Set up chain and asset
@@ -304,7 +369,7 @@ func (suite *KeeperTestSuite) TestWithdrawalTimelock() {
 		sender,
 		"ethereum-1",
 		"USDT",
-		"0xrecipient",
+		testRecipient,
 		math.NewInt(5_000_000),
 	)
 	suite.Require().NoError(err)
@@ -370,7 +435,7 @@ func (suite *KeeperTestSuite) TestProcessWithdrawals() {
 		sender,
 		"ethereum-1",
 		"USDT",
-		"0xrecipient",
+		testRecipient,
 		math.NewInt(5_000_000),
 	)
 	suite.Require().NoError(err)
@@ -380,7 +445,7 @@ func (suite *KeeperTestSuite) TestProcessWithdrawals() {
 		sender,
 		"ethereum-1",
 		"USDT",
-		"0xrecipient",
+		testRecipient,
 		math.NewInt(5_000_000),
 	)
 	suite.Require().NoError(err)
@@ -444,7 +509,7 @@ func (suite *KeeperTestSuite) TestWithdrawalStatusTransitions() {
 		sender,
 		"ethereum-1",
 		"USDT",
-		"0xrecipient",
+		testRecipient,
 		math.NewInt(5_000_000),
 	)
 	suite.Require().NoError(err)
@@ -532,7 +597,7 @@ func (suite *KeeperTestSuite) TestWithdrawalWithCircuitBreaker() {
 		sender,
 		"ethereum-1",
 		"USDT",
-		"0xrecipient",
+		testRecipient,
 		math.NewInt(5_000_000),
 	)
 
@@ -579,7 +644,7 @@ func (suite *KeeperTestSuite) TestGetAllWithdrawals() {
 			sender,
 			"ethereum-1",
 			"USDT",
-			"0xrecipient",
+			testRecipient,
 			math.NewInt(5_000_000),
 		)
 		suite.Require().NoError(err)
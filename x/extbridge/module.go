@@ -3,6 +3,7 @@ package extbridge
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"cosmossdk.io/core/appmodule"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -104,6 +105,11 @@ func (AppModule) Name() string {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	// Message server is registered directly through keeper methods
 	// Query server can be registered when available
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the extbridge module.
@@ -123,7 +129,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the extbridge module.
 func (am AppModule) BeginBlock(ctx context.Context) error {
@@ -134,8 +140,22 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 func (am AppModule) EndBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	// Record anomalies in this block's activity, pausing what they put at risk
+	am.keeper.DetectAnomalies(sdkCtx)
+
+	// Drop reserve attestation rounds that never reached a quorum
+	am.keeper.ExpireReserveRounds(sdkCtx)
+
 	// Process withdrawals that are ready for TSS signing
 	am.keeper.ProcessWithdrawals(sdkCtx)
 
+	// Time out signing sessions past their deadline
+	am.keeper.ExpireTSSSessions(sdkCtx)
+
+	// Close expired key generation rounds, rotate drifted keys and drive custody migrations
+	am.keeper.ProcessTSSKeyGenerations(sdkCtx)
+	am.keeper.CheckTSSKeyRotations(sdkCtx)
+	am.keeper.ProcessTSSKeyMigrations(sdkCtx)
+
 	return nil
 }
@@ -17,6 +17,9 @@ type ExternalChain struct {
 	TSSAddress       string         `json:"tss_address" yaml:"tss_address"`               // TSS-controlled address on external chain
 	MinDeposit       math.Int       `json:"min_deposit" yaml:"min_deposit"`               // Minimum deposit amount
 	MaxDeposit       math.Int       `json:"max_deposit" yaml:"max_deposit"`               // Maximum deposit amount per transaction
+	NetworkID        uint64         `json:"network_id,omitempty" yaml:"network_id,omitempty"` // EIP-155 chain ID of an EVM chain
+	FeeRate          math.Int       `json:"fee_rate" yaml:"fee_rate"`                     // Outbound fee rate: gas price in wei (EVM) or sat/vbyte (UTXO)
+	GasLimit         uint64         `json:"gas_limit,omitempty" yaml:"gas_limit,omitempty"` // Gas limit of outbound EVM transfers
 }
 
 // NewExternalChain creates a new ExternalChain
@@ -73,6 +76,9 @@ func (ec ExternalChain) Validate() error {
 	if ec.MaxDeposit.LT(ec.MinDeposit) {
 		return fmt.Errorf("max deposit must be >= min deposit")
 	}
+	if !ec.FeeRate.IsNil() && ec.FeeRate.IsNegative() {
+		return fmt.Errorf("fee rate must be non-negative")
+	}
 	return nil
 }
 
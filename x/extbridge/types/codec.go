@@ -12,7 +12,15 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAttestDeposit{}, "extbridge/MsgAttestDeposit", nil)
 	cdc.RegisterConcrete(&MsgRequestWithdrawal{}, "extbridge/MsgRequestWithdrawal", nil)
 	cdc.RegisterConcrete(&MsgSubmitTSSSignature{}, "extbridge/MsgSubmitTSSSignature", nil)
+	cdc.RegisterConcrete(&MsgSubmitTSSCommitment{}, "extbridge/MsgSubmitTSSCommitment", nil)
+	cdc.RegisterConcrete(&MsgRegisterTSSKey{}, "extbridge/MsgRegisterTSSKey", nil)
+	cdc.RegisterConcrete(&MsgStartTSSKeyGeneration{}, "extbridge/MsgStartTSSKeyGeneration", nil)
+	cdc.RegisterConcrete(&MsgSubmitTSSDealing{}, "extbridge/MsgSubmitTSSDealing", nil)
+	cdc.RegisterConcrete(&MsgFileTSSComplaint{}, "extbridge/MsgFileTSSComplaint", nil)
+	cdc.RegisterConcrete(&MsgSubmitTSSJustification{}, "extbridge/MsgSubmitTSSJustification", nil)
+	cdc.RegisterConcrete(&MsgAttestReserves{}, "extbridge/MsgAttestReserves", nil)
 	cdc.RegisterConcrete(&MsgUpdateCircuitBreaker{}, "extbridge/MsgUpdateCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimit{}, "extbridge/MsgSetRateLimit", nil)
 	cdc.RegisterConcrete(&MsgAddExternalChain{}, "extbridge/MsgAddExternalChain", nil)
 	cdc.RegisterConcrete(&MsgAddExternalAsset{}, "extbridge/MsgAddExternalAsset", nil)
 }
@@ -42,4 +42,22 @@ var (
 	ErrBurnFailed              = errors.Register(ModuleName, 34, "failed to burn HODL")
 	ErrUnauthorized            = errors.Register(ModuleName, 35, "unauthorized: only governance can perform this action")
 	ErrAddressBanned           = errors.Register(ModuleName, 36, "address is banned from using the bridge")
+	ErrTSSKeyNotFound          = errors.Register(ModuleName, 37, "no TSS key registered for chain")
+	ErrInvalidTSSKey           = errors.Register(ModuleName, 38, "invalid TSS key")
+	ErrTSSKeyExists            = errors.Register(ModuleName, 39, "TSS key already registered for chain")
+	ErrInvalidTSSCommitment    = errors.Register(ModuleName, 40, "invalid TSS nonce commitment")
+	ErrInvalidTSSShare         = errors.Register(ModuleName, 41, "invalid TSS signature share")
+	ErrTSSKeyGenNotFound       = errors.Register(ModuleName, 42, "TSS key generation not found")
+	ErrTSSKeyGenInProgress     = errors.Register(ModuleName, 43, "TSS key generation already in progress for chain")
+	ErrInvalidTSSKeyGenPhase   = errors.Register(ModuleName, 44, "TSS key generation is not in this round")
+	ErrInvalidTSSDealing       = errors.Register(ModuleName, 45, "invalid TSS key generation dealing")
+	ErrInvalidTSSComplaint     = errors.Register(ModuleName, 46, "invalid TSS key generation complaint")
+	ErrTSSMigrationPending     = errors.Register(ModuleName, 47, "TSS custody migration pending for chain")
+	ErrInvalidCircuitBreakerScope = errors.Register(ModuleName, 48, "invalid circuit breaker scope")
+	ErrInvalidReserveAttestation  = errors.Register(ModuleName, 49, "invalid reserve attestation")
+	ErrReserveAttestationTooSoon  = errors.Register(ModuleName, 50, "reserve attestation interval has not elapsed")
+	ErrMintingHalted              = errors.Register(ModuleName, 51, "minting halted: attested reserves do not cover bridged supply")
+	ErrInsufficientCustody        = errors.Register(ModuleName, 52, "custody cannot fund the transfer")
+	ErrExternalTxBuild            = errors.Register(ModuleName, 53, "cannot build the external transfer")
+	ErrInvalidRateLimit           = errors.Register(ModuleName, 54, "invalid withdrawal rate limit")
 )
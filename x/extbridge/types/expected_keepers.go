@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -46,6 +47,9 @@ type StakingKeeper interface {
 
 	// GetValidatorsByTier returns validators at or above a certain tier
 	GetValidatorsByTier(ctx sdk.Context, minTier int32) ([]sdk.AccAddress, error)
+
+	// Slash reduces a validator's stake for misbehavior
+	Slash(ctx sdk.Context, staker sdk.AccAddress, reason string, slashFraction math.LegacyDec) error
 }
 
 // EscrowKeeper defines the expected interface for ban/blacklist checking
@@ -0,0 +1,395 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/btcutil/bech32"
+	"golang.org/x/crypto/sha3"
+)
+
+// Outbound transactions
+//
+// A TSS session signs the chain-native digest of an unsigned transaction the
+// module builds itself, so a signature can only authorise the transfer the
+// module recorded. EVM chains sign the Keccak-256 hash of an EIP-155 legacy
+// transaction; UTXO chains sign the BIP143 sighash of a P2WPKH input spending
+// one custody output. Other chain types have no transaction builder yet and
+// cannot be withdrawn to.
+
+const (
+	// UTXODustLimit is the smallest output UTXO chains relay; smaller change
+	// is left to the miner instead
+	UTXODustLimit = 546
+
+	// erc20TransferSelector is the selector of transfer(address,uint256)
+	erc20TransferSelector = "a9059cbb"
+
+	utxoTxVersion  = 2
+	utxoSequence   = 0xffffffff
+	utxoSigHashAll = 1
+)
+
+// ExternalTx is an unsigned outbound transaction and the digest its TSS
+// signature covers
+type ExternalTx struct {
+	Raw        []byte          `json:"raw" yaml:"raw"`                           // Chain-native unsigned serialization
+	Digest     []byte          `json:"digest" yaml:"digest"`                     // Digest the TSS key signs
+	Nonce      *uint64         `json:"nonce,omitempty" yaml:"nonce,omitempty"`   // EVM account nonce the transaction spends
+	Inputs     []CustodyOutput `json:"inputs,omitempty" yaml:"inputs,omitempty"` // UTXO custody outputs the transaction spends
+	Change     *CustodyOutput  `json:"change,omitempty" yaml:"change,omitempty"` // UTXO change returned to custody
+	NetworkFee math.Int        `json:"network_fee" yaml:"network_fee"`           // Fee paid to the external chain, in external asset units
+}
+
+// CustodyOutput is an unspent output held at a UTXO chain's TSS address
+type CustodyOutput struct {
+	ChainID     string   `json:"chain_id" yaml:"chain_id"`
+	AssetSymbol string   `json:"asset_symbol" yaml:"asset_symbol"`
+	TxID        string   `json:"tx_id" yaml:"tx_id"` // Hex, in the byte order block explorers show
+	Vout        uint32   `json:"vout" yaml:"vout"`
+	Amount      math.Int `json:"amount" yaml:"amount"` // In satoshis
+	Address     string   `json:"address" yaml:"address"`
+}
+
+// Outpoint returns the output's txid:vout
+func (o CustodyOutput) Outpoint() string {
+	return fmt.Sprintf("%s:%d", o.TxID, o.Vout)
+}
+
+// ExternalNonceState tracks the account nonces a chain's TSS address has
+// handed out. Nonces of transactions that were never signed are reused
+// lowest first, so a refund does not leave a gap that stalls later transfers.
+type ExternalNonceState struct {
+	ChainID  string   `json:"chain_id" yaml:"chain_id"`
+	Next     uint64   `json:"next" yaml:"next"`
+	Released []uint64 `json:"released,omitempty" yaml:"released,omitempty"`
+}
+
+// HasTxBuilder returns whether outbound transactions can be built for a chain type
+func HasTxBuilder(chainType string) bool {
+	return chainType == "evm" || chainType == "utxo"
+}
+
+// ValidateExternalRecipient checks that an address can be paid on a chain.
+// UTXO recipients must be on the same network as the chain's TSS address.
+func ValidateExternalRecipient(chain ExternalChain, address string) error {
+	switch chain.ChainType {
+	case "evm":
+		_, err := parseEVMAddress(address)
+		return err
+	case "utxo":
+		if _, err := segwitScript(address); err != nil {
+			return err
+		}
+		hrp, err := tssAddressHRP(chain.ChainType, chain.TSSAddress)
+		if err != nil {
+			return err
+		}
+		if recipientHRP, _ := tssAddressHRP(chain.ChainType, address); recipientHRP != hrp {
+			return fmt.Errorf("%s is not a %s address", address, hrp)
+		}
+		return nil
+	default:
+		return fmt.Errorf("no transaction builder for %s chains", chain.ChainType)
+	}
+}
+
+// ParseOutpoint parses a UTXO deposit's txid:vout
+func ParseOutpoint(outpoint string) (string, uint32, error) {
+	txID, voutStr, found := strings.Cut(outpoint, ":")
+	if !found {
+		return "", 0, fmt.Errorf("outpoint %q is not txid:vout", outpoint)
+	}
+	if bz, err := hex.DecodeString(txID); err != nil || len(bz) != 32 {
+		return "", 0, fmt.Errorf("outpoint %q has an invalid txid", outpoint)
+	}
+	vout, err := strconv.ParseUint(voutStr, 10, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("outpoint %q has an invalid output index", outpoint)
+	}
+	return strings.ToLower(txID), uint32(vout), nil
+}
+
+// BuildEVMTransfer builds the EIP-155 transaction paying amount of an asset
+// from the TSS address: a value transfer for the chain's native asset, or an
+// ERC-20 transfer call on the asset's contract. Gas is paid by the TSS
+// address at the chain's configured fee rate.
+func BuildEVMTransfer(chain ExternalChain, asset ExternalAsset, nonce uint64, recipient string, amount math.Int) (ExternalTx, error) {
+	if chain.NetworkID == 0 {
+		return ExternalTx{}, fmt.Errorf("chain %s has no EIP-155 network ID", chain.ChainID)
+	}
+	if chain.FeeRate.IsNil() || !chain.FeeRate.IsPositive() || chain.GasLimit == 0 {
+		return ExternalTx{}, fmt.Errorf("chain %s has no gas price or gas limit", chain.ChainID)
+	}
+	if amount.IsNil() || !amount.IsPositive() {
+		return ExternalTx{}, fmt.Errorf("amount must be positive")
+	}
+	to, err := parseEVMAddress(recipient)
+	if err != nil {
+		return ExternalTx{}, err
+	}
+
+	value := amount.BigInt()
+	var data []byte
+	if asset.ContractAddress != "" {
+		contract, err := parseEVMAddress(asset.ContractAddress)
+		if err != nil {
+			return ExternalTx{}, fmt.Errorf("asset contract: %w", err)
+		}
+		selector, _ := hex.DecodeString(erc20TransferSelector)
+		data = append(data, selector...)
+		data = append(data, leftPad32(to)...)
+		data = append(data, leftPad32(value.Bytes())...)
+		to, value = contract, new(big.Int)
+	}
+
+	raw := rlpList(
+		rlpUint(new(big.Int).SetUint64(nonce)),
+		rlpUint(chain.FeeRate.BigInt()),
+		rlpUint(new(big.Int).SetUint64(chain.GasLimit)),
+		rlpBytes(to),
+		rlpUint(value),
+		rlpBytes(data),
+		rlpUint(new(big.Int).SetUint64(chain.NetworkID)),
+		rlpUint(new(big.Int)),
+		rlpUint(new(big.Int)),
+	)
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(raw)
+
+	return ExternalTx{
+		Raw:        raw,
+		Digest:     hasher.Sum(nil),
+		Nonce:      &nonce,
+		NetworkFee: chain.FeeRate.MulRaw(int64(chain.GasLimit)),
+	}, nil
+}
+
+// BuildUTXOTransfer builds the transaction spending one custody output to pay
+// amount, less the network fee, to recipient, returning change above the dust
+// limit to the TSS address
+func BuildUTXOTransfer(chain ExternalChain, input CustodyOutput, recipient string, amount math.Int) (ExternalTx, error) {
+	if chain.FeeRate.IsNil() || !chain.FeeRate.IsPositive() {
+		return ExternalTx{}, fmt.Errorf("chain %s has no fee rate", chain.ChainID)
+	}
+	if amount.IsNil() || !amount.IsPositive() || amount.GT(input.Amount) {
+		return ExternalTx{}, fmt.Errorf("amount must be positive and at most the %s input", input.Amount)
+	}
+	recipientScript, err := segwitScript(recipient)
+	if err != nil {
+		return ExternalTx{}, err
+	}
+	custodyScript, err := segwitScript(input.Address)
+	if err != nil {
+		return ExternalTx{}, fmt.Errorf("custody address: %w", err)
+	}
+	if len(custodyScript) != 22 {
+		return ExternalTx{}, fmt.Errorf("custody address %s is not P2WPKH", input.Address)
+	}
+
+	scripts := [][]byte{recipientScript}
+	change := input.Amount.Sub(amount)
+	if change.GTE(math.NewInt(UTXODustLimit)) {
+		scripts = append(scripts, custodyScript)
+	}
+	fee := chain.FeeRate.MulRaw(int64(utxoTxVSize(scripts)))
+	pay := amount.Sub(fee)
+	if pay.LT(math.NewInt(UTXODustLimit)) {
+		return ExternalTx{}, fmt.Errorf("amount %s does not cover the %s network fee", amount, fee)
+	}
+
+	values := []math.Int{pay}
+	if len(scripts) == 2 {
+		values = append(values, change)
+	}
+	prevTxID, err := reversedTxID(input.TxID)
+	if err != nil {
+		return ExternalTx{}, err
+	}
+	var outpoint []byte
+	outpoint = append(outpoint, prevTxID...)
+	outpoint = binary.LittleEndian.AppendUint32(outpoint, input.Vout)
+
+	var outputs []byte
+	for i, script := range scripts {
+		outputs = binary.LittleEndian.AppendUint64(outputs, values[i].Uint64())
+		outputs = append(outputs, varInt(uint64(len(script)))...)
+		outputs = append(outputs, script...)
+	}
+
+	// BIP143: the P2WPKH script code is the P2PKH script of the key hash
+	scriptCode := append([]byte{0x19, 0x76, 0xa9, 0x14}, custodyScript[2:]...)
+	scriptCode = append(scriptCode, 0x88, 0xac)
+
+	var preimage []byte
+	preimage = binary.LittleEndian.AppendUint32(preimage, utxoTxVersion)
+	preimage = append(preimage, doubleSHA256(outpoint)...)
+	preimage = append(preimage, doubleSHA256(binary.LittleEndian.AppendUint32(nil, utxoSequence))...)
+	preimage = append(preimage, outpoint...)
+	preimage = append(preimage, scriptCode...)
+	preimage = binary.LittleEndian.AppendUint64(preimage, input.Amount.Uint64())
+	preimage = binary.LittleEndian.AppendUint32(preimage, utxoSequence)
+	preimage = append(preimage, doubleSHA256(outputs)...)
+	preimage = binary.LittleEndian.AppendUint32(preimage, 0)
+	preimage = binary.LittleEndian.AppendUint32(preimage, utxoSigHashAll)
+
+	// The unsigned serialization omits the witness, so its hash is the txid
+	var raw []byte
+	raw = binary.LittleEndian.AppendUint32(raw, utxoTxVersion)
+	raw = append(raw, 1)
+	raw = append(raw, outpoint...)
+	raw = append(raw, 0)
+	raw = binary.LittleEndian.AppendUint32(raw, utxoSequence)
+	raw = append(raw, varInt(uint64(len(scripts)))...)
+	raw = append(raw, outputs...)
+	raw = binary.LittleEndian.AppendUint32(raw, 0)
+
+	tx := ExternalTx{
+		Raw:        raw,
+		Digest:     doubleSHA256(preimage),
+		Inputs:     []CustodyOutput{input},
+		NetworkFee: fee,
+	}
+	if len(scripts) == 2 {
+		tx.Change = &CustodyOutput{
+			ChainID:     input.ChainID,
+			AssetSymbol: input.AssetSymbol,
+			TxID:        UTXOTxID(raw),
+			Vout:        1,
+			Amount:      change,
+			Address:     input.Address,
+		}
+	}
+	return tx, nil
+}
+
+// UTXOTxID returns the txid of an unsigned segwit transaction, in the byte
+// order block explorers show
+func UTXOTxID(raw []byte) string {
+	txID := doubleSHA256(raw)
+	reverse(txID)
+	return hex.EncodeToString(txID)
+}
+
+// utxoTxVSize returns the virtual size of a transaction spending one P2WPKH
+// input to the given output scripts
+func utxoTxVSize(scripts [][]byte) uint64 {
+	// Version, locktime, counts and segwit marker, then the input with its
+	// witness of a signature and a compressed key at a quarter weight
+	size := uint64(11 + 41 + 27)
+	for _, script := range scripts {
+		size += 9 + uint64(len(script))
+	}
+	return size
+}
+
+// segwitScript returns the output script of a version 0 segwit address
+func segwitScript(address string) ([]byte, error) {
+	_, data, err := bech32.Decode(address, 90)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a segwit address: %w", address, err)
+	}
+	if len(data) == 0 || data[0] != 0 {
+		return nil, fmt.Errorf("%s is not a version 0 segwit address", address)
+	}
+	program, err := bech32.ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return nil, fmt.Errorf("%s has an invalid witness program: %w", address, err)
+	}
+	if len(program) != 20 && len(program) != 32 {
+		return nil, fmt.Errorf("%s has a %d-byte witness program", address, len(program))
+	}
+	return append([]byte{0x00, byte(len(program))}, program...), nil
+}
+
+// parseEVMAddress decodes a 0x-prefixed 20-byte address
+func parseEVMAddress(address string) ([]byte, error) {
+	if !strings.HasPrefix(address, "0x") && !strings.HasPrefix(address, "0X") {
+		return nil, fmt.Errorf("%s is not a 0x address", address)
+	}
+	bz, err := hex.DecodeString(address[2:])
+	if err != nil || len(bz) != 20 {
+		return nil, fmt.Errorf("%s is not a 20-byte hex address", address)
+	}
+	if bytes.Equal(bz, make([]byte, 20)) {
+		return nil, fmt.Errorf("cannot pay the zero address")
+	}
+	return bz, nil
+}
+
+func reversedTxID(txID string) ([]byte, error) {
+	bz, err := hex.DecodeString(txID)
+	if err != nil || len(bz) != 32 {
+		return nil, fmt.Errorf("invalid txid %q", txID)
+	}
+	reverse(bz)
+	return bz, nil
+}
+
+func reverse(bz []byte) {
+	for i, j := 0, len(bz)-1; i < j; i, j = i+1, j-1 {
+		bz[i], bz[j] = bz[j], bz[i]
+	}
+}
+
+func doubleSHA256(data []byte) []byte {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	return second[:]
+}
+
+func varInt(n uint64) []byte {
+	switch {
+	case n < 0xfd:
+		return []byte{byte(n)}
+	case n <= 0xffff:
+		return binary.LittleEndian.AppendUint16([]byte{0xfd}, uint16(n))
+	case n <= 0xffffffff:
+		return binary.LittleEndian.AppendUint32([]byte{0xfe}, uint32(n))
+	default:
+		return binary.LittleEndian.AppendUint64([]byte{0xff}, n)
+	}
+}
+
+func leftPad32(bz []byte) []byte {
+	padded := make([]byte, 32)
+	copy(padded[32-len(bz):], bz)
+	return padded
+}
+
+// rlpBytes encodes a byte string
+func rlpBytes(bz []byte) []byte {
+	if len(bz) == 1 && bz[0] < 0x80 {
+		return bz
+	}
+	return append(rlpHeader(0x80, len(bz)), bz...)
+}
+
+// rlpUint encodes an unsigned integer as its minimal big-endian bytes
+func rlpUint(n *big.Int) []byte {
+	return rlpBytes(n.Bytes())
+}
+
+// rlpList encodes a list of encoded items
+func rlpList(items ...[]byte) []byte {
+	var payload []byte
+	for _, item := range items {
+		payload = append(payload, item...)
+	}
+	return append(rlpHeader(0xc0, len(payload)), payload...)
+}
+
+func rlpHeader(offset byte, length int) []byte {
+	if length <= 55 {
+		return []byte{offset + byte(length)}
+	}
+	lengthBz := new(big.Int).SetInt64(int64(length)).Bytes()
+	return append([]byte{offset + 55 + byte(len(lengthBz))}, lengthBz...)
+}
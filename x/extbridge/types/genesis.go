@@ -13,7 +13,17 @@ type GenesisState struct {
 	Attestations    []DepositAttestation `json:"attestations" yaml:"attestations"`
 	Withdrawals     []Withdrawal     `json:"withdrawals" yaml:"withdrawals"`
 	TSSSessions     []TSSSession     `json:"tss_sessions" yaml:"tss_sessions"`
+	TSSKeys         []TSSKey         `json:"tss_keys" yaml:"tss_keys"`
+	TSSKeyGenerations []TSSKeyGeneration `json:"tss_key_generations" yaml:"tss_key_generations"`
+	TSSKeyMigrations  []TSSKeyMigration  `json:"tss_key_migrations" yaml:"tss_key_migrations"`
 	CircuitBreaker  CircuitBreaker   `json:"circuit_breaker" yaml:"circuit_breaker"`
+	ScopedCircuitBreakers []CircuitBreaker `json:"scoped_circuit_breakers" yaml:"scoped_circuit_breakers"`
+	Anomalies       []AnomalyDetection `json:"anomalies" yaml:"anomalies"`
+	BridgedSupplies []BridgedSupply  `json:"bridged_supplies" yaml:"bridged_supplies"`
+	ReserveRounds   []ReserveRound   `json:"reserve_rounds" yaml:"reserve_rounds"`
+	ReserveSnapshots []ReserveSnapshot `json:"reserve_snapshots" yaml:"reserve_snapshots"`
+	ExternalNonces  []ExternalNonceState `json:"external_nonces" yaml:"external_nonces"`
+	CustodyOutputs  []CustodyOutput  `json:"custody_outputs" yaml:"custody_outputs"`
 	NextDepositID   uint64           `json:"next_deposit_id" yaml:"next_deposit_id"`
 	NextWithdrawalID uint64          `json:"next_withdrawal_id" yaml:"next_withdrawal_id"`
 	NextTSSSessionID uint64          `json:"next_tss_session_id" yaml:"next_tss_session_id"`
@@ -29,7 +39,17 @@ func DefaultGenesisState() *GenesisState {
 		Attestations:    []DepositAttestation{},
 		Withdrawals:     []Withdrawal{},
 		TSSSessions:     []TSSSession{},
+		TSSKeys:         []TSSKey{},
+		TSSKeyGenerations: []TSSKeyGeneration{},
+		TSSKeyMigrations:  []TSSKeyMigration{},
 		CircuitBreaker:  CircuitBreaker{Enabled: false},
+		ScopedCircuitBreakers: []CircuitBreaker{},
+		Anomalies:       []AnomalyDetection{},
+		BridgedSupplies: []BridgedSupply{},
+		ReserveRounds:   []ReserveRound{},
+		ReserveSnapshots: []ReserveSnapshot{},
+		ExternalNonces:  []ExternalNonceState{},
+		CustodyOutputs:  []CustodyOutput{},
 		NextDepositID:   1,
 		NextWithdrawalID: 1,
 		NextTSSSessionID: 1,
@@ -120,10 +140,128 @@ func (gs GenesisState) Validate() error {
 		sessionIDs[session.ID] = true
 	}
 
+	// Validate TSS keys
+	keyChains := make(map[string]bool)
+	for _, key := range gs.TSSKeys {
+		if err := key.Validate(); err != nil {
+			return fmt.Errorf("invalid TSS key for chain %s: %w", key.ChainID, err)
+		}
+		if keyChains[key.ChainID] {
+			return fmt.Errorf("duplicate TSS key for chain %s", key.ChainID)
+		}
+		keyChains[key.ChainID] = true
+
+		if !chainIDs[key.ChainID] {
+			return fmt.Errorf("TSS key references non-existent chain %s", key.ChainID)
+		}
+	}
+
+	// Validate TSS key generations
+	keyGenIDs := make(map[uint64]bool)
+	for _, keyGen := range gs.TSSKeyGenerations {
+		if err := keyGen.Validate(); err != nil {
+			return fmt.Errorf("invalid TSS key generation %d: %w", keyGen.ID, err)
+		}
+		if keyGenIDs[keyGen.ID] {
+			return fmt.Errorf("duplicate TSS key generation ID: %d", keyGen.ID)
+		}
+		keyGenIDs[keyGen.ID] = true
+	}
+
+	// Validate TSS key migrations
+	migrationChains := make(map[string]bool)
+	for _, migration := range gs.TSSKeyMigrations {
+		if err := migration.Validate(); err != nil {
+			return fmt.Errorf("invalid TSS key migration for chain %s: %w", migration.ChainID, err)
+		}
+		if migrationChains[migration.ChainID] {
+			return fmt.Errorf("duplicate TSS key migration for chain %s", migration.ChainID)
+		}
+		migrationChains[migration.ChainID] = true
+
+		if !chainIDs[migration.ChainID] {
+			return fmt.Errorf("TSS key migration references non-existent chain %s", migration.ChainID)
+		}
+	}
+
 	// Validate circuit breaker
 	if err := gs.CircuitBreaker.Validate(); err != nil {
 		return fmt.Errorf("invalid circuit breaker: %w", err)
 	}
+	if gs.CircuitBreaker.IsScoped() {
+		return fmt.Errorf("module-wide circuit breaker cannot be scoped to chain %s", gs.CircuitBreaker.ChainID)
+	}
+
+	// Validate scoped circuit breakers
+	breakerScopes := make(map[string]bool)
+	for _, cb := range gs.ScopedCircuitBreakers {
+		if err := cb.Validate(); err != nil {
+			return fmt.Errorf("invalid circuit breaker for %s/%s: %w", cb.ChainID, cb.AssetSymbol, err)
+		}
+		if !cb.IsScoped() {
+			return fmt.Errorf("scoped circuit breaker must name its chain")
+		}
+		scope := cb.ChainID + "/" + cb.AssetSymbol
+		if breakerScopes[scope] {
+			return fmt.Errorf("duplicate circuit breaker for %s", scope)
+		}
+		breakerScopes[scope] = true
+
+		if !chainIDs[cb.ChainID] {
+			return fmt.Errorf("circuit breaker references non-existent chain %s", cb.ChainID)
+		}
+	}
+
+	// Validate anomalies
+	anomalyIDs := make(map[uint64]bool)
+	for _, anomaly := range gs.Anomalies {
+		if err := anomaly.Validate(); err != nil {
+			return fmt.Errorf("invalid anomaly %d: %w", anomaly.ID, err)
+		}
+		if anomalyIDs[anomaly.ID] {
+			return fmt.Errorf("duplicate anomaly ID: %d", anomaly.ID)
+		}
+		anomalyIDs[anomaly.ID] = true
+	}
+
+	// Validate bridged supplies
+	supplyAssets := make(map[string]bool)
+	for _, supply := range gs.BridgedSupplies {
+		if err := supply.Validate(); err != nil {
+			return fmt.Errorf("invalid bridged supply for %s/%s: %w", supply.ChainID, supply.AssetSymbol, err)
+		}
+		assetKey := supply.ChainID + "/" + supply.AssetSymbol
+		if supplyAssets[assetKey] {
+			return fmt.Errorf("duplicate bridged supply for %s", assetKey)
+		}
+		supplyAssets[assetKey] = true
+	}
+
+	// Validate reserve rounds
+	reserveRounds := make(map[string]bool)
+	for _, round := range gs.ReserveRounds {
+		if err := round.Validate(); err != nil {
+			return fmt.Errorf("invalid reserve round for %s/%s: %w", round.ChainID, round.AssetSymbol, err)
+		}
+		roundKey := fmt.Sprintf("%s/%s/%d", round.ChainID, round.AssetSymbol, round.ExternalHeight)
+		if reserveRounds[roundKey] {
+			return fmt.Errorf("duplicate reserve round for %s", roundKey)
+		}
+		reserveRounds[roundKey] = true
+	}
+
+	// Validate reserve snapshots
+	reserveSnapshots := make(map[string]bool)
+	for _, snapshot := range gs.ReserveSnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return fmt.Errorf("invalid reserve snapshot for %s/%s: %w", snapshot.ChainID, snapshot.AssetSymbol, err)
+		}
+		snapshotKey := fmt.Sprintf("%s/%s/%d", snapshot.ChainID, snapshot.AssetSymbol, snapshot.ExternalHeight)
+		if reserveSnapshots[snapshotKey] {
+			return fmt.Errorf("duplicate reserve snapshot for %s", snapshotKey)
+		}
+		reserveSnapshots[snapshotKey] = true
+	}
 
 	// Validate ID counters
 	if gs.NextDepositID == 0 {
@@ -2,6 +2,9 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -59,8 +62,47 @@ var (
 	// CircuitBreakerKey is the key for circuit breaker status
 	CircuitBreakerKey = []byte{0x0C}
 
-	// TotalBridgedPrefix is the prefix for total bridged amount per asset
+	// TotalBridgedPrefix is the prefix for bridged supply per asset
 	TotalBridgedPrefix = []byte{0x0D}
+
+	// TSSKeyPrefix is the prefix for registered TSS keys per chain
+	TSSKeyPrefix = []byte{0x0E}
+
+	// TSSKeyGenerationPrefix is the prefix for TSS key generation ceremonies
+	TSSKeyGenerationPrefix = []byte{0x0F}
+
+	// NextTSSKeyGenerationIDKey is the key for the next key generation ceremony ID counter
+	NextTSSKeyGenerationIDKey = []byte{0x10}
+
+	// TSSKeyMigrationPrefix is the prefix for custody migrations per chain
+	TSSKeyMigrationPrefix = []byte{0x11}
+
+	// AnomalyPrefix is the prefix for anomaly detection records
+	AnomalyPrefix = []byte{0x12}
+
+	// NextAnomalyIDKey is the key for the next anomaly ID counter
+	NextAnomalyIDKey = []byte{0x13}
+
+	// ScopedCircuitBreakerPrefix is the prefix for per-chain and per-asset circuit breakers
+	ScopedCircuitBreakerPrefix = []byte{0x15}
+
+	// ReserveRoundPrefix is the prefix for open proof-of-reserves attestation rounds
+	ReserveRoundPrefix = []byte{0x16}
+
+	// ReserveSnapshotPrefix is the prefix for attested reserve snapshots per asset
+	ReserveSnapshotPrefix = []byte{0x17}
+
+	// ExternalNoncePrefix is the prefix for the account nonces of EVM TSS addresses
+	ExternalNoncePrefix = []byte{0x18}
+
+	// CustodyOutputPrefix is the prefix for unspent outputs held at UTXO TSS addresses
+	CustodyOutputPrefix = []byte{0x19}
+
+	// OpenTSSSessionPrefix is the prefix for TSS sessions still collecting signatures, by deadline
+	OpenTSSSessionPrefix = []byte{0x1A}
+
+	// WithdrawalRecipientPrefix is the prefix for when each recipient was first sent a withdrawal
+	WithdrawalRecipientPrefix = []byte{0x1B}
 )
 
 // ExternalChainKey returns the key for an external chain config
@@ -112,6 +154,97 @@ func TSSSessionKey(sessionID uint64) []byte {
 	return append(TSSSessionPrefix, bz...)
 }
 
+// OpenTSSSessionKey returns the index key for a TSS session still collecting
+// signatures, ordered by its deadline
+func OpenTSSSessionKey(timeoutAt time.Time, sessionID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, sessionID)
+	key := append([]byte{}, OpenTSSSessionPrefix...)
+	key = append(key, sdk.FormatTimeBytes(timeoutAt)...)
+	return append(key, bz...)
+}
+
+// WithdrawalRecipientKey returns the key for when a recipient on a chain was
+// first sent a withdrawal
+func WithdrawalRecipientKey(chainID string, recipient string) []byte {
+	key := append(WithdrawalRecipientPrefix, []byte(chainID)...)
+	key = append(key, []byte("/")...)
+	return append(key, []byte(recipient)...)
+}
+
+// TSSKeyKey returns the key for a chain's TSS key
+func TSSKeyKey(chainID string) []byte {
+	return append(TSSKeyPrefix, []byte(chainID)...)
+}
+
+// TSSKeyGenerationKey returns the key for a key generation ceremony
+func TSSKeyGenerationKey(keyGenID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, keyGenID)
+	return append(TSSKeyGenerationPrefix, bz...)
+}
+
+// TSSKeyMigrationKey returns the key for a chain's custody migration
+func TSSKeyMigrationKey(chainID string) []byte {
+	return append(TSSKeyMigrationPrefix, []byte(chainID)...)
+}
+
+// AnomalyKey returns the key for an anomaly detection record
+func AnomalyKey(anomalyID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, anomalyID)
+	return append(AnomalyPrefix, bz...)
+}
+
+// ScopedCircuitBreakerKey returns the key for a chain's circuit breaker, or
+// an asset's when assetSymbol is set
+func ScopedCircuitBreakerKey(chainID string, assetSymbol string) []byte {
+	key := append(ScopedCircuitBreakerPrefix, []byte(chainID)...)
+	key = append(key, []byte("/")...)
+	return append(key, []byte(assetSymbol)...)
+}
+
+// ReserveAssetPrefix returns the prefix under which an asset's reserve rounds
+// or snapshots are stored, ordered by external height
+func ReserveAssetPrefix(prefix []byte, chainID string, assetSymbol string) []byte {
+	key := append([]byte{}, prefix...)
+	key = append(key, []byte(chainID)...)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(assetSymbol)...)
+	return append(key, []byte("/")...)
+}
+
+// ReserveRoundKey returns the key for a reserve attestation round
+func ReserveRoundKey(chainID string, assetSymbol string, externalHeight uint64) []byte {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, externalHeight)
+	return append(ReserveAssetPrefix(ReserveRoundPrefix, chainID, assetSymbol), heightBz...)
+}
+
+// ReserveSnapshotKey returns the key for an attested reserve snapshot
+func ReserveSnapshotKey(chainID string, assetSymbol string, externalHeight uint64) []byte {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, externalHeight)
+	return append(ReserveAssetPrefix(ReserveSnapshotPrefix, chainID, assetSymbol), heightBz...)
+}
+
+// ExternalNonceKey returns the key for a chain's outbound account nonces
+func ExternalNonceKey(chainID string) []byte {
+	return append(append([]byte{}, ExternalNoncePrefix...), []byte(chainID)...)
+}
+
+// CustodyChainPrefix returns the prefix under which a chain's custody outputs are stored
+func CustodyChainPrefix(chainID string) []byte {
+	key := append([]byte{}, CustodyOutputPrefix...)
+	key = append(key, []byte(chainID)...)
+	return append(key, []byte("/")...)
+}
+
+// CustodyOutputKey returns the key for a custody output
+func CustodyOutputKey(chainID string, outpoint string) []byte {
+	return append(CustodyChainPrefix(chainID), []byte(outpoint)...)
+}
+
 // RateLimitKey returns the key for rate limit tracking
 func RateLimitKey(chainID string, assetSymbol string, windowStart int64) []byte {
 	key := append(RateLimitPrefix, []byte(chainID)...)
@@ -123,7 +256,7 @@ func RateLimitKey(chainID string, assetSymbol string, windowStart int64) []byte
 	return append(key, windowBz...)
 }
 
-// TotalBridgedKey returns the key for total bridged amount
+// TotalBridgedKey returns the key for an asset's bridged supply
 func TotalBridgedKey(chainID string, assetSymbol string) []byte {
 	key := append(TotalBridgedPrefix, []byte(chainID)...)
 	key = append(key, []byte("/")...)
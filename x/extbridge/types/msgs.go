@@ -164,6 +164,231 @@ func (msg MsgSubmitTSSSignature) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// MsgSubmitTSSCommitment - Validator submits its nonce commitment for a TSS session
+type MsgSubmitTSSCommitment struct {
+	Validator  string `json:"validator" yaml:"validator"`
+	SessionID  uint64 `json:"session_id" yaml:"session_id"`
+	Commitment []byte `json:"commitment" yaml:"commitment"`
+}
+
+// ValidateBasic performs basic validation
+func (msg MsgSubmitTSSCommitment) ValidateBasic() error {
+	if msg.Validator == "" {
+		return ErrNotValidator
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return ErrNotValidator
+	}
+	if msg.SessionID == 0 {
+		return ErrInvalidTSSSession
+	}
+	if len(msg.Commitment) == 0 {
+		return ErrInvalidTSSCommitment
+	}
+	return nil
+}
+
+// GetSigners returns the signers
+func (msg MsgSubmitTSSCommitment) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Validator)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgRegisterTSSKey - Governance registers the TSS key controlling a chain's TSS address
+type MsgRegisterTSSKey struct {
+	Authority string        `json:"authority" yaml:"authority"`
+	ChainID   string        `json:"chain_id" yaml:"chain_id"`
+	Scheme    string        `json:"scheme" yaml:"scheme"`
+	PublicKey []byte        `json:"public_key" yaml:"public_key"`
+	Threshold uint64        `json:"threshold" yaml:"threshold"`
+	Shares    []TSSKeyShare `json:"shares" yaml:"shares"`
+}
+
+// ValidateBasic performs basic validation
+func (msg MsgRegisterTSSKey) ValidateBasic() error {
+	if msg.Authority == "" {
+		return ErrInvalidRecipient
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrInvalidRecipient
+	}
+	if msg.ChainID == "" {
+		return ErrInvalidChain
+	}
+	if len(msg.PublicKey) == 0 || msg.Threshold == 0 || len(msg.Shares) == 0 {
+		return ErrInvalidTSSKey
+	}
+	return nil
+}
+
+// GetSigners returns the signers
+func (msg MsgRegisterTSSKey) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgStartTSSKeyGeneration - Governance starts a key generation ceremony for a chain
+type MsgStartTSSKeyGeneration struct {
+	Authority string `json:"authority" yaml:"authority"`
+	ChainID   string `json:"chain_id" yaml:"chain_id"`
+}
+
+// ValidateBasic performs basic validation
+func (msg MsgStartTSSKeyGeneration) ValidateBasic() error {
+	if msg.Authority == "" {
+		return ErrInvalidRecipient
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrInvalidRecipient
+	}
+	if msg.ChainID == "" {
+		return ErrInvalidChain
+	}
+	return nil
+}
+
+// GetSigners returns the signers
+func (msg MsgStartTSSKeyGeneration) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgSubmitTSSDealing - Validator publishes its polynomial commitments in a key generation ceremony
+type MsgSubmitTSSDealing struct {
+	Validator   string   `json:"validator" yaml:"validator"`
+	KeyGenID    uint64   `json:"key_gen_id" yaml:"key_gen_id"`
+	Commitments [][]byte `json:"commitments" yaml:"commitments"`
+	Proof       []byte   `json:"proof" yaml:"proof"`
+}
+
+// ValidateBasic performs basic validation
+func (msg MsgSubmitTSSDealing) ValidateBasic() error {
+	if msg.Validator == "" {
+		return ErrNotValidator
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return ErrNotValidator
+	}
+	if msg.KeyGenID == 0 {
+		return ErrTSSKeyGenNotFound
+	}
+	if len(msg.Commitments) == 0 || len(msg.Proof) == 0 {
+		return ErrInvalidTSSDealing
+	}
+	return nil
+}
+
+// GetSigners returns the signers
+func (msg MsgSubmitTSSDealing) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Validator)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgFileTSSComplaint - Validator accuses a dealer of sending it a missing or invalid share
+type MsgFileTSSComplaint struct {
+	Validator string `json:"validator" yaml:"validator"`
+	KeyGenID  uint64 `json:"key_gen_id" yaml:"key_gen_id"`
+	Dealer    string `json:"dealer" yaml:"dealer"`
+}
+
+// ValidateBasic performs basic validation
+func (msg MsgFileTSSComplaint) ValidateBasic() error {
+	if msg.Validator == "" {
+		return ErrNotValidator
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return ErrNotValidator
+	}
+	if msg.KeyGenID == 0 {
+		return ErrTSSKeyGenNotFound
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Dealer); err != nil {
+		return ErrInvalidTSSComplaint
+	}
+	if msg.Dealer == msg.Validator {
+		return ErrInvalidTSSComplaint
+	}
+	return nil
+}
+
+// GetSigners returns the signers
+func (msg MsgFileTSSComplaint) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Validator)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgSubmitTSSJustification - Dealer answers a complaint by revealing the disputed share
+type MsgSubmitTSSJustification struct {
+	Validator string `json:"validator" yaml:"validator"`
+	KeyGenID  uint64 `json:"key_gen_id" yaml:"key_gen_id"`
+	Accuser   string `json:"accuser" yaml:"accuser"`
+	Share     []byte `json:"share" yaml:"share"`
+}
+
+// ValidateBasic performs basic validation
+func (msg MsgSubmitTSSJustification) ValidateBasic() error {
+	if msg.Validator == "" {
+		return ErrNotValidator
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return ErrNotValidator
+	}
+	if msg.KeyGenID == 0 {
+		return ErrTSSKeyGenNotFound
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Accuser); err != nil {
+		return ErrInvalidTSSComplaint
+	}
+	if len(msg.Share) == 0 {
+		return ErrInvalidTSSShare
+	}
+	return nil
+}
+
+// GetSigners returns the signers
+func (msg MsgSubmitTSSJustification) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Validator)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgAttestReserves - Validator attests an asset's custody balance at an external block height
+type MsgAttestReserves struct {
+	Validator      string   `json:"validator" yaml:"validator"`
+	ChainID        string   `json:"chain_id" yaml:"chain_id"`
+	AssetSymbol    string   `json:"asset_symbol" yaml:"asset_symbol"`
+	ExternalHeight uint64   `json:"external_height" yaml:"external_height"`
+	Balance        math.Int `json:"balance" yaml:"balance"` // TSS address balance in external asset units
+}
+
+// ValidateBasic performs basic validation
+func (msg MsgAttestReserves) ValidateBasic() error {
+	if msg.Validator == "" {
+		return ErrNotValidator
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return ErrNotValidator
+	}
+	if msg.ChainID == "" {
+		return ErrInvalidChain
+	}
+	if msg.AssetSymbol == "" {
+		return ErrInvalidAsset
+	}
+	if msg.ExternalHeight == 0 {
+		return ErrInvalidReserveAttestation
+	}
+	if msg.Balance.IsNil() || msg.Balance.IsNegative() {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+// GetSigners returns the signers
+func (msg MsgAttestReserves) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Validator)
+	return []sdk.AccAddress{addr}
+}
+
 // MsgUpdateCircuitBreaker - Governance updates circuit breaker
 type MsgUpdateCircuitBreaker struct {
 	Authority   string `json:"authority" yaml:"authority"`
@@ -172,6 +397,8 @@ type MsgUpdateCircuitBreaker struct {
 	CanDeposit  bool   `json:"can_deposit" yaml:"can_deposit"`
 	CanWithdraw bool   `json:"can_withdraw" yaml:"can_withdraw"`
 	CanAttest   bool   `json:"can_attest" yaml:"can_attest"`
+	ChainID     string `json:"chain_id,omitempty" yaml:"chain_id,omitempty"`         // Scope to a chain (empty for module-wide)
+	AssetSymbol string `json:"asset_symbol,omitempty" yaml:"asset_symbol,omitempty"` // Scope to an asset of the chain
 }
 
 // ValidateBasic performs basic validation
@@ -185,6 +412,9 @@ func (msg MsgUpdateCircuitBreaker) ValidateBasic() error {
 	if msg.Enabled && msg.Reason == "" {
 		return ErrInvalidAmount // Reusing error
 	}
+	if msg.ChainID == "" && msg.AssetSymbol != "" {
+		return ErrInvalidCircuitBreakerScope
+	}
 	return nil
 }
 
@@ -194,6 +424,34 @@ func (msg MsgUpdateCircuitBreaker) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// MsgSetRateLimit - Governance sets the withdrawal rate limit. It touches no
+// other parameter, so it can be allowlisted for optimistic governance.
+type MsgSetRateLimit struct {
+	Authority              string   `json:"authority" yaml:"authority"`
+	RateLimitWindow        uint64   `json:"rate_limit_window" yaml:"rate_limit_window"`                 // Window in seconds
+	MaxWithdrawalPerWindow math.Int `json:"max_withdrawal_per_window" yaml:"max_withdrawal_per_window"` // Per asset per window
+}
+
+// ValidateBasic performs basic validation
+func (msg MsgSetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrUnauthorized
+	}
+	if msg.RateLimitWindow == 0 {
+		return ErrInvalidRateLimit.Wrap("rate limit window must be positive")
+	}
+	if msg.MaxWithdrawalPerWindow.IsNil() || !msg.MaxWithdrawalPerWindow.IsPositive() {
+		return ErrInvalidRateLimit.Wrap("max withdrawal per window must be positive")
+	}
+	return nil
+}
+
+// GetSigners returns the signers
+func (msg MsgSetRateLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
 // MsgAddExternalChain - Governance adds new external chain
 type MsgAddExternalChain struct {
 	Authority        string   `json:"authority" yaml:"authority"`
@@ -205,6 +463,9 @@ type MsgAddExternalChain struct {
 	TSSAddress       string   `json:"tss_address" yaml:"tss_address"`
 	MinDeposit       math.Int `json:"min_deposit" yaml:"min_deposit"`
 	MaxDeposit       math.Int `json:"max_deposit" yaml:"max_deposit"`
+	NetworkID        uint64   `json:"network_id,omitempty" yaml:"network_id,omitempty"`
+	FeeRate          math.Int `json:"fee_rate" yaml:"fee_rate"`
+	GasLimit         uint64   `json:"gas_limit,omitempty" yaml:"gas_limit,omitempty"`
 }
 
 // ValidateBasic performs basic validation
@@ -230,6 +491,9 @@ func (msg MsgAddExternalChain) ValidateBasic() error {
 	if msg.MaxDeposit.IsNil() || !msg.MaxDeposit.IsPositive() {
 		return ErrInvalidAmount
 	}
+	if !msg.FeeRate.IsNil() && msg.FeeRate.IsNegative() {
+		return ErrInvalidAmount
+	}
 	return nil
 }
 
@@ -34,22 +34,62 @@ type Params struct {
 	// TSSThreshold is the threshold for TSS signing (e.g., 2/3)
 	TSSThreshold math.LegacyDec `json:"tss_threshold" yaml:"tss_threshold"`
 
-	// EmergencyPauseEnabled allows circuit breaker to halt operations
+	// EmergencyPauseEnabled allows high and critical anomalies to trip circuit breakers automatically
 	EmergencyPauseEnabled bool `json:"emergency_pause_enabled" yaml:"emergency_pause_enabled"`
+
+	// TSSFaultSlashFraction is the stake slashed from a validator whose TSS signature share fails verification
+	TSSFaultSlashFraction math.LegacyDec `json:"tss_fault_slash_fraction" yaml:"tss_fault_slash_fraction"`
+
+	// TSSRotationThreshold is the fraction of a key's holders that must have left or joined the eligible validator set before the key is rotated
+	TSSRotationThreshold math.LegacyDec `json:"tss_rotation_threshold" yaml:"tss_rotation_threshold"`
+
+	// TSSKeyGenRoundDuration is how long each key generation round stays open (in seconds)
+	TSSKeyGenRoundDuration uint64 `json:"tss_keygen_round_duration" yaml:"tss_keygen_round_duration"`
+
+	// AnomalyWindow is the look-back window for withdrawal anomaly detectors (in seconds)
+	AnomalyWindow uint64 `json:"anomaly_window" yaml:"anomaly_window"`
+
+	// AnomalyBurstCount is the number of withdrawals of an asset within the window that counts as a burst (0 disables)
+	AnomalyBurstCount uint64 `json:"anomaly_burst_count" yaml:"anomaly_burst_count"`
+
+	// AnomalyFreshAddressCount is the number of distinct never-paid recipients one sender may withdraw to within the window (0 disables)
+	AnomalyFreshAddressCount uint64 `json:"anomaly_fresh_address_count" yaml:"anomaly_fresh_address_count"`
+
+	// AnomalyDepositPercentile is the percentile of recent deposits above which a deposit is flagged (nil disables)
+	AnomalyDepositPercentile math.LegacyDec `json:"anomaly_deposit_percentile" yaml:"anomaly_deposit_percentile"`
+
+	// AnomalyDepositHistory is the number of recent deposits of an asset the percentile is taken over
+	AnomalyDepositHistory uint64 `json:"anomaly_deposit_history" yaml:"anomaly_deposit_history"`
+
+	// SupplyMismatchTolerance is how far outstanding bridged HODL may exceed its attested reserves before it is flagged (nil disables)
+	SupplyMismatchTolerance math.LegacyDec `json:"supply_mismatch_tolerance" yaml:"supply_mismatch_tolerance"`
+
+	// ReserveAttestationInterval is the minimum time between proof-of-reserves snapshots of an asset (in seconds)
+	ReserveAttestationInterval uint64 `json:"reserve_attestation_interval" yaml:"reserve_attestation_interval"`
 }
 
 // DefaultParams returns default parameters
 func DefaultParams() Params {
 	return Params{
-		BridgingEnabled:        true,
-		AttestationThreshold:   math.LegacyNewDecWithPrec(67, 2), // 67% = 2/3
-		MinValidatorTier:       4,                                 // TierArchon (10M+ HODL)
-		WithdrawalTimelock:     3600,                              // 1 hour
-		RateLimitWindow:        86400,                             // 24 hours
-		MaxWithdrawalPerWindow: math.NewInt(1_000_000_000_000),    // 1M HODL per day
-		BridgeFee:              math.LegacyNewDecWithPrec(1, 3),   // 0.1%
-		TSSThreshold:           math.LegacyNewDecWithPrec(67, 2),  // 67% = 2/3
-		EmergencyPauseEnabled:  false,
+		BridgingEnabled:            true,
+		AttestationThreshold:       math.LegacyNewDecWithPrec(67, 2), // 67% = 2/3
+		MinValidatorTier:           4,                                // TierArchon (10M+ HODL)
+		WithdrawalTimelock:         3600,                             // 1 hour
+		RateLimitWindow:            86400,                            // 24 hours
+		MaxWithdrawalPerWindow:     math.NewInt(1_000_000_000_000),   // 1M HODL per day
+		BridgeFee:                  math.LegacyNewDecWithPrec(1, 3),  // 0.1%
+		TSSThreshold:               math.LegacyNewDecWithPrec(67, 2), // 67% = 2/3
+		EmergencyPauseEnabled:      true,
+		TSSFaultSlashFraction:      math.LegacyNewDecWithPrec(5, 2),  // 5%
+		TSSRotationThreshold:       math.LegacyNewDecWithPrec(20, 2), // 20% of holders changed
+		TSSKeyGenRoundDuration:     600,                              // 10 minutes
+		AnomalyWindow:              600,                              // 10 minutes
+		AnomalyBurstCount:          20,                               // 20 withdrawals per window
+		AnomalyFreshAddressCount:   5,                                // 5 new recipients per sender per window
+		AnomalyDepositPercentile:   math.LegacyNewDecWithPrec(99, 2), // 99th percentile
+		AnomalyDepositHistory:      100,                              // Last 100 deposits
+		SupplyMismatchTolerance:    math.LegacyNewDecWithPrec(1, 3),  // 0.1%
+		ReserveAttestationInterval: 3600,                             // 1 hour
 	}
 }
 
@@ -93,6 +133,22 @@ func (p Params) Validate() error {
 		return fmt.Errorf("TSS threshold must be between 0.5 and 1.0: %s", p.TSSThreshold)
 	}
 
+	if !p.TSSFaultSlashFraction.IsNil() && (p.TSSFaultSlashFraction.IsNegative() || p.TSSFaultSlashFraction.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("TSS fault slash fraction must be between 0 and 1: %s", p.TSSFaultSlashFraction)
+	}
+
+	if !p.TSSRotationThreshold.IsNil() && (!p.TSSRotationThreshold.IsPositive() || p.TSSRotationThreshold.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("TSS rotation threshold must be between 0 and 1: %s", p.TSSRotationThreshold)
+	}
+
+	if !p.AnomalyDepositPercentile.IsNil() && (!p.AnomalyDepositPercentile.IsPositive() || p.AnomalyDepositPercentile.GTE(math.LegacyOneDec())) {
+		return fmt.Errorf("anomaly deposit percentile must be between 0 and 1: %s", p.AnomalyDepositPercentile)
+	}
+
+	if !p.SupplyMismatchTolerance.IsNil() && p.SupplyMismatchTolerance.IsNegative() {
+		return fmt.Errorf("supply mismatch tolerance must be non-negative: %s", p.SupplyMismatchTolerance)
+	}
+
 	return nil
 }
 
@@ -101,6 +157,33 @@ func (p Params) WithdrawalTimelockDuration() time.Duration {
 	return time.Duration(p.WithdrawalTimelock) * time.Second
 }
 
+// TSSKeyGenRoundTimeout returns how long a key generation round stays open,
+// defaulting to 10 minutes when unset
+func (p Params) TSSKeyGenRoundTimeout() time.Duration {
+	if p.TSSKeyGenRoundDuration == 0 {
+		return 10 * time.Minute
+	}
+	return time.Duration(p.TSSKeyGenRoundDuration) * time.Second
+}
+
+// AnomalyWindowDuration returns the withdrawal anomaly look-back window,
+// defaulting to 10 minutes when unset
+func (p Params) AnomalyWindowDuration() time.Duration {
+	if p.AnomalyWindow == 0 {
+		return 10 * time.Minute
+	}
+	return time.Duration(p.AnomalyWindow) * time.Second
+}
+
+// ReserveAttestationIntervalDuration returns the minimum time between reserve
+// snapshots, defaulting to 1 hour when unset
+func (p Params) ReserveAttestationIntervalDuration() time.Duration {
+	if p.ReserveAttestationInterval == 0 {
+		return time.Hour
+	}
+	return time.Duration(p.ReserveAttestationInterval) * time.Second
+}
+
 // RateLimitWindowDuration returns the rate limit window as a duration
 func (p Params) RateLimitWindowDuration() time.Duration {
 	return time.Duration(p.RateLimitWindow) * time.Second
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// ReserveAttestation is a validator's report of the balance an asset's TSS
+// address held at an external block height
+type ReserveAttestation struct {
+	Validator   string    `json:"validator" yaml:"validator"`
+	Balance     math.Int  `json:"balance" yaml:"balance"` // In external asset units
+	SubmittedAt time.Time `json:"submitted_at" yaml:"submitted_at"`
+}
+
+// ReserveRound collects validators' reports of an asset's custody balance at
+// one external block height until a quorum agrees on it
+type ReserveRound struct {
+	ChainID        string               `json:"chain_id" yaml:"chain_id"`
+	AssetSymbol    string               `json:"asset_symbol" yaml:"asset_symbol"`
+	ExternalHeight uint64               `json:"external_height" yaml:"external_height"`
+	TSSAddress     string               `json:"tss_address" yaml:"tss_address"` // Custody address being reported on
+	Attestations   []ReserveAttestation `json:"attestations" yaml:"attestations"`
+	OpenedAt       time.Time            `json:"opened_at" yaml:"opened_at"`
+}
+
+// HasAttested returns whether a validator has reported in the round
+func (r ReserveRound) HasAttested(validator string) bool {
+	for _, attestation := range r.Attestations {
+		if attestation.Validator == validator {
+			return true
+		}
+	}
+	return false
+}
+
+// Attesters returns the validators that reported a balance
+func (r ReserveRound) Attesters(balance math.Int) []string {
+	attesters := []string{}
+	for _, attestation := range r.Attestations {
+		if attestation.Balance.Equal(balance) {
+			attesters = append(attesters, attestation.Validator)
+		}
+	}
+	return attesters
+}
+
+// Validate validates the reserve round
+func (r ReserveRound) Validate() error {
+	if r.ChainID == "" {
+		return fmt.Errorf("chain ID cannot be empty")
+	}
+	if r.AssetSymbol == "" {
+		return fmt.Errorf("asset symbol cannot be empty")
+	}
+	if r.ExternalHeight == 0 {
+		return fmt.Errorf("external height must be positive")
+	}
+	if r.TSSAddress == "" {
+		return fmt.Errorf("TSS address cannot be empty")
+	}
+	seen := make(map[string]bool)
+	for _, attestation := range r.Attestations {
+		if attestation.Balance.IsNil() || attestation.Balance.IsNegative() {
+			return fmt.Errorf("attested balance must be non-negative")
+		}
+		if seen[attestation.Validator] {
+			return fmt.Errorf("duplicate attestation from %s", attestation.Validator)
+		}
+		seen[attestation.Validator] = true
+	}
+	return nil
+}
+
+// ReserveSnapshot is a quorum-attested custody balance reconciled against
+// the bridged HODL outstanding for the asset at the time
+type ReserveSnapshot struct {
+	ChainID        string         `json:"chain_id" yaml:"chain_id"`
+	AssetSymbol    string         `json:"asset_symbol" yaml:"asset_symbol"`
+	ExternalHeight uint64         `json:"external_height" yaml:"external_height"`
+	TSSAddress     string         `json:"tss_address" yaml:"tss_address"`
+	Balance        math.Int       `json:"balance" yaml:"balance"`             // Custody balance in external asset units
+	CustodyValue   math.Int       `json:"custody_value" yaml:"custody_value"` // Balance in HODL at the conversion rate
+	Supply         math.Int       `json:"supply" yaml:"supply"`               // Bridged HODL outstanding
+	BackingRatio   math.LegacyDec `json:"backing_ratio" yaml:"backing_ratio"` // Custody value per outstanding HODL, zero when none is outstanding
+	Backed         bool           `json:"backed" yaml:"backed"`               // Custody value covers the supply
+	Attesters      []string       `json:"attesters" yaml:"attesters"`
+	AttestedAt     time.Time      `json:"attested_at" yaml:"attested_at"`
+}
+
+// NewReserveSnapshot reconciles an attested custody balance against the outstanding supply
+func NewReserveSnapshot(
+	round ReserveRound,
+	balance math.Int,
+	conversionRate math.LegacyDec,
+	supply math.Int,
+	attesters []string,
+	attestedAt time.Time,
+) ReserveSnapshot {
+	custodyValue := math.LegacyNewDecFromInt(balance).Mul(conversionRate).TruncateInt()
+
+	ratio := math.LegacyZeroDec()
+	if supply.IsPositive() {
+		ratio = math.LegacyNewDecFromInt(custodyValue).QuoInt(supply)
+	}
+
+	return ReserveSnapshot{
+		ChainID:        round.ChainID,
+		AssetSymbol:    round.AssetSymbol,
+		ExternalHeight: round.ExternalHeight,
+		TSSAddress:     round.TSSAddress,
+		Balance:        balance,
+		CustodyValue:   custodyValue,
+		Supply:         supply,
+		BackingRatio:   ratio,
+		Backed:         custodyValue.GTE(supply),
+		Attesters:      attesters,
+		AttestedAt:     attestedAt,
+	}
+}
+
+// Validate validates the reserve snapshot
+func (s ReserveSnapshot) Validate() error {
+	if s.ChainID == "" {
+		return fmt.Errorf("chain ID cannot be empty")
+	}
+	if s.AssetSymbol == "" {
+		return fmt.Errorf("asset symbol cannot be empty")
+	}
+	if s.ExternalHeight == 0 {
+		return fmt.Errorf("external height must be positive")
+	}
+	for _, amount := range []math.Int{s.Balance, s.CustodyValue, s.Supply} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("reserve amounts must be non-negative")
+		}
+	}
+	if s.BackingRatio.IsNil() || s.BackingRatio.IsNegative() {
+		return fmt.Errorf("backing ratio must be non-negative")
+	}
+	if len(s.Attesters) == 0 {
+		return fmt.Errorf("attesters cannot be empty")
+	}
+	return nil
+}
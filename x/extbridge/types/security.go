@@ -77,8 +77,12 @@ func (rl RateLimit) RemainingCapacity() math.Int {
 	return remaining
 }
 
-// CircuitBreaker represents the emergency pause mechanism
+// CircuitBreaker represents the emergency pause mechanism. The module-wide
+// breaker has no chain; a breaker with a chain and no asset pauses the whole
+// chain, and one with both pauses a single asset.
 type CircuitBreaker struct {
+	ChainID        string    `json:"chain_id,omitempty" yaml:"chain_id,omitempty"`         // Scoped chain (empty for module-wide)
+	AssetSymbol    string    `json:"asset_symbol,omitempty" yaml:"asset_symbol,omitempty"` // Scoped asset (empty for chain-wide)
 	Enabled        bool      `json:"enabled" yaml:"enabled"`
 	Reason         string    `json:"reason" yaml:"reason"`
 	TriggeredBy    string    `json:"triggered_by" yaml:"triggered_by"`    // Governance address or validator
@@ -126,9 +130,17 @@ func (cb CircuitBreaker) Validate() error {
 	if cb.Enabled && cb.TriggeredBy == "" {
 		return fmt.Errorf("triggered by must be provided when circuit breaker is enabled")
 	}
+	if cb.ChainID == "" && cb.AssetSymbol != "" {
+		return fmt.Errorf("asset-scoped circuit breaker must name its chain")
+	}
 	return nil
 }
 
+// IsScoped returns whether the breaker applies to a single chain or asset
+func (cb CircuitBreaker) IsScoped() bool {
+	return cb.ChainID != ""
+}
+
 // IsExpired returns whether the circuit breaker has expired
 func (cb CircuitBreaker) IsExpired(currentTime time.Time) bool {
 	if !cb.Enabled || cb.ExpiresAt == nil {
@@ -155,8 +167,25 @@ func (cb CircuitBreaker) IsOperationAllowed(operation string) bool {
 	}
 }
 
+// Anomaly detection types
+const (
+	AnomalyTypeRapidWithdrawal         = "rapid_withdrawal"          // Burst of withdrawals for an asset
+	AnomalyTypeLargeDeposit            = "large_deposit"             // Deposit above a percentile of recent deposits
+	AnomalyTypeFreshAddressWithdrawals = "fresh_address_withdrawals" // Repeated withdrawals to never-seen recipients
+	AnomalyTypeSupplyMismatch          = "supply_mismatch"           // Outstanding HODL exceeds attested reserves
+)
+
+// Anomaly severities
+const (
+	AnomalySeverityLow      = "low"
+	AnomalySeverityMedium   = "medium"
+	AnomalySeverityHigh     = "high"
+	AnomalySeverityCritical = "critical"
+)
+
 // AnomalyDetection tracks suspicious activity
 type AnomalyDetection struct {
+	ID             uint64    `json:"id" yaml:"id"`
 	ChainID        string    `json:"chain_id" yaml:"chain_id"`
 	AssetSymbol    string    `json:"asset_symbol" yaml:"asset_symbol"`
 	DetectionType  string    `json:"detection_type" yaml:"detection_type"`  // e.g., "rapid_withdrawal", "large_deposit"
@@ -176,6 +205,7 @@ func NewAnomalyDetection(
 	severity string,
 	description string,
 	relatedTxs []string,
+	detectedAt time.Time,
 ) AnomalyDetection {
 	return AnomalyDetection{
 		ChainID:       chainID,
@@ -183,7 +213,7 @@ func NewAnomalyDetection(
 		DetectionType: detectionType,
 		Severity:      severity,
 		Description:   description,
-		DetectedAt:    time.Now(),
+		DetectedAt:    detectedAt,
 		RelatedTxs:    relatedTxs,
 		Resolved:      false,
 	}
@@ -211,3 +241,57 @@ func (ad AnomalyDetection) Validate() error {
 func (ad AnomalyDetection) IsCritical() bool {
 	return ad.Severity == "critical"
 }
+
+// TripsCircuitBreaker returns whether the anomaly pauses bridge operations
+func (ad AnomalyDetection) TripsCircuitBreaker() bool {
+	return ad.Severity == AnomalySeverityHigh || ad.Severity == AnomalySeverityCritical
+}
+
+// BridgedSupply tracks the HODL minted against an asset's deposits and burned
+// by its withdrawals, alongside the attested external amounts behind them
+type BridgedSupply struct {
+	ChainID     string   `json:"chain_id" yaml:"chain_id"`
+	AssetSymbol string   `json:"asset_symbol" yaml:"asset_symbol"`
+	Minted      math.Int `json:"minted" yaml:"minted"`       // HODL minted for completed deposits
+	Burned      math.Int `json:"burned" yaml:"burned"`       // HODL burned for signed withdrawals
+	Deposited   math.Int `json:"deposited" yaml:"deposited"` // External amount of attested deposits
+	Withdrawn   math.Int `json:"withdrawn" yaml:"withdrawn"` // External amount of signed withdrawals
+}
+
+// NewBridgedSupply creates an empty supply record for an asset
+func NewBridgedSupply(chainID string, assetSymbol string) BridgedSupply {
+	return BridgedSupply{
+		ChainID:     chainID,
+		AssetSymbol: assetSymbol,
+		Minted:      math.ZeroInt(),
+		Burned:      math.ZeroInt(),
+		Deposited:   math.ZeroInt(),
+		Withdrawn:   math.ZeroInt(),
+	}
+}
+
+// Validate validates the supply record
+func (bs BridgedSupply) Validate() error {
+	if bs.ChainID == "" {
+		return fmt.Errorf("chain ID cannot be empty")
+	}
+	if bs.AssetSymbol == "" {
+		return fmt.Errorf("asset symbol cannot be empty")
+	}
+	for _, amount := range []math.Int{bs.Minted, bs.Burned, bs.Deposited, bs.Withdrawn} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("supply amounts must be non-negative")
+		}
+	}
+	return nil
+}
+
+// Outstanding returns the bridged HODL still in circulation
+func (bs BridgedSupply) Outstanding() math.Int {
+	return bs.Minted.Sub(bs.Burned)
+}
+
+// Reserves returns the attested external amount still held in custody
+func (bs BridgedSupply) Reserves() math.Int {
+	return bs.Deposited.Sub(bs.Withdrawn)
+}
@@ -22,8 +22,24 @@ type MsgClient interface {
 	RequestWithdrawal(ctx context.Context, in *MsgRequestWithdrawal, opts ...grpc.CallOption) (*MsgRequestWithdrawalResponse, error)
 	// SubmitTSSSignature allows validators to submit TSS signature shares
 	SubmitTSSSignature(ctx context.Context, in *MsgSubmitTSSSignature, opts ...grpc.CallOption) (*MsgSubmitTSSSignatureResponse, error)
+	// SubmitTSSCommitment allows validators to submit TSS nonce commitments
+	SubmitTSSCommitment(ctx context.Context, in *MsgSubmitTSSCommitment, opts ...grpc.CallOption) (*MsgSubmitTSSCommitmentResponse, error)
+	// RegisterTSSKey allows governance to register a chain's TSS key
+	RegisterTSSKey(ctx context.Context, in *MsgRegisterTSSKey, opts ...grpc.CallOption) (*MsgRegisterTSSKeyResponse, error)
+	// StartTSSKeyGeneration allows governance to start a chain's key generation ceremony
+	StartTSSKeyGeneration(ctx context.Context, in *MsgStartTSSKeyGeneration, opts ...grpc.CallOption) (*MsgStartTSSKeyGenerationResponse, error)
+	// SubmitTSSDealing allows validators to publish key generation dealings
+	SubmitTSSDealing(ctx context.Context, in *MsgSubmitTSSDealing, opts ...grpc.CallOption) (*MsgSubmitTSSDealingResponse, error)
+	// FileTSSComplaint allows validators to accuse key generation dealers
+	FileTSSComplaint(ctx context.Context, in *MsgFileTSSComplaint, opts ...grpc.CallOption) (*MsgFileTSSComplaintResponse, error)
+	// SubmitTSSJustification allows accused dealers to reveal disputed shares
+	SubmitTSSJustification(ctx context.Context, in *MsgSubmitTSSJustification, opts ...grpc.CallOption) (*MsgSubmitTSSJustificationResponse, error)
+	// AttestReserves allows validators to attest custody balances for proof of reserves
+	AttestReserves(ctx context.Context, in *MsgAttestReserves, opts ...grpc.CallOption) (*MsgAttestReservesResponse, error)
 	// UpdateCircuitBreaker allows governance to update the circuit breaker
 	UpdateCircuitBreaker(ctx context.Context, in *MsgUpdateCircuitBreaker, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerResponse, error)
+	// SetRateLimit allows governance to set the withdrawal rate limit
+	SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error)
 	// AddExternalChain allows governance to add a new external chain
 	AddExternalChain(ctx context.Context, in *MsgAddExternalChain, opts ...grpc.CallOption) (*MsgAddExternalChainResponse, error)
 	// AddExternalAsset allows governance to add a new external asset
@@ -40,8 +56,24 @@ type MsgServer interface {
 	RequestWithdrawal(context.Context, *MsgRequestWithdrawal) (*MsgRequestWithdrawalResponse, error)
 	// SubmitTSSSignature allows validators to submit TSS signature shares
 	SubmitTSSSignature(context.Context, *MsgSubmitTSSSignature) (*MsgSubmitTSSSignatureResponse, error)
+	// SubmitTSSCommitment allows validators to submit TSS nonce commitments
+	SubmitTSSCommitment(context.Context, *MsgSubmitTSSCommitment) (*MsgSubmitTSSCommitmentResponse, error)
+	// RegisterTSSKey allows governance to register a chain's TSS key
+	RegisterTSSKey(context.Context, *MsgRegisterTSSKey) (*MsgRegisterTSSKeyResponse, error)
+	// StartTSSKeyGeneration allows governance to start a chain's key generation ceremony
+	StartTSSKeyGeneration(context.Context, *MsgStartTSSKeyGeneration) (*MsgStartTSSKeyGenerationResponse, error)
+	// SubmitTSSDealing allows validators to publish key generation dealings
+	SubmitTSSDealing(context.Context, *MsgSubmitTSSDealing) (*MsgSubmitTSSDealingResponse, error)
+	// FileTSSComplaint allows validators to accuse key generation dealers
+	FileTSSComplaint(context.Context, *MsgFileTSSComplaint) (*MsgFileTSSComplaintResponse, error)
+	// SubmitTSSJustification allows accused dealers to reveal disputed shares
+	SubmitTSSJustification(context.Context, *MsgSubmitTSSJustification) (*MsgSubmitTSSJustificationResponse, error)
+	// AttestReserves allows validators to attest custody balances for proof of reserves
+	AttestReserves(context.Context, *MsgAttestReserves) (*MsgAttestReservesResponse, error)
 	// UpdateCircuitBreaker allows governance to update the circuit breaker
 	UpdateCircuitBreaker(context.Context, *MsgUpdateCircuitBreaker) (*MsgUpdateCircuitBreakerResponse, error)
+	// SetRateLimit allows governance to set the withdrawal rate limit
+	SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error)
 	// AddExternalChain allows governance to add a new external chain
 	AddExternalChain(context.Context, *MsgAddExternalChain) (*MsgAddExternalChainResponse, error)
 	// AddExternalAsset allows governance to add a new external asset
@@ -63,9 +95,33 @@ func (*UnimplementedMsgServer) RequestWithdrawal(context.Context, *MsgRequestWit
 func (*UnimplementedMsgServer) SubmitTSSSignature(context.Context, *MsgSubmitTSSSignature) (*MsgSubmitTSSSignatureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitTSSSignature not implemented")
 }
+func (*UnimplementedMsgServer) SubmitTSSCommitment(context.Context, *MsgSubmitTSSCommitment) (*MsgSubmitTSSCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTSSCommitment not implemented")
+}
+func (*UnimplementedMsgServer) RegisterTSSKey(context.Context, *MsgRegisterTSSKey) (*MsgRegisterTSSKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTSSKey not implemented")
+}
+func (*UnimplementedMsgServer) StartTSSKeyGeneration(context.Context, *MsgStartTSSKeyGeneration) (*MsgStartTSSKeyGenerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTSSKeyGeneration not implemented")
+}
+func (*UnimplementedMsgServer) SubmitTSSDealing(context.Context, *MsgSubmitTSSDealing) (*MsgSubmitTSSDealingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTSSDealing not implemented")
+}
+func (*UnimplementedMsgServer) FileTSSComplaint(context.Context, *MsgFileTSSComplaint) (*MsgFileTSSComplaintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileTSSComplaint not implemented")
+}
+func (*UnimplementedMsgServer) SubmitTSSJustification(context.Context, *MsgSubmitTSSJustification) (*MsgSubmitTSSJustificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTSSJustification not implemented")
+}
+func (*UnimplementedMsgServer) AttestReserves(context.Context, *MsgAttestReserves) (*MsgAttestReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestReserves not implemented")
+}
 func (*UnimplementedMsgServer) UpdateCircuitBreaker(context.Context, *MsgUpdateCircuitBreaker) (*MsgUpdateCircuitBreakerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCircuitBreaker not implemented")
 }
+func (*UnimplementedMsgServer) SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimit not implemented")
+}
 func (*UnimplementedMsgServer) AddExternalChain(context.Context, *MsgAddExternalChain) (*MsgAddExternalChainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddExternalChain not implemented")
 }
@@ -92,12 +148,59 @@ type MsgSubmitTSSSignatureResponse struct {
 	Completed bool   `json:"completed" yaml:"completed"`
 }
 
+type MsgSubmitTSSCommitmentResponse struct {
+	SessionID       uint64 `json:"session_id" yaml:"session_id"`
+	SigningSetReady bool   `json:"signing_set_ready" yaml:"signing_set_ready"`
+}
+
+type MsgRegisterTSSKeyResponse struct{}
+
+type MsgStartTSSKeyGenerationResponse struct {
+	KeyGenID uint64 `json:"key_gen_id" yaml:"key_gen_id"`
+}
+
+type MsgSubmitTSSDealingResponse struct {
+	Dealings uint64 `json:"dealings" yaml:"dealings"`
+}
+
+type MsgFileTSSComplaintResponse struct{}
+
+type MsgSubmitTSSJustificationResponse struct {
+	Accepted bool `json:"accepted" yaml:"accepted"`
+}
+
+type MsgAttestReservesResponse struct {
+	Attestations uint64 `json:"attestations" yaml:"attestations"`
+	Finalized    bool   `json:"finalized" yaml:"finalized"`
+}
+
 type MsgUpdateCircuitBreakerResponse struct{}
 
+type MsgSetRateLimitResponse struct{}
+
 type MsgAddExternalChainResponse struct{}
 
 type MsgAddExternalAssetResponse struct{}
 
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Reserves returns an asset's bridged supply and attested reserve history
+	Reserves(context.Context, *QueryReservesRequest) (*QueryReservesResponse, error)
+}
+
+// Query request/response types
+type QueryReservesRequest struct {
+	ChainID     string `json:"chain_id" yaml:"chain_id"`
+	AssetSymbol string `json:"asset_symbol" yaml:"asset_symbol"`
+	Limit       uint64 `json:"limit" yaml:"limit"` // Most recent snapshots to return (0 for all)
+}
+
+type QueryReservesResponse struct {
+	Supply        BridgedSupply     `json:"supply" yaml:"supply"`
+	History       []ReserveSnapshot `json:"history" yaml:"history"` // Newest first
+	MintingHalted bool              `json:"minting_halted" yaml:"minting_halted"`
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sharehodl.extbridge.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -34,21 +34,34 @@ func (tss TSSSessionStatus) String() string {
 	}
 }
 
+// TSSSessionKind is what a TSS session signs
+type TSSSessionKind int
+
+const (
+	TSSSessionKindWithdrawal TSSSessionKind = 0 // A withdrawal to an external chain
+	TSSSessionKindMigration  TSSSessionKind = 1 // A migration of custodied funds to a rotated key
+)
+
 // TSSSession represents a threshold signature signing session
 type TSSSession struct {
 	ID               uint64           `json:"id" yaml:"id"`
+	Kind             TSSSessionKind   `json:"kind" yaml:"kind"`
 	WithdrawalID     uint64           `json:"withdrawal_id" yaml:"withdrawal_id"`     // Associated withdrawal
 	ChainID          string           `json:"chain_id" yaml:"chain_id"`               // External chain
 	Status           TSSSessionStatus `json:"status" yaml:"status"`
+	Scheme           string           `json:"scheme" yaml:"scheme"`                   // Signature scheme of the chain's TSS key
 	Participants     []string         `json:"participants" yaml:"participants"`       // Validator addresses participating
 	RequiredSigs     uint64           `json:"required_sigs" yaml:"required_sigs"`     // Number of signatures required
 	ReceivedSigs     uint64           `json:"received_sigs" yaml:"received_sigs"`     // Number of signatures received
+	Commitments      []TSSCommitment  `json:"commitments" yaml:"commitments"`         // Commitment round; the first RequiredSigs form the signing set
+	Nonce            []byte           `json:"nonce,omitempty" yaml:"nonce,omitempty"` // Session nonce point, derived once the signing set is formed
 	SignatureShares  []TSSSignatureShare `json:"signature_shares" yaml:"signature_shares"` // Collected signature shares
+	FaultyParticipants []string       `json:"faulty_participants,omitempty" yaml:"faulty_participants,omitempty"` // Signers whose commitments or shares were found at fault
 	CombinedSignature []byte          `json:"combined_signature,omitempty" yaml:"combined_signature,omitempty"` // Final combined signature
 	CreatedAt        time.Time        `json:"created_at" yaml:"created_at"`
 	CompletedAt      *time.Time       `json:"completed_at,omitempty" yaml:"completed_at,omitempty"`
 	TimeoutAt        time.Time        `json:"timeout_at" yaml:"timeout_at"`           // When session expires
-	Message          []byte           `json:"message" yaml:"message"`                 // Message being signed (tx data)
+	Message          []byte           `json:"message" yaml:"message"`                 // Transaction digest (ECDSA) or message (EdDSA) being signed
 }
 
 // NewTSSSession creates a new TSS session
@@ -56,6 +69,7 @@ func NewTSSSession(
 	id uint64,
 	withdrawalID uint64,
 	chainID string,
+	scheme string,
 	participants []string,
 	requiredSigs uint64,
 	timeout time.Duration,
@@ -67,9 +81,11 @@ func NewTSSSession(
 		WithdrawalID: withdrawalID,
 		ChainID:      chainID,
 		Status:       TSSSessionStatusPending,
+		Scheme:       scheme,
 		Participants: participants,
 		RequiredSigs: requiredSigs,
 		ReceivedSigs: 0,
+		Commitments:  []TSSCommitment{},
 		SignatureShares: []TSSSignatureShare{},
 		CreatedAt:    createdAt,
 		TimeoutAt:    createdAt.Add(timeout),
@@ -94,6 +110,9 @@ func (ts TSSSession) Validate() error {
 	if len(ts.Message) == 0 {
 		return fmt.Errorf("message cannot be empty")
 	}
+	if ts.Scheme == TSSSchemeECDSA && len(ts.Message) != TSSDigestSize {
+		return fmt.Errorf("ECDSA sessions sign a %d-byte digest", TSSDigestSize)
+	}
 	return nil
 }
 
@@ -122,6 +141,52 @@ func (ts TSSSession) CanAddSignature() bool {
 	return ts.Status == TSSSessionStatusPending || ts.Status == TSSSessionStatusActive
 }
 
+// IsParticipant returns whether a validator holds a share of the session's key
+func (ts TSSSession) IsParticipant(validator string) bool {
+	for _, p := range ts.Participants {
+		if p == validator {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCommitment returns a validator's commitment, if it is in the signing set
+func (ts TSSSession) GetCommitment(validator string) (TSSCommitment, bool) {
+	for _, commitment := range ts.Commitments {
+		if commitment.Validator == validator {
+			return commitment, true
+		}
+	}
+	return TSSCommitment{}, false
+}
+
+// HasSigningSet returns whether enough signers have committed to fix the signing set
+func (ts TSSSession) HasSigningSet() bool {
+	return uint64(len(ts.Commitments)) >= ts.RequiredSigs
+}
+
+// HasSubmittedShare returns whether a validator already submitted a share,
+// valid or not
+func (ts TSSSession) HasSubmittedShare(validator string) bool {
+	for _, share := range ts.SignatureShares {
+		if share.Validator == validator {
+			return true
+		}
+	}
+	return ts.IsFaulty(validator)
+}
+
+// IsFaulty returns whether a validator was found at fault in the session
+func (ts TSSSession) IsFaulty(validator string) bool {
+	for _, faulty := range ts.FaultyParticipants {
+		if faulty == validator {
+			return true
+		}
+	}
+	return false
+}
+
 // TSSSignatureShare represents a validator's signature share
 type TSSSignatureShare struct {
 	SessionID     uint64    `json:"session_id" yaml:"session_id"`
@@ -135,12 +200,13 @@ func NewTSSSignatureShare(
 	sessionID uint64,
 	validator string,
 	signatureData []byte,
+	submittedAt time.Time,
 ) TSSSignatureShare {
 	return TSSSignatureShare{
 		SessionID:     sessionID,
 		Validator:     validator,
 		SignatureData: signatureData,
-		SubmittedAt:   time.Now(),
+		SubmittedAt:   submittedAt,
 	}
 }
 
@@ -158,12 +224,21 @@ func (tss TSSSignatureShare) Validate() error {
 // TSSKeyGeneration represents a TSS key generation session
 // This is used to generate/rotate the shared TSS key
 type TSSKeyGeneration struct {
-	ID           uint64    `json:"id" yaml:"id"`
-	ChainID      string    `json:"chain_id" yaml:"chain_id"`
-	Status       TSSSessionStatus `json:"status" yaml:"status"`
-	Participants []string  `json:"participants" yaml:"participants"`
-	Threshold    uint64    `json:"threshold" yaml:"threshold"`
-	PublicKey    []byte    `json:"public_key,omitempty" yaml:"public_key,omitempty"`
-	CreatedAt    time.Time `json:"created_at" yaml:"created_at"`
-	CompletedAt  *time.Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty"`
+	ID            uint64           `json:"id" yaml:"id"`
+	ChainID       string           `json:"chain_id" yaml:"chain_id"`
+	Status        TSSSessionStatus `json:"status" yaml:"status"`
+	Scheme        string           `json:"scheme" yaml:"scheme"`
+	Rotation      bool             `json:"rotation" yaml:"rotation"` // Replaces an existing key, whose funds migrate to the new address
+	Participants  []string         `json:"participants" yaml:"participants"` // Share index i+1 belongs to Participants[i]
+	Threshold     uint64           `json:"threshold" yaml:"threshold"`
+	Phase         TSSKeyGenPhase   `json:"phase" yaml:"phase"`
+	PhaseDeadline time.Time        `json:"phase_deadline" yaml:"phase_deadline"` // When the current round closes
+	Dealings      []TSSDealing     `json:"dealings" yaml:"dealings"`
+	Complaints    []TSSComplaint   `json:"complaints" yaml:"complaints"`
+	Blamed        []TSSBlame       `json:"blamed,omitempty" yaml:"blamed,omitempty"`
+	PublicKey     []byte           `json:"public_key,omitempty" yaml:"public_key,omitempty"`
+	Address       string           `json:"address,omitempty" yaml:"address,omitempty"` // Chain address the generated key controls
+	FailureReason string           `json:"failure_reason,omitempty" yaml:"failure_reason,omitempty"`
+	CreatedAt     time.Time        `json:"created_at" yaml:"created_at"`
+	CompletedAt   *time.Time       `json:"completed_at,omitempty" yaml:"completed_at,omitempty"`
 }
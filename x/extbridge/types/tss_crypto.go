@@ -0,0 +1,948 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"filippo.io/edwards25519"
+	"github.com/cosmos/btcutil/base58"
+	"github.com/cosmos/btcutil/bech32"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/ripemd160" //nolint:staticcheck // Bitcoin addresses are defined over RIPEMD-160
+	"golang.org/x/crypto/sha3"
+)
+
+// Threshold signature verification
+//
+// Signing runs in two rounds. In the commitment round each signer publishes
+// its nonce commitment; once the threshold has committed the signing set is
+// fixed and the session nonce is derived. In the share round each signer
+// publishes a 32-byte scalar share, which is checked on its own before it is
+// counted, so a bad share is attributable to the validator that sent it.
+//
+// ECDSA (secp256k1) signers run an off-chain presigning protocol among the
+// signing set, ending with a nonce point R = k⁻¹·G and additive shares k_i of
+// k and χ_i = λ_i·x_i·k_i + δ_i of k·x, δ_i being the signer's part of the
+// cross terms. Each commits R‖K_i‖P_i‖Δ_i with K_i = k_i·R, P_i = x_i·K_i and
+// Δ_i = δ_i·R, plus a proof that P_i uses the discrete log of its registered
+// key share Y_i, so χ_i·R = λ_i·P_i + Δ_i is bound to Y_i. The commitments
+// must sum to G and to the group key, and a share s_i = m·k_i + r·χ_i is
+// checked as s_i·R = m·K_i + r·(λ_i·P_i + Δ_i). A signer whose proof fails or
+// whose nonce differs from the one a majority of the signing set committed is
+// identified; commitments that are each valid but do not sum up cannot be
+// pinned on one signer.
+//
+// EdDSA (Ed25519) signers follow FROST (RFC 9591): each commits D_i‖E_i and
+// a share z_i = d_i + e_i·ρ_i + λ_i·s_i·c is checked against the signer's
+// registered key share Y_i as z_i·B = D_i + ρ_i·E_i + c·λ_i·Y_i. The combined
+// signature is a standard Ed25519 signature.
+
+const (
+	// ECDSACommitmentSize is R, K_i, P_i and Δ_i as compressed points, then the
+	// key share proof's nonce points and response
+	ECDSACommitmentSize = 6*secp256k1.PubKeyBytesLenCompressed + TSSShareSize
+
+	// EdDSACommitmentSize is the hiding and binding nonce commitments D_i and E_i
+	EdDSACommitmentSize = 2 * ed25519.PublicKeySize
+
+	// TSSShareSize is the size of a signature share scalar
+	TSSShareSize = 32
+
+	// ECDSASignatureSize is r‖s‖v, with v the recovery id used by EVM chains
+	ECDSASignatureSize = 65
+
+	frostContext = "FROST-ED25519-SHA512-v1"
+
+	presignContext = "SHAREHODL-EXTBRIDGE-PRESIGN-v1"
+)
+
+// TSSCommitmentFault is returned when a signing set's commitments are
+// inconsistent because of identifiable signers
+type TSSCommitmentFault struct {
+	Indices []uint32 // Key share indices of the faulty signers
+	Reason  string
+}
+
+func (e *TSSCommitmentFault) Error() string {
+	return fmt.Sprintf("signers %v: %s", e.Indices, e.Reason)
+}
+
+// TSSDigestSize is the size of the transaction digest an ECDSA session signs.
+// The session message is the chain-native digest itself (a Keccak-256 hash
+// or a BIP143 sighash) and is signed as-is.
+const TSSDigestSize = 32
+
+// ValidateTSSCommitment checks that a commitment decodes for the scheme
+func ValidateTSSCommitment(scheme string, data []byte) error {
+	switch scheme {
+	case TSSSchemeECDSA:
+		_, err := parseECDSACommitment(data)
+		return err
+	case TSSSchemeEdDSA:
+		_, _, err := parseEdDSACommitment(data)
+		return err
+	default:
+		return fmt.Errorf("unsupported TSS scheme %q", scheme)
+	}
+}
+
+// VerifyTSSCommitmentProof checks the proof in a signer's commitment that it
+// was made with its registered key share. Only ECDSA commitments carry one;
+// FROST binds the key share in the share check instead.
+func VerifyTSSCommitmentProof(key TSSKey, index uint32, data []byte) error {
+	if key.Scheme != TSSSchemeECDSA {
+		return nil
+	}
+	keyShare, found := key.GetShareByIndex(index)
+	if !found {
+		return fmt.Errorf("signer %d holds no key share", index)
+	}
+	commitment, err := parseECDSACommitment(data)
+	if err != nil {
+		return err
+	}
+	return verifyECDSAPresignProof(keyShare, commitment, data)
+}
+
+// ComputeTSSNonce derives the session nonce from the signing set's commitments.
+// For ECDSA it also checks the presignature commitments add up to the group
+// key, returning a *TSSCommitmentFault when the fault is attributable.
+func ComputeTSSNonce(key TSSKey, message []byte, commitments []TSSCommitment) ([]byte, error) {
+	switch key.Scheme {
+	case TSSSchemeECDSA:
+		return ecdsaNonce(key.PublicKey, commitments)
+	case TSSSchemeEdDSA:
+		_, nonce, _, err := frostSigningState(key.PublicKey, message, commitments)
+		if err != nil {
+			return nil, err
+		}
+		return nonce.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported TSS scheme %q", key.Scheme)
+	}
+}
+
+// VerifyTSSShare checks one signer's share against its commitment and, for
+// EdDSA, its registered key share
+func VerifyTSSShare(key TSSKey, message []byte, commitments []TSSCommitment, index uint32, share []byte) error {
+	commitment, found := findCommitment(commitments, index)
+	if !found {
+		return fmt.Errorf("signer %d is not in the signing set", index)
+	}
+
+	switch key.Scheme {
+	case TSSSchemeECDSA:
+		return verifyECDSAShare(message, commitments, commitment, share)
+	case TSSSchemeEdDSA:
+		keyShare, found := key.GetShareByIndex(index)
+		if !found {
+			return fmt.Errorf("signer %d holds no key share", index)
+		}
+		return verifyEdDSAShare(key.PublicKey, message, commitments, commitment, keyShare, share)
+	default:
+		return fmt.Errorf("unsupported TSS scheme %q", key.Scheme)
+	}
+}
+
+// CombineTSSShares sums verified shares into the chain's signature and checks
+// it verifies under the group key
+func CombineTSSShares(key TSSKey, message []byte, commitments []TSSCommitment, shares [][]byte) ([]byte, error) {
+	var signature []byte
+	var err error
+	switch key.Scheme {
+	case TSSSchemeECDSA:
+		signature, err = combineECDSAShares(message, commitments, shares)
+	case TSSSchemeEdDSA:
+		signature, err = combineEdDSAShares(key.PublicKey, message, commitments, shares)
+	default:
+		return nil, fmt.Errorf("unsupported TSS scheme %q", key.Scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := VerifyTSSSignature(key.Scheme, key.PublicKey, message, signature); err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+// VerifyTSSSignature checks a combined signature over a session message
+func VerifyTSSSignature(scheme string, groupKey, message, signature []byte) error {
+	switch scheme {
+	case TSSSchemeECDSA:
+		pubKey, err := secp256k1.ParsePubKey(groupKey)
+		if err != nil {
+			return fmt.Errorf("invalid group key: %w", err)
+		}
+		if len(signature) != ECDSASignatureSize {
+			return fmt.Errorf("signature must be %d bytes", ECDSASignatureSize)
+		}
+		r, err := parseSecpScalar(signature[:32])
+		if err != nil {
+			return err
+		}
+		s, err := parseSecpScalar(signature[32:64])
+		if err != nil {
+			return err
+		}
+		if len(message) != TSSDigestSize {
+			return fmt.Errorf("ECDSA sessions sign a %d-byte digest", TSSDigestSize)
+		}
+		if !ecdsa.NewSignature(&r, &s).Verify(message, pubKey) {
+			return fmt.Errorf("signature does not verify under the group key")
+		}
+		return nil
+	case TSSSchemeEdDSA:
+		if len(groupKey) != ed25519.PublicKeySize {
+			return fmt.Errorf("invalid group key")
+		}
+		if !ed25519.Verify(ed25519.PublicKey(groupKey), message, signature) {
+			return fmt.Errorf("signature does not verify under the group key")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported TSS scheme %q", scheme)
+	}
+}
+
+// VerifyTSSKeyShares checks the public key shares lie on one polynomial of
+// degree threshold-1 whose value at zero is the group key, so any threshold
+// of holders can sign and no smaller set can
+func VerifyTSSKeyShares(scheme string, groupKey []byte, threshold uint64, shares []TSSKeyShare) error {
+	sorted := make([]TSSKeyShare, len(shares))
+	copy(sorted, shares)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Index < sorted[j].Index })
+
+	if threshold == 0 || uint64(len(sorted)) < threshold {
+		return fmt.Errorf("%d key shares cannot meet a threshold of %d", len(sorted), threshold)
+	}
+	base := sorted[:threshold]
+	indices := make([]uint32, len(base))
+	for i, share := range base {
+		indices[i] = share.Index
+	}
+
+	switch scheme {
+	case TSSSchemeECDSA:
+		return verifyECDSAKeyShares(groupKey, base, indices, sorted[threshold:])
+	case TSSSchemeEdDSA:
+		return verifyEdDSAKeyShares(groupKey, base, indices, sorted[threshold:])
+	default:
+		return fmt.Errorf("unsupported TSS scheme %q", scheme)
+	}
+}
+
+// TSSAddressForKey derives the address a chain's funds are held at from the
+// group key. EVM chains use the Keccak-256 address, UTXO chains the P2WPKH
+// address under hrp, and Ed25519 chains the base58 public key.
+func TSSAddressForKey(chainType, scheme string, groupKey []byte, hrp string) (string, error) {
+	if required := TSSSchemeForChainType(chainType); scheme != required {
+		return "", fmt.Errorf("%s chains require %s keys, got %s", chainType, required, scheme)
+	}
+
+	switch chainType {
+	case "evm":
+		pubKey, err := secp256k1.ParsePubKey(groupKey)
+		if err != nil {
+			return "", fmt.Errorf("invalid group key: %w", err)
+		}
+		hasher := sha3.NewLegacyKeccak256()
+		hasher.Write(pubKey.SerializeUncompressed()[1:])
+		return "0x" + hex.EncodeToString(hasher.Sum(nil)[12:]), nil
+	case "utxo":
+		pubKey, err := secp256k1.ParsePubKey(groupKey)
+		if err != nil {
+			return "", fmt.Errorf("invalid group key: %w", err)
+		}
+		sha := sha256.Sum256(pubKey.SerializeCompressed())
+		hasher := ripemd160.New()
+		hasher.Write(sha[:])
+		program, err := bech32.ConvertBits(hasher.Sum(nil), 8, 5, true)
+		if err != nil {
+			return "", err
+		}
+		return bech32.Encode(hrp, append([]byte{0}, program...))
+	default:
+		if len(groupKey) != ed25519.PublicKeySize {
+			return "", fmt.Errorf("invalid group key")
+		}
+		return base58.Encode(groupKey), nil
+	}
+}
+
+// VerifyTSSAddress checks that a chain's TSS address belongs to the group key
+func VerifyTSSAddress(chainType, scheme string, groupKey []byte, address string) error {
+	hrp, err := tssAddressHRP(chainType, address)
+	if err != nil {
+		return err
+	}
+
+	expected, err := TSSAddressForKey(chainType, scheme, groupKey, hrp)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(expected, address) {
+		return fmt.Errorf("TSS address %s does not belong to the group key (expected %s)", address, expected)
+	}
+	return nil
+}
+
+// TSSAddressForChain derives the address a new group key controls on a chain,
+// on the same network as the chain's current TSS address
+func TSSAddressForChain(chain ExternalChain, scheme string, groupKey []byte) (string, error) {
+	hrp, err := tssAddressHRP(chain.ChainType, chain.TSSAddress)
+	if err != nil {
+		return "", err
+	}
+	return TSSAddressForKey(chain.ChainType, scheme, groupKey, hrp)
+}
+
+// tssAddressHRP returns the bech32 prefix of a UTXO chain's segwit address
+func tssAddressHRP(chainType, address string) (string, error) {
+	if chainType != "utxo" {
+		return "", nil
+	}
+	hrp, _, err := bech32.Decode(address, 90)
+	if err != nil {
+		return "", fmt.Errorf("TSS address is not a segwit address: %w", err)
+	}
+	return hrp, nil
+}
+
+// TSSSchemeForChainType returns the signature scheme a chain type verifies
+func TSSSchemeForChainType(chainType string) string {
+	switch chainType {
+	case "evm", "utxo":
+		return TSSSchemeECDSA
+	default:
+		return TSSSchemeEdDSA
+	}
+}
+
+func findCommitment(commitments []TSSCommitment, index uint32) (TSSCommitment, bool) {
+	for _, commitment := range commitments {
+		if commitment.Index == index {
+			return commitment, true
+		}
+	}
+	return TSSCommitment{}, false
+}
+
+// =============================================================================
+// ECDSA (secp256k1)
+// =============================================================================
+
+type ecdsaCommitment struct {
+	R, K, P, Delta secp256k1.JacobianPoint
+	ProofG, ProofK secp256k1.JacobianPoint // Proof nonce a·G and a·K_i
+	ProofZ         secp256k1.ModNScalar
+}
+
+// ECDSASigningFactors returns the digest scalar m and r = R.x mod n a signer
+// combines with its presignature shares as s_i = m·k_i + r·χ_i
+func ECDSASigningFactors(message, nonce []byte) (m, r secp256k1.ModNScalar, err error) {
+	point, err := parseSecpPoint(nonce)
+	if err != nil {
+		return m, r, fmt.Errorf("invalid nonce: %w", err)
+	}
+	point.ToAffine()
+	x := point.X.Bytes()
+	r.SetByteSlice(x[:])
+	if r.IsZero() {
+		return m, r, fmt.Errorf("nonce has a zero r")
+	}
+	if len(message) != TSSDigestSize {
+		return m, r, fmt.Errorf("ECDSA sessions sign a %d-byte digest", TSSDigestSize)
+	}
+	m.SetByteSlice(message)
+	return m, r, nil
+}
+
+// ECDSAPresignChallenge returns the challenge, big-endian, for the proof in a
+// signer's commitment that P_i = x_i·K_i for the x_i of its key share Y_i. The
+// proof is a‖z with z = a + c·x_i; commitment is the commitment's points.
+func ECDSAPresignChallenge(index uint32, keyShare, commitment []byte) []byte {
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], index)
+
+	hasher := sha256.New()
+	hasher.Write([]byte(presignContext))
+	hasher.Write(header[:])
+	hasher.Write(keyShare)
+	hasher.Write(commitment[:ECDSACommitmentSize-TSSShareSize])
+	var challenge secp256k1.ModNScalar
+	challenge.SetByteSlice(hasher.Sum(nil))
+	bz := challenge.Bytes()
+	return bz[:]
+}
+
+func parseECDSACommitment(data []byte) (ecdsaCommitment, error) {
+	var commitment ecdsaCommitment
+	if len(data) != ECDSACommitmentSize {
+		return commitment, fmt.Errorf("ECDSA commitment must be %d bytes", ECDSACommitmentSize)
+	}
+	size := secp256k1.PubKeyBytesLenCompressed
+	points := []struct {
+		point *secp256k1.JacobianPoint
+		name  string
+	}{
+		{&commitment.R, "nonce point"},
+		{&commitment.K, "nonce share commitment"},
+		{&commitment.P, "key share term"},
+		{&commitment.Delta, "cross term commitment"},
+		{&commitment.ProofG, "proof nonce"},
+		{&commitment.ProofK, "proof nonce"},
+	}
+	var err error
+	for i, p := range points {
+		if *p.point, err = parseSecpPoint(data[i*size : (i+1)*size]); err != nil {
+			return commitment, fmt.Errorf("invalid %s: %w", p.name, err)
+		}
+	}
+	if commitment.ProofZ, err = parseSecpScalar(data[len(points)*size:]); err != nil {
+		return commitment, fmt.Errorf("invalid proof response: %w", err)
+	}
+	return commitment, nil
+}
+
+// verifyECDSAPresignProof checks z·G = a·G + c·Y_i and z·K_i = a·K_i + c·P_i
+func verifyECDSAPresignProof(keyShare TSSKeyShare, commitment ecdsaCommitment, data []byte) error {
+	sharePoint, err := parseSecpPoint(keyShare.PublicShare)
+	if err != nil {
+		return fmt.Errorf("invalid public share %d: %w", keyShare.Index, err)
+	}
+	var c secp256k1.ModNScalar
+	c.SetByteSlice(ECDSAPresignChallenge(keyShare.Index, keyShare.PublicShare, data))
+
+	var zG, cY, expectedG secp256k1.JacobianPoint
+	secp256k1.ScalarBaseMultNonConst(&commitment.ProofZ, &zG)
+	secp256k1.ScalarMultNonConst(&c, &sharePoint, &cY)
+	secp256k1.AddNonConst(&commitment.ProofG, &cY, &expectedG)
+
+	var zK, cP, expectedK secp256k1.JacobianPoint
+	secp256k1.ScalarMultNonConst(&commitment.ProofZ, &commitment.K, &zK)
+	secp256k1.ScalarMultNonConst(&c, &commitment.P, &cP)
+	secp256k1.AddNonConst(&commitment.ProofK, &cP, &expectedK)
+
+	if !secpEqual(&zG, &expectedG) || !secpEqual(&zK, &expectedK) {
+		return fmt.Errorf("signer %d's presignature is not bound to its key share", keyShare.Index)
+	}
+	return nil
+}
+
+// ecdsaKeyTerm returns χ_i·R = λ_i·P_i + Δ_i for a signer in the signing set
+func ecdsaKeyTerm(commitment ecdsaCommitment, index uint32, indices []uint32) secp256k1.JacobianPoint {
+	lambda := secpLagrange(0, index, indices)
+	var term secp256k1.JacobianPoint
+	secp256k1.ScalarMultNonConst(&lambda, &commitment.P, &term)
+	secp256k1.AddNonConst(&term, &commitment.Delta, &term)
+	return term
+}
+
+func ecdsaNonce(groupKey []byte, commitments []TSSCommitment) ([]byte, error) {
+	if len(commitments) == 0 {
+		return nil, fmt.Errorf("no commitments")
+	}
+	groupPoint, err := parseSecpPoint(groupKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid group key: %w", err)
+	}
+
+	parsed := make([]ecdsaCommitment, len(commitments))
+	for i, c := range commitments {
+		if parsed[i], err = parseECDSACommitment(c.Data); err != nil {
+			return nil, fmt.Errorf("signer %d: %w", c.Index, err)
+		}
+	}
+
+	// Signers who committed to a nonce other than the majority's are at fault;
+	// without a majority there is no telling which side deviated
+	counts := make(map[string]int)
+	for _, c := range commitments {
+		counts[string(c.Data[:secp256k1.PubKeyBytesLenCompressed])]++
+	}
+	var nonce []byte
+	for _, c := range commitments {
+		if candidate := c.Data[:secp256k1.PubKeyBytesLenCompressed]; 2*counts[string(candidate)] > len(commitments) {
+			nonce = candidate
+			break
+		}
+	}
+	if nonce == nil {
+		return nil, fmt.Errorf("no majority of signers committed to the same nonce")
+	}
+	var deviating []uint32
+	for _, c := range commitments {
+		if !bytes.Equal(c.Data[:secp256k1.PubKeyBytesLenCompressed], nonce) {
+			deviating = append(deviating, c.Index)
+		}
+	}
+	if len(deviating) > 0 {
+		return nil, &TSSCommitmentFault{Indices: deviating, Reason: "committed to a different nonce than the signing set"}
+	}
+
+	indices := commitmentIndices(commitments)
+	var sumK, sumX secp256k1.JacobianPoint
+	for i, c := range commitments {
+		keyTerm := ecdsaKeyTerm(parsed[i], c.Index, indices)
+		secp256k1.AddNonConst(&sumK, &parsed[i].K, &sumK)
+		secp256k1.AddNonConst(&sumX, &keyTerm, &sumX)
+	}
+
+	var one secp256k1.ModNScalar
+	one.SetInt(1)
+	var generator secp256k1.JacobianPoint
+	secp256k1.ScalarBaseMultNonConst(&one, &generator)
+
+	if !secpEqual(&sumK, &generator) {
+		return nil, fmt.Errorf("nonce share commitments do not sum to the generator")
+	}
+	if !secpEqual(&sumX, &groupPoint) {
+		return nil, fmt.Errorf("key share commitments do not sum to the group key")
+	}
+	return append([]byte(nil), nonce...), nil
+}
+
+func verifyECDSAShare(message []byte, commitments []TSSCommitment, signer TSSCommitment, share []byte) error {
+	commitment, err := parseECDSACommitment(signer.Data)
+	if err != nil {
+		return err
+	}
+	s, err := parseSecpScalar(share)
+	if err != nil {
+		return err
+	}
+	m, r, err := ECDSASigningFactors(message, commitments[0].Data[:secp256k1.PubKeyBytesLenCompressed])
+	if err != nil {
+		return err
+	}
+
+	keyTerm := ecdsaKeyTerm(commitment, signer.Index, commitmentIndices(commitments))
+	var lhs, mK, rX, rhs secp256k1.JacobianPoint
+	secp256k1.ScalarMultNonConst(&s, &commitment.R, &lhs)
+	secp256k1.ScalarMultNonConst(&m, &commitment.K, &mK)
+	secp256k1.ScalarMultNonConst(&r, &keyTerm, &rX)
+	secp256k1.AddNonConst(&mK, &rX, &rhs)
+	if !secpEqual(&lhs, &rhs) {
+		return fmt.Errorf("share does not match signer %d's presignature commitment", signer.Index)
+	}
+	return nil
+}
+
+func combineECDSAShares(message []byte, commitments []TSSCommitment, shares [][]byte) ([]byte, error) {
+	nonceBytes := commitments[0].Data[:secp256k1.PubKeyBytesLenCompressed]
+	_, r, err := ECDSASigningFactors(message, nonceBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var s secp256k1.ModNScalar
+	for _, share := range shares {
+		si, err := parseSecpScalar(share)
+		if err != nil {
+			return nil, err
+		}
+		s.Add(&si)
+	}
+	if s.IsZero() {
+		return nil, fmt.Errorf("combined signature has a zero s")
+	}
+
+	// Recovery id: parity of R.y, plus 2 if R.x overflowed the group order
+	nonce, err := parseSecpPoint(nonceBytes)
+	if err != nil {
+		return nil, err
+	}
+	nonce.ToAffine()
+	var v byte
+	if nonce.Y.IsOdd() {
+		v = 1
+	}
+	var overflowCheck secp256k1.ModNScalar
+	x := nonce.X.Bytes()
+	if overflowCheck.SetByteSlice(x[:]) {
+		v |= 2
+	}
+
+	// Chains reject high-s signatures; negating s flips the recovered y parity
+	if s.IsOverHalfOrder() {
+		s.Negate()
+		v ^= 1
+	}
+
+	rBytes, sBytes := r.Bytes(), s.Bytes()
+	signature := make([]byte, 0, ECDSASignatureSize)
+	signature = append(signature, rBytes[:]...)
+	signature = append(signature, sBytes[:]...)
+	return append(signature, v), nil
+}
+
+func verifyECDSAKeyShares(groupKey []byte, base []TSSKeyShare, indices []uint32, rest []TSSKeyShare) error {
+	groupPoint, err := parseSecpPoint(groupKey)
+	if err != nil {
+		return fmt.Errorf("invalid group key: %w", err)
+	}
+
+	points := make([]secp256k1.JacobianPoint, len(base))
+	for i, share := range base {
+		if points[i], err = parseSecpPoint(share.PublicShare); err != nil {
+			return fmt.Errorf("invalid public share %d: %w", share.Index, err)
+		}
+	}
+
+	interpolate := func(at uint32) secp256k1.JacobianPoint {
+		var result secp256k1.JacobianPoint
+		for i, index := range indices {
+			lambda := secpLagrange(at, index, indices)
+			var term secp256k1.JacobianPoint
+			secp256k1.ScalarMultNonConst(&lambda, &points[i], &term)
+			secp256k1.AddNonConst(&result, &term, &result)
+		}
+		return result
+	}
+
+	secret := interpolate(0)
+	if !secpEqual(&secret, &groupPoint) {
+		return fmt.Errorf("public shares do not interpolate to the group key")
+	}
+	for _, share := range rest {
+		point, err := parseSecpPoint(share.PublicShare)
+		if err != nil {
+			return fmt.Errorf("invalid public share %d: %w", share.Index, err)
+		}
+		expected := interpolate(share.Index)
+		if !secpEqual(&point, &expected) {
+			return fmt.Errorf("public share %d is not on the key polynomial", share.Index)
+		}
+	}
+	return nil
+}
+
+// secpLagrange returns the Lagrange coefficient of index at x over indices
+func secpLagrange(x, index uint32, indices []uint32) secp256k1.ModNScalar {
+	var xs, xi, num, den secp256k1.ModNScalar
+	xs.SetInt(x)
+	xi.SetInt(index)
+	num.SetInt(1)
+	den.SetInt(1)
+	for _, j := range indices {
+		if j == index {
+			continue
+		}
+		var xj, diff secp256k1.ModNScalar
+		xj.SetInt(j)
+		diff.NegateVal(&xj).Add(&xs) // x - x_j
+		num.Mul(&diff)
+		diff.NegateVal(&xj).Add(&xi) // x_i - x_j
+		den.Mul(&diff)
+	}
+	return *num.Mul(den.InverseNonConst())
+}
+
+func parseSecpPoint(bz []byte) (secp256k1.JacobianPoint, error) {
+	var point secp256k1.JacobianPoint
+	if len(bz) != secp256k1.PubKeyBytesLenCompressed {
+		return point, fmt.Errorf("point must be a %d-byte compressed secp256k1 point", secp256k1.PubKeyBytesLenCompressed)
+	}
+	pubKey, err := secp256k1.ParsePubKey(bz)
+	if err != nil {
+		return point, err
+	}
+	pubKey.AsJacobian(&point)
+	return point, nil
+}
+
+func parseSecpScalar(bz []byte) (secp256k1.ModNScalar, error) {
+	var scalar secp256k1.ModNScalar
+	if len(bz) != TSSShareSize {
+		return scalar, fmt.Errorf("scalar must be %d bytes", TSSShareSize)
+	}
+	if overflow := scalar.SetByteSlice(bz); overflow {
+		return scalar, fmt.Errorf("scalar exceeds the group order")
+	}
+	return scalar, nil
+}
+
+// secpEqual compares two points, either of which may be the point at infinity
+func secpEqual(a, b *secp256k1.JacobianPoint) bool {
+	aInf := (a.X.IsZero() && a.Y.IsZero()) || a.Z.IsZero()
+	bInf := (b.X.IsZero() && b.Y.IsZero()) || b.Z.IsZero()
+	if aInf || bInf {
+		return aInf == bInf
+	}
+	return a.EquivalentNonConst(b)
+}
+
+// =============================================================================
+// EdDSA (Ed25519, FROST)
+// =============================================================================
+
+// EdDSASignerFactors returns the binding factor ρ_i, Lagrange coefficient λ_i
+// and challenge c a signer uses for its share z_i = d_i + e_i·ρ_i + λ_i·s_i·c
+func EdDSASignerFactors(groupKey, message []byte, commitments []TSSCommitment, index uint32) (rho, lambda, challenge *edwards25519.Scalar, err error) {
+	rhos, _, challenge, err := frostSigningState(groupKey, message, commitments)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	rho, found := rhos[index]
+	if !found {
+		return nil, nil, nil, fmt.Errorf("signer %d is not in the signing set", index)
+	}
+	lambda, err = edLagrange(index, commitmentIndices(commitments))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return rho, lambda, challenge, nil
+}
+
+// frostSigningState computes every signer's binding factor, the group
+// commitment R and the challenge c = H(R‖A‖M)
+func frostSigningState(groupKey, message []byte, commitments []TSSCommitment) (map[uint32]*edwards25519.Scalar, *edwards25519.Point, *edwards25519.Scalar, error) {
+	if len(commitments) == 0 {
+		return nil, nil, nil, fmt.Errorf("no commitments")
+	}
+	if _, err := parseEdPoint(groupKey); err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid group key: %w", err)
+	}
+
+	sorted := make([]TSSCommitment, len(commitments))
+	copy(sorted, commitments)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Index < sorted[j].Index })
+
+	var encoded []byte
+	for _, c := range sorted {
+		encoded = append(encoded, frostIdentifier(c.Index).Bytes()...)
+		encoded = append(encoded, c.Data...)
+	}
+	prefix := append([]byte(nil), groupKey...)
+	prefix = append(prefix, frostHash("msg", message)...)
+	prefix = append(prefix, frostHash("com", encoded)...)
+
+	rhos := make(map[uint32]*edwards25519.Scalar, len(sorted))
+	groupCommitment := edwards25519.NewIdentityPoint()
+	for _, c := range sorted {
+		hiding, binding, err := parseEdDSACommitment(c.Data)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		rho := frostScalar("rho", prefix, frostIdentifier(c.Index).Bytes())
+		rhos[c.Index] = rho
+
+		signerCommitment := new(edwards25519.Point).ScalarMult(rho, binding)
+		signerCommitment.Add(signerCommitment, hiding)
+		groupCommitment.Add(groupCommitment, signerCommitment)
+	}
+
+	hasher := sha512.New()
+	hasher.Write(groupCommitment.Bytes())
+	hasher.Write(groupKey)
+	hasher.Write(message)
+	challenge, err := edwards25519.NewScalar().SetUniformBytes(hasher.Sum(nil))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return rhos, groupCommitment, challenge, nil
+}
+
+func verifyEdDSAShare(groupKey, message []byte, commitments []TSSCommitment, signer TSSCommitment, keyShare TSSKeyShare, share []byte) error {
+	z, err := edwards25519.NewScalar().SetCanonicalBytes(share)
+	if err != nil {
+		return fmt.Errorf("invalid share scalar: %w", err)
+	}
+	publicShare, err := parseEdPoint(keyShare.PublicShare)
+	if err != nil {
+		return fmt.Errorf("invalid public share: %w", err)
+	}
+	hiding, binding, err := parseEdDSACommitment(signer.Data)
+	if err != nil {
+		return err
+	}
+	rho, lambda, challenge, err := EdDSASignerFactors(groupKey, message, commitments, signer.Index)
+	if err != nil {
+		return err
+	}
+
+	// z_i·B == D_i + ρ_i·E_i + (c·λ_i)·Y_i
+	lhs := new(edwards25519.Point).ScalarBaseMult(z)
+	rhs := new(edwards25519.Point).ScalarMult(rho, binding)
+	rhs.Add(rhs, hiding)
+	weight := edwards25519.NewScalar().Multiply(challenge, lambda)
+	rhs.Add(rhs, new(edwards25519.Point).ScalarMult(weight, publicShare))
+	if lhs.Equal(rhs) != 1 {
+		return fmt.Errorf("share does not match signer %d's key share", signer.Index)
+	}
+	return nil
+}
+
+func combineEdDSAShares(groupKey, message []byte, commitments []TSSCommitment, shares [][]byte) ([]byte, error) {
+	_, groupCommitment, _, err := frostSigningState(groupKey, message, commitments)
+	if err != nil {
+		return nil, err
+	}
+
+	z := edwards25519.NewScalar()
+	for _, share := range shares {
+		zi, err := edwards25519.NewScalar().SetCanonicalBytes(share)
+		if err != nil {
+			return nil, fmt.Errorf("invalid share scalar: %w", err)
+		}
+		z.Add(z, zi)
+	}
+	return append(groupCommitment.Bytes(), z.Bytes()...), nil
+}
+
+func verifyEdDSAKeyShares(groupKey []byte, base []TSSKeyShare, indices []uint32, rest []TSSKeyShare) error {
+	groupPoint, err := parseEdPoint(groupKey)
+	if err != nil {
+		return fmt.Errorf("invalid group key: %w", err)
+	}
+
+	points := make([]*edwards25519.Point, len(base))
+	for i, share := range base {
+		if points[i], err = parseEdPoint(share.PublicShare); err != nil {
+			return fmt.Errorf("invalid public share %d: %w", share.Index, err)
+		}
+	}
+
+	interpolate := func(at uint32) (*edwards25519.Point, error) {
+		result := edwards25519.NewIdentityPoint()
+		for i, index := range indices {
+			lambda, err := edLagrangeAt(at, index, indices)
+			if err != nil {
+				return nil, err
+			}
+			result.Add(result, new(edwards25519.Point).ScalarMult(lambda, points[i]))
+		}
+		return result, nil
+	}
+
+	secret, err := interpolate(0)
+	if err != nil {
+		return err
+	}
+	if secret.Equal(groupPoint) != 1 {
+		return fmt.Errorf("public shares do not interpolate to the group key")
+	}
+	for _, share := range rest {
+		point, err := parseEdPoint(share.PublicShare)
+		if err != nil {
+			return fmt.Errorf("invalid public share %d: %w", share.Index, err)
+		}
+		expected, err := interpolate(share.Index)
+		if err != nil {
+			return err
+		}
+		if point.Equal(expected) != 1 {
+			return fmt.Errorf("public share %d is not on the key polynomial", share.Index)
+		}
+	}
+	return nil
+}
+
+func parseEdDSACommitment(data []byte) (*edwards25519.Point, *edwards25519.Point, error) {
+	if len(data) != EdDSACommitmentSize {
+		return nil, nil, fmt.Errorf("EdDSA commitment must be %d bytes", EdDSACommitmentSize)
+	}
+	hiding, err := parseEdPoint(data[:ed25519.PublicKeySize])
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid hiding commitment: %w", err)
+	}
+	binding, err := parseEdPoint(data[ed25519.PublicKeySize:])
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid binding commitment: %w", err)
+	}
+	return hiding, binding, nil
+}
+
+func parseEdPoint(bz []byte) (*edwards25519.Point, error) {
+	point, err := new(edwards25519.Point).SetBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+	if point.Equal(edwards25519.NewIdentityPoint()) == 1 {
+		return nil, fmt.Errorf("point is the identity")
+	}
+	return point, nil
+}
+
+func edLagrange(index uint32, indices []uint32) (*edwards25519.Scalar, error) {
+	return edLagrangeAt(0, index, indices)
+}
+
+// edLagrangeAt returns the Lagrange coefficient of index at x over indices
+func edLagrangeAt(x, index uint32, indices []uint32) (*edwards25519.Scalar, error) {
+	xs, xi := frostIdentifier(x), frostIdentifier(index)
+	num, den := frostIdentifier(1), frostIdentifier(1)
+	for _, j := range indices {
+		if j == index {
+			continue
+		}
+		xj := frostIdentifier(j)
+		num.Multiply(num, edwards25519.NewScalar().Subtract(xs, xj))
+		den.Multiply(den, edwards25519.NewScalar().Subtract(xi, xj))
+	}
+	if den.Equal(edwards25519.NewScalar()) == 1 {
+		return nil, fmt.Errorf("duplicate signer index %d", index)
+	}
+	return num.Multiply(num, edInvert(den)), nil
+}
+
+// edInvert returns 1/s mod L as s^(L-2)
+func edInvert(s *edwards25519.Scalar) *edwards25519.Scalar {
+	// L - 2 in little-endian bytes
+	exponent := [32]byte{
+		0xeb, 0xd3, 0xf5, 0x5c, 0x1a, 0x63, 0x12, 0x58, 0xd6, 0x9c, 0xf7, 0xa2, 0xde, 0xf9, 0xde, 0x14,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+	}
+	result := frostIdentifier(1)
+	base := edwards25519.NewScalar().Set(s)
+	for _, b := range exponent {
+		for bit := 0; bit < 8; bit++ {
+			if b&(1<<bit) != 0 {
+				result.Multiply(result, base)
+			}
+			base.Multiply(base, base)
+		}
+	}
+	return result
+}
+
+// frostIdentifier encodes a share index as a scalar
+func frostIdentifier(index uint32) *edwards25519.Scalar {
+	var buf [32]byte
+	binary.LittleEndian.PutUint32(buf[:4], index)
+	scalar, _ := edwards25519.NewScalar().SetCanonicalBytes(buf[:])
+	return scalar
+}
+
+func frostHash(tag string, parts ...[]byte) []byte {
+	hasher := sha512.New()
+	hasher.Write([]byte(frostContext))
+	hasher.Write([]byte(tag))
+	for _, part := range parts {
+		hasher.Write(part)
+	}
+	return hasher.Sum(nil)
+}
+
+func frostScalar(tag string, parts ...[]byte) *edwards25519.Scalar {
+	scalar, _ := edwards25519.NewScalar().SetUniformBytes(frostHash(tag, parts...))
+	return scalar
+}
+
+func commitmentIndices(commitments []TSSCommitment) []uint32 {
+	indices := make([]uint32, len(commitments))
+	for i, c := range commitments {
+		indices[i] = c.Index
+	}
+	return indices
+}
@@ -0,0 +1,262 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// TSSKeyGenPhase is the round a key generation ceremony is in
+type TSSKeyGenPhase int
+
+const (
+	TSSKeyGenPhaseDealing       TSSKeyGenPhase = 0 // Participants publish polynomial commitments
+	TSSKeyGenPhaseComplaint     TSSKeyGenPhase = 1 // Participants accuse dealers whose private share did not verify
+	TSSKeyGenPhaseJustification TSSKeyGenPhase = 2 // Accused dealers reveal the disputed shares
+	TSSKeyGenPhaseFinished      TSSKeyGenPhase = 3 // Ceremony completed or failed
+)
+
+// String returns the string representation of TSSKeyGenPhase
+func (p TSSKeyGenPhase) String() string {
+	switch p {
+	case TSSKeyGenPhaseDealing:
+		return "dealing"
+	case TSSKeyGenPhaseComplaint:
+		return "complaint"
+	case TSSKeyGenPhaseJustification:
+		return "justification"
+	case TSSKeyGenPhaseFinished:
+		return "finished"
+	default:
+		return "unknown"
+	}
+}
+
+// TSSDealing is a participant's first-round message: Feldman commitments to
+// the coefficients of its secret polynomial and a proof of knowledge of the
+// constant term. Its private shares are sent to the other participants
+// off-chain.
+type TSSDealing struct {
+	Validator   string    `json:"validator" yaml:"validator"`
+	Index       uint32    `json:"index" yaml:"index"`
+	Commitments [][]byte  `json:"commitments" yaml:"commitments"` // a_0·G … a_{t-1}·G
+	Proof       []byte    `json:"proof" yaml:"proof"`             // Schnorr proof of knowledge of a_0
+	SubmittedAt time.Time `json:"submitted_at" yaml:"submitted_at"`
+}
+
+// TSSComplaint is an accusation that a dealer's private share to the accuser
+// was missing or did not match its commitments. The dealer answers by
+// revealing the share.
+type TSSComplaint struct {
+	Accuser    string     `json:"accuser" yaml:"accuser"`
+	Dealer     string     `json:"dealer" yaml:"dealer"`
+	FiledAt    time.Time  `json:"filed_at" yaml:"filed_at"`
+	Share      []byte     `json:"share,omitempty" yaml:"share,omitempty"` // Revealed share, once answered
+	AnsweredAt *time.Time `json:"answered_at,omitempty" yaml:"answered_at,omitempty"`
+}
+
+// TSSBlame records a participant excluded from a ceremony's key
+type TSSBlame struct {
+	Validator string `json:"validator" yaml:"validator"`
+	Reason    string `json:"reason" yaml:"reason"`
+	Slashed   bool   `json:"slashed" yaml:"slashed"`
+}
+
+// IsInProgress returns whether the ceremony still accepts messages
+func (kg TSSKeyGeneration) IsInProgress() bool {
+	return kg.Phase != TSSKeyGenPhaseFinished
+}
+
+// ParticipantIndex returns a validator's share index, or 0 if it does not take part
+func (kg TSSKeyGeneration) ParticipantIndex(validator string) uint32 {
+	for i, p := range kg.Participants {
+		if p == validator {
+			return uint32(i + 1)
+		}
+	}
+	return 0
+}
+
+// GetDealing returns a participant's dealing
+func (kg TSSKeyGeneration) GetDealing(validator string) (TSSDealing, bool) {
+	for _, dealing := range kg.Dealings {
+		if dealing.Validator == validator {
+			return dealing, true
+		}
+	}
+	return TSSDealing{}, false
+}
+
+// GetComplaint returns the position of an accuser's complaint against a dealer
+func (kg TSSKeyGeneration) GetComplaint(accuser, dealer string) (int, bool) {
+	for i, complaint := range kg.Complaints {
+		if complaint.Accuser == accuser && complaint.Dealer == dealer {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// IsBlamed returns whether a participant has been excluded from the key
+func (kg TSSKeyGeneration) IsBlamed(validator string) bool {
+	for _, blame := range kg.Blamed {
+		if blame.Validator == validator {
+			return true
+		}
+	}
+	return false
+}
+
+// QualifiedDealings returns the dealings of participants not excluded from the key
+func (kg TSSKeyGeneration) QualifiedDealings() []TSSDealing {
+	qualified := []TSSDealing{}
+	for _, dealing := range kg.Dealings {
+		if !kg.IsBlamed(dealing.Validator) {
+			qualified = append(qualified, dealing)
+		}
+	}
+	return qualified
+}
+
+// Validate validates the key generation ceremony
+func (kg TSSKeyGeneration) Validate() error {
+	if kg.ChainID == "" {
+		return fmt.Errorf("chain ID cannot be empty")
+	}
+	if kg.Scheme != TSSSchemeECDSA && kg.Scheme != TSSSchemeEdDSA {
+		return fmt.Errorf("unsupported TSS scheme %q", kg.Scheme)
+	}
+	if len(kg.Participants) == 0 {
+		return fmt.Errorf("participants cannot be empty")
+	}
+	if kg.Threshold == 0 {
+		return fmt.Errorf("threshold must be positive")
+	}
+	if kg.Threshold > uint64(len(kg.Participants)) {
+		return fmt.Errorf("threshold cannot exceed participants")
+	}
+	return nil
+}
+
+// TSSMigrationStatus represents the status of a custody migration
+type TSSMigrationStatus int
+
+const (
+	TSSMigrationStatusPending   TSSMigrationStatus = 0 // Waiting to build the sweeps from the old address's custody
+	TSSMigrationStatusSigning   TSSMigrationStatus = 1 // Old key holders are signing the sweeps
+	TSSMigrationStatusAttesting TSSMigrationStatus = 2 // Sweeps signed, waiting for reserves at the new address to be attested
+	TSSMigrationStatusCompleted TSSMigrationStatus = 3 // Sweeps attested, new key active
+)
+
+// String returns the string representation of TSSMigrationStatus
+func (s TSSMigrationStatus) String() string {
+	switch s {
+	case TSSMigrationStatusPending:
+		return "pending"
+	case TSSMigrationStatusSigning:
+		return "signing"
+	case TSSMigrationStatusAttesting:
+		return "attesting"
+	case TSSMigrationStatusCompleted:
+		return "completed"
+	default:
+		return "unknown"
+	}
+}
+
+// TSSMigrationSweep is a transaction moving one asset's custody from the old
+// key's address to the new one
+type TSSMigrationSweep struct {
+	AssetSymbol  string     `json:"asset_symbol" yaml:"asset_symbol"`
+	Amount       math.Int   `json:"amount" yaml:"amount"` // Received at the new address, after network fees
+	Tx           ExternalTx `json:"tx" yaml:"tx"`
+	TSSSessionID *uint64    `json:"tss_session_id,omitempty" yaml:"tss_session_id,omitempty"`
+	Signature    []byte     `json:"signature,omitempty" yaml:"signature,omitempty"`
+}
+
+// IsSigned returns whether the old key has signed the sweep
+func (s TSSMigrationSweep) IsSigned() bool {
+	return len(s.Signature) > 0
+}
+
+// TSSMigrationTarget is the balance of an asset the new address must be
+// attested to hold before the migration completes
+type TSSMigrationTarget struct {
+	AssetSymbol string   `json:"asset_symbol" yaml:"asset_symbol"`
+	Balance     math.Int `json:"balance" yaml:"balance"`
+}
+
+// TSSKeyMigration moves a chain's custodied funds from the address of its
+// current key to the address of a rotated key. The old key signs a sweep of
+// each asset; the new key takes over once a reserve snapshot of the new
+// address attests that the sweeps landed.
+type TSSKeyMigration struct {
+	ChainID         string               `json:"chain_id" yaml:"chain_id"`
+	KeyGenerationID uint64               `json:"key_generation_id" yaml:"key_generation_id"`
+	Status          TSSMigrationStatus   `json:"status" yaml:"status"`
+	OldAddress      string               `json:"old_address" yaml:"old_address"`
+	NewAddress      string               `json:"new_address" yaml:"new_address"`
+	NewKey          TSSKey               `json:"new_key" yaml:"new_key"`
+	SettledAt       *time.Time           `json:"settled_at,omitempty" yaml:"settled_at,omitempty"` // Since when no withdrawal has been signed under the old key
+	Sweeps          []TSSMigrationSweep  `json:"sweeps,omitempty" yaml:"sweeps,omitempty"`
+	Targets         []TSSMigrationTarget `json:"targets,omitempty" yaml:"targets,omitempty"`
+	SignedAt        *time.Time           `json:"signed_at,omitempty" yaml:"signed_at,omitempty"`
+	CreatedAt       time.Time            `json:"created_at" yaml:"created_at"`
+	CompletedAt     *time.Time           `json:"completed_at,omitempty" yaml:"completed_at,omitempty"`
+}
+
+// IsPending returns whether the migration has not completed
+func (m TSSKeyMigration) IsPending() bool {
+	return m.Status != TSSMigrationStatusCompleted
+}
+
+// NextSweep returns the index of the first sweep the old key has not signed
+func (m TSSKeyMigration) NextSweep() (int, bool) {
+	for i, sweep := range m.Sweeps {
+		if !sweep.IsSigned() {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// SweepForSession returns the index of the sweep a session is signing
+func (m TSSKeyMigration) SweepForSession(sessionID uint64) (int, bool) {
+	for i, sweep := range m.Sweeps {
+		if sweep.TSSSessionID != nil && *sweep.TSSSessionID == sessionID {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Validate validates the migration
+func (m TSSKeyMigration) Validate() error {
+	if m.ChainID == "" {
+		return fmt.Errorf("chain ID cannot be empty")
+	}
+	if m.NewKey.ChainID != m.ChainID {
+		return fmt.Errorf("new key belongs to chain %s", m.NewKey.ChainID)
+	}
+	if m.OldAddress == "" || m.NewAddress == "" {
+		return fmt.Errorf("migration addresses cannot be empty")
+	}
+	for _, sweep := range m.Sweeps {
+		if sweep.AssetSymbol == "" {
+			return fmt.Errorf("sweep asset symbol cannot be empty")
+		}
+		if sweep.Amount.IsNil() || !sweep.Amount.IsPositive() {
+			return fmt.Errorf("sweep amount must be positive")
+		}
+		if len(sweep.Tx.Digest) != TSSDigestSize {
+			return fmt.Errorf("sweep digest must be %d bytes", TSSDigestSize)
+		}
+	}
+	for _, target := range m.Targets {
+		if target.Balance.IsNil() || target.Balance.IsNegative() {
+			return fmt.Errorf("target balance cannot be negative")
+		}
+	}
+	return m.NewKey.Validate()
+}
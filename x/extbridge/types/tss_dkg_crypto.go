@@ -0,0 +1,286 @@
+package types
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"filippo.io/edwards25519"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// Distributed key generation
+//
+// Key generation is Pedersen's DKG with Feldman commitments and on-chain
+// complaints (Gennaro et al.). Each participant i picks a random polynomial
+// f_i of degree t-1 and publishes C_ik = a_ik·G for every coefficient, with a
+// Schnorr proof of knowledge of a_i0 bound to the ceremony and its index so
+// no one can publish a key derived from another's. It sends f_i(j) to each
+// participant j off-chain, and j checks f_i(j)·G = Σ_k j^k·C_ik.
+//
+// A participant whose share is missing or wrong files a complaint, and the
+// dealer must reveal f_i(j), which the chain checks against C_i. Dealers that
+// stay silent or reveal a bad share are excluded. The group key is Σ_i C_i0
+// over the remaining dealers, and every holder's public share is
+// Σ_i Σ_k j^k·C_ik, so the chain derives the whole key without seeing a secret.
+
+const (
+	// ECDSADealingProofSize is the Schnorr proof R‖z over secp256k1
+	ECDSADealingProofSize = secp256k1.PubKeyBytesLenCompressed + TSSShareSize
+
+	// EdDSADealingProofSize is the Schnorr proof R‖z over Ed25519
+	EdDSADealingProofSize = ed25519.PublicKeySize + TSSShareSize
+
+	dkgContext = "SHAREHODL-EXTBRIDGE-DKG-v1"
+)
+
+// TSSDealingChallenge returns the Schnorr challenge for a dealing's proof of
+// knowledge, encoded as a scalar of the scheme (big-endian for secp256k1,
+// little-endian for Ed25519)
+func TSSDealingChallenge(scheme string, keyGenID uint64, index uint32, constant, nonce []byte) []byte {
+	var header [12]byte
+	binary.BigEndian.PutUint64(header[:8], keyGenID)
+	binary.BigEndian.PutUint32(header[8:], index)
+
+	switch scheme {
+	case TSSSchemeECDSA:
+		hasher := sha256.New()
+		hasher.Write([]byte(dkgContext))
+		hasher.Write(header[:])
+		hasher.Write(constant)
+		hasher.Write(nonce)
+		var challenge secp256k1.ModNScalar
+		challenge.SetByteSlice(hasher.Sum(nil))
+		bz := challenge.Bytes()
+		return bz[:]
+	default:
+		return frostScalar(dkgContext, header[:], constant, nonce).Bytes()
+	}
+}
+
+// ValidateTSSDealing checks a dealing has one commitment per coefficient of a
+// degree threshold-1 polynomial and a valid proof of knowledge of its constant
+// term
+func ValidateTSSDealing(scheme string, keyGenID uint64, index uint32, threshold uint64, commitments [][]byte, proof []byte) error {
+	if uint64(len(commitments)) != threshold {
+		return fmt.Errorf("dealing must commit to %d coefficients, got %d", threshold, len(commitments))
+	}
+
+	switch scheme {
+	case TSSSchemeECDSA:
+		points, err := parseSecpCommitments(commitments)
+		if err != nil {
+			return err
+		}
+		if len(proof) != ECDSADealingProofSize {
+			return fmt.Errorf("proof must be %d bytes", ECDSADealingProofSize)
+		}
+		nonce, err := parseSecpPoint(proof[:secp256k1.PubKeyBytesLenCompressed])
+		if err != nil {
+			return fmt.Errorf("invalid proof nonce: %w", err)
+		}
+		z, err := parseSecpScalar(proof[secp256k1.PubKeyBytesLenCompressed:])
+		if err != nil {
+			return fmt.Errorf("invalid proof response: %w", err)
+		}
+		var c secp256k1.ModNScalar
+		c.SetByteSlice(TSSDealingChallenge(scheme, keyGenID, index, commitments[0], proof[:secp256k1.PubKeyBytesLenCompressed]))
+
+		// z·G = R + c·C_0
+		var lhs, rhs, term secp256k1.JacobianPoint
+		secp256k1.ScalarBaseMultNonConst(&z, &lhs)
+		secp256k1.ScalarMultNonConst(&c, &points[0], &term)
+		secp256k1.AddNonConst(&nonce, &term, &rhs)
+		if !secpEqual(&lhs, &rhs) {
+			return fmt.Errorf("proof of knowledge does not verify")
+		}
+		return nil
+	case TSSSchemeEdDSA:
+		points, err := parseEdCommitments(commitments)
+		if err != nil {
+			return err
+		}
+		if len(proof) != EdDSADealingProofSize {
+			return fmt.Errorf("proof must be %d bytes", EdDSADealingProofSize)
+		}
+		nonce, err := parseEdPoint(proof[:ed25519.PublicKeySize])
+		if err != nil {
+			return fmt.Errorf("invalid proof nonce: %w", err)
+		}
+		z, err := edwards25519.NewScalar().SetCanonicalBytes(proof[ed25519.PublicKeySize:])
+		if err != nil {
+			return fmt.Errorf("invalid proof response: %w", err)
+		}
+		c, _ := edwards25519.NewScalar().SetCanonicalBytes(TSSDealingChallenge(scheme, keyGenID, index, commitments[0], proof[:ed25519.PublicKeySize]))
+
+		lhs := new(edwards25519.Point).ScalarBaseMult(z)
+		rhs := new(edwards25519.Point).Add(nonce, new(edwards25519.Point).ScalarMult(c, points[0]))
+		if lhs.Equal(rhs) != 1 {
+			return fmt.Errorf("proof of knowledge does not verify")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported TSS scheme %q", scheme)
+	}
+}
+
+// VerifyTSSDealtShare checks a share a dealer sent to the participant at index
+// against the dealer's commitments
+func VerifyTSSDealtShare(scheme string, commitments [][]byte, index uint32, share []byte) error {
+	switch scheme {
+	case TSSSchemeECDSA:
+		points, err := parseSecpCommitments(commitments)
+		if err != nil {
+			return err
+		}
+		scalar, err := parseSecpScalar(share)
+		if err != nil {
+			return fmt.Errorf("invalid share: %w", err)
+		}
+		var actual secp256k1.JacobianPoint
+		secp256k1.ScalarBaseMultNonConst(&scalar, &actual)
+		expected := evalSecpCommitments(points, index)
+		if !secpEqual(&actual, &expected) {
+			return fmt.Errorf("share for participant %d does not match the dealer's commitments", index)
+		}
+		return nil
+	case TSSSchemeEdDSA:
+		points, err := parseEdCommitments(commitments)
+		if err != nil {
+			return err
+		}
+		scalar, err := edwards25519.NewScalar().SetCanonicalBytes(share)
+		if err != nil {
+			return fmt.Errorf("invalid share: %w", err)
+		}
+		if new(edwards25519.Point).ScalarBaseMult(scalar).Equal(evalEdCommitments(points, index)) != 1 {
+			return fmt.Errorf("share for participant %d does not match the dealer's commitments", index)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported TSS scheme %q", scheme)
+	}
+}
+
+// CombineTSSDealings derives the group key from the qualified dealings and the
+// public share of each holder index, in the order given
+func CombineTSSDealings(scheme string, dealings []TSSDealing, indices []uint32) ([]byte, [][]byte, error) {
+	if len(dealings) == 0 {
+		return nil, nil, fmt.Errorf("no qualified dealings")
+	}
+
+	switch scheme {
+	case TSSSchemeECDSA:
+		polys := make([][]secp256k1.JacobianPoint, len(dealings))
+		for i, dealing := range dealings {
+			points, err := parseSecpCommitments(dealing.Commitments)
+			if err != nil {
+				return nil, nil, fmt.Errorf("dealing from %s: %w", dealing.Validator, err)
+			}
+			polys[i] = points
+		}
+		sum := func(at uint32) ([]byte, error) {
+			var result secp256k1.JacobianPoint
+			for _, points := range polys {
+				term := evalSecpCommitments(points, at)
+				secp256k1.AddNonConst(&result, &term, &result)
+			}
+			if (result.X.IsZero() && result.Y.IsZero()) || result.Z.IsZero() {
+				return nil, fmt.Errorf("combined key is the point at infinity")
+			}
+			return secpPointBytes(&result), nil
+		}
+		return combineDealings(sum, indices)
+	case TSSSchemeEdDSA:
+		polys := make([][]*edwards25519.Point, len(dealings))
+		for i, dealing := range dealings {
+			points, err := parseEdCommitments(dealing.Commitments)
+			if err != nil {
+				return nil, nil, fmt.Errorf("dealing from %s: %w", dealing.Validator, err)
+			}
+			polys[i] = points
+		}
+		sum := func(at uint32) ([]byte, error) {
+			result := edwards25519.NewIdentityPoint()
+			for _, points := range polys {
+				result.Add(result, evalEdCommitments(points, at))
+			}
+			if result.Equal(edwards25519.NewIdentityPoint()) == 1 {
+				return nil, fmt.Errorf("combined key is the identity")
+			}
+			return result.Bytes(), nil
+		}
+		return combineDealings(sum, indices)
+	default:
+		return nil, nil, fmt.Errorf("unsupported TSS scheme %q", scheme)
+	}
+}
+
+// combineDealings evaluates the summed commitment polynomial at zero for the
+// group key and at each index for the public shares
+func combineDealings(sum func(at uint32) ([]byte, error), indices []uint32) ([]byte, [][]byte, error) {
+	groupKey, err := sum(0)
+	if err != nil {
+		return nil, nil, err
+	}
+	shares := make([][]byte, len(indices))
+	for i, index := range indices {
+		if shares[i], err = sum(index); err != nil {
+			return nil, nil, fmt.Errorf("public share %d: %w", index, err)
+		}
+	}
+	return groupKey, shares, nil
+}
+
+func parseSecpCommitments(commitments [][]byte) ([]secp256k1.JacobianPoint, error) {
+	points := make([]secp256k1.JacobianPoint, len(commitments))
+	for i, bz := range commitments {
+		var err error
+		if points[i], err = parseSecpPoint(bz); err != nil {
+			return nil, fmt.Errorf("invalid coefficient commitment %d: %w", i, err)
+		}
+	}
+	return points, nil
+}
+
+// evalSecpCommitments returns Σ_k x^k·C_k by Horner's rule
+func evalSecpCommitments(points []secp256k1.JacobianPoint, x uint32) secp256k1.JacobianPoint {
+	var xs secp256k1.ModNScalar
+	xs.SetInt(x)
+	result := points[len(points)-1]
+	for k := len(points) - 2; k >= 0; k-- {
+		var scaled secp256k1.JacobianPoint
+		secp256k1.ScalarMultNonConst(&xs, &result, &scaled)
+		secp256k1.AddNonConst(&scaled, &points[k], &result)
+	}
+	return result
+}
+
+func secpPointBytes(point *secp256k1.JacobianPoint) []byte {
+	affine := *point
+	affine.ToAffine()
+	return secp256k1.NewPublicKey(&affine.X, &affine.Y).SerializeCompressed()
+}
+
+func parseEdCommitments(commitments [][]byte) ([]*edwards25519.Point, error) {
+	points := make([]*edwards25519.Point, len(commitments))
+	for i, bz := range commitments {
+		var err error
+		if points[i], err = parseEdPoint(bz); err != nil {
+			return nil, fmt.Errorf("invalid coefficient commitment %d: %w", i, err)
+		}
+	}
+	return points, nil
+}
+
+// evalEdCommitments returns Σ_k x^k·C_k by Horner's rule
+func evalEdCommitments(points []*edwards25519.Point, x uint32) *edwards25519.Point {
+	xs := frostIdentifier(x)
+	result := new(edwards25519.Point).Set(points[len(points)-1])
+	for k := len(points) - 2; k >= 0; k-- {
+		result.ScalarMult(xs, result)
+		result.Add(result, points[k])
+	}
+	return result
+}
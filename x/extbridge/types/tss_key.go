@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TSS signature schemes
+const (
+	TSSSchemeECDSA = "ecdsa-secp256k1" // EVM and Bitcoin chains
+	TSSSchemeEdDSA = "eddsa-ed25519"   // Ed25519 chains
+)
+
+// TSSKey is the threshold key that controls a chain's TSS address. Each
+// holder keeps a Shamir share of the private key off-chain; the chain keeps
+// the group key and every holder's public share to verify signature shares.
+type TSSKey struct {
+	ChainID      string        `json:"chain_id" yaml:"chain_id"`
+	Scheme       string        `json:"scheme" yaml:"scheme"`
+	PublicKey    []byte        `json:"public_key" yaml:"public_key"` // Compressed secp256k1 or Ed25519 group key
+	Threshold    uint64        `json:"threshold" yaml:"threshold"`   // Holders needed to sign
+	Shares       []TSSKeyShare `json:"shares" yaml:"shares"`
+	RegisteredAt time.Time     `json:"registered_at" yaml:"registered_at"`
+}
+
+// TSSKeyShare is one validator's share of a TSS key
+type TSSKeyShare struct {
+	Validator   string `json:"validator" yaml:"validator"`
+	Index       uint32 `json:"index" yaml:"index"`               // Shamir x-coordinate, starting at 1
+	PublicShare []byte `json:"public_share" yaml:"public_share"` // Public key of the holder's private share
+}
+
+// TSSCommitment is a signer's commitment-round message in a signing session
+type TSSCommitment struct {
+	Validator   string    `json:"validator" yaml:"validator"`
+	Index       uint32    `json:"index" yaml:"index"` // Signer's key share index
+	Data        []byte    `json:"data" yaml:"data"`
+	SubmittedAt time.Time `json:"submitted_at" yaml:"submitted_at"`
+}
+
+// Validate checks the key's fields and that its public shares are consistent
+// with the group key and threshold
+func (k TSSKey) Validate() error {
+	if k.ChainID == "" {
+		return fmt.Errorf("chain ID cannot be empty")
+	}
+	if k.Scheme != TSSSchemeECDSA && k.Scheme != TSSSchemeEdDSA {
+		return fmt.Errorf("unsupported TSS scheme %q", k.Scheme)
+	}
+	if len(k.PublicKey) == 0 {
+		return fmt.Errorf("public key cannot be empty")
+	}
+	if k.Threshold == 0 {
+		return fmt.Errorf("threshold must be positive")
+	}
+	if k.Threshold > uint64(len(k.Shares)) {
+		return fmt.Errorf("threshold %d exceeds %d key shares", k.Threshold, len(k.Shares))
+	}
+
+	validators := make(map[string]bool)
+	indices := make(map[uint32]bool)
+	for _, share := range k.Shares {
+		if _, err := sdk.AccAddressFromBech32(share.Validator); err != nil {
+			return fmt.Errorf("invalid key share holder %s: %w", share.Validator, err)
+		}
+		if share.Index == 0 {
+			return fmt.Errorf("key share index must be positive")
+		}
+		if validators[share.Validator] {
+			return fmt.Errorf("duplicate key share holder %s", share.Validator)
+		}
+		if indices[share.Index] {
+			return fmt.Errorf("duplicate key share index %d", share.Index)
+		}
+		validators[share.Validator] = true
+		indices[share.Index] = true
+	}
+
+	return VerifyTSSKeyShares(k.Scheme, k.PublicKey, k.Threshold, k.Shares)
+}
+
+// GetShare returns a validator's key share
+func (k TSSKey) GetShare(validator string) (TSSKeyShare, bool) {
+	for _, share := range k.Shares {
+		if share.Validator == validator {
+			return share, true
+		}
+	}
+	return TSSKeyShare{}, false
+}
+
+// GetShareByIndex returns the key share at a Shamir index
+func (k TSSKey) GetShareByIndex(index uint32) (TSSKeyShare, bool) {
+	for _, share := range k.Shares {
+		if share.Index == index {
+			return share, true
+		}
+	}
+	return TSSKeyShare{}, false
+}
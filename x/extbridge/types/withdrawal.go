@@ -72,6 +72,7 @@ type Withdrawal struct {
 	ExternalTxHash  string           `json:"external_tx_hash,omitempty" yaml:"external_tx_hash,omitempty"` // Tx hash on external chain
 	CompletedAt     *time.Time       `json:"completed_at,omitempty" yaml:"completed_at,omitempty"`
 	FailureReason   string           `json:"failure_reason,omitempty" yaml:"failure_reason,omitempty"`
+	Tx              *ExternalTx      `json:"tx,omitempty" yaml:"tx,omitempty"` // Unsigned transfer, built when signing first starts
 }
 
 // NewWithdrawal creates a new Withdrawal
@@ -85,8 +86,8 @@ func NewWithdrawal(
 	externalAmount math.Int,
 	feeAmount math.Int,
 	timelockDuration time.Duration,
+	requestedAt time.Time,
 ) Withdrawal {
-	now := time.Now()
 	return Withdrawal{
 		ID:             id,
 		ChainID:        chainID,
@@ -97,8 +98,8 @@ func NewWithdrawal(
 		ExternalAmount: externalAmount,
 		FeeAmount:      feeAmount,
 		Status:         WithdrawalStatusPending,
-		RequestedAt:    now,
-		TimelockExpiry: now.Add(timelockDuration),
+		RequestedAt:    requestedAt,
+		TimelockExpiry: requestedAt.Add(timelockDuration),
 	}
 }
 
@@ -0,0 +1,723 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	equitytypes "github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+	"github.com/sharehodl/sharehodl-blockchain/x/governance/types"
+)
+
+// =============================================================================
+// SHAREHOLDER MEETINGS
+// Annual and special general meetings bundle a company's resolutions and board
+// elections into one agenda. Voting power is fixed by a record-date snapshot,
+// holders vote every item on a single ballot in person or by proxy, and the
+// business only stands if a quorum of record-date power is present.
+// =============================================================================
+
+// ScheduleMeeting gives notice of a shareholder meeting and puts its agenda items
+// forward as company proposals polling for the meeting. Directors may convene a
+// meeting; other holders may requisition one if they could propose every item.
+func (k Keeper) ScheduleMeeting(
+	ctx sdk.Context,
+	convener string,
+	companyID uint64,
+	title string,
+	recordDate time.Time,
+	opensAt time.Time,
+	closesAt time.Time,
+	quorum math.LegacyDec,
+	items []types.MeetingAgendaItem,
+) (uint64, error) {
+	convenerAddr, err := sdk.AccAddressFromBech32(convener)
+	if err != nil {
+		return 0, types.ErrInvalidAddress
+	}
+
+	company, found := k.equityKeeper.GetCompany(ctx, companyID)
+	if !found {
+		return 0, types.ErrCompanyNotFound
+	}
+	compInfo := extractCompanyInfo(company)
+
+	if err := types.ValidateMeetingSchedule(ctx.BlockTime(), recordDate, opensAt, closesAt); err != nil {
+		return 0, types.ErrInvalidMeeting.Wrap(err.Error())
+	}
+	if len(items) == 0 || len(items) > types.MaxMeetingAgendaItems {
+		return 0, types.ErrInvalidMeeting.Wrapf("agenda must have between 1 and %d items", types.MaxMeetingAgendaItems)
+	}
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			return 0, types.ErrInvalidMeeting.Wrap(err.Error())
+		}
+	}
+
+	if quorum.IsNil() || quorum.IsZero() {
+		quorum = types.DefaultMeetingQuorum
+	}
+	if !quorum.IsPositive() || quorum.GT(math.LegacyOneDec()) {
+		return 0, types.ErrInvalidMeeting.Wrap("quorum must be between 0 and 1")
+	}
+
+	// Holders requisitioning a meeting need standing to propose each item
+	if !k.equityKeeper.IsBoardMember(ctx, companyID, convener) {
+		for _, item := range items {
+			if err := k.validateCompanyProposalSubmission(ctx, convenerAddr, compInfo, item.Type); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	meeting := types.ShareholderMeeting{
+		ID:           k.getNextMeetingID(ctx),
+		CompanyID:    companyID,
+		Title:        title,
+		Convener:     convener,
+		RecordDate:   recordDate,
+		OpensAt:      opensAt,
+		ClosesAt:     closesAt,
+		Quorum:       quorum,
+		Status:       types.MeetingStatusScheduled,
+		RecordPower:  math.LegacyZeroDec(),
+		PresentPower: math.LegacyZeroDec(),
+		CreatedAt:    ctx.BlockTime(),
+	}
+
+	for _, item := range items {
+		var election *types.BoardElection
+		if item.Type == types.CompanyProposalTypeBoardElection {
+			election, err = k.newBoardElection(ctx, companyID, item.Seats, item.Nominees)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		proposalID, err := k.submitCompanyProposal(ctx, convener, companyID, item.Type, item.Title, item.Description,
+			nil, 0, math.LegacyZeroDec(), math.LegacyZeroDec(), election, &meeting)
+		if err != nil {
+			return 0, err
+		}
+		meeting.Agenda = append(meeting.Agenda, types.AgendaItem{
+			ProposalID: proposalID,
+			Type:       item.Type,
+			Title:      item.Title,
+		})
+	}
+
+	k.setShareholderMeeting(ctx, meeting)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"schedule_meeting",
+			sdk.NewAttribute("meeting_id", fmt.Sprintf("%d", meeting.ID)),
+			sdk.NewAttribute("company_id", fmt.Sprintf("%d", companyID)),
+			sdk.NewAttribute("convener", convener),
+			sdk.NewAttribute("record_date", recordDate.Format(time.RFC3339)),
+			sdk.NewAttribute("opens_at", opensAt.Format(time.RFC3339)),
+			sdk.NewAttribute("closes_at", closesAt.Format(time.RFC3339)),
+			sdk.NewAttribute("agenda_items", fmt.Sprintf("%d", len(meeting.Agenda))),
+			sdk.NewAttribute("quorum", quorum.String()),
+		),
+	)
+
+	return meeting.ID, nil
+}
+
+// AppointProxy appoints a proxy to vote a shareholder's record-date power at a
+// meeting. The appointment is a revocable company delegation to the proxy for the
+// meeting's duration; directions bind the proxy's vote on particular resolutions.
+func (k Keeper) AppointProxy(ctx sdk.Context, shareholder string, meetingID uint64, proxy string, directions []types.AgendaVote) error {
+	shareholderAddr, err := sdk.AccAddressFromBech32(shareholder)
+	if err != nil {
+		return types.ErrInvalidAddress
+	}
+	if _, err := sdk.AccAddressFromBech32(proxy); err != nil {
+		return types.ErrInvalidDelegate
+	}
+	if shareholder == proxy {
+		return types.ErrInvalidDelegate.Wrap("shareholder cannot appoint themselves as proxy")
+	}
+
+	meeting, found := k.GetShareholderMeeting(ctx, meetingID)
+	if !found {
+		return types.ErrMeetingNotFound
+	}
+	if meeting.IsClosed() || !ctx.BlockTime().Before(meeting.ClosesAt) {
+		return types.ErrMeetingNotOpen.Wrap("meeting has closed")
+	}
+	if err := types.ValidateProxyDirections(meeting.Agenda, directions); err != nil {
+		return types.ErrInvalidMeetingBallot.Wrap(err.Error())
+	}
+
+	if k.hasAttendedMeeting(ctx, meetingID, shareholder) {
+		return types.ErrMeetingBallotCast
+	}
+	if _, found := k.GetProxyCard(ctx, meetingID, shareholder); found {
+		return types.ErrProxyAppointed
+	}
+
+	// Once the record date has passed only holders of record can appoint a proxy
+	if meeting.IsRecordSet() && !k.meetingRecordPower(ctx, meeting, shareholderAddr).IsPositive() {
+		return types.ErrNotShareholder.Wrap("not a holder of record for this meeting")
+	}
+
+	card := types.ProxyCard{
+		MeetingID:   meetingID,
+		CompanyID:   meeting.CompanyID,
+		Shareholder: shareholder,
+		Proxy:       proxy,
+		Directions:  directions,
+		AppointedAt: ctx.BlockTime(),
+	}
+	k.setProxyCard(ctx, card)
+
+	meeting.ProxiesAppointed++
+	k.setShareholderMeeting(ctx, meeting)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"appoint_proxy",
+			sdk.NewAttribute("meeting_id", fmt.Sprintf("%d", meetingID)),
+			sdk.NewAttribute("company_id", fmt.Sprintf("%d", meeting.CompanyID)),
+			sdk.NewAttribute("shareholder", shareholder),
+			sdk.NewAttribute("proxy", proxy),
+			sdk.NewAttribute("directions", fmt.Sprintf("%d", len(directions))),
+		),
+	)
+
+	return nil
+}
+
+// RevokeProxy withdraws a proxy appointment the proxy has not yet voted
+func (k Keeper) RevokeProxy(ctx sdk.Context, shareholder string, meetingID uint64) error {
+	meeting, found := k.GetShareholderMeeting(ctx, meetingID)
+	if !found {
+		return types.ErrMeetingNotFound
+	}
+	if meeting.IsClosed() {
+		return types.ErrMeetingNotOpen.Wrap("meeting has closed")
+	}
+
+	card, found := k.GetProxyCard(ctx, meetingID, shareholder)
+	if !found {
+		return types.ErrProxyNotFound
+	}
+	if k.hasAttendedMeeting(ctx, meetingID, shareholder) {
+		return types.ErrMeetingBallotCast.Wrap("proxy has already voted")
+	}
+
+	k.revokeProxyCard(ctx, &meeting, card)
+	k.setShareholderMeeting(ctx, meeting)
+
+	return nil
+}
+
+// CastMeetingBallot votes every agenda item of an open meeting in one ballot. The
+// voter's own record-date power is voted alongside the power of every shareholder
+// who appointed them as proxy: directed resolutions are voted as instructed, the
+// rest at the proxy's discretion. Voting in person revokes the voter's own proxy.
+func (k Keeper) CastMeetingBallot(ctx sdk.Context, voter string, meetingID uint64, votes []types.AgendaVote) error {
+	voterAddr, err := sdk.AccAddressFromBech32(voter)
+	if err != nil {
+		return types.ErrInvalidAddress
+	}
+
+	meeting, found := k.GetShareholderMeeting(ctx, meetingID)
+	if !found {
+		return types.ErrMeetingNotFound
+	}
+	if !meeting.IsPollOpen(ctx.BlockTime()) {
+		return types.ErrMeetingNotOpen
+	}
+	if err := types.ValidateMeetingBallot(meeting.Agenda, votes); err != nil {
+		return types.ErrInvalidMeetingBallot.Wrap(err.Error())
+	}
+	if k.hasAttendedMeeting(ctx, meetingID, voter) {
+		return types.ErrMeetingBallotCast
+	}
+
+	ownPower := k.meetingRecordPower(ctx, meeting, voterAddr)
+
+	// Gather the record-date power the voter holds as proxy
+	type represented struct {
+		card  types.ProxyCard
+		power math.LegacyDec
+	}
+	var proxied []represented
+	proxiedPower := math.LegacyZeroDec()
+	for _, card := range k.GetMeetingProxyCards(ctx, meetingID) {
+		if card.Proxy != voter || k.hasAttendedMeeting(ctx, meetingID, card.Shareholder) {
+			continue
+		}
+		holder, err := sdk.AccAddressFromBech32(card.Shareholder)
+		if err != nil {
+			continue
+		}
+		power := k.meetingRecordPower(ctx, meeting, holder)
+		if !power.IsPositive() {
+			continue
+		}
+		proxied = append(proxied, represented{card: card, power: power})
+		proxiedPower = proxiedPower.Add(power)
+	}
+
+	presentPower := ownPower.Add(proxiedPower)
+	if !presentPower.IsPositive() {
+		return types.ErrInsufficientVotingPower.Wrap("no record-date voting power to vote")
+	}
+
+	// Attending in person supersedes the voter's own proxy appointment
+	if ownPower.IsPositive() {
+		if card, found := k.GetProxyCard(ctx, meetingID, voter); found {
+			k.revokeProxyCard(ctx, &meeting, card)
+		}
+	}
+
+	for _, v := range votes {
+		proposal, found := k.GetProposal(ctx, v.ProposalID)
+		if !found {
+			return types.ErrProposalNotFound
+		}
+		// Items whose board resolution lapsed are off the poll
+		if proposal.Status != types.ProposalStatusVotingPeriod {
+			continue
+		}
+		companyProposal, found := k.GetCompanyProposal(ctx, v.ProposalID)
+		if !found {
+			return types.ErrCompanyProposalNotFound
+		}
+
+		byProxy := fmt.Sprintf("Voted by proxy: %s", voter)
+
+		if election := companyProposal.BoardElection; election != nil {
+			// Proxies vote elections at their discretion with all represented power
+			option := types.VoteOptionAbstain
+			if len(v.Election) > 0 {
+				if err := equitytypes.ValidateBoardBallot(election.Candidates, election.Seats, election.Cumulative,
+					presentPower.TruncateInt(), v.Election); err != nil {
+					return types.ErrInvalidMeetingBallot.Wrapf("election %d: %s", v.ProposalID, err)
+				}
+				allocated := make(map[string]math.Int, len(v.Election))
+				for _, entry := range v.Election {
+					allocated[entry.Candidate] = entry.Votes
+				}
+				for i, candidate := range election.Candidates {
+					if votes, ok := allocated[candidate.Candidate]; ok {
+						election.Candidates[i].Votes = candidate.Votes.Add(votes)
+					}
+				}
+				companyProposal.UpdatedAt = ctx.BlockTime()
+				k.setCompanyProposal(ctx, companyProposal)
+				option = types.VoteOptionYes
+			}
+
+			k.recordMeetingVote(ctx, companyProposal, voter, option, presentPower, "")
+			for _, r := range proxied {
+				k.recordMeetingVote(ctx, companyProposal, r.card.Shareholder, option, math.LegacyZeroDec(), byProxy)
+			}
+			continue
+		}
+
+		discretionary := ownPower
+		for _, r := range proxied {
+			if direction, directed := r.card.Direction(v.ProposalID); directed {
+				k.recordMeetingVote(ctx, companyProposal, r.card.Shareholder, direction, r.power, byProxy)
+				continue
+			}
+			discretionary = discretionary.Add(r.power)
+			k.recordMeetingVote(ctx, companyProposal, r.card.Shareholder, v.Option, math.LegacyZeroDec(), byProxy)
+		}
+		if discretionary.IsPositive() {
+			k.recordMeetingVote(ctx, companyProposal, voter, v.Option, discretionary, "")
+		}
+	}
+
+	// Record attendance for the voter and every holder represented
+	k.setMeetingAttendance(ctx, meetingID, voter)
+	for _, r := range proxied {
+		k.setMeetingAttendance(ctx, meetingID, r.card.Shareholder)
+	}
+	meeting.PresentPower = meeting.PresentPower.Add(presentPower)
+	meeting.BallotsCast++
+	k.setShareholderMeeting(ctx, meeting)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"cast_meeting_ballot",
+			sdk.NewAttribute("meeting_id", fmt.Sprintf("%d", meetingID)),
+			sdk.NewAttribute("company_id", fmt.Sprintf("%d", meeting.CompanyID)),
+			sdk.NewAttribute("voter", voter),
+			sdk.NewAttribute("own_power", ownPower.String()),
+			sdk.NewAttribute("proxy_power", proxiedPower.String()),
+			sdk.NewAttribute("proxies", fmt.Sprintf("%d", len(proxied))),
+		),
+	)
+
+	return nil
+}
+
+// RecordMeetingMinutes records the content hash of a closed meeting's signed
+// minutes. The convener or a director may record them once.
+func (k Keeper) RecordMeetingMinutes(ctx sdk.Context, recorder string, meetingID uint64, minutesHash string) error {
+	if _, err := sdk.AccAddressFromBech32(recorder); err != nil {
+		return types.ErrInvalidAddress
+	}
+	if err := types.ValidateMinutesHash(minutesHash); err != nil {
+		return types.ErrInvalidMeeting.Wrap(err.Error())
+	}
+
+	meeting, found := k.GetShareholderMeeting(ctx, meetingID)
+	if !found {
+		return types.ErrMeetingNotFound
+	}
+	if !meeting.IsClosed() {
+		return types.ErrInvalidMeeting.Wrap("minutes are recorded after the meeting closes")
+	}
+	if meeting.MinutesHash != "" {
+		return types.ErrMinutesRecorded
+	}
+	if recorder != meeting.Convener && !k.equityKeeper.IsBoardMember(ctx, meeting.CompanyID, recorder) {
+		return types.ErrUnauthorized.Wrap("only the convener or a director may record minutes")
+	}
+
+	meeting.MinutesHash = minutesHash
+	meeting.MinutesRecordedBy = recorder
+	k.setShareholderMeeting(ctx, meeting)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"record_meeting_minutes",
+			sdk.NewAttribute("meeting_id", fmt.Sprintf("%d", meetingID)),
+			sdk.NewAttribute("company_id", fmt.Sprintf("%d", meeting.CompanyID)),
+			sdk.NewAttribute("recorder", recorder),
+			sdk.NewAttribute("minutes_hash", minutesHash),
+		),
+	)
+
+	return nil
+}
+
+// ProcessMeetings takes record-date snapshots, opens polls and closes meetings
+// whose poll has ended. It runs before ended voting periods are processed so a
+// meeting decides its own agenda items.
+func (k Keeper) ProcessMeetings(ctx sdk.Context) {
+	var pending []types.ShareholderMeeting
+	k.IterateShareholderMeetings(ctx, func(meeting types.ShareholderMeeting) bool {
+		if !meeting.IsClosed() {
+			pending = append(pending, meeting)
+		}
+		return false
+	})
+
+	now := ctx.BlockTime()
+	for _, meeting := range pending {
+		if meeting.Status == types.MeetingStatusScheduled && !meeting.IsRecordSet() && !now.Before(meeting.RecordDate) {
+			k.takeMeetingRecord(ctx, &meeting)
+		}
+
+		if meeting.Status == types.MeetingStatusScheduled && meeting.IsRecordSet() && !now.Before(meeting.OpensAt) {
+			meeting.Status = types.MeetingStatusOpen
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					"meeting_opened",
+					sdk.NewAttribute("meeting_id", fmt.Sprintf("%d", meeting.ID)),
+					sdk.NewAttribute("company_id", fmt.Sprintf("%d", meeting.CompanyID)),
+				),
+			)
+		}
+
+		if meeting.Status == types.MeetingStatusOpen && !now.Before(meeting.ClosesAt) {
+			k.closeMeeting(ctx, &meeting)
+		}
+
+		k.setShareholderMeeting(ctx, meeting)
+	}
+}
+
+// takeMeetingRecord snapshots holders of record for every agenda item
+func (k Keeper) takeMeetingRecord(ctx sdk.Context, meeting *types.ShareholderMeeting) {
+	for i, item := range meeting.Agenda {
+		proposal, found := k.GetProposal(ctx, item.ProposalID)
+		if !found {
+			continue
+		}
+		if err := k.CreateVoteSnapshot(ctx, proposal); err != nil {
+			ctx.Logger().Error("Failed to snapshot meeting agenda item",
+				"meeting_id", meeting.ID,
+				"proposal_id", item.ProposalID,
+				"error", err)
+			continue
+		}
+		if i == 0 {
+			if snapshot, found := k.GetVoteSnapshot(ctx, item.ProposalID); found {
+				meeting.RecordPower = snapshot.TotalVotingPower
+			}
+		}
+	}
+	meeting.RecordHeight = ctx.BlockHeight()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"meeting_record_date",
+			sdk.NewAttribute("meeting_id", fmt.Sprintf("%d", meeting.ID)),
+			sdk.NewAttribute("company_id", fmt.Sprintf("%d", meeting.CompanyID)),
+			sdk.NewAttribute("record_height", fmt.Sprintf("%d", meeting.RecordHeight)),
+			sdk.NewAttribute("record_power", meeting.RecordPower.String()),
+		),
+	)
+}
+
+// closeMeeting decides the agenda and publishes the results. Without a quorum
+// every item still on the poll fails; otherwise each is tallied against its own
+// threshold and passed items execute.
+func (k Keeper) closeMeeting(ctx sdk.Context, meeting *types.ShareholderMeeting) {
+	quorumMet := meeting.HasQuorum()
+
+	for _, item := range meeting.Agenda {
+		proposal, found := k.GetProposal(ctx, item.ProposalID)
+		if !found {
+			continue
+		}
+
+		if proposal.Status == types.ProposalStatusVotingPeriod {
+			if quorumMet {
+				if err := k.ProcessProposalResults(ctx, proposal.ID); err != nil {
+					ctx.Logger().Error("Failed to process meeting agenda item",
+						"meeting_id", meeting.ID,
+						"proposal_id", proposal.ID,
+						"error", err)
+				}
+			} else {
+				proposal.Status = types.ProposalStatusRejected
+				proposal.FinalizedAt = ctx.BlockTime()
+				proposal.UpdatedAt = ctx.BlockTime()
+				k.setProposal(ctx, proposal)
+			}
+			proposal, _ = k.GetProposal(ctx, item.ProposalID)
+		}
+
+		result := types.AgendaItemResult{
+			ProposalID:      item.ProposalID,
+			Title:           item.Title,
+			Type:            item.Type.String(),
+			Status:          proposal.Status.String(),
+			YesVotes:        proposal.YesVotes,
+			NoVotes:         proposal.NoVotes,
+			AbstainVotes:    proposal.AbstainVotes,
+			NoWithVetoVotes: proposal.NoWithVetoVotes,
+		}
+		if item.Type == types.CompanyProposalTypeBoardElection && proposal.Status == types.ProposalStatusPassed {
+			for _, seat := range k.equityKeeper.GetActiveBoardSeats(ctx, meeting.CompanyID) {
+				if seat.ProposalID == item.ProposalID {
+					result.Elected = append(result.Elected, seat.Director)
+				}
+			}
+		}
+		meeting.Results = append(meeting.Results, result)
+	}
+
+	meeting.Status = types.MeetingStatusConcluded
+	if !quorumMet {
+		meeting.Status = types.MeetingStatusQuorumNotMet
+	}
+	meeting.ClosedAt = ctx.BlockTime()
+
+	results, _ := json.Marshal(meeting.Results)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"meeting_concluded",
+			sdk.NewAttribute("meeting_id", fmt.Sprintf("%d", meeting.ID)),
+			sdk.NewAttribute("company_id", fmt.Sprintf("%d", meeting.CompanyID)),
+			sdk.NewAttribute("status", meeting.Status.String()),
+			sdk.NewAttribute("quorum_met", fmt.Sprintf("%t", quorumMet)),
+			sdk.NewAttribute("record_power", meeting.RecordPower.String()),
+			sdk.NewAttribute("present_power", meeting.PresentPower.String()),
+			sdk.NewAttribute("ballots_cast", fmt.Sprintf("%d", meeting.BallotsCast)),
+			sdk.NewAttribute("results", string(results)),
+		),
+	)
+}
+
+// recordMeetingVote records a vote on an agenda item. Zero-power votes mark a
+// holder represented by a proxy whose power was voted with the proxy's own.
+func (k Keeper) recordMeetingVote(
+	ctx sdk.Context,
+	companyProposal types.CompanyGovernanceProposal,
+	voter string,
+	option types.VoteOption,
+	power math.LegacyDec,
+	justification string,
+) {
+	weight := math.LegacyOneDec()
+	if !power.IsPositive() {
+		weight = math.LegacyZeroDec()
+	}
+	vote := types.Vote{
+		ProposalID:    companyProposal.ProposalID,
+		Voter:         voter,
+		Option:        option,
+		Weight:        weight,
+		VotingPower:   power.TruncateInt(),
+		CompanyID:     companyProposal.CompanyID,
+		Justification: justification,
+		VotedAt:       ctx.BlockTime(),
+	}
+	k.setVote(ctx, vote)
+
+	if vote.VotingPower.IsPositive() {
+		// Re-read the proposal so consecutive votes accumulate in the tally
+		if proposal, found := k.GetProposal(ctx, companyProposal.ProposalID); found {
+			k.updateCompanyProposalTally(ctx, proposal, companyProposal, vote)
+		}
+	}
+}
+
+// meetingRecordPower returns a holder's record-date voting power for a meeting
+func (k Keeper) meetingRecordPower(ctx sdk.Context, meeting types.ShareholderMeeting, holder sdk.AccAddress) math.LegacyDec {
+	if len(meeting.Agenda) == 0 {
+		return math.LegacyZeroDec()
+	}
+	snapshot, found := k.GetVoteSnapshot(ctx, meeting.Agenda[0].ProposalID)
+	if !found {
+		return math.LegacyZeroDec()
+	}
+	if power, exists := snapshot.EquityHolderPowers[holder.String()]; exists {
+		return power
+	}
+	return math.LegacyZeroDec()
+}
+
+// revokeProxyCard withdraws a proxy card
+func (k Keeper) revokeProxyCard(ctx sdk.Context, meeting *types.ShareholderMeeting, card types.ProxyCard) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.ProxyCardKey(card.MeetingID, card.Shareholder))
+	if meeting.ProxiesAppointed > 0 {
+		meeting.ProxiesAppointed--
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"revoke_proxy",
+			sdk.NewAttribute("meeting_id", fmt.Sprintf("%d", card.MeetingID)),
+			sdk.NewAttribute("company_id", fmt.Sprintf("%d", card.CompanyID)),
+			sdk.NewAttribute("shareholder", card.Shareholder),
+			sdk.NewAttribute("proxy", card.Proxy),
+		),
+	)
+}
+
+// Storage functions for shareholder meetings
+
+// GetShareholderMeeting returns a shareholder meeting
+func (k Keeper) GetShareholderMeeting(ctx sdk.Context, meetingID uint64) (types.ShareholderMeeting, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.MeetingKey(meetingID))
+	if bz == nil {
+		return types.ShareholderMeeting{}, false
+	}
+
+	var meeting types.ShareholderMeeting
+	if err := json.Unmarshal(bz, &meeting); err != nil {
+		return types.ShareholderMeeting{}, false
+	}
+	return meeting, true
+}
+
+// GetCompanyMeetings returns every shareholder meeting a company has scheduled
+func (k Keeper) GetCompanyMeetings(ctx sdk.Context, companyID uint64) []types.ShareholderMeeting {
+	var meetings []types.ShareholderMeeting
+	k.IterateShareholderMeetings(ctx, func(meeting types.ShareholderMeeting) bool {
+		if meeting.CompanyID == companyID {
+			meetings = append(meetings, meeting)
+		}
+		return false
+	})
+	return meetings
+}
+
+// IterateShareholderMeetings iterates over all shareholder meetings
+func (k Keeper) IterateShareholderMeetings(ctx sdk.Context, fn func(meeting types.ShareholderMeeting) bool) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.MeetingPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var meeting types.ShareholderMeeting
+		if err := json.Unmarshal(iterator.Value(), &meeting); err != nil {
+			continue
+		}
+		if fn(meeting) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setShareholderMeeting(ctx sdk.Context, meeting types.ShareholderMeeting) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	value, _ := json.Marshal(meeting)
+	store.Set(types.MeetingKey(meeting.ID), value)
+}
+
+func (k Keeper) getNextMeetingID(ctx sdk.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	id := uint64(1)
+	if bz := store.Get(types.MeetingIDCounterKey); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.MeetingIDCounterKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// GetProxyCard returns a shareholder's proxy card for a meeting
+func (k Keeper) GetProxyCard(ctx sdk.Context, meetingID uint64, shareholder string) (types.ProxyCard, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.ProxyCardKey(meetingID, shareholder))
+	if bz == nil {
+		return types.ProxyCard{}, false
+	}
+
+	var card types.ProxyCard
+	if err := json.Unmarshal(bz, &card); err != nil {
+		return types.ProxyCard{}, false
+	}
+	return card, true
+}
+
+// GetMeetingProxyCards returns every proxy card lodged for a meeting
+func (k Keeper) GetMeetingProxyCards(ctx sdk.Context, meetingID uint64) []types.ProxyCard {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.MeetingProxyCardsPrefix(meetingID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var cards []types.ProxyCard
+	for ; iterator.Valid(); iterator.Next() {
+		var card types.ProxyCard
+		if err := json.Unmarshal(iterator.Value(), &card); err != nil {
+			continue
+		}
+		cards = append(cards, card)
+	}
+	return cards
+}
+
+func (k Keeper) setProxyCard(ctx sdk.Context, card types.ProxyCard) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	value, _ := json.Marshal(card)
+	store.Set(types.ProxyCardKey(card.MeetingID, card.Shareholder), value)
+}
+
+func (k Keeper) hasAttendedMeeting(ctx sdk.Context, meetingID uint64, holder string) bool {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return store.Has(types.MeetingAttendanceKey(meetingID, holder))
+}
+
+func (k Keeper) setMeetingAttendance(ctx sdk.Context, meetingID uint64, holder string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.MeetingAttendanceKey(meetingID, holder), []byte{0x01})
+}
@@ -0,0 +1,419 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	equitytypes "github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+	"github.com/sharehodl/sharehodl-blockchain/x/governance/types"
+)
+
+// =============================================================================
+// BOARD GOVERNANCE
+// Board elections seat directors on the equity module's board registry, and
+// board-gated company proposals need an M-of-N board resolution before the
+// shareholder vote opens
+// =============================================================================
+
+// SubmitBoardElection submits a company proposal electing directors to seats on
+// the company's board. The election uses the board's voting method, straight or
+// cumulative, as configured when the election opens.
+func (k Keeper) SubmitBoardElection(
+	ctx sdk.Context,
+	submitter string,
+	companyID uint64,
+	title string,
+	description string,
+	seats uint32,
+	nominees []equitytypes.BoardNominee,
+) (uint64, error) {
+	election, err := k.newBoardElection(ctx, companyID, seats, nominees)
+	if err != nil {
+		return 0, err
+	}
+
+	return k.submitCompanyProposal(ctx, submitter, companyID, types.CompanyProposalTypeBoardElection,
+		title, description, nil, 0, math.LegacyZeroDec(), math.LegacyZeroDec(), election, nil)
+}
+
+// newBoardElection builds the slate of an election for seats on a company's board
+func (k Keeper) newBoardElection(ctx sdk.Context, companyID uint64, seats uint32, nominees []equitytypes.BoardNominee) (*types.BoardElection, error) {
+	board, found := k.equityKeeper.GetBoard(ctx, companyID)
+	if !found {
+		return nil, types.ErrInvalidBoardElection.Wrap("company has no board of directors")
+	}
+	if seats == 0 || seats > board.Seats {
+		return nil, types.ErrInvalidBoardElection.Wrapf("election must fill between 1 and %d seats", board.Seats)
+	}
+	if err := equitytypes.ValidateBoardNominees(nominees); err != nil {
+		return nil, types.ErrInvalidBoardElection.Wrap(err.Error())
+	}
+
+	return &types.BoardElection{
+		Seats:      seats,
+		Cumulative: board.CumulativeVoting,
+		Candidates: equitytypes.NewBoardCandidates(nominees),
+	}, nil
+}
+
+// CastBoardBallot allocates a shareholder's votes to candidates in a board election.
+// The ballot counts toward the proposal's turnout as a vote for holding the election.
+func (k Keeper) CastBoardBallot(ctx sdk.Context, voter string, proposalID uint64, ballot []equitytypes.BoardBallotEntry) error {
+	voterAddr, err := sdk.AccAddressFromBech32(voter)
+	if err != nil {
+		return types.ErrInvalidAddress
+	}
+
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return types.ErrProposalNotFound
+	}
+
+	companyProposal, found := k.GetCompanyProposal(ctx, proposalID)
+	if !found {
+		return types.ErrCompanyProposalNotFound
+	}
+	election := companyProposal.BoardElection
+	if election == nil {
+		return types.ErrInvalidBoardElection.Wrapf("proposal %d is not a board election", proposalID)
+	}
+	if companyProposal.MeetingID != 0 {
+		return types.ErrMeetingAgendaItem.Wrapf("election %d is on the agenda of meeting %d", proposalID, companyProposal.MeetingID)
+	}
+
+	if err := k.validateCompanyVoting(ctx, proposal, companyProposal, voterAddr); err != nil {
+		return err
+	}
+
+	if k.hasVoted(ctx, proposalID, voterAddr) {
+		return types.ErrAlreadyVoted
+	}
+
+	votingPower, err := k.calculateShareholderVotingPower(ctx, companyProposal, voterAddr)
+	if err != nil {
+		return err
+	}
+	power := votingPower.TruncateInt()
+	if !power.IsPositive() {
+		return types.ErrInsufficientVotingPower
+	}
+
+	if err := equitytypes.ValidateBoardBallot(election.Candidates, election.Seats, election.Cumulative, power, ballot); err != nil {
+		return types.ErrInvalidBoardElection.Wrap(err.Error())
+	}
+
+	// Add the ballot to the candidates' running totals
+	allocated := make(map[string]math.Int, len(ballot))
+	for _, entry := range ballot {
+		allocated[entry.Candidate] = entry.Votes
+	}
+	for i, candidate := range election.Candidates {
+		if votes, ok := allocated[candidate.Candidate]; ok {
+			election.Candidates[i].Votes = candidate.Votes.Add(votes)
+		}
+	}
+	companyProposal.UpdatedAt = ctx.BlockTime()
+	k.setCompanyProposal(ctx, companyProposal)
+
+	vote := types.Vote{
+		ProposalID:  proposalID,
+		Voter:       voter,
+		Option:      types.VoteOptionYes,
+		VotingPower: power,
+		Weight:      math.LegacyOneDec(),
+		CompanyID:   companyProposal.CompanyID,
+		VotedAt:     ctx.BlockTime(),
+	}
+	k.setVote(ctx, vote)
+	k.updateCompanyProposalTally(ctx, proposal, companyProposal, vote)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"cast_board_ballot",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute("company_id", fmt.Sprintf("%d", companyProposal.CompanyID)),
+			sdk.NewAttribute("voter", voter),
+			sdk.NewAttribute("voting_power", power.String()),
+			sdk.NewAttribute("candidates", fmt.Sprintf("%d", len(ballot))),
+			sdk.NewAttribute("cumulative", fmt.Sprintf("%t", election.Cumulative)),
+		),
+	)
+
+	return nil
+}
+
+// SignBoardResolution records an active director's signature on the board resolution
+// of a board-gated company proposal. Once the resolution reaches its threshold the
+// shareholder voting period starts, or for meeting business the item joins the
+// meeting's poll. Returns whether the resolution passed.
+func (k Keeper) SignBoardResolution(ctx sdk.Context, director string, proposalID uint64) (bool, error) {
+	if _, err := sdk.AccAddressFromBech32(director); err != nil {
+		return false, types.ErrInvalidAddress
+	}
+
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return false, types.ErrProposalNotFound
+	}
+	if proposal.Status != types.ProposalStatusBoardReview {
+		return false, types.ErrNotInBoardReview
+	}
+
+	companyProposal, found := k.GetCompanyProposal(ctx, proposalID)
+	if !found {
+		return false, types.ErrCompanyProposalNotFound
+	}
+	if !ctx.BlockTime().Before(companyProposal.BoardReviewEnd) {
+		return false, types.ErrNotInBoardReview.Wrap("board review period has ended")
+	}
+
+	if !k.equityKeeper.IsBoardMember(ctx, companyProposal.CompanyID, director) {
+		return false, types.ErrNotBoardMember
+	}
+
+	resolution, found := k.GetBoardResolution(ctx, proposalID)
+	if !found {
+		return false, types.ErrNotInBoardReview.Wrap("board resolution not found")
+	}
+	if resolution.HasSigned(director) {
+		return false, types.ErrBoardResolutionSigned
+	}
+
+	resolution.Signers = append(resolution.Signers, director)
+	approved := resolution.IsApproved()
+	if approved {
+		resolution.ApprovedAt = ctx.BlockTime()
+
+		proposal.Status = types.ProposalStatusVotingPeriod
+		if companyProposal.MeetingID == 0 {
+			proposal.VotingStartTime = ctx.BlockTime()
+			proposal.VotingEndTime = ctx.BlockTime().Add(companyProposal.VotingPeriod)
+		}
+		proposal.UpdatedAt = ctx.BlockTime()
+		k.setProposal(ctx, proposal)
+		if companyProposal.MeetingID == 0 {
+			k.snapshotCompanyProposal(ctx, proposal)
+		}
+	}
+	k.setBoardResolution(ctx, resolution)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"sign_board_resolution",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute("company_id", fmt.Sprintf("%d", companyProposal.CompanyID)),
+			sdk.NewAttribute("director", director),
+			sdk.NewAttribute("signatures", fmt.Sprintf("%d", len(resolution.Signers))),
+			sdk.NewAttribute("threshold", fmt.Sprintf("%d", resolution.Threshold)),
+			sdk.NewAttribute("approved", fmt.Sprintf("%t", approved)),
+		),
+	)
+
+	return approved, nil
+}
+
+// ProcessBoardReviews rejects board-gated proposals whose board resolution did not
+// pass within the review period
+func (k Keeper) ProcessBoardReviews(ctx sdk.Context) {
+	var inReview []types.Proposal
+	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		if proposal.Status == types.ProposalStatusBoardReview {
+			inReview = append(inReview, proposal)
+		}
+		return false
+	})
+
+	for _, proposal := range inReview {
+		companyProposal, found := k.GetCompanyProposal(ctx, proposal.ID)
+		if found && ctx.BlockTime().Before(companyProposal.BoardReviewEnd) {
+			continue
+		}
+
+		proposal.Status = types.ProposalStatusRejected
+		proposal.FinalizedAt = ctx.BlockTime()
+		proposal.UpdatedAt = ctx.BlockTime()
+		k.setProposal(ctx, proposal)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"board_resolution_lapsed",
+				sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposal.ID)),
+				sdk.NewAttribute("company_id", fmt.Sprintf("%d", proposal.CompanyID)),
+			),
+		)
+	}
+}
+
+// executeCompanyGovernanceProposal carries out a passed company proposal. Board
+// elections seat the winning candidates and corporate actions referenced in the
+// proposal data are approved and executed; other company proposals record the
+// shareholders' decision for the company to act on.
+func (k Keeper) executeCompanyGovernanceProposal(ctx sdk.Context, proposal types.Proposal) error {
+	companyProposal, found := k.GetCompanyProposal(ctx, proposal.ID)
+	if !found {
+		return types.ErrCompanyProposalNotFound
+	}
+
+	switch companyProposal.Type {
+	case types.CompanyProposalTypeBoardElection:
+		election := companyProposal.BoardElection
+		if election == nil {
+			return types.ErrInvalidBoardElection.Wrapf("proposal %d has no election slate", proposal.ID)
+		}
+		return k.equityKeeper.SeatBoardElection(ctx, companyProposal.CompanyID, proposal.ID, election.Seats, election.Candidates)
+
+	case types.CompanyProposalTypeShareSplit:
+		return k.executeShareSplitProposal(ctx, companyProposal)
+
+	case types.CompanyProposalTypeMergerAcquisition:
+		// Each party votes separately; the merger executes in the equity EndBlock
+		// once both the acquirer and the target approved it
+		mergerID, err := companyProposal.DataID(types.ProposalDataMergerID)
+		if err != nil {
+			return err
+		}
+		return k.equityKeeper.ApproveMerger(ctx, mergerID, companyProposal.CompanyID, companyProposal.ProposalID)
+
+	case types.CompanyProposalTypeDissolution:
+		return k.executeDissolutionProposal(ctx, companyProposal)
+
+	case types.CompanyProposalTypeCapitalStructure:
+		if _, ok := companyProposal.ProposalData[types.ProposalDataCapitalChangeID]; ok {
+			return k.executeCapitalChangeProposal(ctx, companyProposal)
+		}
+		if classID, ok := companyProposal.DataString(types.ProposalDataConversionClass); ok {
+			return k.executeConversionProposal(ctx, companyProposal, classID)
+		}
+	}
+
+	return nil
+}
+
+// executeShareSplitProposal approves and executes the stock split named by a passed
+// share split proposal. Both steps run against a cached context so a split that
+// cannot be executed is not left approved.
+func (k Keeper) executeShareSplitProposal(ctx sdk.Context, companyProposal types.CompanyGovernanceProposal) error {
+	splitID, err := companyProposal.DataID(types.ProposalDataSplitID)
+	if err != nil {
+		return err
+	}
+
+	split, found := k.equityKeeper.GetStockSplit(ctx, splitID)
+	if !found {
+		return types.ErrInvalidProposalContent.Wrapf("stock split %d not found", splitID)
+	}
+	if split.CompanyID != companyProposal.CompanyID {
+		return types.ErrInvalidProposalContent.Wrapf("stock split %d belongs to company %d", splitID, split.CompanyID)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.equityKeeper.ApproveStockSplit(cacheCtx, splitID, companyProposal.ProposalID); err != nil {
+		return err
+	}
+	if err := k.equityKeeper.ExecuteStockSplit(cacheCtx, splitID); err != nil {
+		return err
+	}
+	write()
+
+	return nil
+}
+
+// executeDissolutionProposal approves and executes the dissolution named by a
+// passed dissolution proposal, discarding the approval if the payout fails
+func (k Keeper) executeDissolutionProposal(ctx sdk.Context, companyProposal types.CompanyGovernanceProposal) error {
+	dissolutionID, err := companyProposal.DataID(types.ProposalDataDissolutionID)
+	if err != nil {
+		return err
+	}
+
+	dissolution, found := k.equityKeeper.GetDissolution(ctx, dissolutionID)
+	if !found {
+		return types.ErrInvalidProposalContent.Wrapf("dissolution %d not found", dissolutionID)
+	}
+	if dissolution.CompanyID != companyProposal.CompanyID {
+		return types.ErrInvalidProposalContent.Wrapf("dissolution %d belongs to company %d", dissolutionID, dissolution.CompanyID)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.equityKeeper.ApproveDissolution(cacheCtx, dissolutionID, companyProposal.ProposalID); err != nil {
+		return err
+	}
+	if err := k.equityKeeper.ExecuteDissolution(cacheCtx, dissolutionID); err != nil {
+		return err
+	}
+	write()
+
+	return nil
+}
+
+// executeCapitalChangeProposal applies the share class terms named by a passed
+// capital structure proposal
+func (k Keeper) executeCapitalChangeProposal(ctx sdk.Context, companyProposal types.CompanyGovernanceProposal) error {
+	changeID, err := companyProposal.DataID(types.ProposalDataCapitalChangeID)
+	if err != nil {
+		return err
+	}
+
+	change, found := k.equityKeeper.GetCapitalStructureChange(ctx, changeID)
+	if !found {
+		return types.ErrInvalidProposalContent.Wrapf("capital structure change %d not found", changeID)
+	}
+	if change.CompanyID != companyProposal.CompanyID {
+		return types.ErrInvalidProposalContent.Wrapf("capital structure change %d belongs to company %d", changeID, change.CompanyID)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.equityKeeper.ApplyCapitalStructureChange(cacheCtx, changeID, companyProposal.ProposalID); err != nil {
+		return err
+	}
+	write()
+
+	return nil
+}
+
+// executeConversionProposal converts every holder of a share class whose terms make
+// conversion mandatory on a governance vote. The conversion is all or nothing.
+func (k Keeper) executeConversionProposal(ctx sdk.Context, companyProposal types.CompanyGovernanceProposal, classID string) error {
+	cacheCtx, write := ctx.CacheContext()
+	converted, err := k.equityKeeper.ExecuteGovernanceConversion(cacheCtx, companyProposal.CompanyID, classID, companyProposal.ProposalID)
+	if err != nil {
+		return err
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"governance_conversion_executed",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", companyProposal.ProposalID)),
+			sdk.NewAttribute("company_id", fmt.Sprintf("%d", companyProposal.CompanyID)),
+			sdk.NewAttribute("class_id", classID),
+			sdk.NewAttribute("holders_converted", fmt.Sprintf("%d", converted)),
+		),
+	)
+
+	return nil
+}
+
+// GetBoardResolution returns the board resolution on a company proposal
+func (k Keeper) GetBoardResolution(ctx sdk.Context, proposalID uint64) (types.BoardResolution, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.BoardResolutionKey(proposalID))
+	if bz == nil {
+		return types.BoardResolution{}, false
+	}
+
+	var resolution types.BoardResolution
+	if err := json.Unmarshal(bz, &resolution); err != nil {
+		return types.BoardResolution{}, false
+	}
+	return resolution, true
+}
+
+func (k Keeper) setBoardResolution(ctx sdk.Context, resolution types.BoardResolution) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	value, _ := json.Marshal(resolution)
+	store.Set(types.BoardResolutionKey(resolution.ProposalID), value)
+}
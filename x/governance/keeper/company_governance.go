@@ -12,6 +12,9 @@ import (
 	"github.com/sharehodl/sharehodl-blockchain/x/governance/types"
 )
 
+// commonClassID is the default share class the equity module creates for every company
+const commonClassID = "COMMON"
+
 // companyInfo helper struct to extract company info from interface{}
 type companyInfo struct {
 	ID     uint64
@@ -48,10 +51,11 @@ func extractShareholdingInfo(shareholding interface{}) shareholdingInfo {
 	if s, ok := shareholding.(equitytypes.Shareholding); ok {
 		return shareholdingInfo{ClassID: s.ClassID, Shares: s.Shares}
 	}
-	return shareholdingInfo{ClassID: "common", Shares: math.ZeroInt()}
+	return shareholdingInfo{ClassID: commonClassID, Shares: math.ZeroInt()}
 }
 
-// SubmitCompanyProposal submits a company-specific governance proposal
+// SubmitCompanyProposal submits a company-specific governance proposal. Board
+// elections are submitted through SubmitBoardElection.
 func (k Keeper) SubmitCompanyProposal(
 	ctx sdk.Context,
 	submitter string,
@@ -63,6 +67,31 @@ func (k Keeper) SubmitCompanyProposal(
 	votingPeriod time.Duration,
 	quorumRequirement math.LegacyDec,
 	thresholdRequirement math.LegacyDec,
+) (uint64, error) {
+	if proposalType == types.CompanyProposalTypeBoardElection {
+		return 0, types.ErrInvalidBoardElection.Wrap("board elections need a slate of nominees")
+	}
+	return k.submitCompanyProposal(ctx, submitter, companyID, proposalType, title, description,
+		proposalData, votingPeriod, quorumRequirement, thresholdRequirement, nil, nil)
+}
+
+// submitCompanyProposal creates a company proposal. Proposals that require board
+// approval start in board review and open for shareholder voting once the board
+// resolution passes. Agenda items of a shareholder meeting are put forward by the
+// meeting's convener and poll for the meeting's voting window.
+func (k Keeper) submitCompanyProposal(
+	ctx sdk.Context,
+	submitter string,
+	companyID uint64,
+	proposalType types.CompanyProposalType,
+	title string,
+	description string,
+	proposalData map[string]interface{},
+	votingPeriod time.Duration,
+	quorumRequirement math.LegacyDec,
+	thresholdRequirement math.LegacyDec,
+	election *types.BoardElection,
+	meeting *types.ShareholderMeeting,
 ) (uint64, error) {
 	submitterAddr, err := sdk.AccAddressFromBech32(submitter)
 	if err != nil {
@@ -78,15 +107,33 @@ func (k Keeper) SubmitCompanyProposal(
 	// Extract company info
 	compInfo := extractCompanyInfo(company)
 
-	// Validate submitter eligibility
-	if err := k.validateCompanyProposalSubmission(ctx, submitterAddr, compInfo, proposalType); err != nil {
-		return 0, err
+	// Validate submitter eligibility; ScheduleMeeting authorizes a meeting's convener
+	if meeting == nil {
+		if err := k.validateCompanyProposalSubmission(ctx, submitterAddr, compInfo, proposalType); err != nil {
+			return 0, err
+		}
+	}
+
+	// Board-gated proposals need a board to pass the resolution
+	boardApprovalReq := k.requiresBoardApproval(proposalType)
+	var board equitytypes.Board
+	if boardApprovalReq {
+		var found bool
+		board, found = k.equityKeeper.GetBoard(ctx, companyID)
+		if !found {
+			return 0, types.ErrBoardApprovalRequired.Wrapf("%s has no board of directors", compInfo.Symbol)
+		}
 	}
 
 	// Get next proposal ID
 	proposalID := k.getNextProposalID(ctx)
 
 	// Determine voting period and requirements based on proposal type
+	votingStart := ctx.BlockTime()
+	if meeting != nil {
+		votingStart = meeting.OpensAt
+		votingPeriod = meeting.ClosesAt.Sub(meeting.OpensAt)
+	}
 	if votingPeriod == 0 {
 		votingPeriod = k.getDefaultVotingPeriodForType(proposalType)
 	}
@@ -110,7 +157,8 @@ func (k Keeper) SubmitCompanyProposal(
 		Proposer:          submitter,
 		ShareClassVoting:  k.getShareClassVotingWeights(ctx, compInfo),
 		RequiredApproval:  thresholdRequirement,
-		BoardApprovalReq:  k.requiresBoardApproval(proposalType),
+		BoardApprovalReq:  boardApprovalReq,
+		BoardElection:     election,
 		QuorumRequirement: quorumRequirement,
 		VotingPeriod:      votingPeriod,
 		ExecutionDelay:    k.getExecutionDelay(proposalType),
@@ -127,8 +175,8 @@ func (k Keeper) SubmitCompanyProposal(
 		Description:       description,
 		Proposer:          submitter,
 		Status:            types.ProposalStatusVotingPeriod, // Skip deposit period for company proposals
-		VotingStartTime:   ctx.BlockTime(),
-		VotingEndTime:     ctx.BlockTime().Add(votingPeriod),
+		VotingStartTime:   votingStart,
+		VotingEndTime:     votingStart.Add(votingPeriod),
 		DepositEndTime:    ctx.BlockTime(),
 		TotalDeposit:      math.ZeroInt(),
 		MinDeposit:        math.ZeroInt(), // No deposit required for company proposals
@@ -145,6 +193,26 @@ func (k Keeper) SubmitCompanyProposal(
 		UpdatedAt:         ctx.BlockTime(),
 	}
 
+	// Meeting business must clear the board before the poll opens
+	reviewEnd := ctx.BlockTime().Add(types.DefaultBoardReviewPeriod)
+	if meeting != nil {
+		companyProposal.MeetingID = meeting.ID
+		reviewEnd = meeting.OpensAt
+	}
+
+	// Hold board-gated proposals for the board resolution
+	if boardApprovalReq {
+		proposal.Status = types.ProposalStatusBoardReview
+		companyProposal.BoardReviewEnd = reviewEnd
+		k.setBoardResolution(ctx, types.BoardResolution{
+			ProposalID: proposalID,
+			CompanyID:  companyID,
+			Threshold:  board.ResolutionThreshold,
+			Signers:    []string{},
+			CreatedAt:  ctx.BlockTime(),
+		})
+	}
+
 	// Store both proposals
 	k.setProposal(ctx, proposal)
 	k.setCompanyProposal(ctx, companyProposal)
@@ -153,6 +221,11 @@ func (k Keeper) SubmitCompanyProposal(
 	k.indexProposal(ctx, proposal)
 	k.indexCompanyProposal(ctx, companyProposal)
 
+	// Snapshot holders as the poll opens; meeting business is snapshotted on the record date
+	if proposal.Status == types.ProposalStatusVotingPeriod && meeting == nil {
+		k.snapshotCompanyProposal(ctx, proposal)
+	}
+
 	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -163,6 +236,7 @@ func (k Keeper) SubmitCompanyProposal(
 			sdk.NewAttribute("proposal_type", proposalType.String()),
 			sdk.NewAttribute("submitter", submitter),
 			sdk.NewAttribute("title", title),
+			sdk.NewAttribute("status", proposal.Status.String()),
 		),
 	)
 
@@ -192,6 +266,9 @@ func (k Keeper) VoteOnCompanyProposal(
 	if !found {
 		return types.ErrProposalNotFound
 	}
+	if companyProposal.MeetingID != 0 {
+		return types.ErrMeetingAgendaItem.Wrapf("proposal %d is on the agenda of meeting %d", proposalID, companyProposal.MeetingID)
+	}
 
 	// Validate voting conditions
 	if err := k.validateCompanyVoting(ctx, proposal, companyProposal, voterAddr); err != nil {
@@ -209,13 +286,52 @@ func (k Keeper) VoteOnCompanyProposal(
 		return err
 	}
 
-	if votingPower.IsZero() {
-		return types.ErrInsufficientVotingPower
+	delegatedPower, err := k.castCompanyVote(ctx, proposal, companyProposal, voter, option, reason, votingPower)
+	if err != nil {
+		return err
+	}
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"vote_company_proposal",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute("company_id", fmt.Sprintf("%d", companyProposal.CompanyID)),
+			sdk.NewAttribute("voter", voter),
+			sdk.NewAttribute("option", option.String()),
+			sdk.NewAttribute("voting_power", votingPower.String()),
+			sdk.NewAttribute("delegated_power", delegatedPower.String()),
+		),
+	)
+
+	return nil
+}
+
+// castCompanyVote records a shareholder's direct vote at the given power and
+// carries the power of holders following the voter on the company. Returns
+// the delegated power cast with the vote.
+func (k Keeper) castCompanyVote(
+	ctx sdk.Context,
+	proposal types.Proposal,
+	companyProposal types.CompanyGovernanceProposal,
+	voter string,
+	option types.VoteOption,
+	reason string,
+	votingPower math.LegacyDec,
+) (math.Int, error) {
+	// A direct vote takes back any of this voter's power a delegate already cast
+	proposal = k.reclaimDelegatedVotes(ctx, proposal, voter)
+
+	// Shareholders following this voter on the company are carried on this vote
+	delegated := k.collectDelegatedVotes(ctx, proposal, voter, k.shareholderVotingPowerFunc(ctx, companyProposal))
+
+	if votingPower.IsZero() && len(delegated) == 0 {
+		return math.ZeroInt(), types.ErrInsufficientVotingPower
 	}
 
 	// Create vote
 	vote := types.Vote{
-		ProposalID:  proposalID,
+		ProposalID:  proposal.ID,
 		Voter:       voter,
 		Option:      option,
 		VotingPower: votingPower.TruncateInt(),
@@ -231,19 +347,11 @@ func (k Keeper) VoteOnCompanyProposal(
 	// Update tally
 	k.updateCompanyProposalTally(ctx, proposal, companyProposal, vote)
 
-	// Emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"vote_company_proposal",
-			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
-			sdk.NewAttribute("company_id", fmt.Sprintf("%d", companyProposal.CompanyID)),
-			sdk.NewAttribute("voter", voter),
-			sdk.NewAttribute("option", option.String()),
-			sdk.NewAttribute("voting_power", votingPower.String()),
-		),
-	)
+	options := []types.WeightedVoteOption{{Option: option, Weight: math.LegacyOneDec()}}
+	delegatedPower := k.castDelegatedVotes(ctx, proposal.ID, options, delegated)
+	k.recordDelegateVote(ctx, proposal, voter, options, vote.VotingPower, delegated)
 
-	return nil
+	return delegatedPower, nil
 }
 
 // validateCompanyProposalSubmission validates if submitter can submit company proposal
@@ -254,7 +362,7 @@ func (k Keeper) validateCompanyProposalSubmission(
 	proposalType types.CompanyProposalType,
 ) error {
 	// Check if submitter is a shareholder
-	shareholdingIface, found := k.equityKeeper.GetShareholding(ctx, compInfo.ID, "common", submitter.String())
+	shareholdingIface, found := k.equityKeeper.GetShareholding(ctx, compInfo.ID, commonClassID, submitter.String())
 	if !found {
 		return types.ErrNotAuthorized
 	}
@@ -315,14 +423,14 @@ func (k Keeper) validateCompanyVoting(
 	hasBeneficialOwnership := false
 
 	// 1. Check direct shareholding
-	_, found := k.equityKeeper.GetShareholding(ctx, companyProposal.CompanyID, "common", voter.String())
+	_, found := k.equityKeeper.GetShareholding(ctx, companyProposal.CompanyID, commonClassID, voter.String())
 	if found {
 		hasDirectShares = true
 	}
 
 	// 2. Check beneficial ownership (shares in LP pools, escrow, etc.)
 	if !hasDirectShares {
-		ownerships, err := k.equityKeeper.GetBeneficialOwnershipsByOwner(ctx, companyProposal.CompanyID, "common", voter.String())
+		ownerships, err := k.equityKeeper.GetBeneficialOwnershipsByOwner(ctx, companyProposal.CompanyID, commonClassID, voter.String())
 		if err == nil && len(ownerships) > 0 {
 			hasBeneficialOwnership = true
 		}
@@ -345,7 +453,7 @@ func (k Keeper) calculateShareholderVotingPower(
 	voter sdk.AccAddress,
 ) (math.LegacyDec, error) {
 	totalShares := math.ZeroInt()
-	classID := "common"
+	classID := commonClassID
 
 	// 1. Get shareholder's DIRECT holdings
 	shareholdingIface, found := k.equityKeeper.GetShareholding(ctx, companyProposal.CompanyID, classID, voter.String())
@@ -409,7 +517,7 @@ func (k Keeper) getDefaultVotingPeriodForType(proposalType types.CompanyProposal
 	switch proposalType {
 	case types.CompanyProposalTypeBoardElection:
 		return time.Hour * 24 * 14 // 2 weeks
-	case types.CompanyProposalTypeMergerAcquisition:
+	case types.CompanyProposalTypeMergerAcquisition, types.CompanyProposalTypeDissolution:
 		return time.Hour * 24 * 30 // 30 days
 	case types.CompanyProposalTypeBylaw:
 		return time.Hour * 24 * 21 // 3 weeks
@@ -422,7 +530,7 @@ func (k Keeper) getDefaultVotingPeriodForType(proposalType types.CompanyProposal
 
 func (k Keeper) getDefaultQuorumForType(proposalType types.CompanyProposalType) math.LegacyDec {
 	switch proposalType {
-	case types.CompanyProposalTypeMergerAcquisition:
+	case types.CompanyProposalTypeMergerAcquisition, types.CompanyProposalTypeDissolution:
 		return math.LegacyNewDecWithPrec(67, 2) // 67%
 	case types.CompanyProposalTypeBylaw:
 		return math.LegacyNewDecWithPrec(6, 1)  // 60%
@@ -435,7 +543,7 @@ func (k Keeper) getDefaultQuorumForType(proposalType types.CompanyProposalType)
 
 func (k Keeper) getDefaultThresholdForType(proposalType types.CompanyProposalType) math.LegacyDec {
 	switch proposalType {
-	case types.CompanyProposalTypeMergerAcquisition:
+	case types.CompanyProposalTypeMergerAcquisition, types.CompanyProposalTypeDissolution:
 		return math.LegacyNewDecWithPrec(75, 2) // 75%
 	case types.CompanyProposalTypeBylaw:
 		return math.LegacyNewDecWithPrec(67, 2) // 67%
@@ -450,7 +558,7 @@ func (k Keeper) getMinOwnershipForProposalType(proposalType types.CompanyProposa
 	switch proposalType {
 	case types.CompanyProposalTypeBoardElection:
 		return math.LegacyNewDecWithPrec(1, 2) // 1%
-	case types.CompanyProposalTypeMergerAcquisition:
+	case types.CompanyProposalTypeMergerAcquisition, types.CompanyProposalTypeDissolution:
 		return math.LegacyNewDecWithPrec(5, 2) // 5%
 	case types.CompanyProposalTypeExecutiveComp:
 		return math.LegacyNewDecWithPrec(3, 2) // 3%
@@ -463,7 +571,8 @@ func (k Keeper) requiresBoardApproval(proposalType types.CompanyProposalType) bo
 	switch proposalType {
 	case types.CompanyProposalTypeMergerAcquisition,
 		 types.CompanyProposalTypeCapitalStructure,
-		 types.CompanyProposalTypeAssetSale:
+		 types.CompanyProposalTypeAssetSale,
+		 types.CompanyProposalTypeDissolution:
 		return true
 	default:
 		return false
@@ -472,7 +581,7 @@ func (k Keeper) requiresBoardApproval(proposalType types.CompanyProposalType) bo
 
 func (k Keeper) getExecutionDelay(proposalType types.CompanyProposalType) time.Duration {
 	switch proposalType {
-	case types.CompanyProposalTypeMergerAcquisition:
+	case types.CompanyProposalTypeMergerAcquisition, types.CompanyProposalTypeDissolution:
 		return time.Hour * 24 * 7 // 1 week delay
 	case types.CompanyProposalTypeBylaw:
 		return time.Hour * 24 * 3 // 3 days delay
@@ -485,28 +594,24 @@ func (k Keeper) getShareClassVotingWeights(ctx sdk.Context, compInfo companyInfo
 	weights := make(map[string]math.LegacyDec)
 
 	// Default implementation - would be enhanced based on actual share class structure
-	weights["common"] = math.LegacyOneDec()
+	weights[commonClassID] = math.LegacyOneDec()
 	weights["preferred"] = math.LegacyNewDec(2) // Preferred shares get 2x voting power
 	
 	return weights
 }
 
 func (k Keeper) getMaxVotingPowerPerShareholder(ctx sdk.Context, companyProposal types.CompanyGovernanceProposal) math.LegacyDec {
-	// Default cap at 25% to prevent single shareholder dominance
-	return math.LegacyNewDecWithPrec(25, 2)
+	// Default cap at 25% of outstanding common shares to prevent single shareholder dominance
+	totalShares := k.equityKeeper.GetTotalShares(ctx, companyProposal.CompanyID, commonClassID)
+	return math.LegacyNewDecFromInt(totalShares).Mul(math.LegacyNewDecWithPrec(25, 2))
 }
 
 func (k Keeper) isBoardMember(ctx sdk.Context, compInfo companyInfo, addr sdk.AccAddress) bool {
-	// Implementation would check if address is a board member
-	// This would integrate with equity module's board tracking
-	_ = compInfo // avoid unused warning
-	return false
+	return k.equityKeeper.IsBoardMember(ctx, compInfo.ID, addr.String())
 }
 
 func (k Keeper) isIndependentBoardMember(ctx sdk.Context, compInfo companyInfo, addr sdk.AccAddress) bool {
-	// Implementation would check if address is an independent board member
-	_ = compInfo // avoid unused warning
-	return false
+	return k.equityKeeper.IsIndependentBoardMember(ctx, compInfo.ID, addr.String())
 }
 
 // Storage functions for company proposals
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	equitytypes "github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+	"github.com/sharehodl/sharehodl-blockchain/x/governance/types"
+)
+
+// mockEquityKeeper serves shareholdings and beneficial ownership for one
+// company; methods the tests don't need panic through the nil interface
+type mockEquityKeeper struct {
+	EquityKeeper
+	holdings   map[string]equitytypes.Shareholding
+	beneficial map[string][]equitytypes.BeneficialOwnership
+	totals     map[string]math.Int
+}
+
+func newMockEquityKeeper() *mockEquityKeeper {
+	return &mockEquityKeeper{
+		holdings:   make(map[string]equitytypes.Shareholding),
+		beneficial: make(map[string][]equitytypes.BeneficialOwnership),
+		totals:     make(map[string]math.Int),
+	}
+}
+
+func (m *mockEquityKeeper) hold(classID, owner string, shares int64) {
+	m.holdings[classID+"/"+owner] = equitytypes.Shareholding{CompanyID: 1, ClassID: classID, Owner: owner, Shares: math.NewInt(shares)}
+	m.addTotal(classID, shares)
+}
+
+func (m *mockEquityKeeper) lock(classID, owner string, shares int64) {
+	m.beneficial[classID+"/"+owner] = append(m.beneficial[classID+"/"+owner], equitytypes.BeneficialOwnership{
+		ModuleAccount: "escrow", CompanyID: 1, ClassID: classID, BeneficialOwner: owner, Shares: math.NewInt(shares),
+	})
+	m.addTotal(classID, shares)
+}
+
+func (m *mockEquityKeeper) addTotal(classID string, shares int64) {
+	total, found := m.totals[classID]
+	if !found {
+		total = math.ZeroInt()
+	}
+	m.totals[classID] = total.Add(math.NewInt(shares))
+}
+
+func (m *mockEquityKeeper) GetShareholding(_ context.Context, _ uint64, classID, owner string) (interface{}, bool) {
+	holding, found := m.holdings[classID+"/"+owner]
+	return holding, found
+}
+
+func (m *mockEquityKeeper) GetBeneficialOwnershipsByOwner(_ sdk.Context, _ uint64, classID, owner string) ([]equitytypes.BeneficialOwnership, error) {
+	return m.beneficial[classID+"/"+owner], nil
+}
+
+func (m *mockEquityKeeper) GetTotalShares(_ sdk.Context, _ uint64, classID string) math.Int {
+	if total, found := m.totals[classID]; found {
+		return total
+	}
+	return math.ZeroInt()
+}
+
+// TestShareholderVotingPowerReadsCommonClass tests that direct and beneficial
+// holdings are found in the COMMON class the equity module creates
+func TestShareholderVotingPowerReadsCommonClass(t *testing.T) {
+	equity := newMockEquityKeeper()
+	k := Keeper{equityKeeper: equity}
+
+	direct := sdk.AccAddress([]byte("direct_holder_______"))
+	pooled := sdk.AccAddress([]byte("pooled_holder_______"))
+	escrowed := sdk.AccAddress([]byte("escrowed_holder_____"))
+	other := sdk.AccAddress([]byte("other_holder________"))
+	equity.hold("COMMON", direct.String(), 100)
+	equity.hold("COMMON", pooled.String(), 40)
+	equity.lock("COMMON", pooled.String(), 60)
+	equity.lock("COMMON", escrowed.String(), 50)
+	equity.hold("COMMON", other.String(), 750)
+
+	proposal := types.CompanyGovernanceProposal{CompanyID: 1}
+
+	power, err := k.calculateShareholderVotingPower(sdk.Context{}, proposal, direct)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(100), power)
+
+	power, err = k.calculateShareholderVotingPower(sdk.Context{}, proposal, pooled)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(100), power)
+
+	// A holder whose shares are all escrowed has no direct holding to take the class from
+	power, err = k.calculateShareholderVotingPower(sdk.Context{}, proposal, escrowed)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(50), power)
+}
+
+// TestShareholderVotingPowerCap tests that a holder's power is capped at a
+// quarter of the outstanding common shares and holders below it keep theirs
+func TestShareholderVotingPowerCap(t *testing.T) {
+	equity := newMockEquityKeeper()
+	k := Keeper{equityKeeper: equity}
+
+	whale := sdk.AccAddress([]byte("whale_holder________"))
+	small := sdk.AccAddress([]byte("small_holder________"))
+	equity.hold("COMMON", whale.String(), 500)
+	equity.lock("COMMON", whale.String(), 100)
+	equity.hold("COMMON", small.String(), 100)
+	equity.hold("COMMON", sdk.AccAddress([]byte("other_holder________")).String(), 300)
+
+	proposal := types.CompanyGovernanceProposal{CompanyID: 1}
+	require.Equal(t, math.LegacyNewDec(250), k.getMaxVotingPowerPerShareholder(sdk.Context{}, proposal))
+
+	// 600 of 1000 shares, direct and pooled together, vote as 250
+	power, err := k.calculateShareholderVotingPower(sdk.Context{}, proposal, whale)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(250), power)
+
+	power, err = k.calculateShareholderVotingPower(sdk.Context{}, proposal, small)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(100), power)
+}
@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	equitytypes "github.com/sharehodl/sharehodl-blockchain/x/equity/types"
@@ -26,6 +27,9 @@ type Keeper struct {
 	accountKeeper        AccountKeeper
 	feeAbstractionKeeper FeeAbstractionKeeper
 
+	// Message router for executing passed proposal messages
+	router baseapp.MessageRouter
+
 	// Authority for governance parameter updates
 	authority string
 }
@@ -43,6 +47,34 @@ type EquityKeeper interface {
 	// GetVotingSharesForCompany returns total voting shares (direct + beneficial ownership)
 	// for a voter in a specific company - used for company proposal voting
 	GetVotingSharesForCompany(ctx sdk.Context, companyID uint64, voter string) math.Int
+	// GetCompanyShareholdings returns the direct holders of a share class, used for record-date snapshots
+	GetCompanyShareholdings(ctx sdk.Context, companyID uint64, classID string) []equitytypes.Shareholding
+	// GetCompanyBeneficialOwnerships returns the beneficial owners of a share class's shares in module accounts
+	GetCompanyBeneficialOwnerships(ctx sdk.Context, companyID uint64, classID string) []equitytypes.BeneficialOwnership
+	// GetBoard returns a company's board of directors configuration
+	GetBoard(ctx sdk.Context, companyID uint64) (equitytypes.Board, bool)
+	// IsBoardMember and IsIndependentBoardMember check for an active board seat
+	IsBoardMember(ctx sdk.Context, companyID uint64, address string) bool
+	IsIndependentBoardMember(ctx sdk.Context, companyID uint64, address string) bool
+	// GetActiveBoardSeats returns the directors currently holding seats
+	GetActiveBoardSeats(ctx sdk.Context, companyID uint64) []equitytypes.BoardSeat
+	// SeatBoardElection seats the winners of a passed board election
+	SeatBoardElection(ctx sdk.Context, companyID uint64, proposalID uint64, seats uint32, candidates []equitytypes.BoardCandidate) error
+	// GetStockSplit, ApproveStockSplit and ExecuteStockSplit carry out a passed share split
+	GetStockSplit(ctx sdk.Context, splitID uint64) (equitytypes.StockSplit, bool)
+	ApproveStockSplit(ctx sdk.Context, splitID uint64, proposalID uint64) error
+	ExecuteStockSplit(ctx sdk.Context, splitID uint64) error
+	// ApproveMerger records one party's approval of a merger
+	ApproveMerger(ctx sdk.Context, mergerID uint64, companyID uint64, proposalID uint64) error
+	// GetDissolution, ApproveDissolution and ExecuteDissolution carry out a passed dissolution
+	GetDissolution(ctx sdk.Context, dissolutionID uint64) (equitytypes.Dissolution, bool)
+	ApproveDissolution(ctx sdk.Context, dissolutionID uint64, proposalID uint64) error
+	ExecuteDissolution(ctx sdk.Context, dissolutionID uint64) error
+	// ExecuteGovernanceConversion converts a share class whose terms allow a governance-mandated conversion
+	ExecuteGovernanceConversion(ctx sdk.Context, companyID uint64, sourceClassID string, proposalID uint64) (int, error)
+	// GetCapitalStructureChange and ApplyCapitalStructureChange carry out a passed change to share class terms
+	GetCapitalStructureChange(ctx sdk.Context, changeID uint64) (equitytypes.CapitalStructureChange, bool)
+	ApplyCapitalStructureChange(ctx sdk.Context, changeID uint64, proposalID uint64) error
 }
 
 // BeneficialOwnership is an alias to equitytypes.BeneficialOwnership for local use
@@ -68,6 +100,12 @@ type UniversalStakingKeeper interface {
 	GetUserTierInt(ctx sdk.Context, addr sdk.AccAddress) int
 	// GetTotalStaked returns the total amount staked across all users
 	GetTotalStaked(ctx sdk.Context) math.Int
+	// GetReputation returns the user's reputation score (0-100); used to gate quadratic voting
+	GetReputation(ctx sdk.Context, addr sdk.AccAddress) math.LegacyDec
+	// LockForVote locks the user's stake while a conviction vote is outstanding
+	LockForVote(ctx sdk.Context, addr sdk.AccAddress, proposalID string) error
+	// UnlockVote releases a conviction vote lock once the proposal is finalized
+	UnlockVote(ctx sdk.Context, addr sdk.AccAddress, proposalID string) error
 }
 
 // GetValidatorByAddress returns validator info by address (helper method for keeper)
@@ -272,6 +310,12 @@ func (k *Keeper) SetAccountKeeper(accountKeeper AccountKeeper) {
 	k.accountKeeper = accountKeeper
 }
 
+// SetRouter sets the message router (for late binding)
+// Required for executing the messages of passed proposals
+func (k *Keeper) SetRouter(router baseapp.MessageRouter) {
+	k.router = router
+}
+
 // Proposal operations
 
 // SubmitProposal submits a new governance proposal
@@ -333,6 +377,7 @@ func (k Keeper) SubmitProposal(
 		Quorum:           quorumRequired,
 		Threshold:        thresholdRequired,
 		VetoThreshold:    math.LegacyNewDecWithPrec(334, 3),
+		VotingMode:       params.GetVotingMode(proposalType),
 		CreatedAt:        ctx.BlockTime(),
 		UpdatedAt:        ctx.BlockTime(),
 	}
@@ -394,16 +439,27 @@ func (k Keeper) Vote(
 		return types.ErrAlreadyVoted
 	}
 
-	// Calculate voting power
-	votingPower, err := k.calculateVotingPower(ctx, proposal, voterAddr)
+	// A direct vote takes back any of this voter's power a delegate already cast
+	proposal = k.reclaimDelegatedVotes(ctx, proposal, voter)
+
+	// Calculate voting power under the proposal's voting mode
+	votingPower, err := k.calculateModeVotingPower(ctx, proposal, voterAddr)
 	if err != nil {
 		return err
 	}
 
-	if votingPower.IsZero() {
+	// Followers who have not voted are carried on this vote
+	delegated := k.collectDelegatedVotes(ctx, proposal, voter, k.delegatedVotingPowerFunc(ctx, proposal))
+
+	if votingPower.IsZero() && len(delegated) == 0 {
 		return types.ErrInsufficientVotingPower
 	}
 
+	// Conviction votes hold the voter's stake until the proposal is finalized
+	if err := k.lockConvictionVote(ctx, proposal, voterAddr); err != nil {
+		return err
+	}
+
 	// Create vote
 	vote := types.Vote{
 		ProposalID:    proposalID,
@@ -421,6 +477,10 @@ func (k Keeper) Vote(
 	// Update tally
 	k.updateTallyResult(ctx, proposal, vote, math.LegacyOneDec())
 
+	options := []types.WeightedVoteOption{{Option: option, Weight: math.LegacyOneDec()}}
+	delegatedPower := k.castDelegatedVotes(ctx, proposalID, options, delegated)
+	k.recordDelegateVote(ctx, proposal, voter, options, vote.VotingPower, delegated)
+
 	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -429,6 +489,8 @@ func (k Keeper) Vote(
 			sdk.NewAttribute("voter", voter),
 			sdk.NewAttribute("option", option.String()),
 			sdk.NewAttribute("voting_power", votingPower.String()),
+			sdk.NewAttribute("delegated_power", delegatedPower.String()),
+			sdk.NewAttribute("voting_mode", proposal.VotingMode.String()),
 		),
 	)
 
@@ -464,16 +526,27 @@ func (k Keeper) VoteWeighted(
 		return types.ErrAlreadyVoted
 	}
 
-	// Calculate voting power
-	votingPower, err := k.calculateVotingPower(ctx, proposal, voterAddr)
+	// A direct vote takes back any of this voter's power a delegate already cast
+	proposal = k.reclaimDelegatedVotes(ctx, proposal, voter)
+
+	// Calculate voting power under the proposal's voting mode
+	votingPower, err := k.calculateModeVotingPower(ctx, proposal, voterAddr)
 	if err != nil {
 		return err
 	}
 
-	if votingPower.IsZero() {
+	// Followers who have not voted are carried on this vote
+	delegated := k.collectDelegatedVotes(ctx, proposal, voter, k.delegatedVotingPowerFunc(ctx, proposal))
+
+	if votingPower.IsZero() && len(delegated) == 0 {
 		return types.ErrInsufficientVotingPower
 	}
 
+	// Conviction votes hold the voter's stake until the proposal is finalized
+	if err := k.lockConvictionVote(ctx, proposal, voterAddr); err != nil {
+		return err
+	}
+
 	// Create weighted vote
 	weightedVote := types.WeightedVote{
 		ProposalID:  proposalID,
@@ -497,8 +570,12 @@ func (k Keeper) VoteWeighted(
 			VotedAt:     ctx.BlockTime(),
 		}
 		k.updateTallyResult(ctx, proposal, vote, opt.Weight)
+		proposal, _ = k.GetProposal(ctx, proposalID)
 	}
 
+	delegatedPower := k.castDelegatedVotes(ctx, proposalID, options, delegated)
+	k.recordDelegateVote(ctx, proposal, voter, options, weightedVote.VotingPower, delegated)
+
 	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -506,6 +583,8 @@ func (k Keeper) VoteWeighted(
 			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
 			sdk.NewAttribute("voter", voter),
 			sdk.NewAttribute("voting_power", votingPower.String()),
+			sdk.NewAttribute("delegated_power", delegatedPower.String()),
+			sdk.NewAttribute("voting_mode", proposal.VotingMode.String()),
 		),
 	)
 
@@ -595,11 +674,15 @@ func (k Keeper) setWeightedVote(ctx sdk.Context, vote types.WeightedVote) {
 	store.Set(key, bz)
 }
 
-// hasVoted checks if an address has already voted on a proposal
+// hasVoted checks if an address has already cast a vote or weighted vote on a proposal.
+// Votes are keyed by the voter's bech32 address, as setVote and setWeightedVote store them.
 func (k Keeper) hasVoted(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) bool {
 	store := k.storeService.OpenKVStore(ctx)
-	key := types.VoteKey(proposalID, voter.Bytes())
-	has, _ := store.Has(key)
+	voterKey := []byte(voter.String())
+	if has, _ := store.Has(types.VoteKey(proposalID, voterKey)); has {
+		return true
+	}
+	has, _ := store.Has(types.WeightedVoteKey(proposalID, voterKey))
 	return has
 }
 
@@ -872,6 +955,13 @@ func (k Keeper) validateVoting(ctx sdk.Context, proposal types.Proposal, voter s
 		return types.ErrVotingPeriodEnded
 	}
 
+	// Meeting business is only voted on the meeting ballot, at record-date power
+	if proposal.Type == types.ProposalTypeCompanyGovernance {
+		if companyProposal, found := k.GetCompanyProposal(ctx, proposal.ID); found && companyProposal.MeetingID != 0 {
+			return types.ErrMeetingAgendaItem.Wrapf("proposal %d is on the agenda of meeting %d", proposal.ID, companyProposal.MeetingID)
+		}
+	}
+
 	// Check voter eligibility based on proposal type
 	if err := k.checkVoterEligibility(ctx, proposal, voter); err != nil {
 		return err
@@ -0,0 +1,638 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sharehodl/sharehodl-blockchain/x/governance/types"
+)
+
+// =============================================================================
+// LIQUID DELEGATION
+// =============================================================================
+// Holders follow registered delegates per topic: a proposal type, a company,
+// or both. A proposal is covered by the holder's most specific delegation:
+//   1. the proposal's company and type
+//   2. every type for the proposal's company
+//   3. the proposal's type
+//   4. every type
+// Delegations are transitive: if a holder's delegate has not voted, the power
+// passes on to the delegate's own delegate, up to MaxDelegationDepth hops.
+// The first delegate along the chain to vote casts it at the holder's power
+// at that moment. Anyone along the chain who later votes directly takes the
+// power back for that proposal only; the delegation itself stays in place.
+
+// votingPowerFunc returns a follower's power on the proposal being voted
+type votingPowerFunc func(addr sdk.AccAddress) math.LegacyDec
+
+// RegisterDelegate registers or updates a delegate's public profile
+func (k Keeper) RegisterDelegate(
+	ctx sdk.Context,
+	delegate string,
+	name string,
+	statement string,
+	topics []types.ProposalType,
+	companyIDs []uint64,
+) error {
+	if _, err := sdk.AccAddressFromBech32(delegate); err != nil {
+		return types.ErrInvalidAddress
+	}
+	if err := types.ValidateDelegateProfile(name, statement, topics); err != nil {
+		return types.ErrInvalidDelegateProfile.Wrap(err.Error())
+	}
+
+	profile, updated := k.GetDelegateProfile(ctx, delegate)
+	if !updated {
+		profile = types.DelegateProfile{
+			Address:      delegate,
+			RegisteredAt: ctx.BlockTime(),
+		}
+	}
+	profile.Name = name
+	profile.Statement = statement
+	profile.Topics = topics
+	profile.CompanyIDs = companyIDs
+	profile.UpdatedAt = ctx.BlockTime()
+
+	k.setDelegateProfile(ctx, profile)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"register_delegate",
+			sdk.NewAttribute("delegate", delegate),
+			sdk.NewAttribute("name", name),
+			sdk.NewAttribute("updated", fmt.Sprintf("%t", updated)),
+		),
+	)
+
+	return nil
+}
+
+// DelegateVotes has a holder follow a registered delegate within a scope,
+// replacing any delegation the holder already has in that scope
+func (k Keeper) DelegateVotes(
+	ctx sdk.Context,
+	delegator string,
+	delegate string,
+	companyID uint64,
+	proposalType types.ProposalType,
+	allProposalTypes bool,
+	expiryHeight uint64,
+) error {
+	if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+		return types.ErrInvalidAddress
+	}
+	if _, err := sdk.AccAddressFromBech32(delegate); err != nil {
+		return types.ErrInvalidAddress
+	}
+	if delegator == delegate {
+		return types.ErrInvalidDelegate.Wrap("cannot delegate to yourself")
+	}
+	if _, found := k.GetDelegateProfile(ctx, delegate); !found {
+		return types.ErrDelegateNotRegistered.Wrapf("%s", delegate)
+	}
+
+	if allProposalTypes {
+		proposalType = types.ProposalTypeText
+	} else if proposalType.String() == "unknown" {
+		return types.ErrInvalidDelegation.Wrapf("unknown proposal type %d", proposalType)
+	}
+	if expiryHeight != 0 && expiryHeight <= uint64(ctx.BlockHeight()) {
+		return types.ErrInvalidDelegation.Wrap("expiry height has already passed")
+	}
+
+	delegation := types.LiquidDelegation{
+		Delegator:        delegator,
+		Delegate:         delegate,
+		CompanyID:        companyID,
+		ProposalType:     proposalType,
+		AllProposalTypes: allProposalTypes,
+		ExpiryHeight:     expiryHeight,
+		CreatedAt:        ctx.BlockTime(),
+	}
+
+	if err := k.checkDelegationChain(ctx, delegation); err != nil {
+		return err
+	}
+
+	k.setLiquidDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"delegate_votes",
+			sdk.NewAttribute("delegator", delegator),
+			sdk.NewAttribute("delegate", delegate),
+			sdk.NewAttribute("scope", delegation.Scope()),
+			sdk.NewAttribute("expiry_height", fmt.Sprintf("%d", expiryHeight)),
+		),
+	)
+
+	return nil
+}
+
+// UndelegateVotes removes a holder's delegation in a scope. Votes the delegate
+// already cast with the holder's power stand until the holder votes directly.
+func (k Keeper) UndelegateVotes(
+	ctx sdk.Context,
+	delegator string,
+	companyID uint64,
+	proposalType types.ProposalType,
+	allProposalTypes bool,
+) error {
+	if allProposalTypes {
+		proposalType = types.ProposalTypeText
+	}
+
+	delegation, found := k.GetLiquidDelegation(ctx, delegator, companyID, proposalType, allProposalTypes)
+	if !found {
+		return types.ErrDelegationNotFound
+	}
+
+	k.removeLiquidDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"undelegate_votes",
+			sdk.NewAttribute("delegator", delegator),
+			sdk.NewAttribute("delegate", delegation.Delegate),
+			sdk.NewAttribute("scope", delegation.Scope()),
+		),
+	)
+
+	return nil
+}
+
+// checkDelegationChain rejects a delegation that would loop back to the
+// delegator, or chain through more than MaxDelegationDepth delegates, for any
+// proposal type in its scope. Loops that only form through company-specific
+// delegations further up the chain are cut off when votes are counted instead.
+func (k Keeper) checkDelegationChain(ctx sdk.Context, delegation types.LiquidDelegation) error {
+	for _, proposalType := range delegation.ScopeProposalTypes() {
+		hops := 1
+		current := delegation.Delegate
+		for {
+			if current == delegation.Delegator {
+				return types.ErrCircularDelegation.Wrapf("%s delegations lead back to %s", proposalType, delegation.Delegator)
+			}
+			next, found := k.resolveLiquidDelegation(ctx, current, proposalType, delegation.CompanyID)
+			if !found {
+				break
+			}
+			hops++
+			if hops > types.MaxDelegationDepth {
+				return types.ErrDelegationTooDeep.Wrapf("%s delegations would pass through more than %d delegates", proposalType, types.MaxDelegationDepth)
+			}
+			current = next.Delegate
+		}
+	}
+	return nil
+}
+
+// resolveLiquidDelegation returns the holder's most specific active delegation
+// covering a proposal of the given type and company
+func (k Keeper) resolveLiquidDelegation(
+	ctx sdk.Context,
+	delegator string,
+	proposalType types.ProposalType,
+	companyID uint64,
+) (types.LiquidDelegation, bool) {
+	type scope struct {
+		companyID uint64
+		allTypes  bool
+	}
+	scopes := []scope{{0, false}, {0, true}}
+	if companyID != 0 {
+		scopes = append([]scope{{companyID, false}, {companyID, true}}, scopes...)
+	}
+
+	for _, s := range scopes {
+		pt := proposalType
+		if s.allTypes {
+			pt = types.ProposalTypeText
+		}
+		delegation, found := k.GetLiquidDelegation(ctx, delegator, s.companyID, pt, s.allTypes)
+		if found && delegation.IsActive(ctx.BlockHeight()) {
+			return delegation, true
+		}
+	}
+	return types.LiquidDelegation{}, false
+}
+
+// collectDelegatedVotes walks the delegation graph back from a voter and
+// returns the power of every follower the voter casts on this proposal: those
+// whose delegation chain reaches the voter before anyone else who has voted
+func (k Keeper) collectDelegatedVotes(
+	ctx sdk.Context,
+	proposal types.Proposal,
+	voter string,
+	power votingPowerFunc,
+) []types.DelegatedVote {
+	visited := map[string]bool{voter: true}
+	paths := map[string][]string{voter: nil}
+	frontier := []string{voter}
+
+	var delegated []types.DelegatedVote
+	for depth := 1; depth <= types.MaxDelegationDepth && len(frontier) > 0; depth++ {
+		var next []string
+		for _, delegate := range frontier {
+			for _, delegation := range k.GetLiquidDelegationsToDelegate(ctx, delegate) {
+				follower := delegation.Delegator
+				if visited[follower] {
+					continue
+				}
+
+				// Only the follower's most specific delegation for this proposal counts
+				resolved, found := k.resolveLiquidDelegation(ctx, follower, proposal.Type, proposal.CompanyID)
+				if !found || resolved.Delegate != delegate {
+					continue
+				}
+				visited[follower] = true
+
+				// Followers who voted, or whose power is already cast, keep
+				// their own and their followers' power out of this vote
+				followerAddr, err := sdk.AccAddressFromBech32(follower)
+				if err != nil || k.hasVoted(ctx, proposal.ID, followerAddr) {
+					continue
+				}
+				if _, counted := k.GetDelegatedVote(ctx, proposal.ID, follower); counted {
+					continue
+				}
+
+				path := append([]string{follower}, paths[delegate]...)
+				paths[follower] = path
+				next = append(next, follower)
+
+				// A follower without power on this proposal still relays its followers'
+				followerPower := power(followerAddr).TruncateInt()
+				if !followerPower.IsPositive() {
+					continue
+				}
+				delegated = append(delegated, types.DelegatedVote{
+					ProposalID:  proposal.ID,
+					Delegator:   follower,
+					CastBy:      voter,
+					Path:        path,
+					VotingPower: followerPower,
+					CastAt:      ctx.BlockTime(),
+				})
+			}
+		}
+		frontier = next
+	}
+
+	return delegated
+}
+
+// castDelegatedVotes adds followers' power to the tally on the voter's options
+// and records it so it can be taken back. Returns the total power cast.
+func (k Keeper) castDelegatedVotes(
+	ctx sdk.Context,
+	proposalID uint64,
+	options []types.WeightedVoteOption,
+	delegated []types.DelegatedVote,
+) math.Int {
+	total := math.ZeroInt()
+	if len(delegated) == 0 {
+		return total
+	}
+
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return total
+	}
+
+	for _, vote := range delegated {
+		vote.Options = options
+		applyDelegatedTally(&proposal, options, vote.VotingPower)
+		k.setDelegatedVote(ctx, vote)
+		total = total.Add(vote.VotingPower)
+	}
+
+	proposal.UpdatedAt = ctx.BlockTime()
+	k.setProposal(ctx, proposal)
+
+	return total
+}
+
+// reclaimDelegatedVotes takes back, for this proposal only, every delegated
+// vote whose power passed through the voter: the voter's own power and that
+// of followers who reach the voter first. The voter's direct vote then casts
+// the followers' power itself. Returns the updated proposal.
+func (k Keeper) reclaimDelegatedVotes(ctx sdk.Context, proposal types.Proposal, voter string) types.Proposal {
+	reclaimed := 0
+	for _, vote := range k.GetDelegatedVotes(ctx, proposal.ID) {
+		if !vote.PassesThrough(voter) {
+			continue
+		}
+		applyDelegatedTally(&proposal, vote.Options, vote.VotingPower.Neg())
+		k.deleteDelegatedVote(ctx, vote)
+		k.reduceDelegateVoteRecord(ctx, vote)
+		reclaimed++
+	}
+
+	if reclaimed == 0 {
+		return proposal
+	}
+
+	proposal.UpdatedAt = ctx.BlockTime()
+	k.setProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"delegated_votes_overridden",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute("voter", voter),
+			sdk.NewAttribute("reclaimed", fmt.Sprintf("%d", reclaimed)),
+		),
+	)
+
+	return proposal
+}
+
+// applyDelegatedTally adds (or, with negative power, removes) power on the
+// vote's options the same way updateTallyResult counts a direct vote
+func applyDelegatedTally(proposal *types.Proposal, options []types.WeightedVoteOption, power math.Int) {
+	for _, opt := range options {
+		weightedPower := math.LegacyNewDecFromInt(power).Mul(opt.Weight).TruncateInt()
+
+		switch opt.Option {
+		case types.VoteOptionYes:
+			proposal.YesVotes = proposal.YesVotes.Add(weightedPower)
+		case types.VoteOptionNo:
+			proposal.NoVotes = proposal.NoVotes.Add(weightedPower)
+		case types.VoteOptionAbstain:
+			proposal.AbstainVotes = proposal.AbstainVotes.Add(weightedPower)
+		case types.VoteOptionNoWithVeto:
+			proposal.NoWithVetoVotes = proposal.NoWithVetoVotes.Add(weightedPower)
+		}
+
+		proposal.TotalVotingPower = proposal.TotalVotingPower.Add(weightedPower)
+	}
+}
+
+// delegatedVotingPowerFunc returns followers' power on a protocol proposal.
+// Followers must be eligible to vote on it themselves. On conviction votes
+// their stake is not locked, so they carry their power without conviction.
+func (k Keeper) delegatedVotingPowerFunc(ctx sdk.Context, proposal types.Proposal) votingPowerFunc {
+	return func(addr sdk.AccAddress) math.LegacyDec {
+		if err := k.checkVoterEligibility(ctx, proposal, addr); err != nil {
+			return math.LegacyZeroDec()
+		}
+
+		var power math.LegacyDec
+		var err error
+		if proposal.VotingMode == types.VotingModeConviction {
+			power, err = k.calculateVotingPower(ctx, proposal, addr)
+		} else {
+			power, err = k.calculateModeVotingPower(ctx, proposal, addr)
+		}
+		if err != nil {
+			return math.LegacyZeroDec()
+		}
+		return power
+	}
+}
+
+// shareholderVotingPowerFunc returns followers' shareholder power on a company proposal
+func (k Keeper) shareholderVotingPowerFunc(ctx sdk.Context, companyProposal types.CompanyGovernanceProposal) votingPowerFunc {
+	return func(addr sdk.AccAddress) math.LegacyDec {
+		power, err := k.calculateShareholderVotingPower(ctx, companyProposal, addr)
+		if err != nil {
+			return math.LegacyZeroDec()
+		}
+		return power
+	}
+}
+
+// recordDelegateVote adds a registered delegate's vote to their voting record
+func (k Keeper) recordDelegateVote(
+	ctx sdk.Context,
+	proposal types.Proposal,
+	delegate string,
+	options []types.WeightedVoteOption,
+	ownPower math.Int,
+	delegated []types.DelegatedVote,
+) {
+	if _, found := k.GetDelegateProfile(ctx, delegate); !found {
+		return
+	}
+
+	delegatedPower := math.ZeroInt()
+	for _, vote := range delegated {
+		delegatedPower = delegatedPower.Add(vote.VotingPower)
+	}
+
+	k.setDelegateVoteRecord(ctx, delegate, types.DelegateVoteRecord{
+		ProposalID:     proposal.ID,
+		ProposalType:   proposal.Type,
+		CompanyID:      proposal.CompanyID,
+		Title:          proposal.Title,
+		Options:        options,
+		OwnPower:       ownPower,
+		DelegatedPower: delegatedPower,
+		Delegators:     uint32(len(delegated)),
+		VotedAt:        ctx.BlockTime(),
+	})
+}
+
+// reduceDelegateVoteRecord takes reclaimed power out of the casting delegate's voting record
+func (k Keeper) reduceDelegateVoteRecord(ctx sdk.Context, vote types.DelegatedVote) {
+	record, found := k.GetDelegateVoteRecord(ctx, vote.CastBy, vote.ProposalID)
+	if !found {
+		return
+	}
+	record.DelegatedPower = record.DelegatedPower.Sub(vote.VotingPower)
+	if record.Delegators > 0 {
+		record.Delegators--
+	}
+	k.setDelegateVoteRecord(ctx, vote.CastBy, record)
+}
+
+// Storage functions for liquid delegation
+
+// GetLiquidDelegation returns a holder's delegation in one scope
+func (k Keeper) GetLiquidDelegation(
+	ctx sdk.Context,
+	delegator string,
+	companyID uint64,
+	proposalType types.ProposalType,
+	allProposalTypes bool,
+) (types.LiquidDelegation, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.LiquidDelegationKey(delegator, companyID, allProposalTypes, uint32(proposalType)))
+	if bz == nil {
+		return types.LiquidDelegation{}, false
+	}
+
+	var delegation types.LiquidDelegation
+	if err := json.Unmarshal(bz, &delegation); err != nil {
+		return types.LiquidDelegation{}, false
+	}
+	return delegation, true
+}
+
+// GetLiquidDelegations returns every delegation a holder has made
+func (k Keeper) GetLiquidDelegations(ctx sdk.Context, delegator string) []types.LiquidDelegation {
+	return k.getLiquidDelegations(ctx, types.LiquidDelegationsByDelegatorKey(delegator))
+}
+
+// GetLiquidDelegationsToDelegate returns every delegation made to a delegate
+func (k Keeper) GetLiquidDelegationsToDelegate(ctx sdk.Context, delegate string) []types.LiquidDelegation {
+	return k.getLiquidDelegations(ctx, types.LiquidDelegationsToDelegateKey(delegate))
+}
+
+func (k Keeper) getLiquidDelegations(ctx sdk.Context, keyPrefix []byte) []types.LiquidDelegation {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var delegations []types.LiquidDelegation
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.LiquidDelegation
+		if err := json.Unmarshal(iterator.Value(), &delegation); err != nil {
+			continue
+		}
+		delegations = append(delegations, delegation)
+	}
+	return delegations
+}
+
+func (k Keeper) setLiquidDelegation(ctx sdk.Context, delegation types.LiquidDelegation) {
+	if existing, found := k.GetLiquidDelegation(ctx, delegation.Delegator, delegation.CompanyID, delegation.ProposalType, delegation.AllProposalTypes); found {
+		k.removeLiquidDelegation(ctx, existing)
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	value, _ := json.Marshal(delegation)
+	store.Set(types.LiquidDelegationKey(delegation.Delegator, delegation.CompanyID, delegation.AllProposalTypes, uint32(delegation.ProposalType)), value)
+	store.Set(types.LiquidDelegateIndexKey(delegation.Delegate, delegation.Delegator, delegation.CompanyID, delegation.AllProposalTypes, uint32(delegation.ProposalType)), value)
+}
+
+func (k Keeper) removeLiquidDelegation(ctx sdk.Context, delegation types.LiquidDelegation) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.LiquidDelegationKey(delegation.Delegator, delegation.CompanyID, delegation.AllProposalTypes, uint32(delegation.ProposalType)))
+	store.Delete(types.LiquidDelegateIndexKey(delegation.Delegate, delegation.Delegator, delegation.CompanyID, delegation.AllProposalTypes, uint32(delegation.ProposalType)))
+}
+
+// GetDelegateProfile returns a registered delegate's profile
+func (k Keeper) GetDelegateProfile(ctx sdk.Context, delegate string) (types.DelegateProfile, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.DelegateProfileKey(delegate))
+	if bz == nil {
+		return types.DelegateProfile{}, false
+	}
+
+	var profile types.DelegateProfile
+	if err := json.Unmarshal(bz, &profile); err != nil {
+		return types.DelegateProfile{}, false
+	}
+	return profile, true
+}
+
+// GetAllDelegateProfiles returns every registered delegate
+func (k Keeper) GetAllDelegateProfiles(ctx sdk.Context) []types.DelegateProfile {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DelegateProfilePrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var profiles []types.DelegateProfile
+	for ; iterator.Valid(); iterator.Next() {
+		var profile types.DelegateProfile
+		if err := json.Unmarshal(iterator.Value(), &profile); err != nil {
+			continue
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles
+}
+
+func (k Keeper) setDelegateProfile(ctx sdk.Context, profile types.DelegateProfile) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	value, _ := json.Marshal(profile)
+	store.Set(types.DelegateProfileKey(profile.Address), value)
+}
+
+// GetDelegatedVote returns a holder's power cast by a delegate on a proposal
+func (k Keeper) GetDelegatedVote(ctx sdk.Context, proposalID uint64, delegator string) (types.DelegatedVote, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.DelegatedVoteKey(proposalID, delegator))
+	if bz == nil {
+		return types.DelegatedVote{}, false
+	}
+
+	var vote types.DelegatedVote
+	if err := json.Unmarshal(bz, &vote); err != nil {
+		return types.DelegatedVote{}, false
+	}
+	return vote, true
+}
+
+// GetDelegatedVotes returns every holder's power cast by delegates on a proposal
+func (k Keeper) GetDelegatedVotes(ctx sdk.Context, proposalID uint64) []types.DelegatedVote {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DelegatedVotesByProposalKey(proposalID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var votes []types.DelegatedVote
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.DelegatedVote
+		if err := json.Unmarshal(iterator.Value(), &vote); err != nil {
+			continue
+		}
+		votes = append(votes, vote)
+	}
+	return votes
+}
+
+func (k Keeper) setDelegatedVote(ctx sdk.Context, vote types.DelegatedVote) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	value, _ := json.Marshal(vote)
+	store.Set(types.DelegatedVoteKey(vote.ProposalID, vote.Delegator), value)
+}
+
+func (k Keeper) deleteDelegatedVote(ctx sdk.Context, vote types.DelegatedVote) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.DelegatedVoteKey(vote.ProposalID, vote.Delegator))
+}
+
+// GetDelegateVoteRecord returns a delegate's voting record entry on a proposal
+func (k Keeper) GetDelegateVoteRecord(ctx sdk.Context, delegate string, proposalID uint64) (types.DelegateVoteRecord, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.DelegateVoteRecordKey(delegate, proposalID))
+	if bz == nil {
+		return types.DelegateVoteRecord{}, false
+	}
+
+	var record types.DelegateVoteRecord
+	if err := json.Unmarshal(bz, &record); err != nil {
+		return types.DelegateVoteRecord{}, false
+	}
+	return record, true
+}
+
+// GetDelegateVotingRecord returns a delegate's voting record, oldest proposal first
+func (k Keeper) GetDelegateVotingRecord(ctx sdk.Context, delegate string) []types.DelegateVoteRecord {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DelegateVoteRecordsKey(delegate))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var records []types.DelegateVoteRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.DelegateVoteRecord
+		if err := json.Unmarshal(iterator.Value(), &record); err != nil {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func (k Keeper) setDelegateVoteRecord(ctx sdk.Context, delegate string, record types.DelegateVoteRecord) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	value, _ := json.Marshal(record)
+	store.Set(types.DelegateVoteRecordKey(delegate, record.ProposalID), value)
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sharehodl/sharehodl-blockchain/x/governance/types"
+)
+
+// Migrator runs the governance module's store migrations
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 moves vote delegations into liquid delegations
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.migrateVoteDelegations(ctx)
+}
+
+// migrateVoteDelegations replaces every stored vote delegation with a liquid
+// delegation in the same company and proposal type scope, which is what
+// delegated votes are counted from. Delegations that have expired, that an
+// AGM proxy card wrote, that a liquid delegation in the same scope already
+// overrides, or that would close a loop or pass through too many delegates are
+// dropped. The old records and their indexes are deleted either way.
+func (k Keeper) migrateVoteDelegations(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	// Proxy cards lapse with their meeting and never stood for a delegation
+	proxies := make(map[string]bool)
+	cardIter := prefix.NewStore(store, types.ProxyCardPrefix).Iterator(nil, nil)
+	for ; cardIter.Valid(); cardIter.Next() {
+		var card types.ProxyCard
+		if err := json.Unmarshal(cardIter.Value(), &card); err != nil {
+			continue
+		}
+		proxies[fmt.Sprintf("%s/%d/%s", card.Shareholder, card.CompanyID, card.Proxy)] = true
+	}
+	cardIter.Close()
+
+	var delegations []types.VoteDelegation
+	for _, keyPrefix := range [][]byte{
+		types.DelegationPrefix,
+		types.DelegateIndexPrefix,
+		types.DelegatorIndexPrefix,
+		types.CompanyDelegationPrefix,
+	} {
+		legacy := prefix.NewStore(store, keyPrefix)
+		var keys [][]byte
+		iter := legacy.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+			if !bytes.Equal(keyPrefix, types.DelegationPrefix) {
+				continue
+			}
+			var delegation types.VoteDelegation
+			if err := json.Unmarshal(iter.Value(), &delegation); err != nil {
+				continue
+			}
+			delegations = append(delegations, delegation)
+		}
+		iter.Close()
+		for _, key := range keys {
+			legacy.Delete(key)
+		}
+	}
+
+	migrated := 0
+	for _, delegation := range delegations {
+		if delegation.ExpiryHeight != 0 && uint64(ctx.BlockHeight()) > delegation.ExpiryHeight {
+			continue
+		}
+		if delegation.ProposalType == types.ProposalTypeCompanyGovernance &&
+			proxies[fmt.Sprintf("%s/%d/%s", delegation.Delegator, delegation.CompanyID, delegation.Delegate)] {
+			continue
+		}
+		if _, found := k.GetLiquidDelegation(ctx, delegation.Delegator, delegation.CompanyID, delegation.ProposalType, false); found {
+			continue
+		}
+
+		liquid := types.LiquidDelegation{
+			Delegator:    delegation.Delegator,
+			Delegate:     delegation.Delegate,
+			CompanyID:    delegation.CompanyID,
+			ProposalType: delegation.ProposalType,
+			ExpiryHeight: delegation.ExpiryHeight,
+			CreatedAt:    delegation.CreatedAt,
+		}
+		if err := k.checkDelegationChain(ctx, liquid); err != nil {
+			ctx.Logger().Info("Dropped vote delegation during migration",
+				"delegator", delegation.Delegator,
+				"delegate", delegation.Delegate,
+				"company_id", delegation.CompanyID,
+				"error", err,
+			)
+			continue
+		}
+		k.setLiquidDelegation(ctx, liquid)
+		migrated++
+	}
+
+	ctx.Logger().Info("Migrated vote delegations to liquid delegations",
+		"found", len(delegations),
+		"migrated", migrated,
+	)
+	return nil
+}
@@ -34,6 +34,11 @@ func (ms msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitPr
 		initialDeposit = math.ZeroInt()
 	}
 
+	// Validate proposal messages before charging the proposal fee
+	if err := ms.Keeper.ValidateProposalMessages(ctx, msg.Type, msg.Messages); err != nil {
+		return nil, err
+	}
+
 	// Submit the proposal
 	proposalID, err := ms.Keeper.SubmitProposal(
 		ctx,
@@ -51,6 +56,13 @@ func (ms msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitPr
 		return nil, err
 	}
 
+	// Attach messages executed on passage
+	if len(msg.Messages) > 0 {
+		if err := ms.Keeper.SetProposalMessages(ctx, proposalID, msg.Messages); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgSubmitProposalResponse{
 		ProposalID: proposalID,
 	}, nil
@@ -155,6 +167,281 @@ func (ms msgServer) SetGovernanceParams(goCtx context.Context, msg *types.MsgSet
 	return &types.MsgSetGovernanceParamsResponse{}, nil
 }
 
+// SubmitCompanyGovernanceProposal handles shareholder proposals for a company,
+// including board elections
+func (ms msgServer) SubmitCompanyGovernanceProposal(goCtx context.Context, msg *types.MsgSubmitCompanyGovernanceProposal) (*types.MsgSubmitCompanyGovernanceProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	var proposalID uint64
+	var err error
+	if msg.Type == types.CompanyProposalTypeBoardElection {
+		proposalID, err = ms.Keeper.SubmitBoardElection(ctx, msg.Proposer, msg.CompanyID, msg.Title, msg.Description, msg.Seats, msg.Nominees)
+	} else {
+		proposalID, err = ms.Keeper.SubmitCompanyProposal(ctx, msg.Proposer, msg.CompanyID, msg.Type, msg.Title, msg.Description,
+			nil, 0, math.LegacyZeroDec(), math.LegacyZeroDec())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitCompanyGovernanceProposalResponse{
+		ProposalID: proposalID,
+	}, nil
+}
+
+// SignBoardResolution handles a director signing the board resolution on a company proposal
+func (ms msgServer) SignBoardResolution(goCtx context.Context, msg *types.MsgSignBoardResolution) (*types.MsgSignBoardResolutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	approved, err := ms.Keeper.SignBoardResolution(ctx, msg.Director, msg.ProposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSignBoardResolutionResponse{
+		Approved: approved,
+	}, nil
+}
+
+// CastBoardBallot handles a shareholder's ballot in a board election
+func (ms msgServer) CastBoardBallot(goCtx context.Context, msg *types.MsgCastBoardBallot) (*types.MsgCastBoardBallotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.Keeper.CastBoardBallot(ctx, msg.Voter, msg.ProposalID, msg.Votes); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCastBoardBallotResponse{}, nil
+}
+
+// ScheduleMeeting handles giving notice of a shareholder meeting
+func (ms msgServer) ScheduleMeeting(goCtx context.Context, msg *types.MsgScheduleMeeting) (*types.MsgScheduleMeetingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	meetingID, err := ms.Keeper.ScheduleMeeting(ctx, msg.Convener, msg.CompanyID, msg.Title,
+		msg.RecordDate, msg.OpensAt, msg.ClosesAt, msg.Quorum, msg.Agenda)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleMeetingResponse{
+		MeetingID: meetingID,
+	}, nil
+}
+
+// AppointProxy handles a shareholder appointing a proxy for a meeting
+func (ms msgServer) AppointProxy(goCtx context.Context, msg *types.MsgAppointProxy) (*types.MsgAppointProxyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.AppointProxy(ctx, msg.Shareholder, msg.MeetingID, msg.Proxy, msg.Directions); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAppointProxyResponse{}, nil
+}
+
+// RevokeProxy handles a shareholder withdrawing a proxy appointment
+func (ms msgServer) RevokeProxy(goCtx context.Context, msg *types.MsgRevokeProxy) (*types.MsgRevokeProxyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.RevokeProxy(ctx, msg.Shareholder, msg.MeetingID); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeProxyResponse{}, nil
+}
+
+// CastMeetingBallot handles a ballot on every agenda item of a meeting
+func (ms msgServer) CastMeetingBallot(goCtx context.Context, msg *types.MsgCastMeetingBallot) (*types.MsgCastMeetingBallotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.CastMeetingBallot(ctx, msg.Voter, msg.MeetingID, msg.Votes); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCastMeetingBallotResponse{}, nil
+}
+
+// RecordMeetingMinutes handles recording the content hash of a meeting's minutes
+func (ms msgServer) RecordMeetingMinutes(goCtx context.Context, msg *types.MsgRecordMeetingMinutes) (*types.MsgRecordMeetingMinutesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.RecordMeetingMinutes(ctx, msg.Recorder, msg.MeetingID, msg.MinutesHash); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRecordMeetingMinutesResponse{}, nil
+}
+
+// SetGuardianCouncil handles installing an elected guardian council (authority only)
+func (ms msgServer) SetGuardianCouncil(goCtx context.Context, msg *types.MsgSetGuardianCouncil) (*types.MsgSetGuardianCouncilResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Verify authority
+	if msg.Authority != ms.Keeper.authority {
+		return nil, types.ErrInvalidAuthority
+	}
+
+	if err := ms.Keeper.SetGuardianCouncil(ctx, msg.Members, msg.VetoThreshold); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetGuardianCouncilResponse{}, nil
+}
+
+// GuardianVeto handles a guardian signing a veto of a queued execution
+func (ms msgServer) GuardianVeto(goCtx context.Context, msg *types.MsgGuardianVeto) (*types.MsgGuardianVetoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	vetoed, err := ms.Keeper.GuardianVeto(ctx, msg.Guardian, msg.ProposalID, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGuardianVetoResponse{Vetoed: vetoed}, nil
+}
+
+// ProposeExecutionVeto handles opening an emergency vote to veto a queued execution
+func (ms msgServer) ProposeExecutionVeto(goCtx context.Context, msg *types.MsgProposeExecutionVeto) (*types.MsgProposeExecutionVetoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	vetoID, err := ms.Keeper.ProposeExecutionVeto(ctx, msg.Proposer, msg.ProposalID, msg.Reason, msg.Deposit.AmountOf("uhodl"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeExecutionVetoResponse{VetoProposalID: vetoID}, nil
+}
+
+// RegisterDelegate handles registering or updating a delegate profile
+func (ms msgServer) RegisterDelegate(goCtx context.Context, msg *types.MsgRegisterDelegate) (*types.MsgRegisterDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.RegisterDelegate(ctx, msg.Delegate, msg.Name, msg.Statement, msg.Topics, msg.CompanyIDs); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterDelegateResponse{}, nil
+}
+
+// DelegateVotes handles a holder following a delegate within a scope
+func (ms msgServer) DelegateVotes(goCtx context.Context, msg *types.MsgDelegateVotes) (*types.MsgDelegateVotesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.DelegateVotes(ctx, msg.Delegator, msg.Delegate, msg.CompanyID, msg.ProposalType, msg.AllProposalTypes, msg.ExpiryHeight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelegateVotesResponse{}, nil
+}
+
+// UndelegateVotes handles a holder no longer following a delegate within a scope
+func (ms msgServer) UndelegateVotes(goCtx context.Context, msg *types.MsgUndelegateVotes) (*types.MsgUndelegateVotesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.UndelegateVotes(ctx, msg.Delegator, msg.CompanyID, msg.ProposalType, msg.AllProposalTypes); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUndelegateVotesResponse{}, nil
+}
+
+// SubmitOptimisticProposal handles submitting an allowlisted parameter change on the optimistic track
+func (ms msgServer) SubmitOptimisticProposal(goCtx context.Context, msg *types.MsgSubmitOptimisticProposal) (*types.MsgSubmitOptimisticProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	proposalID, err := ms.Keeper.SubmitOptimisticProposal(ctx, msg.Proposer, msg.Title, msg.Description, msg.Messages, msg.Deposit.AmountOf("uhodl"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitOptimisticProposalResponse{
+		ProposalID: proposalID,
+	}, nil
+}
+
+// ObjectOptimisticProposal handles a holder objecting to an optimistic proposal
+func (ms msgServer) ObjectOptimisticProposal(goCtx context.Context, msg *types.MsgObjectOptimisticProposal) (*types.MsgObjectOptimisticProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	escalated, err := ms.Keeper.ObjectOptimisticProposal(ctx, msg.Objector, msg.ProposalID, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgObjectOptimisticProposalResponse{
+		Escalated: escalated,
+	}, nil
+}
+
+// SubmitSignedVotes handles a relayer submitting a batch of off-chain signed votes
+func (ms msgServer) SubmitSignedVotes(goCtx context.Context, msg *types.MsgSubmitSignedVotes) (*types.MsgSubmitSignedVotesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	results, accepted := ms.Keeper.SubmitSignedVotes(ctx, msg.Relayer, msg.Votes, msg.Ballots)
+
+	return &types.MsgSubmitSignedVotesResponse{
+		Accepted: accepted,
+		Results:  results,
+	}, nil
+}
+
 // Helper function to create company-specific proposal
 func (ms msgServer) SubmitCompanyProposal(
 	ctx sdk.Context,
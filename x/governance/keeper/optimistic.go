@@ -0,0 +1,280 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sharehodl/sharehodl-blockchain/x/governance/types"
+)
+
+// =============================================================================
+// OPTIMISTIC GOVERNANCE
+// =============================================================================
+// Parameter changes whose every message is on the governance-defined allowlist
+// may skip the deposit and voting periods. The proposal opens a challenge
+// window instead and passes when it closes, without a vote or timelock, unless
+// objecting stake reaches the optimistic veto threshold first. Reaching it
+// escalates the proposal to a normal vote, timelock included.
+
+// SubmitOptimisticProposal submits a parameter change on the optimistic track
+func (k Keeper) SubmitOptimisticProposal(
+	ctx sdk.Context,
+	proposer string,
+	title string,
+	description string,
+	msgs []types.ProposalMessage,
+	deposit math.Int,
+) (uint64, error) {
+	params := k.GetExtendedParams(ctx)
+	if len(msgs) == 0 {
+		return 0, types.ErrInvalidProposalMsg.Wrap("optimistic proposals must execute at least one message")
+	}
+	for i, msg := range msgs {
+		if !params.IsOptimisticAllowed(msg.TypeURL) {
+			return 0, types.ErrNotOptimisticEligible.Wrapf("message %d: %s", i, msg.TypeURL)
+		}
+	}
+	if err := k.ValidateProposalMessages(ctx, types.ProposalTypeParameterChange, msgs); err != nil {
+		return 0, err
+	}
+
+	// The deposit is the bond against spam that the voting period would
+	// otherwise give; it is refunded when the proposal is resolved
+	if minDeposit := k.GetMinDeposit(ctx); deposit.LT(minDeposit) {
+		return 0, types.ErrInsufficientDeposit.Wrapf("optimistic proposals need the full deposit of %s uhodl up front", minDeposit)
+	}
+
+	proposalID, err := k.SubmitProposal(
+		ctx,
+		proposer,
+		types.ProposalTypeParameterChange,
+		title,
+		description,
+		deposit,
+		uint32(k.GetVotingPeriodDays(ctx)),
+		k.GetQuorum(ctx),
+		k.GetThreshold(ctx),
+	)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.SetProposalMessages(ctx, proposalID, msgs); err != nil {
+		return 0, err
+	}
+
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return 0, types.ErrProposalNotFound
+	}
+
+	// The full deposit opened a voting period; the challenge window replaces it
+	k.clearProposalQueues(ctx, proposal)
+	proposal.Status = types.ProposalStatusChallengePeriod
+	proposal.Optimistic = true
+	proposal.UpdatedAt = ctx.BlockTime()
+	k.setProposal(ctx, proposal)
+
+	optimistic := types.OptimisticProposal{
+		ProposalID:       proposalID,
+		Title:            title,
+		SubmittedAt:      ctx.BlockTime(),
+		ChallengeEndTime: ctx.BlockTime().Add(params.GetOptimisticChallengePeriod()),
+		ObjectedPower:    math.ZeroInt(),
+	}
+	k.setOptimisticProposal(ctx, optimistic)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"optimistic_proposal_submitted",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute("proposer", proposer),
+			sdk.NewAttribute("challenge_end_time", optimistic.ChallengeEndTime.String()),
+		),
+	)
+
+	return proposalID, nil
+}
+
+// ObjectOptimisticProposal records a holder's stake objecting to an optimistic
+// proposal. Returns true when the objection escalates it to a full vote.
+func (k Keeper) ObjectOptimisticProposal(
+	ctx sdk.Context,
+	objector string,
+	proposalID uint64,
+	reason string,
+) (bool, error) {
+	objectorAddr, err := sdk.AccAddressFromBech32(objector)
+	if err != nil {
+		return false, types.ErrInvalidAddress
+	}
+
+	optimistic, found := k.GetOptimisticProposal(ctx, proposalID)
+	if !found || !optimistic.IsChallengeOpen(ctx.BlockTime()) {
+		return false, types.ErrNotInChallengePeriod
+	}
+	if optimistic.HasObjected(objector) {
+		return false, types.ErrAlreadyObjected
+	}
+
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return false, types.ErrProposalNotFound
+	}
+	if proposal.Status != types.ProposalStatusChallengePeriod {
+		return false, types.ErrNotInChallengePeriod
+	}
+
+	// Objectors must be able to vote on the proposal, at the same power
+	if err := k.checkVoterEligibility(ctx, proposal, objectorAddr); err != nil {
+		return false, err
+	}
+	power, err := k.calculateVotingPower(ctx, proposal, objectorAddr)
+	if err != nil {
+		return false, err
+	}
+	if !power.TruncateInt().IsPositive() {
+		return false, types.ErrInsufficientVotingPower
+	}
+
+	optimistic.Objections = append(optimistic.Objections, types.Objection{
+		Objector:    objector,
+		VotingPower: power.TruncateInt(),
+		Reason:      reason,
+		ObjectedAt:  ctx.BlockTime(),
+	})
+	optimistic.ObjectedPower = optimistic.ObjectedPower.Add(power.TruncateInt())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"optimistic_objection",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute("objector", objector),
+			sdk.NewAttribute("voting_power", power.TruncateInt().String()),
+			sdk.NewAttribute("objected_power", optimistic.ObjectedPower.String()),
+		),
+	)
+
+	eligible := k.calculateTotalEligibleVotingPower(ctx, proposal)
+	vetoPower := eligible.Mul(k.GetExtendedParams(ctx).GetOptimisticVetoThreshold())
+	if eligible.IsPositive() && math.LegacyNewDecFromInt(optimistic.ObjectedPower).GTE(vetoPower) {
+		k.escalateOptimisticProposal(ctx, proposal, optimistic)
+		return true, nil
+	}
+
+	k.setOptimisticProposal(ctx, optimistic)
+	return false, nil
+}
+
+// escalateOptimisticProposal sends a challenged proposal to a normal voting
+// period; from there it is tallied, timelocked and executed like any other
+func (k Keeper) escalateOptimisticProposal(ctx sdk.Context, proposal types.Proposal, optimistic types.OptimisticProposal) {
+	k.deleteOptimisticProposal(ctx, proposal.ID)
+
+	proposal.Status = types.ProposalStatusVotingPeriod
+	proposal.VotingStartTime = ctx.BlockTime()
+	proposal.VotingEndTime = ctx.BlockTime().AddDate(0, 0, int(k.GetVotingPeriodDays(ctx)))
+	proposal.UpdatedAt = ctx.BlockTime()
+	k.setProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"optimistic_proposal_escalated",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute("objections", fmt.Sprintf("%d", len(optimistic.Objections))),
+			sdk.NewAttribute("objected_power", optimistic.ObjectedPower.String()),
+			sdk.NewAttribute("voting_end_time", proposal.VotingEndTime.String()),
+		),
+	)
+}
+
+// ProcessOptimisticProposals passes optimistic proposals whose challenge window
+// closed without escalation. Called from EndBlock.
+func (k Keeper) ProcessOptimisticProposals(ctx sdk.Context) {
+	for _, optimistic := range k.GetOptimisticProposals(ctx) {
+		if optimistic.IsChallengeOpen(ctx.BlockTime()) {
+			continue
+		}
+		k.deleteOptimisticProposal(ctx, optimistic.ProposalID)
+
+		proposal, found := k.GetProposal(ctx, optimistic.ProposalID)
+		if !found || proposal.Status != types.ProposalStatusChallengePeriod {
+			continue
+		}
+
+		proposal.Status = types.ProposalStatusPassed
+		k.applyExecutionResult(ctx, &proposal, k.executeProposal(ctx, proposal), "Optimistic proposal executed successfully")
+		proposal.FinalizedAt = ctx.BlockTime()
+		proposal.UpdatedAt = ctx.BlockTime()
+		k.setProposal(ctx, proposal)
+
+		k.refundProposalDeposits(ctx, proposal.ID)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"optimistic_proposal_passed",
+				sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposal.ID)),
+				sdk.NewAttribute("status", proposal.Status.String()),
+				sdk.NewAttribute("objections", fmt.Sprintf("%d", len(optimistic.Objections))),
+				sdk.NewAttribute("objected_power", optimistic.ObjectedPower.String()),
+			),
+		)
+	}
+}
+
+// clearProposalQueues removes a proposal from the deposit and voting end-time queues
+func (k Keeper) clearProposalQueues(ctx sdk.Context, proposal types.Proposal) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.DepositEndTimeKey(proposal.DepositEndTime.Unix(), proposal.ID))
+	store.Delete(types.VotingEndTimeKey(proposal.VotingEndTime.Unix(), proposal.ID))
+}
+
+// =============================================================================
+// OPTIMISTIC PROPOSAL STORAGE
+// =============================================================================
+
+// GetOptimisticProposal returns an optimistic proposal in its challenge window
+func (k Keeper) GetOptimisticProposal(ctx sdk.Context, proposalID uint64) (types.OptimisticProposal, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.OptimisticProposalKey(proposalID))
+	if bz == nil {
+		return types.OptimisticProposal{}, false
+	}
+
+	var optimistic types.OptimisticProposal
+	if err := json.Unmarshal(bz, &optimistic); err != nil {
+		return types.OptimisticProposal{}, false
+	}
+	return optimistic, true
+}
+
+// GetOptimisticProposals returns every optimistic proposal in its challenge window, in proposal order
+func (k Keeper) GetOptimisticProposals(ctx sdk.Context) []types.OptimisticProposal {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.OptimisticProposalPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var proposals []types.OptimisticProposal
+	for ; iterator.Valid(); iterator.Next() {
+		var optimistic types.OptimisticProposal
+		if err := json.Unmarshal(iterator.Value(), &optimistic); err != nil {
+			continue
+		}
+		proposals = append(proposals, optimistic)
+	}
+	return proposals
+}
+
+func (k Keeper) setOptimisticProposal(ctx sdk.Context, optimistic types.OptimisticProposal) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	value, _ := json.Marshal(optimistic)
+	store.Set(types.OptimisticProposalKey(optimistic.ProposalID), value)
+}
+
+func (k Keeper) deleteOptimisticProposal(ctx sdk.Context, proposalID uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.OptimisticProposalKey(proposalID))
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/governance/types"
+)
+
+// =============================================================================
+// PROPOSAL MESSAGE EXECUTION
+// Passed proposals execute Any-packed messages through the app's message router
+// with the governance authority as signer, so any module's authority-gated message
+// (parameter updates, software upgrades, treasury sends) is reachable the same way
+// =============================================================================
+
+// ValidateProposalMessages checks that every message unpacks, passes basic validation,
+// is signed by the governance authority alone and has a route in the message router
+func (k Keeper) ValidateProposalMessages(ctx sdk.Context, proposalType types.ProposalType, msgs []types.ProposalMessage) error {
+	if err := types.ValidateProposalMessagesBasic(proposalType, msgs); err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	if k.router == nil {
+		return types.ErrUnroutableProposalMsg.Wrap("message router not set")
+	}
+
+	authority, err := sdk.AccAddressFromBech32(k.authority)
+	if err != nil {
+		return types.ErrInvalidAddress.Wrapf("governance authority: %v", err)
+	}
+
+	for i, packed := range msgs {
+		msg, err := k.unpackProposalMessage(packed)
+		if err != nil {
+			return types.ErrInvalidProposalMsg.Wrapf("message %d: %v", i, err)
+		}
+
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return types.ErrInvalidProposalMsg.Wrapf("message %d: %v", i, err)
+			}
+		}
+
+		signers, _, err := k.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return types.ErrInvalidProposalSigner.Wrapf("message %d: %v", i, err)
+		}
+		if len(signers) != 1 || !bytes.Equal(signers[0], authority) {
+			return types.ErrInvalidProposalSigner.Wrapf("message %d (%s)", i, packed.TypeURL)
+		}
+
+		if k.router.Handler(msg) == nil {
+			return types.ErrUnroutableProposalMsg.Wrapf("message %d: %s", i, packed.TypeURL)
+		}
+	}
+
+	return nil
+}
+
+// SetProposalMessages attaches validated messages to a proposal
+func (k Keeper) SetProposalMessages(ctx sdk.Context, proposalID uint64, msgs []types.ProposalMessage) error {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return types.ErrProposalNotFound
+	}
+
+	proposal.Messages = msgs
+	proposal.UpdatedAt = ctx.BlockTime()
+	k.setProposal(ctx, proposal)
+	return nil
+}
+
+// executeProposalMessages runs a passed proposal's messages in order. Either every
+// message succeeds and all state changes are written, or none are. Each message's
+// result, up to the first failure, is stored in the proposal's ExecutionRecord.
+func (k Keeper) executeProposalMessages(ctx sdk.Context, proposal types.Proposal) error {
+	if len(proposal.Messages) == 0 {
+		return nil
+	}
+	if k.router == nil {
+		return types.ErrUnroutableProposalMsg.Wrap("message router not set")
+	}
+
+	results := make([]MessageExecutionResult, 0, len(proposal.Messages))
+	var events sdk.Events
+	var execErr error
+
+	cacheCtx, write := ctx.CacheContext()
+	for i, packed := range proposal.Messages {
+		result := MessageExecutionResult{Index: i, TypeURL: packed.TypeURL}
+
+		res, err := k.executeProposalMessage(cacheCtx, packed)
+		if err != nil {
+			result.Error = err.Error()
+			results = append(results, result)
+			execErr = types.ErrProposalMsgFailed.Wrapf("message %d (%s): %v", i, packed.TypeURL, err)
+			break
+		}
+
+		result.Success = true
+		result.Data = res.Data
+		result.Log = res.Log
+		results = append(results, result)
+		events = append(events, res.GetEvents()...)
+	}
+
+	if execErr == nil {
+		write()
+		ctx.EventManager().EmitEvents(events)
+	}
+
+	record := ExecutionRecord{
+		ProposalID:      proposal.ID,
+		ExecutedAt:      ctx.BlockTime().Unix(),
+		Success:         execErr == nil,
+		ExecutionHeight: ctx.BlockHeight(),
+		Messages:        results,
+	}
+	if execErr != nil {
+		record.Error = execErr.Error()
+	}
+	k.setExecutionRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"proposal_messages_executed",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute("messages", fmt.Sprintf("%d", len(proposal.Messages))),
+			sdk.NewAttribute("executed", fmt.Sprintf("%d", len(results))),
+			sdk.NewAttribute("success", fmt.Sprintf("%t", execErr == nil)),
+		),
+	)
+
+	return execErr
+}
+
+// executeProposalMessage routes one message, converting handler panics into errors
+func (k Keeper) executeProposalMessage(ctx sdk.Context, packed types.ProposalMessage) (res *sdk.Result, err error) {
+	msg, err := k.unpackProposalMessage(packed)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return nil, types.ErrUnroutableProposalMsg.Wrap(packed.TypeURL)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("message handler panicked: %v", r)
+		}
+	}()
+
+	return handler(ctx, msg)
+}
+
+// unpackProposalMessage resolves a packed message through the interface registry
+func (k Keeper) unpackProposalMessage(packed types.ProposalMessage) (sdk.Msg, error) {
+	var msg sdk.Msg
+	if err := k.cdc.UnpackAny(packed.ToAny(), &msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// Router returns the message router used for proposal execution
+func (k Keeper) Router() baseapp.MessageRouter {
+	return k.router
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sharehodl/sharehodl-blockchain/x/governance/types"
+)
+
+// =============================================================================
+// SIGNED VOTE BATCHES
+// =============================================================================
+// Holders sign votes on company proposals and shareholder meeting ballots
+// off-chain, and any relayer submits them in batches so holders pay no gas.
+// Each vote is bound to the snapshot height it counts at, so a relayer cannot
+// hold a vote back until the voter's holdings change. Signed votes are stored
+// as direct votes: a holder who already voted, directly or by signature,
+// cannot be counted again, and a signed vote blocks a later direct vote.
+
+// SubmitSignedVotes counts a relayer's batch of signed votes and ballots.
+// Votes that fail, such as holders who already voted, are reported in the
+// results without failing the batch.
+func (k Keeper) SubmitSignedVotes(
+	ctx sdk.Context,
+	relayer string,
+	votes []types.SignedVote,
+	ballots []types.SignedMeetingBallot,
+) ([]types.SignedVoteResult, uint32) {
+	results := make([]types.SignedVoteResult, 0, len(votes)+len(ballots))
+	var accepted uint32
+
+	// Each vote is counted in its own cache so a failure leaves no partial state
+	apply := func(result types.SignedVoteResult, cast func(sdk.Context) error) {
+		ctx.GasMeter().ConsumeGas(types.SignedVoteVerifyGas, "signed vote signature")
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := cast(cacheCtx); err != nil {
+			result.Error = err.Error()
+		} else {
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			result.Accepted = true
+			accepted++
+		}
+		results = append(results, result)
+	}
+
+	for _, vote := range votes {
+		vote := vote
+		apply(types.SignedVoteResult{Voter: vote.Voter, ProposalID: vote.ProposalID}, func(cacheCtx sdk.Context) error {
+			return k.castSignedVote(cacheCtx, relayer, vote)
+		})
+	}
+	for _, ballot := range ballots {
+		ballot := ballot
+		apply(types.SignedVoteResult{Voter: ballot.Voter, MeetingID: ballot.MeetingID}, func(cacheCtx sdk.Context) error {
+			return k.castSignedMeetingBallot(cacheCtx, relayer, ballot)
+		})
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"signed_votes_submitted",
+			sdk.NewAttribute("relayer", relayer),
+			sdk.NewAttribute("accepted", fmt.Sprintf("%d", accepted)),
+			sdk.NewAttribute("rejected", fmt.Sprintf("%d", uint32(len(results))-accepted)),
+		),
+	)
+
+	return results, accepted
+}
+
+// castSignedVote counts a signed vote on a company proposal at the voter's
+// snapshot power
+func (k Keeper) castSignedVote(ctx sdk.Context, relayer string, vote types.SignedVote) error {
+	if err := vote.ValidateBasic(); err != nil {
+		return types.ErrInvalidVote.Wrap(err.Error())
+	}
+	if err := vote.VerifySignature(ctx.ChainID()); err != nil {
+		return err
+	}
+	voterAddr, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		return types.ErrInvalidAddress
+	}
+
+	proposal, found := k.GetProposal(ctx, vote.ProposalID)
+	if !found {
+		return types.ErrProposalNotFound
+	}
+	companyProposal, found := k.GetCompanyProposal(ctx, vote.ProposalID)
+	if !found {
+		return types.ErrCompanyProposalNotFound
+	}
+	if companyProposal.MeetingID != 0 {
+		return types.ErrMeetingAgendaItem.Wrapf("proposal %d is on the agenda of meeting %d", vote.ProposalID, companyProposal.MeetingID)
+	}
+
+	// Eligibility is the snapshot: holders who sold since still vote the
+	// power they held, and buyers since have none
+	if proposal.Status != types.ProposalStatusVotingPeriod {
+		return types.ErrProposalNotActive
+	}
+	if ctx.BlockTime().Before(proposal.VotingStartTime) || ctx.BlockTime().After(proposal.VotingEndTime) {
+		return types.ErrVotingPeriodEnded
+	}
+	if k.hasVoted(ctx, vote.ProposalID, voterAddr) {
+		return types.ErrAlreadyVoted
+	}
+
+	snapshot, found := k.GetVoteSnapshot(ctx, vote.ProposalID)
+	if !found {
+		return types.ErrNoVoteSnapshot
+	}
+	if snapshot.SnapshotHeight != vote.SnapshotHeight {
+		return types.ErrSnapshotMismatch.Wrapf("signed %d, snapshot %d", vote.SnapshotHeight, snapshot.SnapshotHeight)
+	}
+
+	votingPower, err := k.GetSnapshotVotingPower(ctx, vote.ProposalID, voterAddr)
+	if err != nil {
+		return err
+	}
+
+	delegatedPower, err := k.castCompanyVote(ctx, proposal, companyProposal, vote.Voter, vote.Option, vote.Reason, votingPower)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"signed_vote",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", vote.ProposalID)),
+			sdk.NewAttribute("company_id", fmt.Sprintf("%d", companyProposal.CompanyID)),
+			sdk.NewAttribute("voter", vote.Voter),
+			sdk.NewAttribute("relayer", relayer),
+			sdk.NewAttribute("option", vote.Option.String()),
+			sdk.NewAttribute("snapshot_height", fmt.Sprintf("%d", vote.SnapshotHeight)),
+			sdk.NewAttribute("voting_power", votingPower.String()),
+			sdk.NewAttribute("delegated_power", delegatedPower.String()),
+		),
+	)
+
+	return nil
+}
+
+// castSignedMeetingBallot casts a signed ballot as if the voter attended the meeting
+func (k Keeper) castSignedMeetingBallot(ctx sdk.Context, relayer string, ballot types.SignedMeetingBallot) error {
+	if err := ballot.ValidateBasic(); err != nil {
+		return types.ErrInvalidMeetingBallot.Wrap(err.Error())
+	}
+	if err := ballot.VerifySignature(ctx.ChainID()); err != nil {
+		return err
+	}
+
+	meeting, found := k.GetShareholderMeeting(ctx, ballot.MeetingID)
+	if !found {
+		return types.ErrMeetingNotFound
+	}
+	if !meeting.IsRecordSet() {
+		return types.ErrNoVoteSnapshot.Wrap("meeting record date has not passed")
+	}
+	if meeting.RecordHeight != ballot.RecordHeight {
+		return types.ErrSnapshotMismatch.Wrapf("signed %d, record height %d", ballot.RecordHeight, meeting.RecordHeight)
+	}
+
+	if err := k.CastMeetingBallot(ctx, ballot.Voter, ballot.MeetingID, ballot.Votes); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"signed_meeting_ballot",
+			sdk.NewAttribute("meeting_id", fmt.Sprintf("%d", ballot.MeetingID)),
+			sdk.NewAttribute("company_id", fmt.Sprintf("%d", meeting.CompanyID)),
+			sdk.NewAttribute("voter", ballot.Voter),
+			sdk.NewAttribute("relayer", relayer),
+			sdk.NewAttribute("record_height", fmt.Sprintf("%d", ballot.RecordHeight)),
+		),
+	)
+
+	return nil
+}
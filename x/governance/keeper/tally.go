@@ -151,12 +151,17 @@ func (k Keeper) ProcessProposalResults(ctx sdk.Context, proposalID uint64) error
 	// Update proposal status based on outcome
 	switch outcome {
 	case types.OutcomePassed:
+		// Protocol proposals wait out their timelock so users can exit first
+		if delay := k.GetExtendedParams(ctx).GetTimelockDelay(proposal.Type); delay > 0 {
+			proposal.Status = types.ProposalStatusQueued
+			k.queueExecution(ctx, proposal, delay)
+			k.refundProposalDeposits(ctx, proposalID)
+			break
+		}
+
 		proposal.Status = types.ProposalStatusPassed
 		// Execute the proposal
-		err := k.executeProposal(ctx, proposal)
-		if err != nil {
-			proposal.Status = types.ProposalStatusFailed
-		}
+		k.applyExecutionResult(ctx, &proposal, k.executeProposal(ctx, proposal), "Proposal executed successfully")
 
 		// Refund deposits on successful proposals
 		k.refundProposalDeposits(ctx, proposalID)
@@ -179,6 +184,9 @@ func (k Keeper) ProcessProposalResults(ctx sdk.Context, proposalID uint64) error
 	proposal.UpdatedAt = ctx.BlockTime()
 	k.setProposal(ctx, proposal)
 
+	// Conviction voters may unstake again now the outcome is decided
+	k.releaseConvictionLocks(ctx, proposal)
+
 	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -197,6 +205,24 @@ func (k Keeper) ProcessProposalResults(ctx sdk.Context, proposalID uint64) error
 	return nil
 }
 
+// applyExecutionResult records the outcome of running a passed proposal. Only a
+// proposal whose execution succeeded is marked executed; a failed one gets the
+// failed status and keeps the error as its execution result.
+func (k Keeper) applyExecutionResult(ctx sdk.Context, proposal *types.Proposal, err error, successResult string) {
+	if err != nil {
+		proposal.Status = types.ProposalStatusFailed
+		proposal.Executed = false
+		proposal.ExecutionResult = err.Error()
+		k.RecordExecution(ctx, proposal.ID, false, "", err)
+		return
+	}
+
+	proposal.Executed = true
+	proposal.ExecutionTime = ctx.BlockTime()
+	proposal.ExecutionResult = successResult
+	k.RecordExecution(ctx, proposal.ID, true, successResult, nil)
+}
+
 // executeProposal executes a passed proposal
 func (k Keeper) executeProposal(ctx sdk.Context, proposal types.Proposal) error {
 	switch proposal.Type {
@@ -206,17 +232,19 @@ func (k Keeper) executeProposal(ctx sdk.Context, proposal types.Proposal) error
 		return k.executeCompanyDelistingProposal(ctx, proposal)
 	case types.ProposalTypeCompanyParameter:
 		return k.executeCompanyParameterProposal(ctx, proposal)
+	case types.ProposalTypeCompanyGovernance:
+		return k.executeCompanyGovernanceProposal(ctx, proposal)
 	case types.ProposalTypeValidatorPromotion:
 		return k.executeValidatorPromotionProposal(ctx, proposal)
 	case types.ProposalTypeValidatorDemotion:
 		return k.executeValidatorDemotionProposal(ctx, proposal)
 	case types.ProposalTypeValidatorRemoval:
 		return k.executeValidatorRemovalProposal(ctx, proposal)
-	case types.ProposalTypeProtocolParameter:
+	case types.ProposalTypeProtocolParameter, types.ProposalTypeParameterChange:
 		return k.executeProtocolParameterProposal(ctx, proposal)
-	case types.ProposalTypeProtocolUpgrade:
+	case types.ProposalTypeProtocolUpgrade, types.ProposalTypeSoftwareUpgrade:
 		return k.executeProtocolUpgradeProposal(ctx, proposal)
-	case types.ProposalTypeTreasurySpend:
+	case types.ProposalTypeTreasurySpend, types.ProposalTypeCommunityPoolSpend:
 		return k.executeTreasurySpendProposal(ctx, proposal)
 	case types.ProposalTypeEmergencyAction:
 		return k.executeEmergencyActionProposal(ctx, proposal)
@@ -258,24 +286,30 @@ func (k Keeper) executeValidatorRemovalProposal(ctx sdk.Context, proposal types.
 	return nil
 }
 
+// executeProtocolParameterProposal executes the proposal's messages, e.g. a module's MsgUpdateParams
 func (k Keeper) executeProtocolParameterProposal(ctx sdk.Context, proposal types.Proposal) error {
-	// Implementation would parse proposal content and update protocol parameters
-	return nil
+	return k.executeProposalMessages(ctx, proposal)
 }
 
+// executeProtocolUpgradeProposal executes the proposal's messages, e.g. x/upgrade MsgSoftwareUpgrade
 func (k Keeper) executeProtocolUpgradeProposal(ctx sdk.Context, proposal types.Proposal) error {
-	// Implementation would handle software upgrade logic
-	return nil
+	return k.executeProposalMessages(ctx, proposal)
 }
 
+// executeTreasurySpendProposal executes the proposal's messages, e.g. a bank send from the governance account
 func (k Keeper) executeTreasurySpendProposal(ctx sdk.Context, proposal types.Proposal) error {
-	// Implementation would transfer funds from treasury
-	return nil
+	return k.executeProposalMessages(ctx, proposal)
 }
 
+// executeEmergencyActionProposal executes the proposal's messages; an emergency
+// veto vote vetoes the queued execution it was raised against
 func (k Keeper) executeEmergencyActionProposal(ctx sdk.Context, proposal types.Proposal) error {
-	// Implementation would execute emergency actions
-	return nil
+	if proposal.VetoesProposalID > 0 {
+		if err := k.vetoQueuedExecution(ctx, proposal.VetoesProposalID, fmt.Sprintf("emergency_vote_%d", proposal.ID)); err != nil {
+			return err
+		}
+	}
+	return k.executeProposalMessages(ctx, proposal)
 }
 
 // refundProposalDeposits refunds deposits to depositors
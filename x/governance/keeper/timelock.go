@@ -0,0 +1,327 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sharehodl/sharehodl-blockchain/x/governance/types"
+)
+
+// =============================================================================
+// EXECUTION TIMELOCK
+// =============================================================================
+// Passed protocol proposals are queued for a type-specific delay before they
+// execute, so users who oppose a change have time to exit first. While an item
+// is queued it can be vetoed by a majority of the elected guardian council, or
+// by an emergency vote held to the validator quorum and threshold. An open
+// emergency veto vote holds the execution until the vote is decided.
+
+// queueExecution places a passed proposal in the timelock queue
+func (k Keeper) queueExecution(ctx sdk.Context, proposal types.Proposal, delay time.Duration) types.QueuedExecution {
+	queued := types.QueuedExecution{
+		ProposalID:   proposal.ID,
+		ProposalType: proposal.Type,
+		Title:        proposal.Title,
+		QueuedAt:     ctx.BlockTime(),
+		ExecuteAt:    ctx.BlockTime().Add(delay),
+	}
+	k.setQueuedExecution(ctx, queued)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"proposal_queued",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute("proposal_type", proposal.Type.String()),
+			sdk.NewAttribute("execute_at", queued.ExecuteAt.String()),
+		),
+	)
+
+	return queued
+}
+
+// ProcessTimelockQueue executes queued proposals whose timelock has elapsed
+func (k Keeper) ProcessTimelockQueue(ctx sdk.Context) {
+	for _, queued := range k.GetPendingExecutions(ctx) {
+		if !queued.IsReady(ctx.BlockTime()) || k.isVetoVoteOpen(ctx, queued) {
+			continue
+		}
+		k.executeQueuedProposal(ctx, queued)
+	}
+}
+
+// executeQueuedProposal runs a proposal that has waited out its timelock
+func (k Keeper) executeQueuedProposal(ctx sdk.Context, queued types.QueuedExecution) {
+	k.deleteQueuedExecution(ctx, queued.ProposalID)
+
+	proposal, found := k.GetProposal(ctx, queued.ProposalID)
+	if !found || proposal.Status != types.ProposalStatusQueued {
+		return
+	}
+
+	proposal.Status = types.ProposalStatusPassed
+	k.applyExecutionResult(ctx, &proposal, k.executeProposal(ctx, proposal), "Proposal executed successfully")
+	proposal.UpdatedAt = ctx.BlockTime()
+	k.setProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"queued_proposal_executed",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute("status", proposal.Status.String()),
+		),
+	)
+}
+
+// isVetoVoteOpen reports whether an emergency veto vote against a queued
+// execution is still being voted on
+func (k Keeper) isVetoVoteOpen(ctx sdk.Context, queued types.QueuedExecution) bool {
+	if queued.VetoProposalID == 0 {
+		return false
+	}
+	vetoVote, found := k.GetProposal(ctx, queued.VetoProposalID)
+	return found && vetoVote.Status == types.ProposalStatusVotingPeriod
+}
+
+// =============================================================================
+// GUARDIAN COUNCIL
+// =============================================================================
+
+// SetGuardianCouncil installs a newly elected guardian council. Vetoes signed
+// by the outgoing council on queued executions do not carry over.
+func (k Keeper) SetGuardianCouncil(ctx sdk.Context, members []string, vetoThreshold uint32) error {
+	if err := types.ValidateGuardianCouncil(members, vetoThreshold); err != nil {
+		return types.ErrInvalidGuardianCouncil.Wrap(err.Error())
+	}
+
+	council := types.GuardianCouncil{
+		Members:       members,
+		VetoThreshold: vetoThreshold,
+		ElectedAt:     ctx.BlockTime(),
+	}
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz, err := json.Marshal(council)
+	if err != nil {
+		return err
+	}
+	store.Set(types.GuardianCouncilKey, bz)
+
+	for _, queued := range k.GetPendingExecutions(ctx) {
+		if len(queued.GuardianVetoes) > 0 {
+			queued.GuardianVetoes = nil
+			k.setQueuedExecution(ctx, queued)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"guardian_council_elected",
+			sdk.NewAttribute("members", fmt.Sprintf("%d", len(members))),
+			sdk.NewAttribute("veto_threshold", fmt.Sprintf("%d", vetoThreshold)),
+		),
+	)
+
+	return nil
+}
+
+// GetGuardianCouncil returns the sitting guardian council
+func (k Keeper) GetGuardianCouncil(ctx sdk.Context) (types.GuardianCouncil, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.GuardianCouncilKey)
+	if bz == nil {
+		return types.GuardianCouncil{}, false
+	}
+
+	var council types.GuardianCouncil
+	if err := json.Unmarshal(bz, &council); err != nil {
+		return types.GuardianCouncil{}, false
+	}
+	return council, true
+}
+
+// GuardianVeto records a guardian's signature on a veto of a queued execution.
+// The execution is vetoed once the council's veto threshold is reached.
+func (k Keeper) GuardianVeto(ctx sdk.Context, guardian string, proposalID uint64, reason string) (bool, error) {
+	council, found := k.GetGuardianCouncil(ctx)
+	if !found || !council.IsMember(guardian) {
+		return false, types.ErrNotGuardian
+	}
+
+	queued, found := k.GetQueuedExecution(ctx, proposalID)
+	if !found {
+		return false, types.ErrNotQueued
+	}
+	if queued.HasGuardianVeto(guardian) {
+		return false, types.ErrGuardianVetoCast
+	}
+
+	queued.GuardianVetoes = append(queued.GuardianVetoes, guardian)
+	k.setQueuedExecution(ctx, queued)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"guardian_veto_signed",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute("guardian", guardian),
+			sdk.NewAttribute("signatures", fmt.Sprintf("%d", len(queued.GuardianVetoes))),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+
+	if uint32(len(queued.GuardianVetoes)) < council.VetoThreshold {
+		return false, nil
+	}
+	if err := k.vetoQueuedExecution(ctx, proposalID, "guardian_council"); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// =============================================================================
+// EMERGENCY VETO VOTES
+// =============================================================================
+
+// ProposeExecutionVeto opens an emergency vote to veto a queued execution. The
+// full minimum deposit must be put up front so the vote opens at once; the
+// execution is held until the vote is decided.
+func (k Keeper) ProposeExecutionVeto(
+	ctx sdk.Context,
+	proposer string,
+	proposalID uint64,
+	reason string,
+	deposit math.Int,
+) (uint64, error) {
+	queued, found := k.GetQueuedExecution(ctx, proposalID)
+	if !found {
+		return 0, types.ErrNotQueued
+	}
+	if k.isVetoVoteOpen(ctx, queued) {
+		return 0, types.ErrVetoVotePending
+	}
+	if minDeposit := k.GetMinDeposit(ctx); deposit.LT(minDeposit) {
+		return 0, types.ErrInsufficientDeposit.Wrapf("veto votes need the full deposit of %s uhodl up front", minDeposit)
+	}
+
+	vetoID, err := k.SubmitProposal(
+		ctx,
+		proposer,
+		types.ProposalTypeEmergencyAction,
+		fmt.Sprintf("[VETO] %s", queued.Title),
+		reason,
+		deposit,
+		types.EmergencyVetoVotingPeriodDays,
+		k.GetValidatorQuorum(ctx),
+		k.GetValidatorThreshold(ctx),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	vetoVote, found := k.GetProposal(ctx, vetoID)
+	if !found {
+		return 0, types.ErrProposalNotFound
+	}
+	vetoVote.VetoesProposalID = proposalID
+	vetoVote.Status = types.ProposalStatusVotingPeriod
+	vetoVote.VotingStartTime = ctx.BlockTime()
+	vetoVote.VotingEndTime = ctx.BlockTime().AddDate(0, 0, int(types.EmergencyVetoVotingPeriodDays))
+	k.setProposal(ctx, vetoVote)
+
+	queued.VetoProposalID = vetoID
+	k.setQueuedExecution(ctx, queued)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"execution_veto_proposed",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute("veto_proposal_id", fmt.Sprintf("%d", vetoID)),
+			sdk.NewAttribute("proposer", proposer),
+		),
+	)
+
+	return vetoID, nil
+}
+
+// vetoQueuedExecution removes a queued execution so it never runs
+func (k Keeper) vetoQueuedExecution(ctx sdk.Context, proposalID uint64, vetoedBy string) error {
+	if _, found := k.GetQueuedExecution(ctx, proposalID); !found {
+		return types.ErrNotQueued
+	}
+	k.deleteQueuedExecution(ctx, proposalID)
+
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return types.ErrProposalNotFound
+	}
+	proposal.Status = types.ProposalStatusVetoed
+	proposal.UpdatedAt = ctx.BlockTime()
+	k.setProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"execution_vetoed",
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute("vetoed_by", vetoedBy),
+		),
+	)
+
+	return nil
+}
+
+// =============================================================================
+// QUEUE STORAGE
+// =============================================================================
+
+// GetQueuedExecution returns a passed proposal waiting out its timelock
+func (k Keeper) GetQueuedExecution(ctx sdk.Context, proposalID uint64) (types.QueuedExecution, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.ExecutionQueueKey(proposalID))
+	if bz == nil {
+		return types.QueuedExecution{}, false
+	}
+
+	var queued types.QueuedExecution
+	if err := json.Unmarshal(bz, &queued); err != nil {
+		return types.QueuedExecution{}, false
+	}
+	return queued, true
+}
+
+// GetPendingExecutions returns every queued execution, in proposal order
+func (k Keeper) GetPendingExecutions(ctx sdk.Context) []types.QueuedExecution {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	queueStore := prefix.NewStore(store, types.ExecutionQueuePrefix)
+
+	iterator := queueStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var pending []types.QueuedExecution
+	for ; iterator.Valid(); iterator.Next() {
+		var queued types.QueuedExecution
+		if err := json.Unmarshal(iterator.Value(), &queued); err != nil {
+			continue
+		}
+		pending = append(pending, queued)
+	}
+	return pending
+}
+
+// setQueuedExecution stores a queued execution
+func (k Keeper) setQueuedExecution(ctx sdk.Context, queued types.QueuedExecution) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz, err := json.Marshal(queued)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.ExecutionQueueKey(queued.ProposalID), bz)
+}
+
+// deleteQueuedExecution removes a queued execution
+func (k Keeper) deleteQueuedExecution(ctx sdk.Context, proposalID uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.ExecutionQueueKey(proposalID))
+}
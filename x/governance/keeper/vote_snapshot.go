@@ -57,7 +57,8 @@ func (k Keeper) CreateVoteSnapshot(ctx sdk.Context, proposal types.Proposal) err
 	// For company proposals, also capture equity holder voting power
 	if proposal.Type == types.ProposalTypeCompanyListing ||
 		proposal.Type == types.ProposalTypeCompanyDelisting ||
-		proposal.Type == types.ProposalTypeCompanyParameter {
+		proposal.Type == types.ProposalTypeCompanyParameter ||
+		proposal.Type == types.ProposalTypeCompanyGovernance {
 		equityPower := k.captureEquityHolderVotingPower(ctx, proposal, &snapshot)
 		snapshot.TotalVotingPower = snapshot.TotalVotingPower.Add(equityPower)
 	}
@@ -77,6 +78,16 @@ func (k Keeper) CreateVoteSnapshot(ctx sdk.Context, proposal types.Proposal) err
 	return nil
 }
 
+// snapshotCompanyProposal snapshots a company proposal's holders as its poll
+// opens, so holders signing votes off-chain know the height they are counted at
+func (k Keeper) snapshotCompanyProposal(ctx sdk.Context, proposal types.Proposal) {
+	if err := k.CreateVoteSnapshot(ctx, proposal); err != nil {
+		ctx.Logger().Error("Failed to snapshot company proposal",
+			"proposal_id", proposal.ID,
+			"error", err)
+	}
+}
+
 // captureValidatorVotingPower captures voting power for all validators
 func (k Keeper) captureValidatorVotingPower(ctx sdk.Context, proposal types.Proposal, snapshot *VoteSnapshot) math.LegacyDec {
 	totalPower := math.LegacyZeroDec()
@@ -102,9 +113,50 @@ func (k Keeper) captureHODLHolderVotingPower(ctx sdk.Context, proposal types.Pro
 // captureEquityHolderVotingPower captures voting power for equity holders (company proposals)
 func (k Keeper) captureEquityHolderVotingPower(ctx sdk.Context, proposal types.Proposal, snapshot *VoteSnapshot) math.LegacyDec {
 	totalPower := math.LegacyZeroDec()
+	if proposal.CompanyID == 0 {
+		return totalPower
+	}
+
+	// Company proposals weight holders by share class and cap them as the live
+	// vote would; other company-scoped proposals count voting shares one for one
+	companyProposal, isCompanyProposal := k.GetCompanyProposal(ctx, proposal.ID)
+
+	// Holders of record are the direct common holders and the beneficial owners
+	// of common shares in pools and escrow, who vote them as the live vote
+	// does; a holder's direct and beneficial shares count together
+	var owners []string
+	for _, holding := range k.equityKeeper.GetCompanyShareholdings(ctx, proposal.CompanyID, commonClassID) {
+		owners = append(owners, holding.Owner)
+	}
+	for _, ownership := range k.equityKeeper.GetCompanyBeneficialOwnerships(ctx, proposal.CompanyID, commonClassID) {
+		owners = append(owners, ownership.BeneficialOwner)
+	}
 
-	// This would need to iterate through all equity holdings for the specific company
-	// For now, we'll provide a placeholder that would integrate with the equity keeper
+	for _, owner := range owners {
+		if _, counted := snapshot.EquityHolderPowers[owner]; counted {
+			continue
+		}
+		holder, err := sdk.AccAddressFromBech32(owner)
+		if err != nil || k.isModuleAccount(holder) {
+			continue
+		}
+
+		var power math.LegacyDec
+		if isCompanyProposal {
+			power, err = k.calculateShareholderVotingPower(ctx, companyProposal, holder)
+			if err != nil {
+				continue
+			}
+		} else {
+			power = math.LegacyNewDecFromInt(k.equityKeeper.GetVotingSharesForCompany(ctx, proposal.CompanyID, owner))
+		}
+		if !power.IsPositive() {
+			continue
+		}
+
+		snapshot.EquityHolderPowers[owner] = power
+		totalPower = totalPower.Add(power)
+	}
 
 	return totalPower
 }
@@ -181,6 +233,19 @@ type ExecutionRecord struct {
 	Result          string `json:"result"`
 	Error           string `json:"error,omitempty"`
 	ExecutionHeight int64  `json:"execution_height"`
+
+	// Per-message results of proposals executing messages, up to the first failure
+	Messages []MessageExecutionResult `json:"messages,omitempty"`
+}
+
+// MessageExecutionResult stores the outcome of one proposal message
+type MessageExecutionResult struct {
+	Index   int    `json:"index"`
+	TypeURL string `json:"type_url"`
+	Success bool   `json:"success"`
+	Data    []byte `json:"data,omitempty"`
+	Log     string `json:"log,omitempty"`
+	Error   string `json:"error,omitempty"`
 }
 
 // ExecutionRecordPrefix for storing execution records
@@ -205,9 +270,19 @@ func (k Keeper) RecordExecution(ctx sdk.Context, proposalID uint64, success bool
 		record.Error = err.Error()
 	}
 
+	// Keep message results recorded while executing at this height
+	if existing, found := k.GetExecutionRecord(ctx, proposalID); found && existing.ExecutionHeight == ctx.BlockHeight() {
+		record.Messages = existing.Messages
+	}
+
+	k.setExecutionRecord(ctx, record)
+}
+
+// setExecutionRecord stores an execution record
+func (k Keeper) setExecutionRecord(ctx sdk.Context, record ExecutionRecord) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	bz, _ := json.Marshal(record)
-	store.Set(ExecutionRecordKey(proposalID), bz)
+	store.Set(ExecutionRecordKey(record.ProposalID), bz)
 }
 
 // GetExecutionRecord retrieves an execution record
@@ -275,16 +350,8 @@ func (k Keeper) ProcessExecutionQueue(ctx sdk.Context) {
 		if ctx.BlockHeight() >= entry.ExecuteAfter {
 			proposal, found := k.GetProposal(ctx, entry.ProposalID)
 			if found && proposal.Status == types.ProposalStatusPassed {
-				err := k.executeProposal(ctx, proposal)
-				if err != nil {
-					k.RecordExecution(ctx, entry.ProposalID, false, "", err)
-					proposal.Status = types.ProposalStatusFailed
-				} else {
-					k.RecordExecution(ctx, entry.ProposalID, true, "Proposal executed successfully", nil)
-					// Keep status as passed since it executed successfully
-				}
-				proposal.Executed = true
-				proposal.ExecutionTime = ctx.BlockTime()
+				// Status stays passed if it executed successfully
+				k.applyExecutionResult(ctx, &proposal, k.executeProposal(ctx, proposal), "Proposal executed successfully")
 				proposal.UpdatedAt = ctx.BlockTime()
 				k.setProposal(ctx, proposal)
 				k.updateProposalIndex(ctx, proposal)
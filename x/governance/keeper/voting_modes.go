@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sharehodl/sharehodl-blockchain/x/governance/types"
+)
+
+// =============================================================================
+// VOTING MODES
+// =============================================================================
+// Each proposal type votes in the mode set in governance params, fixed on the
+// proposal when it is submitted:
+//   - Standard:   stake weighted by staking tier (calculateVotingPower)
+//   - Quadratic:  square root of snapshot power, so influence grows slower than
+//                 stake; voters need a minimum staking reputation so that
+//                 splitting stake across fresh addresses does not pay
+//   - Conviction: power grows with how long the vote is held before voting
+//                 closes; the voter's stake is locked until the proposal is
+//                 finalized so the conviction is backed for its whole term
+
+// calculateModeVotingPower calculates a voter's power under the proposal's voting mode
+func (k Keeper) calculateModeVotingPower(ctx sdk.Context, proposal types.Proposal, voter sdk.AccAddress) (math.LegacyDec, error) {
+	switch proposal.VotingMode {
+	case types.VotingModeQuadratic:
+		return k.calculateQuadraticVotingPower(ctx, proposal, voter)
+	case types.VotingModeConviction:
+		return k.calculateConvictionVotingPower(ctx, proposal, voter)
+	default:
+		return k.calculateVotingPower(ctx, proposal, voter)
+	}
+}
+
+// calculateQuadraticVotingPower returns the square root of the voter's snapshot power
+func (k Keeper) calculateQuadraticVotingPower(ctx sdk.Context, proposal types.Proposal, voter sdk.AccAddress) (math.LegacyDec, error) {
+	params := k.GetExtendedParams(ctx)
+	reputation := k.stakingKeeper.GetReputation(ctx, voter)
+	minReputation := math.LegacyNewDec(int64(params.MinQuadraticReputation))
+	if reputation.LT(minReputation) {
+		return math.LegacyZeroDec(), types.ErrInsufficientReputation.Wrapf("required: %s, have: %s", minReputation, reputation)
+	}
+
+	power, err := k.GetSnapshotVotingPower(ctx, proposal.ID, voter)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+	if !power.IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+	return power.ApproxSqrt()
+}
+
+// calculateConvictionVotingPower scales the voter's power by the conviction
+// the vote will have accrued when voting closes. Votes cannot be changed once
+// cast, so a vote cast now is held for the rest of the voting period.
+func (k Keeper) calculateConvictionVotingPower(ctx sdk.Context, proposal types.Proposal, voter sdk.AccAddress) (math.LegacyDec, error) {
+	power, err := k.calculateVotingPower(ctx, proposal, voter)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+	return power.Mul(k.convictionMultiplier(ctx, proposal)), nil
+}
+
+// convictionMultiplier grows linearly from 1x for a vote cast as voting closes
+// to the maximum multiplier for a vote held for the full conviction period
+func (k Keeper) convictionMultiplier(ctx sdk.Context, proposal types.Proposal) math.LegacyDec {
+	params := k.GetExtendedParams(ctx)
+	maxMultiplier := params.GetMaxConvictionMultiplier()
+	if params.ConvictionPeriodDays == 0 || maxMultiplier.LTE(math.LegacyOneDec()) {
+		return math.LegacyOneDec()
+	}
+
+	held := proposal.VotingEndTime.Sub(ctx.BlockTime())
+	if held <= 0 {
+		return math.LegacyOneDec()
+	}
+	period := int64(params.ConvictionPeriodDays) * 24 * 60 * 60
+	heldSeconds := int64(held.Seconds())
+	if heldSeconds > period {
+		heldSeconds = period
+	}
+
+	conviction := math.LegacyNewDec(heldSeconds).QuoInt64(period)
+	return math.LegacyOneDec().Add(maxMultiplier.Sub(math.LegacyOneDec()).Mul(conviction))
+}
+
+// lockConvictionVote locks the voter's stake for a conviction vote
+func (k Keeper) lockConvictionVote(ctx sdk.Context, proposal types.Proposal, voter sdk.AccAddress) error {
+	if proposal.VotingMode != types.VotingModeConviction {
+		return nil
+	}
+	return k.stakingKeeper.LockForVote(ctx, voter, fmt.Sprintf("%d", proposal.ID))
+}
+
+// releaseConvictionLocks unlocks the stake of every conviction voter on a finalized proposal
+func (k Keeper) releaseConvictionLocks(ctx sdk.Context, proposal types.Proposal) {
+	if proposal.VotingMode != types.VotingModeConviction {
+		return
+	}
+
+	proposalRef := fmt.Sprintf("%d", proposal.ID)
+	for _, voter := range k.getProposalVoters(ctx, proposal.ID) {
+		addr, err := sdk.AccAddressFromBech32(voter)
+		if err != nil {
+			continue
+		}
+		if err := k.stakingKeeper.UnlockVote(ctx, addr, proposalRef); err != nil {
+			ctx.Logger().Error("failed to release conviction vote lock",
+				"proposal_id", proposal.ID,
+				"voter", voter,
+				"error", err,
+			)
+		}
+	}
+}
+
+// getProposalVoters returns the addresses that cast a vote or weighted vote on a proposal
+func (k Keeper) getProposalVoters(ctx sdk.Context, proposalID uint64) []string {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	seen := make(map[string]bool)
+	var voters []string
+
+	voteStore := prefix.NewStore(store, types.ProposalVoteIteratorKey(proposalID))
+	iterator := voteStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		if err := json.Unmarshal(iterator.Value(), &vote); err != nil || seen[vote.Voter] {
+			continue
+		}
+		seen[vote.Voter] = true
+		voters = append(voters, vote.Voter)
+	}
+	iterator.Close()
+
+	weightedStore := prefix.NewStore(store, types.ProposalWeightedVoteIteratorKey(proposalID))
+	weightedIterator := weightedStore.Iterator(nil, nil)
+	for ; weightedIterator.Valid(); weightedIterator.Next() {
+		var vote types.WeightedVote
+		if err := json.Unmarshal(weightedIterator.Value(), &vote); err != nil || seen[vote.Voter] {
+			continue
+		}
+		seen[vote.Voter] = true
+		voters = append(voters, vote.Voter)
+	}
+	weightedIterator.Close()
+
+	return voters
+}
@@ -2,6 +2,7 @@ package governance
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -81,6 +82,14 @@ func (am AppModule) IsOnePerModuleType() {}
 // IsAppModule implements the appmodule.AppModule interface
 func (am AppModule) IsAppModule() {}
 
+// RegisterServices registers the governance module's store migrations
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+}
+
 // BeginBlock executes all ABCI BeginBlock logic for the governance module
 func (am AppModule) BeginBlock(ctx sdk.Context) error {
 	return nil
@@ -88,9 +97,21 @@ func (am AppModule) BeginBlock(ctx sdk.Context) error {
 
 // EndBlock executes all ABCI EndBlock logic for the governance module
 func (am AppModule) EndBlock(ctx sdk.Context) error {
+	// Snapshot, open and close shareholder meetings; meetings decide their own agenda items
+	am.keeper.ProcessMeetings(ctx)
+
 	// Process voting periods that have ended
 	am.processEndedVotingPeriods(ctx)
 
+	// Reject board-gated company proposals whose board resolution lapsed
+	am.keeper.ProcessBoardReviews(ctx)
+
+	// Pass optimistic proposals whose challenge window closed unescalated
+	am.keeper.ProcessOptimisticProposals(ctx)
+
+	// Execute passed protocol proposals whose timelock has elapsed
+	am.keeper.ProcessTimelockQueue(ctx)
+
 	// Process execution queue
 	am.keeper.ProcessExecutionQueue(ctx)
 
@@ -176,5 +197,5 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 
 // ConsensusVersion returns the governance module's consensus version
 func (am AppModule) ConsensusVersion() uint64 {
-	return 1
+	return 2
 }
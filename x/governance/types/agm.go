@@ -0,0 +1,292 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	equitytypes "github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// Shareholder meeting limits
+const (
+	MaxMeetingAgendaItems = 20                  // Most resolutions and elections a single meeting may put to shareholders
+	MinMeetingNotice      = 7 * 24 * time.Hour  // Shortest notice between scheduling a meeting and opening the poll
+	MinMeetingDuration    = 24 * time.Hour      // Shortest poll
+	MaxMeetingDuration    = 30 * 24 * time.Hour // Longest poll
+)
+
+// DefaultMeetingQuorum is the share of record-date voting power that must be
+// present in person or by proxy for the meeting's business to be valid
+var DefaultMeetingQuorum = math.LegacyNewDecWithPrec(5, 1) // 50%
+
+// MeetingStatus represents the lifecycle of a shareholder meeting
+type MeetingStatus int32
+
+const (
+	MeetingStatusScheduled    MeetingStatus = 0 // Notice given; record date and poll pending
+	MeetingStatusOpen         MeetingStatus = 1 // Poll open for meeting ballots
+	MeetingStatusConcluded    MeetingStatus = 2 // Quorum present; agenda items decided
+	MeetingStatusQuorumNotMet MeetingStatus = 3 // Poll closed without a quorum; all business fails
+)
+
+// String returns the string representation of MeetingStatus
+func (ms MeetingStatus) String() string {
+	switch ms {
+	case MeetingStatusScheduled:
+		return "scheduled"
+	case MeetingStatusOpen:
+		return "open"
+	case MeetingStatusConcluded:
+		return "concluded"
+	case MeetingStatusQuorumNotMet:
+		return "quorum_not_met"
+	default:
+		return "unknown"
+	}
+}
+
+// MeetingAgendaItem is a resolution or board election to put on a meeting's agenda
+type MeetingAgendaItem struct {
+	Type        CompanyProposalType        `json:"type"`
+	Title       string                     `json:"title"`
+	Description string                     `json:"description"`
+	Seats       uint32                     `json:"seats,omitempty"`    // Board elections only
+	Nominees    []equitytypes.BoardNominee `json:"nominees,omitempty"` // Board elections only
+}
+
+// Validate validates an agenda item
+func (item MeetingAgendaItem) Validate() error {
+	if item.Title == "" {
+		return fmt.Errorf("agenda item title cannot be empty")
+	}
+	if item.Description == "" {
+		return fmt.Errorf("agenda item %q needs a description", item.Title)
+	}
+	if item.Type == CompanyProposalTypeBoardElection {
+		if item.Seats == 0 {
+			return fmt.Errorf("election %q must fill at least one seat", item.Title)
+		}
+		if err := equitytypes.ValidateBoardNominees(item.Nominees); err != nil {
+			return fmt.Errorf("election %q: %v", item.Title, err)
+		}
+	} else if len(item.Nominees) > 0 {
+		return fmt.Errorf("only board elections nominate candidates")
+	}
+	return nil
+}
+
+// AgendaItem is an item of business on a meeting's agenda. Each item is a
+// company proposal whose poll runs for the meeting.
+type AgendaItem struct {
+	ProposalID uint64              `json:"proposal_id"`
+	Type       CompanyProposalType `json:"type"`
+	Title      string              `json:"title"`
+}
+
+// AgendaItemResult is the published outcome of an agenda item
+type AgendaItemResult struct {
+	ProposalID      uint64   `json:"proposal_id"`
+	Title           string   `json:"title"`
+	Type            string   `json:"type"`
+	Status          string   `json:"status"`
+	YesVotes        math.Int `json:"yes_votes"`
+	NoVotes         math.Int `json:"no_votes"`
+	AbstainVotes    math.Int `json:"abstain_votes"`
+	NoWithVetoVotes math.Int `json:"no_with_veto_votes"`
+	Elected         []string `json:"elected,omitempty"` // Board elections only
+}
+
+// ShareholderMeeting is an annual or special general meeting of a company's
+// shareholders. Voting power is fixed at the record date; holders vote every
+// agenda item on one ballot, in person or through a proxy, and the meeting's
+// business only counts if a quorum of record-date power is present.
+type ShareholderMeeting struct {
+	ID        uint64 `json:"id"`
+	CompanyID uint64 `json:"company_id"`
+	Title     string `json:"title"`
+	Convener  string `json:"convener"`
+
+	RecordDate time.Time      `json:"record_date"` // Holders of record at this time may vote
+	OpensAt    time.Time      `json:"opens_at"`
+	ClosesAt   time.Time      `json:"closes_at"`
+	Quorum     math.LegacyDec `json:"quorum"` // Share of record-date power that must be present
+	Agenda     []AgendaItem   `json:"agenda"`
+	Status     MeetingStatus  `json:"status"`
+
+	RecordHeight     int64          `json:"record_height,omitempty"` // Block the record-date snapshot was taken at
+	RecordPower      math.LegacyDec `json:"record_power"`            // Total voting power of holders of record
+	PresentPower     math.LegacyDec `json:"present_power"`           // Power present in person or by proxy
+	BallotsCast      uint64         `json:"ballots_cast"`
+	ProxiesAppointed uint64         `json:"proxies_appointed"`
+
+	Results           []AgendaItemResult `json:"results,omitempty"`
+	MinutesHash       string             `json:"minutes_hash,omitempty"` // SHA-256 of the signed minutes, hex encoded
+	MinutesRecordedBy string             `json:"minutes_recorded_by,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	ClosedAt  time.Time `json:"closed_at,omitempty"`
+}
+
+// IsRecordSet reports whether the record-date snapshot has been taken
+func (m ShareholderMeeting) IsRecordSet() bool {
+	return m.RecordHeight != 0
+}
+
+// IsPollOpen reports whether meeting ballots are accepted at the given time
+func (m ShareholderMeeting) IsPollOpen(now time.Time) bool {
+	return m.Status == MeetingStatusOpen && !now.Before(m.OpensAt) && now.Before(m.ClosesAt)
+}
+
+// IsClosed reports whether the meeting has closed its poll
+func (m ShareholderMeeting) IsClosed() bool {
+	return m.Status == MeetingStatusConcluded || m.Status == MeetingStatusQuorumNotMet
+}
+
+// HasQuorum reports whether enough record-date power is present
+func (m ShareholderMeeting) HasQuorum() bool {
+	if m.RecordPower.IsNil() || !m.RecordPower.IsPositive() {
+		return false
+	}
+	return m.PresentPower.Quo(m.RecordPower).GTE(m.Quorum)
+}
+
+// AgendaItem returns the agenda item for a proposal
+func (m ShareholderMeeting) AgendaItem(proposalID uint64) (AgendaItem, bool) {
+	for _, item := range m.Agenda {
+		if item.ProposalID == proposalID {
+			return item, true
+		}
+	}
+	return AgendaItem{}, false
+}
+
+// ValidateMeetingSchedule checks a meeting's timetable against the time it is scheduled
+func ValidateMeetingSchedule(now, recordDate, opensAt, closesAt time.Time) error {
+	if recordDate.Before(now) {
+		return fmt.Errorf("record date cannot be in the past")
+	}
+	if opensAt.Before(now.Add(MinMeetingNotice)) {
+		return fmt.Errorf("meeting needs at least %s notice", MinMeetingNotice)
+	}
+	if recordDate.After(opensAt) {
+		return fmt.Errorf("record date must fall on or before the meeting opens")
+	}
+	duration := closesAt.Sub(opensAt)
+	if duration < MinMeetingDuration || duration > MaxMeetingDuration {
+		return fmt.Errorf("poll must run between %s and %s", MinMeetingDuration, MaxMeetingDuration)
+	}
+	return nil
+}
+
+// ValidateMinutesHash checks that minutes are recorded as a hex SHA-256 digest
+func ValidateMinutesHash(hash string) error {
+	bz, err := hex.DecodeString(hash)
+	if err != nil || len(bz) != 32 {
+		return fmt.Errorf("minutes hash must be a hex-encoded SHA-256 digest")
+	}
+	return nil
+}
+
+// AgendaVote is a holder's vote on one agenda item. Resolutions take an option;
+// board elections take an allocation of votes to candidates, or none to abstain.
+type AgendaVote struct {
+	ProposalID uint64                         `json:"proposal_id"`
+	Option     VoteOption                     `json:"option,omitempty"`
+	Election   []equitytypes.BoardBallotEntry `json:"election,omitempty"`
+}
+
+// ValidateMeetingBallot checks that a ballot votes every agenda item exactly once
+func ValidateMeetingBallot(agenda []AgendaItem, votes []AgendaVote) error {
+	if len(votes) != len(agenda) {
+		return fmt.Errorf("ballot must vote all %d agenda items", len(agenda))
+	}
+	items := make(map[uint64]AgendaItem, len(agenda))
+	for _, item := range agenda {
+		items[item.ProposalID] = item
+	}
+	seen := make(map[uint64]bool, len(votes))
+	for _, v := range votes {
+		item, ok := items[v.ProposalID]
+		if !ok {
+			return fmt.Errorf("proposal %d is not on the agenda", v.ProposalID)
+		}
+		if seen[v.ProposalID] {
+			return fmt.Errorf("proposal %d voted twice", v.ProposalID)
+		}
+		seen[v.ProposalID] = true
+		if err := validateAgendaVote(item, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateAgendaVote checks a vote's shape against the kind of agenda item
+func validateAgendaVote(item AgendaItem, v AgendaVote) error {
+	if item.Type == CompanyProposalTypeBoardElection {
+		if v.Option != VoteOptionEmpty && v.Option != VoteOptionAbstain {
+			return fmt.Errorf("election %d takes candidate votes, not an option", v.ProposalID)
+		}
+		return nil
+	}
+	if len(v.Election) > 0 {
+		return fmt.Errorf("resolution %d takes an option, not candidate votes", v.ProposalID)
+	}
+	switch v.Option {
+	case VoteOptionYes, VoteOptionNo, VoteOptionAbstain, VoteOptionNoWithVeto:
+		return nil
+	default:
+		return fmt.Errorf("invalid option on resolution %d", v.ProposalID)
+	}
+}
+
+// ProxyCard appoints a proxy to vote a shareholder's record-date power at a
+// meeting. Directions instruct the proxy how to vote on particular resolutions;
+// items without a direction are left to the proxy's discretion.
+type ProxyCard struct {
+	MeetingID   uint64       `json:"meeting_id"`
+	CompanyID   uint64       `json:"company_id"`
+	Shareholder string       `json:"shareholder"`
+	Proxy       string       `json:"proxy"`
+	Directions  []AgendaVote `json:"directions,omitempty"`
+	AppointedAt time.Time    `json:"appointed_at"`
+}
+
+// Direction returns the shareholder's instruction on an agenda item
+func (c ProxyCard) Direction(proposalID uint64) (VoteOption, bool) {
+	for _, d := range c.Directions {
+		if d.ProposalID == proposalID {
+			return d.Option, true
+		}
+	}
+	return VoteOptionEmpty, false
+}
+
+// ValidateProxyDirections checks that directions name resolutions on the agenda.
+// Election votes are always left to the proxy.
+func ValidateProxyDirections(agenda []AgendaItem, directions []AgendaVote) error {
+	items := make(map[uint64]AgendaItem, len(agenda))
+	for _, item := range agenda {
+		items[item.ProposalID] = item
+	}
+	seen := make(map[uint64]bool, len(directions))
+	for _, d := range directions {
+		item, ok := items[d.ProposalID]
+		if !ok {
+			return fmt.Errorf("proposal %d is not on the agenda", d.ProposalID)
+		}
+		if item.Type == CompanyProposalTypeBoardElection {
+			return fmt.Errorf("proxies vote elections at their discretion")
+		}
+		if seen[d.ProposalID] {
+			return fmt.Errorf("proposal %d directed twice", d.ProposalID)
+		}
+		seen[d.ProposalID] = true
+		if err := validateAgendaVote(item, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
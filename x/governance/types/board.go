@@ -0,0 +1,47 @@
+package types
+
+import (
+	"time"
+
+	equitytypes "github.com/sharehodl/sharehodl-blockchain/x/equity/types"
+)
+
+// DefaultBoardReviewPeriod is how long the board has to pass a resolution on a
+// board-gated company proposal before it lapses
+const DefaultBoardReviewPeriod = time.Hour * 24 * 14 // 2 weeks
+
+// BoardElection is the slate and running tally of a board election proposal.
+// Holders cast ballots allocating votes to candidates; under cumulative voting
+// they may concentrate shares x seats votes on a single candidate.
+type BoardElection struct {
+	Seats      uint32                       `json:"seats"`      // Seats being filled
+	Cumulative bool                         `json:"cumulative"` // Board's voting method when the election opened
+	Candidates []equitytypes.BoardCandidate `json:"candidates"`
+}
+
+// BoardResolution collects director signatures on a board-gated company proposal.
+// Once Threshold (M of the board's N seats) active directors sign, the shareholder
+// vote opens.
+type BoardResolution struct {
+	ProposalID uint64    `json:"proposal_id"`
+	CompanyID  uint64    `json:"company_id"`
+	Threshold  uint32    `json:"threshold"`
+	Signers    []string  `json:"signers"`
+	CreatedAt  time.Time `json:"created_at"`
+	ApprovedAt time.Time `json:"approved_at,omitempty"`
+}
+
+// HasSigned reports whether a director signed the resolution
+func (r BoardResolution) HasSigned(director string) bool {
+	for _, signer := range r.Signers {
+		if signer == director {
+			return true
+		}
+	}
+	return false
+}
+
+// IsApproved reports whether the resolution has enough signatures
+func (r BoardResolution) IsApproved() bool {
+	return r.Threshold > 0 && len(r.Signers) >= int(r.Threshold)
+}
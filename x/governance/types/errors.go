@@ -31,6 +31,10 @@ var (
 	ErrProposalAlreadyExists = errors.Register(DefaultCodespace, 112, "proposal already exists")
 	ErrInvalidProposalID = errors.Register(DefaultCodespace, 113, "invalid proposal ID")
 	ErrInvalidProposer = errors.Register(DefaultCodespace, 114, "invalid proposer - only the original proposer can perform this action")
+	ErrInvalidProposalMsg = errors.Register(DefaultCodespace, 115, "invalid proposal message")
+	ErrInvalidProposalSigner = errors.Register(DefaultCodespace, 116, "proposal message must be signed by the governance authority only")
+	ErrUnroutableProposalMsg = errors.Register(DefaultCodespace, 117, "proposal message has no handler")
+	ErrProposalMsgFailed = errors.Register(DefaultCodespace, 118, "proposal message execution failed")
 
 	// Voting errors
 	ErrInvalidVote = errors.Register(DefaultCodespace, 200, "invalid vote")
@@ -97,6 +101,10 @@ var (
 	ErrInvalidDelegation = errors.Register(DefaultCodespace, 853, "invalid delegation parameters")
 	ErrDelegationExpired = errors.Register(DefaultCodespace, 854, "delegation has expired")
 	ErrCircularDelegation = errors.Register(DefaultCodespace, 855, "circular delegation detected")
+	ErrDelegationTooDeep = errors.Register(DefaultCodespace, 856, "delegation chain exceeds the maximum depth")
+	ErrDelegateNotRegistered = errors.Register(DefaultCodespace, 857, "delegate has no registered profile")
+	ErrInvalidDelegateProfile = errors.Register(DefaultCodespace, 858, "invalid delegate profile")
+	ErrNoDelegatedPower = errors.Register(DefaultCodespace, 859, "no delegated voting power for this proposal")
 	
 	// Emergency proposal errors
 	ErrInvalidEmergencyType = errors.Register(DefaultCodespace, 860, "invalid emergency type")
@@ -114,6 +122,28 @@ var (
 	ErrBoardApprovalRequired = errors.Register(DefaultCodespace, 874, "board approval required")
 	ErrCompanyProposalNotFound = errors.Register(DefaultCodespace, 875, "company proposal not found")
 	ErrInvalidCompanyProposalType = errors.Register(DefaultCodespace, 876, "invalid company proposal type")
+	ErrNotBoardMember = errors.Register(DefaultCodespace, 877, "not an active director of the company")
+	ErrBoardResolutionSigned = errors.Register(DefaultCodespace, 878, "director already signed the board resolution")
+	ErrNotInBoardReview = errors.Register(DefaultCodespace, 879, "proposal is not awaiting a board resolution")
+	ErrInvalidBoardElection = errors.Register(DefaultCodespace, 880, "invalid board election")
+	ErrMeetingNotFound = errors.Register(DefaultCodespace, 881, "shareholder meeting not found")
+	ErrInvalidMeeting = errors.Register(DefaultCodespace, 882, "invalid shareholder meeting")
+	ErrMeetingNotOpen = errors.Register(DefaultCodespace, 883, "shareholder meeting poll is not open")
+	ErrMeetingBallotCast = errors.Register(DefaultCodespace, 884, "meeting ballot already cast")
+	ErrInvalidMeetingBallot = errors.Register(DefaultCodespace, 885, "invalid meeting ballot")
+	ErrProxyAppointed = errors.Register(DefaultCodespace, 886, "proxy already appointed for this meeting")
+	ErrProxyNotFound = errors.Register(DefaultCodespace, 887, "proxy card not found")
+	ErrMeetingAgendaItem = errors.Register(DefaultCodespace, 888, "agenda items are voted on the meeting ballot")
+	ErrMinutesRecorded = errors.Register(DefaultCodespace, 889, "meeting minutes already recorded")
+	ErrInsufficientReputation = errors.Register(DefaultCodespace, 890, "reputation below the minimum for quadratic voting")
+	ErrNotQueued = errors.Register(DefaultCodespace, 891, "proposal is not queued for execution")
+	ErrNotGuardian = errors.Register(DefaultCodespace, 892, "not a member of the guardian council")
+	ErrGuardianVetoCast = errors.Register(DefaultCodespace, 893, "guardian already vetoed this execution")
+	ErrInvalidGuardianCouncil = errors.Register(DefaultCodespace, 894, "invalid guardian council")
+	ErrVetoVotePending = errors.Register(DefaultCodespace, 895, "an emergency veto vote is already open for this execution")
+	ErrNotOptimisticEligible = errors.Register(DefaultCodespace, 896, "message is not on the optimistic governance allowlist")
+	ErrNotInChallengePeriod = errors.Register(DefaultCodespace, 897, "proposal is not in an optimistic challenge window")
+	ErrAlreadyObjected = errors.Register(DefaultCodespace, 898, "already objected to this proposal")
 	
 	// State and storage errors
 	ErrInvalidState = errors.Register(DefaultCodespace, 900, "invalid module state")
@@ -146,4 +176,9 @@ var (
 	ErrValidatorRequired = errors.Register(DefaultCodespace, 961, "only validators can vote on this proposal")
 	ErrShareholderRequired = errors.Register(DefaultCodespace, 962, "only shareholders of this company can vote on this proposal")
 	ErrHODLHolderRequired = errors.Register(DefaultCodespace, 963, "must hold HODL tokens to vote on this proposal")
+
+	// Signed vote errors
+	ErrInvalidVoteSignature = errors.Register(DefaultCodespace, 970, "invalid signed vote signature")
+	ErrNoVoteSnapshot = errors.Register(DefaultCodespace, 971, "proposal has no vote snapshot")
+	ErrSnapshotMismatch = errors.Register(DefaultCodespace, 972, "signed snapshot height does not match the proposal's snapshot")
 )
\ No newline at end of file
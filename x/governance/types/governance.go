@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"cosmossdk.io/math"
@@ -56,6 +58,24 @@ func (pt ProposalType) String() string {
 		return "treasury_grant"
 	case ProposalTypeTreasuryFunding:
 		return "treasury_funding"
+	case ProposalTypeCompanyListing:
+		return "company_listing"
+	case ProposalTypeCompanyDelisting:
+		return "company_delisting"
+	case ProposalTypeCompanyParameter:
+		return "company_parameter"
+	case ProposalTypeValidatorPromotion:
+		return "validator_promotion"
+	case ProposalTypeValidatorDemotion:
+		return "validator_demotion"
+	case ProposalTypeValidatorRemoval:
+		return "validator_removal"
+	case ProposalTypeProtocolParameter:
+		return "protocol_parameter"
+	case ProposalTypeProtocolUpgrade:
+		return "protocol_upgrade"
+	case ProposalTypeTreasurySpend:
+		return "treasury_spend"
 	default:
 		return "unknown"
 	}
@@ -71,6 +91,10 @@ const (
 	ProposalStatusRejected      ProposalStatus = 3
 	ProposalStatusFailed        ProposalStatus = 4
 	ProposalStatusCanceled      ProposalStatus = 5
+	ProposalStatusBoardReview   ProposalStatus = 6 // Company proposal awaiting a board resolution before shareholders vote
+	ProposalStatusQueued        ProposalStatus = 7 // Passed; waiting out its execution timelock
+	ProposalStatusVetoed        ProposalStatus = 8 // Passed, then vetoed by the guardian council or an emergency vote before execution
+	ProposalStatusChallengePeriod ProposalStatus = 9 // Optimistic proposal; passes unless enough stake objects before the challenge window closes
 )
 
 // String returns the string representation of ProposalStatus
@@ -88,6 +112,14 @@ func (ps ProposalStatus) String() string {
 		return "failed"
 	case ProposalStatusCanceled:
 		return "canceled"
+	case ProposalStatusBoardReview:
+		return "board_review"
+	case ProposalStatusQueued:
+		return "queued"
+	case ProposalStatusVetoed:
+		return "vetoed"
+	case ProposalStatusChallengePeriod:
+		return "challenge_period"
 	default:
 		return "unknown"
 	}
@@ -155,6 +187,16 @@ type Proposal struct {
 	Executed        bool      `json:"executed"`
 	ExecutionTime   time.Time `json:"execution_time,omitempty"`
 	ExecutionResult string    `json:"execution_result,omitempty"`
+	Messages        []ProposalMessage `json:"messages,omitempty"` // Executed in order by the governance authority on passage
+	
+	// Voting mode fixed at submission from the params for the proposal's type
+	VotingMode      VotingMode `json:"voting_mode,omitempty"`
+	
+	// Emergency veto votes only: the queued proposal this vote would veto
+	VetoesProposalID uint64    `json:"vetoes_proposal_id,omitempty"`
+
+	// Submitted on the optimistic track; stays set if objections escalate it to a vote
+	Optimistic       bool      `json:"optimistic,omitempty"`
 	
 	// Metadata
 	Metadata        map[string]interface{} `json:"metadata,omitempty"`
@@ -254,6 +296,9 @@ type CompanyGovernanceProposal struct {
 	ShareClassVoting  map[string]math.LegacyDec `json:"share_class_voting"` // Voting weights by share class
 	RequiredApproval  math.LegacyDec            `json:"required_approval"`  // Required approval percentage
 	BoardApprovalReq  bool                      `json:"board_approval_req"` // Requires board approval
+	BoardReviewEnd    time.Time                 `json:"board_review_end,omitempty"` // Board resolution must pass by this time
+	BoardElection     *BoardElection            `json:"board_election,omitempty"`   // Board election proposals only
+	MeetingID         uint64                    `json:"meeting_id,omitempty"`       // Shareholder meeting the proposal is an agenda item of
 	QuorumRequirement math.LegacyDec            `json:"quorum_requirement"`
 	VotingPeriod      time.Duration             `json:"voting_period"`
 	ExecutionDelay    time.Duration             `json:"execution_delay"`    // Delay before execution
@@ -446,6 +491,7 @@ const (
 	CompanyProposalTypeAuditorSelection CompanyProposalType = 7
 	CompanyProposalTypeCapitalStructure CompanyProposalType = 8
 	CompanyProposalTypeAssetSale       CompanyProposalType = 9
+	CompanyProposalTypeDissolution     CompanyProposalType = 10
 )
 
 // String returns the string representation of CompanyProposalType
@@ -471,12 +517,69 @@ func (cpt CompanyProposalType) String() string {
 		return "capital_structure"
 	case CompanyProposalTypeAssetSale:
 		return "asset_sale"
+	case CompanyProposalTypeDissolution:
+		return "dissolution"
 	default:
 		return "unknown"
 	}
 }
 
-// VoteDelegation represents voting power delegation
+// ProposalData keys linking a company proposal to the corporate action it approves
+const (
+	ProposalDataSplitID         = "split_id"            // Share split proposals: equity stock split ID
+	ProposalDataMergerID        = "merger_id"           // Merger proposals: equity merger ID, approved for the proposing company
+	ProposalDataDissolutionID   = "dissolution_id"      // Dissolution proposals: equity dissolution ID
+	ProposalDataConversionClass = "conversion_class_id" // Capital structure proposals: share class to convert under its mandatory-on-governance terms
+	ProposalDataCapitalChangeID = "capital_change_id"   // Capital structure proposals: equity capital structure change ID
+)
+
+// DataString returns a non-empty string stored in ProposalData
+func (p CompanyGovernanceProposal) DataString(key string) (string, bool) {
+	value, ok := p.ProposalData[key].(string)
+	return value, ok && value != ""
+}
+
+// DataID returns a corporate action ID stored in ProposalData. Numbers decode
+// as float64 from JSON, so whole float values and numeric strings are accepted.
+func (p CompanyGovernanceProposal) DataID(key string) (uint64, error) {
+	value, ok := p.ProposalData[key]
+	if !ok {
+		return 0, ErrInvalidProposalContent.Wrapf("proposal %d has no %s", p.ProposalID, key)
+	}
+
+	var id uint64
+	switch v := value.(type) {
+	case float64:
+		if v >= 1 && v < 1<<53 && v == float64(uint64(v)) {
+			id = uint64(v)
+		}
+	case uint64:
+		id = v
+	case int:
+		if v > 0 {
+			id = uint64(v)
+		}
+	case json.Number:
+		parsed, err := strconv.ParseUint(v.String(), 10, 64)
+		if err != nil {
+			return 0, ErrInvalidProposalContent.Wrapf("%s: %s", key, err)
+		}
+		id = parsed
+	case string:
+		parsed, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return 0, ErrInvalidProposalContent.Wrapf("%s: %s", key, err)
+		}
+		id = parsed
+	}
+	if id == 0 {
+		return 0, ErrInvalidProposalContent.Wrapf("%s must be a positive integer", key)
+	}
+	return id, nil
+}
+
+// VoteDelegation is the stored form of the delegations liquid delegations
+// replaced; it is only read by the version 2 store migration
 type VoteDelegation struct {
 	Delegator     string             `json:"delegator"`
 	Delegate      string             `json:"delegate"`
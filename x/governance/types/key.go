@@ -41,6 +41,8 @@ var (
 	// Proposal execution
 	ExecutionPrefix            = []byte{0x40}
 	ExecutionQueuePrefix       = []byte{0x41}
+	GuardianCouncilKey         = []byte{0x42}
+	OptimisticProposalPrefix   = []byte{0x43}
 	
 	// Indexing and queries
 	ProposalByStatusPrefix     = []byte{0x50}
@@ -50,17 +52,27 @@ var (
 	// Company and validator specific
 	CompanyProposalPrefix      = []byte{0x60}
 	ValidatorProposalPrefix    = []byte{0x61}
+	BoardResolutionPrefix      = []byte{0x62}
+	MeetingPrefix              = []byte{0x63}
+	MeetingIDCounterKey        = []byte{0x64}
+	ProxyCardPrefix            = []byte{0x65}
+	MeetingAttendancePrefix    = []byte{0x66}
 	
 	// Emergency proposals
 	EmergencyProposalPrefix    = []byte{0x70}
 	EmergencyTypeIndexPrefix   = []byte{0x71}
 	EmergencySeverityPrefix    = []byte{0x72}
 	
-	// Delegation
+	// Delegation (0x80-0x83 hold pre-liquid delegations until migrated)
 	DelegationPrefix           = []byte{0x80}
 	DelegateIndexPrefix        = []byte{0x81}
 	DelegatorIndexPrefix       = []byte{0x82}
 	CompanyDelegationPrefix    = []byte{0x83}
+	LiquidDelegationPrefix     = []byte{0x84}
+	LiquidDelegateIndexPrefix  = []byte{0x85}
+	DelegateProfilePrefix      = []byte{0x86}
+	DelegatedVotePrefix        = []byte{0x87}
+	DelegateVoteRecordPrefix   = []byte{0x88}
 	
 	// Statistics and analytics
 	GovernanceStatsPrefix      = []byte{0x90}
@@ -158,6 +170,22 @@ func ExecutionKey(proposalID uint64) []byte {
 	return key
 }
 
+// ExecutionQueueKey returns the store key for a passed proposal waiting out its timelock
+func ExecutionQueueKey(proposalID uint64) []byte {
+	key := make([]byte, len(ExecutionQueuePrefix)+8)
+	copy(key, ExecutionQueuePrefix)
+	binary.BigEndian.PutUint64(key[len(ExecutionQueuePrefix):], proposalID)
+	return key
+}
+
+// OptimisticProposalKey returns the store key for an optimistic proposal in its challenge window
+func OptimisticProposalKey(proposalID uint64) []byte {
+	key := make([]byte, len(OptimisticProposalPrefix)+8)
+	copy(key, OptimisticProposalPrefix)
+	binary.BigEndian.PutUint64(key[len(OptimisticProposalPrefix):], proposalID)
+	return key
+}
+
 // ProposalByStatusKey returns the store key for indexing proposals by status
 func ProposalByStatusKey(status string, proposalID uint64) []byte {
 	statusBytes := []byte(status)
@@ -198,6 +226,44 @@ func CompanyProposalKey(companyID uint64, proposalID uint64) []byte {
 	return key
 }
 
+// BoardResolutionKey returns the store key for the board resolution on a company proposal
+func BoardResolutionKey(proposalID uint64) []byte {
+	key := make([]byte, len(BoardResolutionPrefix)+8)
+	copy(key, BoardResolutionPrefix)
+	binary.BigEndian.PutUint64(key[len(BoardResolutionPrefix):], proposalID)
+	return key
+}
+
+// MeetingKey returns the store key for a shareholder meeting
+func MeetingKey(meetingID uint64) []byte {
+	key := make([]byte, len(MeetingPrefix)+8)
+	copy(key, MeetingPrefix)
+	binary.BigEndian.PutUint64(key[len(MeetingPrefix):], meetingID)
+	return key
+}
+
+// ProxyCardKey returns the store key for a shareholder's proxy card at a meeting
+func ProxyCardKey(meetingID uint64, shareholder string) []byte {
+	return append(MeetingProxyCardsPrefix(meetingID), []byte(shareholder)...)
+}
+
+// MeetingProxyCardsPrefix returns the prefix for all proxy cards at a meeting
+func MeetingProxyCardsPrefix(meetingID uint64) []byte {
+	key := make([]byte, len(ProxyCardPrefix)+8)
+	copy(key, ProxyCardPrefix)
+	binary.BigEndian.PutUint64(key[len(ProxyCardPrefix):], meetingID)
+	return key
+}
+
+// MeetingAttendanceKey returns the key marking that a holder's power was voted at a meeting
+func MeetingAttendanceKey(meetingID uint64, holder string) []byte {
+	key := make([]byte, len(MeetingAttendancePrefix)+8+len(holder))
+	copy(key, MeetingAttendancePrefix)
+	binary.BigEndian.PutUint64(key[len(MeetingAttendancePrefix):], meetingID)
+	copy(key[len(MeetingAttendancePrefix)+8:], holder)
+	return key
+}
+
 // ValidatorProposalKey returns the store key for validator-specific proposals
 func ValidatorProposalKey(validatorAddr []byte, proposalID uint64) []byte {
 	key := make([]byte, len(ValidatorProposalPrefix)+len(validatorAddr)+8)
@@ -392,6 +458,81 @@ func CompanyDelegationKey(companyID uint64, proposalType uint32, delegator strin
 	return key
 }
 
+// delegationScopeBytes encodes a liquid delegation scope: company, an
+// all-types flag, then the proposal type
+func delegationScopeBytes(companyID uint64, allTypes bool, proposalType uint32) []byte {
+	bz := make([]byte, 8+1+4)
+	binary.BigEndian.PutUint64(bz, companyID)
+	if allTypes {
+		bz[8] = 0x01
+	}
+	binary.BigEndian.PutUint32(bz[9:], proposalType)
+	return bz
+}
+
+// LiquidDelegationKey returns the store key for a delegator's liquid delegation in one scope
+func LiquidDelegationKey(delegator string, companyID uint64, allTypes bool, proposalType uint32) []byte {
+	return append(LiquidDelegationsByDelegatorKey(delegator), delegationScopeBytes(companyID, allTypes, proposalType)...)
+}
+
+// LiquidDelegationsByDelegatorKey returns the prefix for all of a delegator's liquid delegations
+func LiquidDelegationsByDelegatorKey(delegator string) []byte {
+	key := make([]byte, 0, len(LiquidDelegationPrefix)+len(delegator)+1)
+	key = append(key, LiquidDelegationPrefix...)
+	key = append(key, []byte(delegator)...)
+	return append(key, 0x00) // separator
+}
+
+// LiquidDelegateIndexKey returns the index key for liquid delegations by delegate
+func LiquidDelegateIndexKey(delegate, delegator string, companyID uint64, allTypes bool, proposalType uint32) []byte {
+	key := LiquidDelegationsToDelegateKey(delegate)
+	key = append(key, []byte(delegator)...)
+	key = append(key, 0x00) // separator
+	return append(key, delegationScopeBytes(companyID, allTypes, proposalType)...)
+}
+
+// LiquidDelegationsToDelegateKey returns the prefix for all liquid delegations to a delegate
+func LiquidDelegationsToDelegateKey(delegate string) []byte {
+	key := make([]byte, 0, len(LiquidDelegateIndexPrefix)+len(delegate)+1)
+	key = append(key, LiquidDelegateIndexPrefix...)
+	key = append(key, []byte(delegate)...)
+	return append(key, 0x00) // separator
+}
+
+// DelegateProfileKey returns the store key for a registered delegate's profile
+func DelegateProfileKey(delegate string) []byte {
+	return append(append([]byte{}, DelegateProfilePrefix...), []byte(delegate)...)
+}
+
+// DelegatedVoteKey returns the store key for a delegator's power cast by a delegate on a proposal
+func DelegatedVoteKey(proposalID uint64, delegator string) []byte {
+	return append(DelegatedVotesByProposalKey(proposalID), []byte(delegator)...)
+}
+
+// DelegatedVotesByProposalKey returns the prefix for all delegated votes on a proposal
+func DelegatedVotesByProposalKey(proposalID uint64) []byte {
+	key := make([]byte, len(DelegatedVotePrefix)+8)
+	copy(key, DelegatedVotePrefix)
+	binary.BigEndian.PutUint64(key[len(DelegatedVotePrefix):], proposalID)
+	return key
+}
+
+// DelegateVoteRecordKey returns the store key for a delegate's voting record entry on a proposal
+func DelegateVoteRecordKey(delegate string, proposalID uint64) []byte {
+	key := DelegateVoteRecordsKey(delegate)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, proposalID)
+	return append(key, bz...)
+}
+
+// DelegateVoteRecordsKey returns the prefix for a delegate's voting record
+func DelegateVoteRecordsKey(delegate string) []byte {
+	key := make([]byte, 0, len(DelegateVoteRecordPrefix)+len(delegate)+1)
+	key = append(key, DelegateVoteRecordPrefix...)
+	key = append(key, []byte(delegate)...)
+	return append(key, 0x00) // separator
+}
+
 // Statistics key functions
 
 // GovernanceStatsKey returns the store key for governance statistics
@@ -0,0 +1,161 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// Liquid delegation limits
+const (
+	// MaxDelegationDepth is the longest chain of delegations a holder's power
+	// travels before it is dropped (holder -> A -> B -> C -> D)
+	MaxDelegationDepth = 4
+
+	MaxDelegateNameLength      = 64
+	MaxDelegateStatementLength = 2000
+	MaxDelegateTopics          = 16
+)
+
+// LiquidDelegation has a holder follow a registered delegate's votes within a
+// scope. The scope is a proposal type, a company, or both: a delegation with
+// CompanyID 0 covers proposals not tied to a company, and AllProposalTypes
+// covers every proposal type in the company scope. The delegation carries the
+// holder's full power at the time the delegate votes, never a fixed amount.
+type LiquidDelegation struct {
+	Delegator        string       `json:"delegator"`
+	Delegate         string       `json:"delegate"`
+	CompanyID        uint64       `json:"company_id,omitempty"`
+	ProposalType     ProposalType `json:"proposal_type"`
+	AllProposalTypes bool         `json:"all_proposal_types,omitempty"`
+	ExpiryHeight     uint64       `json:"expiry_height,omitempty"` // 0 = never expires
+	CreatedAt        time.Time    `json:"created_at"`
+}
+
+// IsActive reports whether the delegation has not expired at a block height
+func (d LiquidDelegation) IsActive(height int64) bool {
+	return d.ExpiryHeight == 0 || uint64(height) <= d.ExpiryHeight
+}
+
+// Scope describes the delegation's scope for events and queries
+func (d LiquidDelegation) Scope() string {
+	types := d.ProposalType.String()
+	if d.AllProposalTypes {
+		types = "all"
+	}
+	if d.CompanyID == 0 {
+		return types
+	}
+	return fmt.Sprintf("company:%d/%s", d.CompanyID, types)
+}
+
+// ScopeProposalTypes returns the proposal types the delegation covers
+func (d LiquidDelegation) ScopeProposalTypes() []ProposalType {
+	if d.AllProposalTypes {
+		return AllProposalTypes()
+	}
+	return []ProposalType{d.ProposalType}
+}
+
+// AllProposalTypes returns every proposal type, by value
+func AllProposalTypes() []ProposalType {
+	return []ProposalType{
+		ProposalTypeText,
+		ProposalTypeParameterChange,
+		ProposalTypeSoftwareUpgrade,
+		ProposalTypeCommunityPoolSpend,
+		ProposalTypeCompanyGovernance,
+		ProposalTypeValidatorTierChange,
+		ProposalTypeListingRequirement,
+		ProposalTypeTradingHalt,
+		ProposalTypeEmergencyAction,
+		ProposalTypeSetCharityWallet,
+		ProposalTypeCompanyListing,
+		ProposalTypeCompanyDelisting,
+		ProposalTypeCompanyParameter,
+		ProposalTypeValidatorPromotion,
+		ProposalTypeValidatorDemotion,
+		ProposalTypeValidatorRemoval,
+		ProposalTypeProtocolParameter,
+		ProposalTypeProtocolUpgrade,
+		ProposalTypeTreasurySpend,
+		ProposalTypeFeeAbstractionParams,
+		ProposalTypeTreasuryGrant,
+		ProposalTypeTreasuryFunding,
+	}
+}
+
+// DelegateProfile is a registered delegate's public profile. Holders may only
+// follow registered delegates, so every delegate has a statement and a
+// queryable voting record.
+type DelegateProfile struct {
+	Address      string         `json:"address"`
+	Name         string         `json:"name"`
+	Statement    string         `json:"statement"`
+	Topics       []ProposalType `json:"topics,omitempty"`      // Proposal types the delegate covers
+	CompanyIDs   []uint64       `json:"company_ids,omitempty"` // Companies the delegate follows
+	RegisteredAt time.Time      `json:"registered_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+// ValidateDelegateProfile checks the fields a delegate registers
+func ValidateDelegateProfile(name, statement string, topics []ProposalType) error {
+	if name == "" || len(name) > MaxDelegateNameLength {
+		return fmt.Errorf("name must be between 1 and %d characters", MaxDelegateNameLength)
+	}
+	if len(statement) > MaxDelegateStatementLength {
+		return fmt.Errorf("statement exceeds %d characters", MaxDelegateStatementLength)
+	}
+	if len(topics) > MaxDelegateTopics {
+		return fmt.Errorf("at most %d topics", MaxDelegateTopics)
+	}
+	seen := make(map[ProposalType]bool, len(topics))
+	for _, t := range topics {
+		if t.String() == "unknown" {
+			return fmt.Errorf("unknown proposal type %d", t)
+		}
+		if seen[t] {
+			return fmt.Errorf("duplicate topic %s", t)
+		}
+		seen[t] = true
+	}
+	return nil
+}
+
+// DelegatedVote is a holder's power cast on a proposal by the first delegate
+// up their delegation chain who voted. Path lists the holder and every
+// delegate the power passed through before reaching CastBy; if any of them
+// votes directly, the power is taken back from CastBy for this proposal only.
+type DelegatedVote struct {
+	ProposalID  uint64               `json:"proposal_id"`
+	Delegator   string               `json:"delegator"`
+	CastBy      string               `json:"cast_by"`
+	Path        []string             `json:"path"`
+	Options     []WeightedVoteOption `json:"options"`
+	VotingPower math.Int             `json:"voting_power"`
+	CastAt      time.Time            `json:"cast_at"`
+}
+
+// PassesThrough reports whether the power passed through an address on its way to CastBy
+func (v DelegatedVote) PassesThrough(addr string) bool {
+	for _, p := range v.Path {
+		if p == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// DelegateVoteRecord is one entry in a registered delegate's voting record
+type DelegateVoteRecord struct {
+	ProposalID     uint64               `json:"proposal_id"`
+	ProposalType   ProposalType         `json:"proposal_type"`
+	CompanyID      uint64               `json:"company_id,omitempty"`
+	Title          string               `json:"title"`
+	Options        []WeightedVoteOption `json:"options"`
+	OwnPower       math.Int             `json:"own_power"`
+	DelegatedPower math.Int             `json:"delegated_power"` // Net of power later taken back by direct votes
+	Delegators     uint32               `json:"delegators"`
+	VotedAt        time.Time            `json:"voted_at"`
+}
@@ -3,9 +3,12 @@ package types
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	equitytypes "github.com/sharehodl/sharehodl-blockchain/x/equity/types"
 )
 
 // Message types for governance module
@@ -18,6 +21,23 @@ type MsgServer interface {
 	Deposit(ctx context.Context, msg *MsgDeposit) (*MsgDepositResponse, error)
 	CancelProposal(ctx context.Context, msg *MsgCancelProposal) (*MsgCancelProposalResponse, error)
 	SetGovernanceParams(ctx context.Context, msg *MsgSetGovernanceParams) (*MsgSetGovernanceParamsResponse, error)
+	SubmitCompanyGovernanceProposal(ctx context.Context, msg *MsgSubmitCompanyGovernanceProposal) (*MsgSubmitCompanyGovernanceProposalResponse, error)
+	SignBoardResolution(ctx context.Context, msg *MsgSignBoardResolution) (*MsgSignBoardResolutionResponse, error)
+	CastBoardBallot(ctx context.Context, msg *MsgCastBoardBallot) (*MsgCastBoardBallotResponse, error)
+	ScheduleMeeting(ctx context.Context, msg *MsgScheduleMeeting) (*MsgScheduleMeetingResponse, error)
+	AppointProxy(ctx context.Context, msg *MsgAppointProxy) (*MsgAppointProxyResponse, error)
+	RevokeProxy(ctx context.Context, msg *MsgRevokeProxy) (*MsgRevokeProxyResponse, error)
+	CastMeetingBallot(ctx context.Context, msg *MsgCastMeetingBallot) (*MsgCastMeetingBallotResponse, error)
+	RecordMeetingMinutes(ctx context.Context, msg *MsgRecordMeetingMinutes) (*MsgRecordMeetingMinutesResponse, error)
+	SetGuardianCouncil(ctx context.Context, msg *MsgSetGuardianCouncil) (*MsgSetGuardianCouncilResponse, error)
+	GuardianVeto(ctx context.Context, msg *MsgGuardianVeto) (*MsgGuardianVetoResponse, error)
+	ProposeExecutionVeto(ctx context.Context, msg *MsgProposeExecutionVeto) (*MsgProposeExecutionVetoResponse, error)
+	RegisterDelegate(ctx context.Context, msg *MsgRegisterDelegate) (*MsgRegisterDelegateResponse, error)
+	DelegateVotes(ctx context.Context, msg *MsgDelegateVotes) (*MsgDelegateVotesResponse, error)
+	UndelegateVotes(ctx context.Context, msg *MsgUndelegateVotes) (*MsgUndelegateVotesResponse, error)
+	SubmitOptimisticProposal(ctx context.Context, msg *MsgSubmitOptimisticProposal) (*MsgSubmitOptimisticProposalResponse, error)
+	ObjectOptimisticProposal(ctx context.Context, msg *MsgObjectOptimisticProposal) (*MsgObjectOptimisticProposalResponse, error)
+	SubmitSignedVotes(ctx context.Context, msg *MsgSubmitSignedVotes) (*MsgSubmitSignedVotesResponse, error)
 }
 
 // MsgSetGovernanceParams defines a message to update governance parameters
@@ -55,6 +75,7 @@ type MsgSubmitProposal struct {
 	Type           ProposalType   `json:"type"`
 	InitialDeposit sdk.Coins      `json:"initial_deposit"`
 	Content        interface{}    `json:"content,omitempty"`
+	Messages       []ProposalMessage `json:"messages,omitempty"` // Any-packed messages signed by the governance authority
 }
 
 func (msg MsgSubmitProposal) Route() string { return ModuleName }
@@ -72,6 +93,9 @@ func (msg MsgSubmitProposal) ValidateBasic() error {
 	if !msg.InitialDeposit.IsValid() {
 		return fmt.Errorf("invalid initial deposit")
 	}
+	if err := ValidateProposalMessagesBasic(msg.Type, msg.Messages); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -230,3 +254,642 @@ func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
 
 // MsgCancelProposalResponse is the response for canceling
 type MsgCancelProposalResponse struct{}
+
+// MsgSubmitCompanyGovernanceProposal defines a message to submit a shareholder proposal for a company.
+// Board elections carry the seats being filled and the nominated candidates.
+type MsgSubmitCompanyGovernanceProposal struct {
+	Proposer    string                     `json:"proposer"`
+	CompanyID   uint64                     `json:"company_id"`
+	Type        CompanyProposalType        `json:"type"`
+	Title       string                     `json:"title"`
+	Description string                     `json:"description"`
+	Seats       uint32                     `json:"seats,omitempty"`
+	Nominees    []equitytypes.BoardNominee `json:"nominees,omitempty"`
+}
+
+func (msg MsgSubmitCompanyGovernanceProposal) Route() string { return ModuleName }
+func (msg MsgSubmitCompanyGovernanceProposal) Type_() string { return "submit_company_governance_proposal" }
+func (msg MsgSubmitCompanyGovernanceProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return fmt.Errorf("invalid proposer address: %v", err)
+	}
+	if msg.CompanyID == 0 {
+		return fmt.Errorf("invalid company id")
+	}
+	if msg.Title == "" {
+		return fmt.Errorf("proposal title cannot be empty")
+	}
+	if msg.Description == "" {
+		return fmt.Errorf("proposal description cannot be empty")
+	}
+	if msg.Type == CompanyProposalTypeBoardElection {
+		if msg.Seats == 0 {
+			return ErrInvalidBoardElection.Wrap("election must fill at least one seat")
+		}
+		if err := equitytypes.ValidateBoardNominees(msg.Nominees); err != nil {
+			return ErrInvalidBoardElection.Wrap(err.Error())
+		}
+	} else if len(msg.Nominees) > 0 {
+		return ErrInvalidBoardElection.Wrap("only board elections nominate candidates")
+	}
+	return nil
+}
+
+func (msg MsgSubmitCompanyGovernanceProposal) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgSubmitCompanyGovernanceProposal) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgSubmitCompanyGovernanceProposalResponse is the response for submitting a company proposal
+type MsgSubmitCompanyGovernanceProposalResponse struct {
+	ProposalID uint64 `json:"proposal_id"`
+}
+
+// MsgSignBoardResolution defines a message for a director to sign the board
+// resolution on a board-gated company proposal
+type MsgSignBoardResolution struct {
+	ProposalID uint64 `json:"proposal_id"`
+	Director   string `json:"director"`
+}
+
+func (msg MsgSignBoardResolution) Route() string { return ModuleName }
+func (msg MsgSignBoardResolution) Type_() string { return "sign_board_resolution" }
+func (msg MsgSignBoardResolution) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Director); err != nil {
+		return fmt.Errorf("invalid director address: %v", err)
+	}
+	if msg.ProposalID == 0 {
+		return fmt.Errorf("invalid proposal id")
+	}
+	return nil
+}
+
+func (msg MsgSignBoardResolution) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgSignBoardResolution) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Director)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgSignBoardResolutionResponse is the response for signing a board resolution
+type MsgSignBoardResolutionResponse struct {
+	Approved bool `json:"approved"` // Resolution reached its threshold and the shareholder vote opened
+}
+
+// MsgCastBoardBallot defines a message to allocate votes to candidates in a board election
+type MsgCastBoardBallot struct {
+	ProposalID uint64                         `json:"proposal_id"`
+	Voter      string                         `json:"voter"`
+	Votes      []equitytypes.BoardBallotEntry `json:"votes"`
+}
+
+func (msg MsgCastBoardBallot) Route() string { return ModuleName }
+func (msg MsgCastBoardBallot) Type_() string { return "cast_board_ballot" }
+func (msg MsgCastBoardBallot) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return fmt.Errorf("invalid voter address: %v", err)
+	}
+	if msg.ProposalID == 0 {
+		return fmt.Errorf("invalid proposal id")
+	}
+	if len(msg.Votes) == 0 {
+		return ErrInvalidBoardElection.Wrap("ballot is empty")
+	}
+	return nil
+}
+
+func (msg MsgCastBoardBallot) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgCastBoardBallot) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgCastBoardBallotResponse is the response for casting a board election ballot
+type MsgCastBoardBallotResponse struct{}
+
+// MsgScheduleMeeting defines a message to give notice of a shareholder meeting
+// and put its agenda of resolutions and board elections to shareholders
+type MsgScheduleMeeting struct {
+	Convener   string              `json:"convener"`
+	CompanyID  uint64              `json:"company_id"`
+	Title      string              `json:"title"`
+	RecordDate time.Time           `json:"record_date"`
+	OpensAt    time.Time           `json:"opens_at"`
+	ClosesAt   time.Time           `json:"closes_at"`
+	Quorum     math.LegacyDec      `json:"quorum"` // Zero for the default meeting quorum
+	Agenda     []MeetingAgendaItem `json:"agenda"`
+}
+
+func (msg MsgScheduleMeeting) Route() string { return ModuleName }
+func (msg MsgScheduleMeeting) Type_() string { return "schedule_meeting" }
+func (msg MsgScheduleMeeting) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Convener); err != nil {
+		return fmt.Errorf("invalid convener address: %v", err)
+	}
+	if msg.CompanyID == 0 {
+		return fmt.Errorf("invalid company id")
+	}
+	if msg.Title == "" {
+		return fmt.Errorf("meeting title cannot be empty")
+	}
+	if len(msg.Agenda) == 0 || len(msg.Agenda) > MaxMeetingAgendaItems {
+		return ErrInvalidMeeting.Wrapf("agenda must have between 1 and %d items", MaxMeetingAgendaItems)
+	}
+	for _, item := range msg.Agenda {
+		if err := item.Validate(); err != nil {
+			return ErrInvalidMeeting.Wrap(err.Error())
+		}
+	}
+	if !msg.Quorum.IsNil() && (msg.Quorum.IsNegative() || msg.Quorum.GT(math.LegacyOneDec())) {
+		return ErrInvalidMeeting.Wrap("quorum must be between 0 and 1")
+	}
+	return nil
+}
+
+func (msg MsgScheduleMeeting) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgScheduleMeeting) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Convener)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgScheduleMeetingResponse is the response for scheduling a shareholder meeting
+type MsgScheduleMeetingResponse struct {
+	MeetingID uint64 `json:"meeting_id"`
+}
+
+// MsgAppointProxy defines a message for a shareholder to appoint a proxy for a meeting
+type MsgAppointProxy struct {
+	MeetingID   uint64       `json:"meeting_id"`
+	Shareholder string       `json:"shareholder"`
+	Proxy       string       `json:"proxy"`
+	Directions  []AgendaVote `json:"directions,omitempty"` // Binding instructions on particular resolutions
+}
+
+func (msg MsgAppointProxy) Route() string { return ModuleName }
+func (msg MsgAppointProxy) Type_() string { return "appoint_proxy" }
+func (msg MsgAppointProxy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Shareholder); err != nil {
+		return fmt.Errorf("invalid shareholder address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Proxy); err != nil {
+		return fmt.Errorf("invalid proxy address: %v", err)
+	}
+	if msg.Shareholder == msg.Proxy {
+		return fmt.Errorf("shareholder cannot appoint themselves as proxy")
+	}
+	if msg.MeetingID == 0 {
+		return fmt.Errorf("invalid meeting id")
+	}
+	return nil
+}
+
+func (msg MsgAppointProxy) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgAppointProxy) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Shareholder)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgAppointProxyResponse is the response for appointing a proxy
+type MsgAppointProxyResponse struct{}
+
+// MsgRevokeProxy defines a message for a shareholder to withdraw a proxy appointment
+type MsgRevokeProxy struct {
+	MeetingID   uint64 `json:"meeting_id"`
+	Shareholder string `json:"shareholder"`
+}
+
+func (msg MsgRevokeProxy) Route() string { return ModuleName }
+func (msg MsgRevokeProxy) Type_() string { return "revoke_proxy" }
+func (msg MsgRevokeProxy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Shareholder); err != nil {
+		return fmt.Errorf("invalid shareholder address: %v", err)
+	}
+	if msg.MeetingID == 0 {
+		return fmt.Errorf("invalid meeting id")
+	}
+	return nil
+}
+
+func (msg MsgRevokeProxy) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgRevokeProxy) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Shareholder)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgRevokeProxyResponse is the response for revoking a proxy
+type MsgRevokeProxyResponse struct{}
+
+// MsgCastMeetingBallot defines a message to vote every agenda item of a meeting,
+// in person and for any shareholders the voter holds proxies for
+type MsgCastMeetingBallot struct {
+	MeetingID uint64       `json:"meeting_id"`
+	Voter     string       `json:"voter"`
+	Votes     []AgendaVote `json:"votes"`
+}
+
+func (msg MsgCastMeetingBallot) Route() string { return ModuleName }
+func (msg MsgCastMeetingBallot) Type_() string { return "cast_meeting_ballot" }
+func (msg MsgCastMeetingBallot) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return fmt.Errorf("invalid voter address: %v", err)
+	}
+	if msg.MeetingID == 0 {
+		return fmt.Errorf("invalid meeting id")
+	}
+	if len(msg.Votes) == 0 {
+		return ErrInvalidMeetingBallot.Wrap("ballot is empty")
+	}
+	return nil
+}
+
+func (msg MsgCastMeetingBallot) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgCastMeetingBallot) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgCastMeetingBallotResponse is the response for casting a meeting ballot
+type MsgCastMeetingBallotResponse struct{}
+
+// MsgRecordMeetingMinutes defines a message to record the content hash of a
+// closed meeting's minutes
+type MsgRecordMeetingMinutes struct {
+	MeetingID   uint64 `json:"meeting_id"`
+	Recorder    string `json:"recorder"`
+	MinutesHash string `json:"minutes_hash"` // Hex-encoded SHA-256 of the signed minutes
+}
+
+func (msg MsgRecordMeetingMinutes) Route() string { return ModuleName }
+func (msg MsgRecordMeetingMinutes) Type_() string { return "record_meeting_minutes" }
+func (msg MsgRecordMeetingMinutes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Recorder); err != nil {
+		return fmt.Errorf("invalid recorder address: %v", err)
+	}
+	if msg.MeetingID == 0 {
+		return fmt.Errorf("invalid meeting id")
+	}
+	if err := ValidateMinutesHash(msg.MinutesHash); err != nil {
+		return ErrInvalidMeeting.Wrap(err.Error())
+	}
+	return nil
+}
+
+func (msg MsgRecordMeetingMinutes) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgRecordMeetingMinutes) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Recorder)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgRecordMeetingMinutesResponse is the response for recording meeting minutes
+type MsgRecordMeetingMinutesResponse struct{}
+
+// MsgSetGuardianCouncil defines a message to install an elected guardian council (authority only)
+type MsgSetGuardianCouncil struct {
+	Authority     string   `json:"authority"`
+	Members       []string `json:"members"`
+	VetoThreshold uint32   `json:"veto_threshold"` // Guardian signatures needed to veto a queued execution
+}
+
+func (msg MsgSetGuardianCouncil) Route() string { return ModuleName }
+func (msg MsgSetGuardianCouncil) Type_() string { return "set_guardian_council" }
+func (msg MsgSetGuardianCouncil) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %v", err)
+	}
+	if err := ValidateGuardianCouncil(msg.Members, msg.VetoThreshold); err != nil {
+		return ErrInvalidGuardianCouncil.Wrap(err.Error())
+	}
+	return nil
+}
+
+func (msg MsgSetGuardianCouncil) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgSetGuardianCouncil) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgSetGuardianCouncilResponse is the response for installing a guardian council
+type MsgSetGuardianCouncilResponse struct{}
+
+// MsgGuardianVeto defines a message for a guardian to sign a veto of a queued execution
+type MsgGuardianVeto struct {
+	Guardian   string `json:"guardian"`
+	ProposalID uint64 `json:"proposal_id"`
+	Reason     string `json:"reason"`
+}
+
+func (msg MsgGuardianVeto) Route() string { return ModuleName }
+func (msg MsgGuardianVeto) Type_() string { return "guardian_veto" }
+func (msg MsgGuardianVeto) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Guardian); err != nil {
+		return fmt.Errorf("invalid guardian address: %v", err)
+	}
+	if msg.ProposalID == 0 {
+		return fmt.Errorf("invalid proposal id")
+	}
+	if msg.Reason == "" {
+		return fmt.Errorf("a veto must give a reason")
+	}
+	return nil
+}
+
+func (msg MsgGuardianVeto) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgGuardianVeto) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Guardian)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgGuardianVetoResponse is the response for signing a guardian veto
+type MsgGuardianVetoResponse struct {
+	Vetoed bool `json:"vetoed"` // True once the council's veto threshold is reached
+}
+
+// MsgProposeExecutionVeto defines a message to open an emergency vote vetoing a queued execution
+type MsgProposeExecutionVeto struct {
+	Proposer   string    `json:"proposer"`
+	ProposalID uint64    `json:"proposal_id"`
+	Reason     string    `json:"reason"`
+	Deposit    sdk.Coins `json:"deposit"` // Must cover the full minimum deposit
+}
+
+func (msg MsgProposeExecutionVeto) Route() string { return ModuleName }
+func (msg MsgProposeExecutionVeto) Type_() string { return "propose_execution_veto" }
+func (msg MsgProposeExecutionVeto) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return fmt.Errorf("invalid proposer address: %v", err)
+	}
+	if msg.ProposalID == 0 {
+		return fmt.Errorf("invalid proposal id")
+	}
+	if msg.Reason == "" {
+		return fmt.Errorf("a veto must give a reason")
+	}
+	if !msg.Deposit.IsValid() {
+		return fmt.Errorf("invalid deposit")
+	}
+	return nil
+}
+
+func (msg MsgProposeExecutionVeto) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgProposeExecutionVeto) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgProposeExecutionVetoResponse is the response for opening an emergency veto vote
+type MsgProposeExecutionVetoResponse struct {
+	VetoProposalID uint64 `json:"veto_proposal_id"`
+}
+
+// MsgRegisterDelegate defines a message to register or update a delegate profile
+type MsgRegisterDelegate struct {
+	Delegate   string         `json:"delegate"`
+	Name       string         `json:"name"`
+	Statement  string         `json:"statement"`
+	Topics     []ProposalType `json:"topics,omitempty"`
+	CompanyIDs []uint64       `json:"company_ids,omitempty"`
+}
+
+func (msg MsgRegisterDelegate) Route() string { return ModuleName }
+func (msg MsgRegisterDelegate) Type_() string { return "register_delegate" }
+func (msg MsgRegisterDelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Delegate); err != nil {
+		return fmt.Errorf("invalid delegate address: %v", err)
+	}
+	return ValidateDelegateProfile(msg.Name, msg.Statement, msg.Topics)
+}
+
+func (msg MsgRegisterDelegate) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgRegisterDelegate) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Delegate)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgRegisterDelegateResponse is the response for registering a delegate
+type MsgRegisterDelegateResponse struct{}
+
+// MsgDelegateVotes defines a message for a holder to follow a delegate within a scope
+type MsgDelegateVotes struct {
+	Delegator        string       `json:"delegator"`
+	Delegate         string       `json:"delegate"`
+	CompanyID        uint64       `json:"company_id,omitempty"` // 0 = proposals not tied to a company
+	ProposalType     ProposalType `json:"proposal_type"`
+	AllProposalTypes bool         `json:"all_proposal_types,omitempty"`
+	ExpiryHeight     uint64       `json:"expiry_height,omitempty"`
+}
+
+func (msg MsgDelegateVotes) Route() string { return ModuleName }
+func (msg MsgDelegateVotes) Type_() string { return "delegate_votes" }
+func (msg MsgDelegateVotes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Delegator); err != nil {
+		return fmt.Errorf("invalid delegator address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Delegate); err != nil {
+		return fmt.Errorf("invalid delegate address: %v", err)
+	}
+	if msg.Delegator == msg.Delegate {
+		return fmt.Errorf("cannot delegate to yourself")
+	}
+	if !msg.AllProposalTypes && msg.ProposalType.String() == "unknown" {
+		return fmt.Errorf("unknown proposal type %d", msg.ProposalType)
+	}
+	return nil
+}
+
+func (msg MsgDelegateVotes) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgDelegateVotes) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Delegator)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgDelegateVotesResponse is the response for delegating votes
+type MsgDelegateVotesResponse struct{}
+
+// MsgUndelegateVotes defines a message for a holder to stop following a delegate within a scope
+type MsgUndelegateVotes struct {
+	Delegator        string       `json:"delegator"`
+	CompanyID        uint64       `json:"company_id,omitempty"`
+	ProposalType     ProposalType `json:"proposal_type"`
+	AllProposalTypes bool         `json:"all_proposal_types,omitempty"`
+}
+
+func (msg MsgUndelegateVotes) Route() string { return ModuleName }
+func (msg MsgUndelegateVotes) Type_() string { return "undelegate_votes" }
+func (msg MsgUndelegateVotes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Delegator); err != nil {
+		return fmt.Errorf("invalid delegator address: %v", err)
+	}
+	return nil
+}
+
+func (msg MsgUndelegateVotes) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgUndelegateVotes) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Delegator)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgUndelegateVotesResponse is the response for undelegating votes
+type MsgUndelegateVotesResponse struct{}
+
+// MsgSubmitOptimisticProposal defines a message to submit an allowlisted parameter
+// change that passes after a challenge window unless enough stake objects
+type MsgSubmitOptimisticProposal struct {
+	Proposer    string            `json:"proposer"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Messages    []ProposalMessage `json:"messages"`
+	Deposit     sdk.Coins         `json:"deposit"`
+}
+
+func (msg MsgSubmitOptimisticProposal) Route() string { return ModuleName }
+func (msg MsgSubmitOptimisticProposal) Type_() string { return "submit_optimistic_proposal" }
+func (msg MsgSubmitOptimisticProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return fmt.Errorf("invalid proposer address: %v", err)
+	}
+	if msg.Title == "" {
+		return fmt.Errorf("proposal title cannot be empty")
+	}
+	if msg.Description == "" {
+		return fmt.Errorf("proposal description cannot be empty")
+	}
+	if len(msg.Messages) == 0 {
+		return fmt.Errorf("optimistic proposals must execute at least one message")
+	}
+	if !msg.Deposit.IsValid() || msg.Deposit.AmountOf("uhodl").IsZero() {
+		return fmt.Errorf("invalid deposit")
+	}
+	return ValidateProposalMessagesBasic(ProposalTypeParameterChange, msg.Messages)
+}
+
+func (msg MsgSubmitOptimisticProposal) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgSubmitOptimisticProposal) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgSubmitOptimisticProposalResponse is the response for submitting an optimistic proposal
+type MsgSubmitOptimisticProposalResponse struct {
+	ProposalID uint64 `json:"proposal_id"`
+}
+
+// MsgObjectOptimisticProposal defines a message for a holder to object to an
+// optimistic proposal during its challenge window
+type MsgObjectOptimisticProposal struct {
+	Objector   string `json:"objector"`
+	ProposalID uint64 `json:"proposal_id"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+func (msg MsgObjectOptimisticProposal) Route() string { return ModuleName }
+func (msg MsgObjectOptimisticProposal) Type_() string { return "object_optimistic_proposal" }
+func (msg MsgObjectOptimisticProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Objector); err != nil {
+		return fmt.Errorf("invalid objector address: %v", err)
+	}
+	if msg.ProposalID == 0 {
+		return fmt.Errorf("proposal ID cannot be zero")
+	}
+	if len(msg.Reason) > 1000 {
+		return fmt.Errorf("reason exceeds 1000 characters")
+	}
+	return nil
+}
+
+func (msg MsgObjectOptimisticProposal) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgObjectOptimisticProposal) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Objector)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgObjectOptimisticProposalResponse is the response for objecting to an optimistic proposal
+type MsgObjectOptimisticProposalResponse struct {
+	Escalated bool `json:"escalated"`
+}
+
+// MsgSubmitSignedVotes defines a message for a relayer to submit votes and
+// meeting ballots that holders signed off-chain
+type MsgSubmitSignedVotes struct {
+	Relayer string                `json:"relayer"`
+	Votes   []SignedVote          `json:"votes,omitempty"`
+	Ballots []SignedMeetingBallot `json:"ballots,omitempty"`
+}
+
+func (msg MsgSubmitSignedVotes) Route() string { return ModuleName }
+func (msg MsgSubmitSignedVotes) Type_() string { return "submit_signed_votes" }
+func (msg MsgSubmitSignedVotes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Relayer); err != nil {
+		return fmt.Errorf("invalid relayer address: %v", err)
+	}
+	total := len(msg.Votes) + len(msg.Ballots)
+	if total == 0 {
+		return fmt.Errorf("batch has no signed votes")
+	}
+	if total > MaxSignedVotesPerBatch {
+		return fmt.Errorf("batch of %d exceeds the maximum of %d signed votes", total, MaxSignedVotesPerBatch)
+	}
+	return nil
+}
+
+func (msg MsgSubmitSignedVotes) GetSignBytes() []byte {
+	return []byte(fmt.Sprintf("%+v", msg))
+}
+
+func (msg MsgSubmitSignedVotes) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Relayer)
+	return []sdk.AccAddress{addr}
+}
+
+// MsgSubmitSignedVotesResponse is the response for submitting signed votes
+type MsgSubmitSignedVotesResponse struct {
+	Accepted uint32             `json:"accepted"`
+	Results  []SignedVoteResult `json:"results"`
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// OptimisticProposal is a low-risk parameter change in its challenge window.
+// It passes when the window closes unless objecting stake reaches the
+// optimistic veto threshold first, which escalates it to a full vote.
+type OptimisticProposal struct {
+	ProposalID       uint64      `json:"proposal_id"`
+	Title            string      `json:"title"`
+	SubmittedAt      time.Time   `json:"submitted_at"`
+	ChallengeEndTime time.Time   `json:"challenge_end_time"`
+	Objections       []Objection `json:"objections,omitempty"`
+	ObjectedPower    math.Int    `json:"objected_power"`
+}
+
+// Objection is one holder's stake objecting to an optimistic proposal
+type Objection struct {
+	Objector    string    `json:"objector"`
+	VotingPower math.Int  `json:"voting_power"`
+	Reason      string    `json:"reason"`
+	ObjectedAt  time.Time `json:"objected_at"`
+}
+
+// IsChallengeOpen reports whether objections are still accepted
+func (o OptimisticProposal) IsChallengeOpen(now time.Time) bool {
+	return now.Before(o.ChallengeEndTime)
+}
+
+// HasObjected reports whether an address has objected
+func (o OptimisticProposal) HasObjected(addr string) bool {
+	for _, obj := range o.Objections {
+		if obj.Objector == addr {
+			return true
+		}
+	}
+	return false
+}
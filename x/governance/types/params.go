@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"cosmossdk.io/math"
 )
@@ -48,8 +50,96 @@ const (
 
 	// Deposit period (days to reach minimum deposit)
 	DefaultDepositPeriodDays uint64 = 7 // 7 days to reach minimum deposit or proposal is deleted
+
+	// ==========================================================================
+	// VOTING MODE PARAMETERS
+	// ==========================================================================
+
+	// Quadratic voting Sybil resistance (staking reputation score, 0-100)
+	DefaultMinQuadraticReputation uint64 = 50 // Medium reputation or better to vote quadratically
+
+	// Conviction voting
+	DefaultConvictionPeriodDays                uint64 = 14    // Days a vote must be held to reach full conviction
+	DefaultMaxConvictionMultiplierBasisPoints uint64 = 30000 // 3x weight at full conviction
+
+	// ==========================================================================
+	// EXECUTION TIMELOCK PARAMETERS
+	// ==========================================================================
+
+	DefaultTimelockHours uint64 = 24       // Delay for passed protocol proposals without a type-specific delay
+	MaxTimelockHours     uint64 = 30 * 24  // Longest any passed proposal may be held before execution
+
+	// ==========================================================================
+	// OPTIMISTIC GOVERNANCE PARAMETERS
+	// ==========================================================================
+
+	DefaultOptimisticChallengeHours  uint64 = 72  // Window for stake to object before an optimistic proposal passes
+	MinOptimisticChallengeHours      uint64 = 24  // Shortest window; objectors need time to notice
+	DefaultOptimisticVetoBasisPoints uint64 = 400 // 4% of eligible stake objecting escalates to a full vote
 )
 
+// GovernanceMsgTypeURLPrefix prefixes the type URLs of governance's own messages,
+// which the optimistic allowlist may not contain
+const GovernanceMsgTypeURLPrefix = "/sharehodl.governance."
+
+// VotingMode determines how a voter's power is weighted on a proposal
+type VotingMode int32
+
+const (
+	VotingModeStandard   VotingMode = 0 // Stake weighted by staking tier
+	VotingModeQuadratic  VotingMode = 1 // Square root of snapshot power, reputation gated
+	VotingModeConviction VotingMode = 2 // Weighted by how long the vote is held, stake locked until finalized
+)
+
+// String returns the string representation of VotingMode
+func (vm VotingMode) String() string {
+	switch vm {
+	case VotingModeStandard:
+		return "standard"
+	case VotingModeQuadratic:
+		return "quadratic"
+	case VotingModeConviction:
+		return "conviction"
+	default:
+		return "unknown"
+	}
+}
+
+// ProposalVotingMode sets the voting mode for a proposal type
+type ProposalVotingMode struct {
+	ProposalType ProposalType `json:"proposal_type" yaml:"proposal_type"`
+	Mode         VotingMode   `json:"mode" yaml:"mode"`
+}
+
+// ProposalTimelock sets the execution delay for a proposal type
+type ProposalTimelock struct {
+	ProposalType ProposalType `json:"proposal_type" yaml:"proposal_type"`
+	DelayHours   uint64       `json:"delay_hours" yaml:"delay_hours"`
+}
+
+// DefaultProposalTimelocks returns the default per-type execution delays.
+// Changes users may want to exit ahead of wait longest.
+func DefaultProposalTimelocks() []ProposalTimelock {
+	return []ProposalTimelock{
+		{ProposalType: ProposalTypeParameterChange, DelayHours: 48},
+		{ProposalType: ProposalTypeProtocolParameter, DelayHours: 48},
+		{ProposalType: ProposalTypeSoftwareUpgrade, DelayHours: 72},
+		{ProposalType: ProposalTypeProtocolUpgrade, DelayHours: 72},
+		{ProposalType: ProposalTypeCommunityPoolSpend, DelayHours: 48},
+		{ProposalType: ProposalTypeTreasurySpend, DelayHours: 48},
+	}
+}
+
+// DefaultProposalVotingModes returns the default per-type voting modes.
+// Treasury spending is decided quadratically so large holders cannot outvote
+// the community on its own funds; every other type votes in standard mode.
+func DefaultProposalVotingModes() []ProposalVotingMode {
+	return []ProposalVotingMode{
+		{ProposalType: ProposalTypeCommunityPoolSpend, Mode: VotingModeQuadratic},
+		{ProposalType: ProposalTypeTreasurySpend, Mode: VotingModeQuadratic},
+	}
+}
+
 // ExtendedParams defines extended parameters for the governance module
 // ALL parameters are governance-controllable via validator voting
 // Note: This extends the existing GovernanceParams in governance.go
@@ -97,6 +187,39 @@ type ExtendedParams struct {
 
 	// Deposit period
 	DepositPeriodDays uint64 `json:"deposit_period_days" yaml:"deposit_period_days"` // Days to reach min deposit
+
+	// ==========================================================================
+	// VOTING MODES
+	// ==========================================================================
+
+	// Per proposal type; types not listed vote in standard mode
+	VotingModes []ProposalVotingMode `json:"voting_modes" yaml:"voting_modes"`
+
+	// Quadratic voting
+	MinQuadraticReputation uint64 `json:"min_quadratic_reputation" yaml:"min_quadratic_reputation"` // Minimum staking reputation to vote
+
+	// Conviction voting
+	ConvictionPeriodDays                uint64 `json:"conviction_period_days" yaml:"conviction_period_days"`                                 // Hold time for full conviction
+	MaxConvictionMultiplierBasisPoints uint64 `json:"max_conviction_multiplier_basis_points" yaml:"max_conviction_multiplier_basis_points"` // Weight at full conviction
+
+	// ==========================================================================
+	// EXECUTION TIMELOCK
+	// ==========================================================================
+
+	// Per proposal type; other protocol proposals wait DefaultTimelockHours
+	TimelockDelays       []ProposalTimelock `json:"timelock_delays" yaml:"timelock_delays"`
+	DefaultTimelockHours uint64             `json:"default_timelock_hours" yaml:"default_timelock_hours"`
+
+	// ==========================================================================
+	// OPTIMISTIC GOVERNANCE
+	// ==========================================================================
+
+	// Message type URLs of low-risk parameter updates that may skip the deposit
+	// and voting periods, such as /sharehodl.dex.v1.MsgSetFeeTier or
+	// /sharehodl.extbridge.v1.MsgSetRateLimit; empty disables the optimistic track
+	OptimisticAllowlist       []string `json:"optimistic_allowlist" yaml:"optimistic_allowlist"`
+	OptimisticChallengeHours  uint64   `json:"optimistic_challenge_hours" yaml:"optimistic_challenge_hours"`
+	OptimisticVetoBasisPoints uint64   `json:"optimistic_veto_basis_points" yaml:"optimistic_veto_basis_points"` // Objecting share of eligible stake that escalates to a vote
 }
 
 // ProtoMessage implements proto.Message interface
@@ -133,6 +256,18 @@ func DefaultExtendedParams() ExtendedParams {
 		ProposalCooldownHours: DefaultProposalCooldownHours,
 		MinProposerStake:      DefaultMinProposerStake,
 		DepositPeriodDays:     DefaultDepositPeriodDays,
+		// Voting modes
+		VotingModes:                        DefaultProposalVotingModes(),
+		MinQuadraticReputation:             DefaultMinQuadraticReputation,
+		ConvictionPeriodDays:               DefaultConvictionPeriodDays,
+		MaxConvictionMultiplierBasisPoints: DefaultMaxConvictionMultiplierBasisPoints,
+		// Execution timelock
+		TimelockDelays:       DefaultProposalTimelocks(),
+		DefaultTimelockHours: DefaultTimelockHours,
+		// Optimistic governance
+		OptimisticAllowlist:       []string{},
+		OptimisticChallengeHours:  DefaultOptimisticChallengeHours,
+		OptimisticVetoBasisPoints: DefaultOptimisticVetoBasisPoints,
 	}
 }
 
@@ -171,9 +306,139 @@ func (p ExtendedParams) Validate() error {
 	}
 	// Note: MinDeposit, ProposalFee, MinProposerStake can be 0 (disabled)
 
+	// Validate voting modes
+	seen := make(map[ProposalType]bool, len(p.VotingModes))
+	for _, vm := range p.VotingModes {
+		if seen[vm.ProposalType] {
+			return fmt.Errorf("duplicate voting mode for %s proposals", vm.ProposalType)
+		}
+		seen[vm.ProposalType] = true
+		switch vm.Mode {
+		case VotingModeStandard, VotingModeQuadratic, VotingModeConviction:
+		default:
+			return fmt.Errorf("invalid voting mode %d for %s proposals", vm.Mode, vm.ProposalType)
+		}
+		// Company proposals are voted by shareholders under their share classes
+		if vm.ProposalType == ProposalTypeCompanyGovernance && vm.Mode != VotingModeStandard {
+			return fmt.Errorf("company governance proposals vote in standard mode")
+		}
+		if vm.Mode == VotingModeConviction && (p.ConvictionPeriodDays == 0 || p.MaxConvictionMultiplierBasisPoints < 10000) {
+			return fmt.Errorf("conviction voting needs a positive conviction period and a multiplier of at least 1x")
+		}
+	}
+	if p.MinQuadraticReputation > 100 {
+		return fmt.Errorf("min quadratic reputation cannot exceed 100")
+	}
+
+	// Validate execution timelocks
+	if p.DefaultTimelockHours > MaxTimelockHours {
+		return fmt.Errorf("default timelock cannot exceed %d hours", MaxTimelockHours)
+	}
+	delayed := make(map[ProposalType]bool, len(p.TimelockDelays))
+	for _, tl := range p.TimelockDelays {
+		if delayed[tl.ProposalType] {
+			return fmt.Errorf("duplicate timelock for %s proposals", tl.ProposalType)
+		}
+		delayed[tl.ProposalType] = true
+		if !IsTimelockedProposalType(tl.ProposalType) {
+			return fmt.Errorf("%s proposals are not timelocked", tl.ProposalType)
+		}
+		if tl.DelayHours > MaxTimelockHours {
+			return fmt.Errorf("timelock for %s proposals cannot exceed %d hours", tl.ProposalType, MaxTimelockHours)
+		}
+	}
+
+	// Validate optimistic governance
+	allowed := make(map[string]bool, len(p.OptimisticAllowlist))
+	for _, typeURL := range p.OptimisticAllowlist {
+		if !strings.HasPrefix(typeURL, "/") {
+			return fmt.Errorf("optimistic allowlist entry %q is not a message type URL", typeURL)
+		}
+		if allowed[typeURL] {
+			return fmt.Errorf("duplicate optimistic allowlist entry %s", typeURL)
+		}
+		// Governance's own params, allowlist included, always go through a full vote
+		if strings.HasPrefix(typeURL, GovernanceMsgTypeURLPrefix) {
+			return fmt.Errorf("governance message %s cannot be allowlisted for optimistic governance", typeURL)
+		}
+		allowed[typeURL] = true
+	}
+	if len(p.OptimisticAllowlist) > 0 {
+		if p.OptimisticChallengeHours < MinOptimisticChallengeHours || p.OptimisticChallengeHours > MaxTimelockHours {
+			return fmt.Errorf("optimistic challenge window must be between %d and %d hours", MinOptimisticChallengeHours, MaxTimelockHours)
+		}
+		if p.OptimisticVetoBasisPoints == 0 || p.OptimisticVetoBasisPoints > 10000 {
+			return fmt.Errorf("optimistic veto threshold must be between 1 and 10000 basis points")
+		}
+	}
+
 	return nil
 }
 
+// IsOptimisticAllowed reports whether a message type may be proposed on the optimistic track
+func (p ExtendedParams) IsOptimisticAllowed(typeURL string) bool {
+	for _, allowed := range p.OptimisticAllowlist {
+		if allowed == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// GetOptimisticChallengePeriod returns how long an optimistic proposal is open to objections
+func (p ExtendedParams) GetOptimisticChallengePeriod() time.Duration {
+	return time.Duration(p.OptimisticChallengeHours) * time.Hour
+}
+
+// GetOptimisticVetoThreshold returns the objecting share of eligible stake that escalates to a vote
+func (p ExtendedParams) GetOptimisticVetoThreshold() math.LegacyDec {
+	return math.LegacyNewDec(int64(p.OptimisticVetoBasisPoints)).Quo(math.LegacyNewDec(10000))
+}
+
+// IsTimelockedProposalType reports whether passed proposals of a type wait out
+// a timelock before execution. Company proposals keep their own board and
+// execution delays. Emergency actions must run at once and are how a queued
+// proposal is vetoed by vote; they are exempt only because they can execute
+// nothing beyond EmergencyMessageTypeURLs.
+func IsTimelockedProposalType(proposalType ProposalType) bool {
+	switch proposalType {
+	case ProposalTypeCompanyGovernance, ProposalTypeEmergencyAction:
+		return false
+	default:
+		return true
+	}
+}
+
+// GetTimelockDelay returns the execution delay for a proposal type
+func (p ExtendedParams) GetTimelockDelay(proposalType ProposalType) time.Duration {
+	if !IsTimelockedProposalType(proposalType) {
+		return 0
+	}
+	hours := p.DefaultTimelockHours
+	for _, tl := range p.TimelockDelays {
+		if tl.ProposalType == proposalType {
+			hours = tl.DelayHours
+			break
+		}
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// GetVotingMode returns the voting mode for a proposal type
+func (p ExtendedParams) GetVotingMode(proposalType ProposalType) VotingMode {
+	for _, vm := range p.VotingModes {
+		if vm.ProposalType == proposalType {
+			return vm.Mode
+		}
+	}
+	return VotingModeStandard
+}
+
+// GetMaxConvictionMultiplier returns the full-conviction multiplier as a decimal
+func (p ExtendedParams) GetMaxConvictionMultiplier() math.LegacyDec {
+	return math.LegacyNewDec(int64(p.MaxConvictionMultiplierBasisPoints)).QuoInt64(10000)
+}
+
 // Helper methods to convert basis points to decimals
 
 // GetQuorum returns quorum as a decimal (e.g., 0.334 for 33.4%)
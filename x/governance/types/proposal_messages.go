@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxProposalMessages bounds the messages a single proposal may execute
+const MaxProposalMessages = 16
+
+// EmergencyMessageTypeURLs lists the only messages an emergency action may
+// execute. Emergency actions skip the timelock, so they are limited to
+// circuit breakers and halts that stop activity rather than change rules.
+var EmergencyMessageTypeURLs = []string{
+	"/sharehodl.extbridge.v1.MsgUpdateCircuitBreaker",
+	"/sharehodl.equity.v1.MsgSuspendListing",
+	"/cosmos.upgrade.v1beta1.MsgCancelUpgrade",
+}
+
+// IsEmergencyMessage reports whether an emergency action may execute a message type
+func IsEmergencyMessage(typeURL string) bool {
+	for _, allowed := range EmergencyMessageTypeURLs {
+		if typeURL == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// ProposalMessage is an Any-packed sdk.Msg executed with the governance authority as
+// signer when the proposal passes. It carries the same type URL and protobuf bytes as
+// codectypes.Any, in a form that stores as plain JSON.
+type ProposalMessage struct {
+	TypeURL string `json:"type_url"`
+	Value   []byte `json:"value"`
+}
+
+// NewProposalMessages packs messages for inclusion in a proposal
+func NewProposalMessages(msgs ...sdk.Msg) ([]ProposalMessage, error) {
+	packed := make([]ProposalMessage, 0, len(msgs))
+	for i, msg := range msgs {
+		packedAny, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, fmt.Errorf("message %d: %w", i, err)
+		}
+		packed = append(packed, ProposalMessage{TypeURL: packedAny.TypeUrl, Value: packedAny.Value})
+	}
+	return packed, nil
+}
+
+// ToAny returns the message as a codectypes.Any for unpacking
+func (m ProposalMessage) ToAny() *codectypes.Any {
+	return &codectypes.Any{TypeUrl: m.TypeURL, Value: m.Value}
+}
+
+// ValidateProposalMessagesBasic performs stateless checks on proposal messages
+func ValidateProposalMessagesBasic(proposalType ProposalType, msgs []ProposalMessage) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	if !proposalType.ExecutesMessages() {
+		return ErrInvalidProposalMsg.Wrapf("%s proposals do not execute messages", proposalType.String())
+	}
+	if len(msgs) > MaxProposalMessages {
+		return ErrInvalidProposalMsg.Wrapf("%d messages exceeds the maximum of %d", len(msgs), MaxProposalMessages)
+	}
+	for i, msg := range msgs {
+		if msg.TypeURL == "" {
+			return ErrInvalidProposalMsg.Wrapf("message %d has no type URL", i)
+		}
+		if proposalType == ProposalTypeEmergencyAction && !IsEmergencyMessage(msg.TypeURL) {
+			return ErrInvalidProposalMsg.Wrapf("message %d: emergency actions cannot execute %s", i, msg.TypeURL)
+		}
+	}
+	return nil
+}
+
+// ExecutesMessages reports whether passed proposals of this type execute their messages
+func (pt ProposalType) ExecutesMessages() bool {
+	switch pt {
+	case ProposalTypeParameterChange, ProposalTypeSoftwareUpgrade, ProposalTypeCommunityPoolSpend,
+		ProposalTypeEmergencyAction, ProposalTypeProtocolParameter, ProposalTypeProtocolUpgrade,
+		ProposalTypeTreasurySpend:
+		return true
+	default:
+		return false
+	}
+}
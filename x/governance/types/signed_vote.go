@@ -0,0 +1,184 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Signed vote limits
+const (
+	// SignedVoteDomain separates signed vote documents from anything else a
+	// holder's key signs, so a vote signature cannot be replayed as a transaction
+	SignedVoteDomain = "sharehodl/governance/signed-vote/v1"
+
+	// MaxSignedVotesPerBatch caps the votes and ballots a relayer submits at once
+	MaxSignedVotesPerBatch = 200
+
+	// SignedVoteVerifyGas is charged per signature verified, matching the ante
+	// handler's cost for a secp256k1 transaction signature
+	SignedVoteVerifyGas = 1000
+)
+
+// SignedVote is a holder's vote on a company proposal, signed off-chain and
+// submitted by any relayer. It counts at the holder's power in the proposal's
+// vote snapshot, so it is bound to the snapshot height the holder saw.
+type SignedVote struct {
+	Voter          string     `json:"voter"`
+	ProposalID     uint64     `json:"proposal_id"`
+	SnapshotHeight int64      `json:"snapshot_height"`
+	Option         VoteOption `json:"option"`
+	Reason         string     `json:"reason,omitempty"`
+	PubKey         []byte     `json:"pub_key"` // Compressed secp256k1 key of the voter's account
+	Signature      []byte     `json:"signature"`
+}
+
+// SignedMeetingBallot is a holder's ballot for a shareholder meeting, signed
+// off-chain and submitted by any relayer. It is bound to the meeting's record
+// height and counts the holder's record-date power like a ballot cast on-chain.
+type SignedMeetingBallot struct {
+	Voter        string       `json:"voter"`
+	MeetingID    uint64       `json:"meeting_id"`
+	RecordHeight int64        `json:"record_height"`
+	Votes        []AgendaVote `json:"votes"`
+	PubKey       []byte       `json:"pub_key"`
+	Signature    []byte       `json:"signature"`
+}
+
+// SignedVoteResult reports whether one vote or ballot in a batch was counted
+type SignedVoteResult struct {
+	Voter      string `json:"voter"`
+	ProposalID uint64 `json:"proposal_id,omitempty"`
+	MeetingID  uint64 `json:"meeting_id,omitempty"`
+	Accepted   bool   `json:"accepted"`
+	Error      string `json:"error,omitempty"`
+}
+
+// signedVoteDocument is the structure a holder signs for a proposal vote.
+// Integers are strings so wallets can render and sign it without precision loss.
+type signedVoteDocument struct {
+	Domain         string `json:"domain"`
+	ChainID        string `json:"chain_id"`
+	Voter          string `json:"voter"`
+	ProposalID     string `json:"proposal_id"`
+	SnapshotHeight string `json:"snapshot_height"`
+	Option         string `json:"option"`
+	Reason         string `json:"reason"`
+}
+
+// signedBallotDocument is the structure a holder signs for a meeting ballot
+type signedBallotDocument struct {
+	Domain       string       `json:"domain"`
+	ChainID      string       `json:"chain_id"`
+	Voter        string       `json:"voter"`
+	MeetingID    string       `json:"meeting_id"`
+	RecordHeight string       `json:"record_height"`
+	Votes        []AgendaVote `json:"votes"`
+}
+
+// SignBytes returns the canonical bytes the voter signs on a chain
+func (v SignedVote) SignBytes(chainID string) []byte {
+	bz, err := json.Marshal(signedVoteDocument{
+		Domain:         SignedVoteDomain,
+		ChainID:        chainID,
+		Voter:          v.Voter,
+		ProposalID:     fmt.Sprintf("%d", v.ProposalID),
+		SnapshotHeight: fmt.Sprintf("%d", v.SnapshotHeight),
+		Option:         v.Option.String(),
+		Reason:         v.Reason,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic checks the vote's fields without verifying its signature
+func (v SignedVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(v.Voter); err != nil {
+		return fmt.Errorf("invalid voter address: %v", err)
+	}
+	if v.ProposalID == 0 {
+		return fmt.Errorf("invalid proposal id")
+	}
+	if v.SnapshotHeight <= 0 {
+		return fmt.Errorf("invalid snapshot height")
+	}
+	if v.Option == VoteOptionEmpty || v.Option > VoteOptionNoWithVeto {
+		return fmt.Errorf("invalid vote option")
+	}
+	return validateSignatureFields(v.PubKey, v.Signature)
+}
+
+// VerifySignature checks the vote was signed by the voter's key for a chain
+func (v SignedVote) VerifySignature(chainID string) error {
+	return verifyVoterSignature(v.Voter, v.PubKey, v.Signature, v.SignBytes(chainID))
+}
+
+// SignBytes returns the canonical bytes the voter signs on a chain
+func (b SignedMeetingBallot) SignBytes(chainID string) []byte {
+	bz, err := json.Marshal(signedBallotDocument{
+		Domain:       SignedVoteDomain,
+		ChainID:      chainID,
+		Voter:        b.Voter,
+		MeetingID:    fmt.Sprintf("%d", b.MeetingID),
+		RecordHeight: fmt.Sprintf("%d", b.RecordHeight),
+		Votes:        b.Votes,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic checks the ballot's fields without verifying its signature
+func (b SignedMeetingBallot) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(b.Voter); err != nil {
+		return fmt.Errorf("invalid voter address: %v", err)
+	}
+	if b.MeetingID == 0 {
+		return fmt.Errorf("invalid meeting id")
+	}
+	if b.RecordHeight <= 0 {
+		return fmt.Errorf("invalid record height")
+	}
+	if len(b.Votes) == 0 {
+		return ErrInvalidMeetingBallot.Wrap("ballot is empty")
+	}
+	return validateSignatureFields(b.PubKey, b.Signature)
+}
+
+// VerifySignature checks the ballot was signed by the voter's key for a chain
+func (b SignedMeetingBallot) VerifySignature(chainID string) error {
+	return verifyVoterSignature(b.Voter, b.PubKey, b.Signature, b.SignBytes(chainID))
+}
+
+func validateSignatureFields(pubKey, signature []byte) error {
+	if len(pubKey) != secp256k1.PubKeySize {
+		return fmt.Errorf("public key must be a %d-byte compressed secp256k1 key", secp256k1.PubKeySize)
+	}
+	if len(signature) == 0 {
+		return fmt.Errorf("signature cannot be empty")
+	}
+	return nil
+}
+
+// verifyVoterSignature checks that the public key belongs to the voter's
+// address and produced the signature over the sign bytes. The key travels
+// with the vote because holders who only ever received shares have never
+// sent a transaction, so their accounts carry no public key on-chain.
+func verifyVoterSignature(voter string, pubKey, signature, signBytes []byte) error {
+	if err := validateSignatureFields(pubKey, signature); err != nil {
+		return ErrInvalidVoteSignature.Wrap(err.Error())
+	}
+	key := &secp256k1.PubKey{Key: pubKey}
+	if sdk.AccAddress(key.Address()).String() != voter {
+		return ErrInvalidVoteSignature.Wrap("public key does not belong to the voter")
+	}
+	if !key.VerifySignature(signBytes, signature) {
+		return ErrInvalidVoteSignature
+	}
+	return nil
+}
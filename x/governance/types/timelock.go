@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Guardian council limits
+const (
+	MinGuardianCouncilSize = 3  // Smallest council; no single guardian can veto alone
+	MaxGuardianCouncilSize = 15 // Largest council
+
+	// EmergencyVetoVotingPeriodDays is how long an emergency veto vote runs.
+	// Not governance-controllable: it must fit inside the shortest timelocks.
+	EmergencyVetoVotingPeriodDays uint32 = 3
+)
+
+// QueuedExecution is a passed proposal waiting out its execution timelock.
+// Until ExecuteAt the guardian council, or an emergency vote, may veto it.
+type QueuedExecution struct {
+	ProposalID   uint64       `json:"proposal_id"`
+	ProposalType ProposalType `json:"proposal_type"`
+	Title        string       `json:"title"`
+	QueuedAt     time.Time    `json:"queued_at"`
+	ExecuteAt    time.Time    `json:"execute_at"`
+
+	GuardianVetoes []string `json:"guardian_vetoes,omitempty"`  // Guardians who have signed a veto
+	VetoProposalID uint64   `json:"veto_proposal_id,omitempty"` // Emergency veto vote raised against this execution
+}
+
+// IsReady reports whether the timelock has elapsed
+func (q QueuedExecution) IsReady(now time.Time) bool {
+	return !now.Before(q.ExecuteAt)
+}
+
+// HasGuardianVeto reports whether a guardian has signed a veto
+func (q QueuedExecution) HasGuardianVeto(guardian string) bool {
+	for _, g := range q.GuardianVetoes {
+		if g == guardian {
+			return true
+		}
+	}
+	return false
+}
+
+// GuardianCouncil is the elected council that may veto queued executions.
+// A veto takes VetoThreshold guardian signatures.
+type GuardianCouncil struct {
+	Members       []string  `json:"members"`
+	VetoThreshold uint32    `json:"veto_threshold"`
+	ElectedAt     time.Time `json:"elected_at"`
+}
+
+// IsMember reports whether an address sits on the council
+func (c GuardianCouncil) IsMember(addr string) bool {
+	for _, m := range c.Members {
+		if m == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateGuardianCouncil checks a council's membership and veto threshold
+func ValidateGuardianCouncil(members []string, vetoThreshold uint32) error {
+	if len(members) < MinGuardianCouncilSize || len(members) > MaxGuardianCouncilSize {
+		return fmt.Errorf("council must have between %d and %d guardians", MinGuardianCouncilSize, MaxGuardianCouncilSize)
+	}
+	seen := make(map[string]bool, len(members))
+	for _, m := range members {
+		if _, err := sdk.AccAddressFromBech32(m); err != nil {
+			return fmt.Errorf("invalid guardian address %s: %v", m, err)
+		}
+		if seen[m] {
+			return fmt.Errorf("duplicate guardian %s", m)
+		}
+		seen[m] = true
+	}
+	// A veto needs a majority of the council
+	if int(vetoThreshold) <= len(members)/2 || int(vetoThreshold) > len(members) {
+		return fmt.Errorf("veto threshold must be a majority of the council, at most %d", len(members))
+	}
+	return nil
+}
@@ -345,6 +345,12 @@ func (k Keeper) ActivateLoan(ctx sdk.Context, loanID uint64, lender string) erro
 		return err
 	}
 
+	// COMPLIANCE: The lender takes the equity collateral on default, so it must be an
+	// eligible holder under the share class rules before the loan is funded
+	if err := k.checkCollateralSeizureCompliance(ctx, loan, lender); err != nil {
+		return err
+	}
+
 	// Transfer principal from lender to borrower
 	principalCoins := sdk.NewCoins(sdk.NewCoin("hodl", loan.Principal))
 	if err := k.bankKeeper.SendCoins(ctx, lenderAddr, borrowerAddr, principalCoins); err != nil {
@@ -493,6 +499,12 @@ func (k Keeper) LiquidateLoan(ctx sdk.Context, loanID uint64, liquidator string)
 		return err
 	}
 
+	// COMPLIANCE: The equity collateral is seized for the lender, so the share class
+	// rules are checked again in case they changed since the loan was funded
+	if err := k.checkCollateralSeizureCompliance(ctx, loan, loan.Lender); err != nil {
+		return err
+	}
+
 	// Calculate liquidation amounts
 	totalOwed := loan.TotalOwed.TruncateInt()
 	penalty := loan.CollateralValue.Mul(loan.LiquidationPenalty).TruncateInt()
@@ -814,6 +826,24 @@ func (k Keeper) isEquityCollateral(c types.Collateral) bool {
 	return c.Type == types.CollateralTypeEquity && c.CompanyID > 0 && c.ShareClass != ""
 }
 
+// checkCollateralSeizureCompliance verifies that the equity collateral of a loan could
+// be transferred from the borrower to the given recipient under the share class rules
+func (k Keeper) checkCollateralSeizureCompliance(ctx sdk.Context, loan types.Loan, recipient string) error {
+	if k.equityKeeper == nil {
+		return nil
+	}
+
+	for _, c := range loan.Collateral {
+		if !k.isEquityCollateral(c) {
+			continue
+		}
+		if err := k.equityKeeper.CheckTransferCompliance(ctx, c.CompanyID, c.ShareClass, loan.Borrower, recipient, c.Amount); err != nil {
+			return fmt.Errorf("collateral %s cannot pass to %s: %w", c.Denom, recipient, err)
+		}
+	}
+	return nil
+}
+
 // lockCollateral locks collateral in the module account
 func (k Keeper) lockCollateral(ctx sdk.Context, owner string, collateral []types.Collateral) error {
 	ownerAddr, err := sdk.AccAddressFromBech32(owner)
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/lending/types"
+)
+
+// AfterShareExchange moves equity collateral on open loans and loan requests from the
+// target to the acquirer after a merger in the equity module. Amounts round down,
+// matching the beneficial owner registry. The symbol balance held by the module
+// account has already been exchanged by the equity module, and any cash consideration
+// was paid to the borrower, so collateral may be worth less; the next health check
+// re-values it.
+func (k Keeper) AfterShareExchange(
+	ctx sdk.Context,
+	targetID uint64, targetClassID, targetSymbol string,
+	acquirerID uint64, acquirerClassID, acquirerSymbol string,
+	numerator, denominator uint64,
+) error {
+	if denominator == 0 {
+		return types.ErrInvalidCollateral.Wrap("exchange ratio denominator must be positive")
+	}
+	num := math.NewIntFromUint64(numerator)
+	den := math.NewIntFromUint64(denominator)
+
+	exchange := func(collateral []types.Collateral) bool {
+		changed := false
+		for i, c := range collateral {
+			if !k.isEquityCollateral(c) || c.CompanyID != targetID || c.ShareClass != targetClassID {
+				continue
+			}
+			collateral[i].CompanyID = acquirerID
+			collateral[i].ShareClass = acquirerClassID
+			collateral[i].Denom = acquirerSymbol
+			collateral[i].Amount = c.Amount.Mul(num).Quo(den)
+			changed = true
+		}
+		return changed
+	}
+
+	loansAdjusted := 0
+	for _, loan := range k.GetAllLoans(ctx) {
+		if loan.Status != types.LoanStatusPending && loan.Status != types.LoanStatusActive {
+			continue
+		}
+		if !exchange(loan.Collateral) {
+			continue
+		}
+		if err := k.SetLoan(ctx, loan); err != nil {
+			return err
+		}
+		loansAdjusted++
+	}
+
+	var requests []types.LoanRequest
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.LoanRequestPrefix).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.LoanRequest
+		if err := json.Unmarshal(iterator.Value(), &request); err != nil {
+			continue
+		}
+		if request.Active && exchange(request.Collateral) {
+			requests = append(requests, request)
+		}
+	}
+	iterator.Close()
+
+	for _, request := range requests {
+		if err := k.SetLoanRequest(ctx, request); err != nil {
+			return err
+		}
+	}
+
+	k.Logger(ctx).Info("moved equity collateral for merger",
+		"target_id", targetID,
+		"target_symbol", targetSymbol,
+		"acquirer_id", acquirerID,
+		"acquirer_symbol", acquirerSymbol,
+		"loans", loansAdjusted,
+		"loan_requests", len(requests),
+	)
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sharehodl/sharehodl-blockchain/x/lending/types"
+)
+
+// AfterShareSplit rescales equity collateral on open loans and loan requests after a
+// stock split in the equity module. Amounts round down, matching the beneficial owner
+// registry. Collateral value is in HODL and does not change; the symbol balance held
+// by the module account is rescaled by the equity module.
+func (k Keeper) AfterShareSplit(ctx sdk.Context, companyID uint64, classID, symbol string, numerator, denominator uint64) error {
+	if numerator == 0 || denominator == 0 {
+		return types.ErrInvalidCollateral.Wrap("split ratio terms must be positive")
+	}
+	num := math.NewIntFromUint64(numerator)
+	den := math.NewIntFromUint64(denominator)
+
+	rescale := func(collateral []types.Collateral) bool {
+		changed := false
+		for i, c := range collateral {
+			if !k.isEquityCollateral(c) || c.CompanyID != companyID || c.ShareClass != classID {
+				continue
+			}
+			collateral[i].Amount = c.Amount.Mul(num).Quo(den)
+			changed = true
+		}
+		return changed
+	}
+
+	loansAdjusted := 0
+	for _, loan := range k.GetAllLoans(ctx) {
+		if loan.Status != types.LoanStatusPending && loan.Status != types.LoanStatusActive {
+			continue
+		}
+		if !rescale(loan.Collateral) {
+			continue
+		}
+		if err := k.SetLoan(ctx, loan); err != nil {
+			return err
+		}
+		loansAdjusted++
+	}
+
+	var requests []types.LoanRequest
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.LoanRequestPrefix).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.LoanRequest
+		if err := json.Unmarshal(iterator.Value(), &request); err != nil {
+			continue
+		}
+		if request.Active && rescale(request.Collateral) {
+			requests = append(requests, request)
+		}
+	}
+	iterator.Close()
+
+	for _, request := range requests {
+		if err := k.SetLoanRequest(ctx, request); err != nil {
+			return err
+		}
+	}
+
+	k.Logger(ctx).Info("rescaled equity collateral for stock split",
+		"company_id", companyID,
+		"class_id", classID,
+		"symbol", symbol,
+		"loans", loansAdjusted,
+		"loan_requests", len(requests),
+	)
+
+	return nil
+}
@@ -30,6 +30,7 @@ type AccountKeeper interface {
 type EquityKeeper interface {
 	GetShareholding(ctx context.Context, companyID uint64, classID, holder string) (interface{}, bool)
 	TransferShares(ctx sdk.Context, companyID uint64, classID string, from, to string, shares math.Int) error
+	CheckTransferCompliance(ctx sdk.Context, companyID uint64, classID string, from, to string, shares math.Int) error
 	IsSymbolTaken(ctx context.Context, symbol string) bool
 	GetCompany(ctx context.Context, companyID uint64) (interface{}, bool)
 